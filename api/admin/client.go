@@ -142,3 +142,23 @@ func (c *Client) DBGet(ctx context.Context, key []byte, options ...rpc.Option) (
 	}
 	return formatting.Decode(formatting.HexNC, res.Value)
 }
+
+func (c *Client) GetConsensusState(ctx context.Context, chain string, options ...rpc.Option) (*GetConsensusStateReply, error) {
+	res := &GetConsensusStateReply{}
+	err := c.Requester.SendRequest(ctx, "admin.getConsensusState", &GetConsensusStateArgs{
+		Chain: chain,
+	}, res, options...)
+	return res, err
+}
+
+// GetSimplexEquivocations returns the stored evidence of validators of the
+// simplex chain [chain] that voted for conflicting blocks. If [nodeID] is
+// empty, the evidence of every validator is returned.
+func (c *Client) GetSimplexEquivocations(ctx context.Context, chain string, nodeID ids.NodeID, options ...rpc.Option) ([]SimplexEquivocation, error) {
+	res := &GetSimplexEquivocationsReply{}
+	err := c.Requester.SendRequest(ctx, "admin.getSimplexEquivocations", &GetSimplexEquivocationsArgs{
+		Chain:  chain,
+		NodeID: nodeID,
+	}, res, options...)
+	return res.Equivocations, err
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"path"
 	"sync"
+	"time"
 
 	"github.com/gorilla/rpc/v2"
 	"go.uber.org/zap"
@@ -19,6 +20,7 @@ import (
 	"github.com/ava-labs/avalanchego/database/rpcdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/bag"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/formatting"
 	"github.com/ava-labs/avalanchego/utils/json"
@@ -413,3 +415,184 @@ func (a *Admin) DbGet(_ *http.Request, args *DBGetArgs, reply *DBGetReply) error
 	reply.Value, err = formatting.Encode(formatting.HexNC, value)
 	return err
 }
+
+// GetConsensusStateArgs are the arguments for calling GetConsensusState
+type GetConsensusStateArgs struct {
+	Chain string `json:"chain"`
+}
+
+// ProcessingBlock is a block that is being processed by consensus
+type ProcessingBlock struct {
+	ID       ids.ID      `json:"id"`
+	ParentID ids.ID      `json:"parentID"`
+	Height   json.Uint64 `json:"height"`
+	// Preferred is true if the block is in the preferred chain
+	Preferred bool `json:"preferred"`
+	// Snowball is the confidence of the snowball instance that is deciding
+	// between the block and its siblings
+	Snowball string `json:"snowball"`
+}
+
+// OutstandingPoll is a poll that hasn't finished yet
+type OutstandingPoll struct {
+	RequestID json.Uint32            `json:"requestID"`
+	Start     time.Time              `json:"start"`
+	Responded []ids.NodeID           `json:"responded"`
+	Waiting   []ids.NodeID           `json:"waiting"`
+	Votes     map[ids.ID]json.Uint64 `json:"votes"`
+}
+
+// FinishedPoll is the outcome of a poll that was applied to consensus
+type FinishedPoll struct {
+	Finished           time.Time              `json:"finished"`
+	Votes              map[ids.ID]json.Uint64 `json:"votes"`
+	Preference         ids.ID                 `json:"preference"`
+	LastAcceptedHeight json.Uint64            `json:"lastAcceptedHeight"`
+}
+
+// GetConsensusStateReply is a snapshot of the consensus engine of a chain
+type GetConsensusStateReply struct {
+	Preference         ids.ID            `json:"preference"`
+	LastAcceptedID     ids.ID            `json:"lastAcceptedID"`
+	LastAcceptedHeight json.Uint64       `json:"lastAcceptedHeight"`
+	Processing         []ProcessingBlock `json:"processing"`
+	Polls              []OutstandingPoll `json:"polls"`
+	RecentPolls        []FinishedPoll    `json:"recentPolls"`
+}
+
+// GetConsensusState returns the blocks being processed, the outstanding polls,
+// and the recently finished polls of a snowman chain
+func (a *Admin) GetConsensusState(_ *http.Request, args *GetConsensusStateArgs, reply *GetConsensusStateReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "getConsensusState"),
+		logging.UserString("chain", args.Chain),
+	)
+
+	chainID, err := a.ChainManager.Lookup(args.Chain)
+	if err != nil {
+		return err
+	}
+
+	state, err := a.ChainManager.ConsensusState(chainID)
+	if err != nil {
+		return err
+	}
+
+	reply.Preference = state.Preference
+	reply.LastAcceptedID = state.LastAcceptedID
+	reply.LastAcceptedHeight = json.Uint64(state.LastAcceptedHeight)
+	reply.Processing = make([]ProcessingBlock, len(state.Processing))
+	for i, blk := range state.Processing {
+		reply.Processing[i] = ProcessingBlock{
+			ID:        blk.ID,
+			ParentID:  blk.ParentID,
+			Height:    json.Uint64(blk.Height),
+			Preferred: blk.Preferred,
+			Snowball:  blk.Snowball,
+		}
+	}
+	reply.Polls = make([]OutstandingPoll, len(state.Polls))
+	for i, poll := range state.Polls {
+		reply.Polls[i] = OutstandingPoll{
+			RequestID: json.Uint32(poll.RequestID),
+			Start:     poll.Start,
+			Responded: poll.Responded,
+			Waiting:   poll.Waiting,
+			Votes:     voteCounts(poll.Votes),
+		}
+	}
+	reply.RecentPolls = make([]FinishedPoll, len(state.RecentPolls))
+	for i, poll := range state.RecentPolls {
+		reply.RecentPolls[i] = FinishedPoll{
+			Finished:           poll.Finished,
+			Votes:              voteCounts(poll.Votes),
+			Preference:         poll.Preference,
+			LastAcceptedHeight: json.Uint64(poll.LastAcceptedHeight),
+		}
+	}
+	return nil
+}
+
+// GetSimplexEquivocationsArgs are the arguments for calling
+// GetSimplexEquivocations
+type GetSimplexEquivocationsArgs struct {
+	Chain string `json:"chain"`
+	// NodeID filters the evidence by validator. If empty, the evidence of every
+	// validator is returned.
+	NodeID ids.NodeID `json:"nodeID"`
+}
+
+// SimplexSignedHeader is a block header and the signature of a vote for it
+type SimplexSignedHeader struct {
+	Header    string `json:"header"`
+	Signature string `json:"signature"`
+}
+
+// SimplexEquivocation is evidence that a validator voted for two different
+// blocks in the same round
+type SimplexEquivocation struct {
+	NodeID   ids.NodeID            `json:"nodeID"`
+	Epoch    json.Uint64           `json:"epoch"`
+	Round    json.Uint64           `json:"round"`
+	Finalize bool                  `json:"finalize"`
+	Headers  []SimplexSignedHeader `json:"headers"`
+}
+
+// GetSimplexEquivocationsReply are the results from calling
+// GetSimplexEquivocations
+type GetSimplexEquivocationsReply struct {
+	Equivocations []SimplexEquivocation `json:"equivocations"`
+}
+
+// GetSimplexEquivocations returns the stored evidence of validators of a
+// simplex chain that voted for conflicting blocks in the same round
+func (a *Admin) GetSimplexEquivocations(_ *http.Request, args *GetSimplexEquivocationsArgs, reply *GetSimplexEquivocationsReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "getSimplexEquivocations"),
+		logging.UserString("chain", args.Chain),
+		zap.Stringer("nodeID", args.NodeID),
+	)
+
+	chainID, err := a.ChainManager.Lookup(args.Chain)
+	if err != nil {
+		return err
+	}
+
+	equivocations, err := a.ChainManager.SimplexEquivocations(chainID, args.NodeID)
+	if err != nil {
+		return err
+	}
+
+	reply.Equivocations = make([]SimplexEquivocation, len(equivocations))
+	for i, equivocation := range equivocations {
+		headers := make([]SimplexSignedHeader, len(equivocation.Headers))
+		for j, header := range equivocation.Headers {
+			headers[j].Header, err = formatting.Encode(formatting.HexNC, header.Header)
+			if err != nil {
+				return err
+			}
+			headers[j].Signature, err = formatting.Encode(formatting.HexNC, header.Signature)
+			if err != nil {
+				return err
+			}
+		}
+		reply.Equivocations[i] = SimplexEquivocation{
+			NodeID:   equivocation.Signer,
+			Epoch:    json.Uint64(equivocation.Epoch),
+			Round:    json.Uint64(equivocation.Round),
+			Finalize: equivocation.Finalize,
+			Headers:  headers,
+		}
+	}
+	return nil
+}
+
+func voteCounts(votes bag.Bag[ids.ID]) map[ids.ID]json.Uint64 {
+	counts := make(map[ids.ID]json.Uint64, votes.Len())
+	for _, blkID := range votes.List() {
+		counts[blkID] = json.Uint64(votes.Count(blkID))
+	}
+	return counts
+}
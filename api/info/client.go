@@ -96,6 +96,12 @@ func (c *Client) GetVMs(ctx context.Context, options ...rpc.Option) (map[ids.ID]
 	return res.VMs, err
 }
 
+func (c *Client) GetBandwidthUsage(ctx context.Context, options ...rpc.Option) ([]ChainBandwidthUsage, error) {
+	res := &GetBandwidthUsageReply{}
+	err := c.Requester.SendRequest(ctx, "info.getBandwidthUsage", struct{}{}, res, options...)
+	return res.Chains, err
+}
+
 // AwaitBootstrapped polls the node every [freq] to check if [chainID] has
 // finished bootstrapping. Returns true once [chainID] reports that it has
 // finished bootstrapping.
@@ -6,8 +6,10 @@ package info
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"net/http"
 	"net/netip"
+	"slices"
 
 	"github.com/gorilla/rpc/v2"
 	"go.uber.org/zap"
@@ -16,6 +18,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/upgrade"
@@ -75,6 +78,7 @@ type Info struct {
 	chainManager chains.Manager
 	vmManager    vms.Manager
 	benchlist    benchlist.Manager
+	bandwidth    throttling.SubnetBandwidthThrottler
 }
 
 type Parameters struct {
@@ -98,6 +102,7 @@ func NewService(
 	myIP *utils.Atomic[netip.AddrPort],
 	network network.Network,
 	benchlist benchlist.Manager,
+	bandwidth throttling.SubnetBandwidthThrottler,
 ) (http.Handler, error) {
 	server := rpc.NewServer()
 	codec := json.NewCodec()
@@ -113,6 +118,7 @@ func NewService(
 			myIP:         myIP,
 			networking:   network,
 			benchlist:    benchlist,
+			bandwidth:    bandwidth,
 		},
 		"info",
 	)
@@ -479,3 +485,47 @@ func (i *Info) GetVMs(_ *http.Request, _ *struct{}, reply *GetVMsReply) error {
 	}
 	return err
 }
+
+// ChainBandwidthUsage is the bandwidth consumed on behalf of a chain since the
+// node started
+type ChainBandwidthUsage struct {
+	ChainID  ids.ID `json:"chainID"`
+	SubnetID ids.ID `json:"subnetID"`
+	// Bytes received that were handed to the chain
+	BytesReceived json.Uint64 `json:"bytesReceived"`
+	// Bytes sent on behalf of the chain
+	BytesSent json.Uint64 `json:"bytesSent"`
+	// Bytes received that were dropped due to the subnet's bandwidth quota
+	BytesReceivedDropped json.Uint64 `json:"bytesReceivedDropped"`
+	// Bytes that weren't sent due to the subnet's bandwidth quota
+	BytesSentDropped json.Uint64 `json:"bytesSentDropped"`
+}
+
+// GetBandwidthUsageReply are the results from calling GetBandwidthUsage
+type GetBandwidthUsageReply struct {
+	Chains []ChainBandwidthUsage `json:"chains"`
+}
+
+// GetBandwidthUsage returns the bandwidth consumed on behalf of each chain,
+// sorted by chain ID.
+func (i *Info) GetBandwidthUsage(_ *http.Request, _ *struct{}, reply *GetBandwidthUsageReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "getBandwidthUsage"),
+	)
+
+	usage := i.bandwidth.Usage()
+	reply.Chains = make([]ChainBandwidthUsage, 0, len(usage))
+	for _, chainID := range slices.SortedFunc(maps.Keys(usage), ids.ID.Compare) {
+		chainUsage := usage[chainID]
+		reply.Chains = append(reply.Chains, ChainBandwidthUsage{
+			ChainID:              chainID,
+			SubnetID:             chainUsage.SubnetID,
+			BytesReceived:        json.Uint64(chainUsage.BytesReceived),
+			BytesSent:            json.Uint64(chainUsage.BytesSent),
+			BytesReceivedDropped: json.Uint64(chainUsage.BytesReceivedDropped),
+			BytesSentDropped:     json.Uint64(chainUsage.BytesSentDropped),
+		})
+	}
+	return nil
+}
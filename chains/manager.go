@@ -27,6 +27,7 @@ import (
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/simplex"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/snow/engine/avalanche/bootstrap/queue"
@@ -59,6 +60,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
 	"github.com/ava-labs/avalanchego/vms/propertyfx"
 	"github.com/ava-labs/avalanchego/vms/proposervm"
+	"github.com/ava-labs/avalanchego/vms/proposervm/proposer"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 	"github.com/ava-labs/avalanchego/vms/tracedvm"
 
@@ -71,6 +73,7 @@ import (
 	smbootstrap "github.com/ava-labs/avalanchego/snow/engine/snowman/bootstrap"
 	snowgetter "github.com/ava-labs/avalanchego/snow/engine/snowman/getter"
 	timetracker "github.com/ava-labs/avalanchego/snow/networking/tracker"
+	simplexwal "github.com/ava-labs/simplex/wal"
 )
 
 const (
@@ -79,6 +82,12 @@ const (
 	defaultChannelSize = 1
 	initialQueueSize   = 3
 
+	// consensusStateLockTimeout is the maximum amount of time to wait for a
+	// chain's lock when introspecting its consensus engine. A chain that is
+	// stalled while holding its lock is reported as busy rather than blocking
+	// the caller.
+	consensusStateLockTimeout = 5 * time.Second
+
 	avalancheNamespace    = constants.PlatformName + metric.NamespaceSeparator + "avalanche"
 	handlerNamespace      = constants.PlatformName + metric.NamespaceSeparator + "handler"
 	meterchainvmNamespace = constants.PlatformName + metric.NamespaceSeparator + "meterchainvm"
@@ -102,10 +111,24 @@ var (
 	// Bootstrapping prefixes for ChainVMs
 	ChainBootstrappingDBPrefix = []byte("interval_bs")
 
+	// Prefix for the checkpoint that ChainVMs state synced to
+	ChainStateSyncDBPrefix = []byte("state_sync")
+
+	// Prefix for the finalizations of chains running Simplex
+	SimplexDBPrefix = []byte("simplex")
+	// Prefix for the evidence of validators equivocating on chains running
+	// Simplex
+	SimplexEvidenceDBPrefix = []byte("simplex_evidence")
+
 	errUnknownVMType           = errors.New("the vm should have type avalanche.DAGVM or snowman.ChainVM")
 	errCreatePlatformVM        = errors.New("attempted to create a chain running the PlatformVM")
+	errSimplexPrimaryNetwork   = errors.New("the primary network can't run simplex")
 	errNotBootstrapped         = errors.New("subnets not bootstrapped")
 	errPartialSyncAsAValidator = errors.New("partial sync should not be configured for a validator")
+	errUnknownChain            = errors.New("unknown chain")
+	errNotSnowmanChain         = errors.New("chain doesn't run the snowman engine")
+	errNotSimplexChain         = errors.New("chain doesn't run the simplex engine")
+	errChainBusy               = errors.New("chain busy")
 
 	fxs = map[ids.ID]fx.Factory{
 		secp256k1fx.ID: &secp256k1fx.Factory{},
@@ -144,6 +167,14 @@ type Manager interface {
 	// Returns true iff the chain with the given ID exists and is finished bootstrapping
 	IsBootstrapped(ids.ID) bool
 
+	// Returns a snapshot of the snowman engine of the chain with the given ID
+	ConsensusState(ids.ID) (smeng.State, error)
+
+	// Returns the stored evidence of the validators of the simplex chain with
+	// the given ID that voted for conflicting blocks. If the node ID is empty,
+	// the evidence of every validator is returned.
+	SimplexEquivocations(ids.ID, ids.NodeID) ([]*simplex.Equivocation, error)
+
 	// Starts the chain creator with the initial platform chain parameters, must
 	// be called once.
 	StartChainCreator(platformChain ChainParameters) error
@@ -172,14 +203,24 @@ type chain struct {
 	Context *snow.ConsensusContext
 	VM      common.VM
 	Handler handler.Handler
+	// Engine is the snowman engine of the chain, or nil if the chain doesn't
+	// run the snowman engine.
+	Engine *smeng.Engine
+	// SimplexEngine is the simplex engine of the chain, or nil if the chain
+	// doesn't run the simplex engine.
+	SimplexEngine *simplex.Engine
 }
 
 // ChainConfig is configuration settings for the current execution.
 // [Config] is the user-provided config blob for the chain.
 // [Upgrade] is a chain-specific blob for coordinating upgrades.
+// [Checkpoint] is an optional warp message, signed by the chain's validators,
+// that the chain state syncs to instead of the summaries of the beacons, or
+// [syncer.CheckpointFromBeacons] to fetch the checkpoint from the beacons.
 type ChainConfig struct {
-	Config  []byte
-	Upgrade []byte
+	Config     []byte
+	Upgrade    []byte
+	Checkpoint []byte
 }
 
 type ManagerConfig struct {
@@ -232,6 +273,13 @@ type ManagerConfig struct {
 	// This node will only consider the first [AncestorsMaxContainersReceived]
 	// containers in an ancestors message it receives.
 	BootstrapAncestorsMaxContainersReceived int
+	// Max number of GetAncestors requests outstanding at once. If 0, the
+	// number of requests is unlimited.
+	BootstrapMaxOutstandingRequests int
+	// Number of goroutines parsing fetched blocks ahead of their execution,
+	// for the chains whose VM supports concurrent parsing. If 0, blocks are
+	// parsed when they are executed.
+	BootstrapParseWorkers int
 
 	Upgrades upgrade.Config
 
@@ -267,6 +315,12 @@ type manager struct {
 	// Key: Chain's ID
 	// Value: The chain
 	chains map[ids.ID]handler.Handler
+	// Key: Chain's ID
+	// Value: The chain's snowman engine
+	snowmanEngines map[ids.ID]*smeng.Engine
+	// Key: Chain's ID
+	// Value: The chain's simplex engine
+	simplexEngines map[ids.ID]*simplex.Engine
 
 	// snowman++ related interface to allow validators retrieval
 	validatorState validators.State
@@ -328,6 +382,8 @@ func New(config *ManagerConfig) (Manager, error) {
 		Aliaser:                ids.NewAliaser(),
 		ManagerConfig:          *config,
 		chains:                 make(map[ids.ID]handler.Handler),
+		snowmanEngines:         make(map[ids.ID]*smeng.Engine),
+		simplexEngines:         make(map[ids.ID]*simplex.Engine),
 		chainsQueue:            buffer.NewUnboundedBlockingDeque[ChainParameters](initialQueueSize),
 		unblockChainCreatorCh:  make(chan struct{}),
 		chainCreatorShutdownCh: make(chan struct{}),
@@ -434,6 +490,12 @@ func (m *manager) createChain(chainParams ChainParameters) {
 
 	m.chainsLock.Lock()
 	m.chains[chainParams.ID] = chain.Handler
+	if chain.Engine != nil {
+		m.snowmanEngines[chainParams.ID] = chain.Engine
+	}
+	if chain.SimplexEngine != nil {
+		m.simplexEngines[chainParams.ID] = chain.SimplexEngine
+	}
 	m.chainsLock.Unlock()
 
 	// Associate the newly created chain with its default alias
@@ -561,6 +623,25 @@ func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*c
 			return nil, fmt.Errorf("error while creating new avalanche vm %w", err)
 		}
 	case block.ChainVM:
+		if sb.Config().Consensus == subnets.SimplexConsensus {
+			if chainParams.SubnetID == constants.PrimaryNetworkID {
+				return nil, errSimplexPrimaryNetwork
+			}
+
+			chain, err = m.createSimplexChain(
+				ctx,
+				chainParams.GenesisData,
+				m.Validators,
+				vm,
+				chainFxs,
+				sb,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error while creating new simplex vm %w", err)
+			}
+			break
+		}
+
 		beacons := m.Validators
 		if chainParams.ID == constants.PlatformChainID {
 			beacons = chainParams.CustomBeacons
@@ -751,12 +832,16 @@ func (m *manager) createAvalancheChain(
 		subnetCfg           = m.SubnetConfigs[ctx.SubnetID]
 		minBlockDelay       = subnetCfg.ProposerMinBlockDelay
 		numHistoricalBlocks = subnetCfg.ProposerNumHistoricalBlocks
+		proposerSelection   = subnetCfg.ProposerSelection
+		selectionHeight     = subnetCfg.ProposerSelectionPChainHeight
 	)
 	m.Log.Info("creating proposervm wrapper",
 		zap.Time("activationTime", m.Upgrades.ApricotPhase4Time),
 		zap.Uint64("minPChainHeight", m.Upgrades.ApricotPhase4MinPChainHeight),
 		zap.Duration("minBlockDelay", minBlockDelay),
 		zap.Uint64("numHistoricalBlocks", numHistoricalBlocks),
+		zap.String("proposerSelection", proposerSelection),
+		zap.Uint64("proposerSelectionPChainHeight", selectionHeight),
 	)
 
 	// Note: this does not use [dagVM] to ensure we use the [vm]'s height index.
@@ -783,7 +868,9 @@ func (m *manager) createAvalancheChain(
 			NumHistoricalBlocks: numHistoricalBlocks,
 			StakingLeafSigner:   m.StakingTLSSigner,
 			StakingCertLeaf:     m.StakingTLSCert,
+			StakingBLSSigner:    m.StakingBLSKey,
 			Registerer:          proposervmReg,
+			NewWindower:         newWindower(proposerSelection, selectionHeight),
 		},
 	)
 
@@ -912,6 +999,7 @@ func (m *manager) createAvalancheChain(
 		ctx.Log,
 		m.BootstrapMaxTimeGetAncestors,
 		m.BootstrapAncestorsMaxContainersSent,
+		nil,
 		ctx.Registerer,
 	)
 	if err != nil {
@@ -934,12 +1022,13 @@ func (m *manager) createAvalancheChain(
 		ConnectedValidators: connectedValidators,
 		Params:              consensusParams,
 		Consensus:           snowmanConsensus,
+		Timeouts:            m.TimeoutManager,
 	}
-	var snowmanEngine common.Engine
-	snowmanEngine, err = smeng.New(snowmanEngineConfig)
+	smEngine, err := smeng.New(snowmanEngineConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing snowman engine: %w", err)
 	}
+	var snowmanEngine common.Engine = smEngine
 
 	if m.TracingEnabled {
 		snowmanEngine = common.TraceEngine(snowmanEngine, m.Tracer)
@@ -958,6 +1047,8 @@ func (m *manager) createAvalancheChain(
 		BootstrapTracker:               sb,
 		PeerTracker:                    peerTracker,
 		AncestorsMaxContainersReceived: m.BootstrapAncestorsMaxContainersReceived,
+		MaxOutstandingRequests:         m.BootstrapMaxOutstandingRequests,
+		ParseWorkers:                   m.bootstrapParseWorkers(vm),
 		DB:                             blockBootstrappingDB,
 		VM:                             vmWrappingProposerVM,
 	}
@@ -1047,6 +1138,7 @@ func (m *manager) createAvalancheChain(
 		Context: ctx,
 		VM:      dagVM,
 		Handler: h,
+		Engine:  smEngine,
 	}, nil
 }
 
@@ -1085,6 +1177,7 @@ func (m *manager) createSnowmanChain(
 	prefixDB := prefixdb.New(ctx.ChainID[:], meterDB)
 	vmDB := prefixdb.New(VMDBPrefix, prefixDB)
 	bootstrappingDB := prefixdb.New(ChainBootstrappingDBPrefix, prefixDB)
+	stateSyncDB := prefixdb.New(ChainStateSyncDBPrefix, prefixDB)
 
 	// Passes messages from the consensus engine to the network
 	messageSender, err := sender.New(
@@ -1164,12 +1257,16 @@ func (m *manager) createSnowmanChain(
 		subnetCfg           = m.SubnetConfigs[ctx.SubnetID]
 		minBlockDelay       = subnetCfg.ProposerMinBlockDelay
 		numHistoricalBlocks = subnetCfg.ProposerNumHistoricalBlocks
+		proposerSelection   = subnetCfg.ProposerSelection
+		selectionHeight     = subnetCfg.ProposerSelectionPChainHeight
 	)
 	m.Log.Info("creating proposervm wrapper",
 		zap.Time("activationTime", m.Upgrades.ApricotPhase4Time),
 		zap.Uint64("minPChainHeight", m.Upgrades.ApricotPhase4MinPChainHeight),
 		zap.Duration("minBlockDelay", minBlockDelay),
 		zap.Uint64("numHistoricalBlocks", numHistoricalBlocks),
+		zap.String("proposerSelection", proposerSelection),
+		zap.Uint64("proposerSelectionPChainHeight", selectionHeight),
 	)
 
 	if m.TracingEnabled {
@@ -1192,7 +1289,9 @@ func (m *manager) createSnowmanChain(
 			NumHistoricalBlocks: numHistoricalBlocks,
 			StakingLeafSigner:   m.StakingTLSSigner,
 			StakingCertLeaf:     m.StakingTLSCert,
+			StakingBLSSigner:    m.StakingBLSKey,
 			Registerer:          proposervmReg,
+			NewWindower:         newWindower(proposerSelection, selectionHeight),
 		},
 	)
 
@@ -1308,12 +1407,18 @@ func (m *manager) createSnowmanChain(
 	startupTracker := tracker.NewStartup(connectedBeacons, (3*bootstrapWeight+3)/4)
 	beacons.RegisterSetCallbackListener(ctx.SubnetID, startupTracker)
 
+	checkpoint, err := syncer.CheckpointToServe(chainConfig.Checkpoint, stateSyncDB)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get checkpoint: %w", err)
+	}
+
 	snowGetHandler, err := snowgetter.New(
 		vm,
 		messageSender,
 		ctx.Log,
 		m.BootstrapMaxTimeGetAncestors,
 		m.BootstrapAncestorsMaxContainersSent,
+		checkpoint,
 		ctx.Registerer,
 	)
 	if err != nil {
@@ -1337,12 +1442,13 @@ func (m *manager) createSnowmanChain(
 		Params:              consensusParams,
 		Consensus:           consensus,
 		PartialSync:         m.PartialSyncPrimaryNetwork && ctx.ChainID == constants.PlatformChainID,
+		Timeouts:            m.TimeoutManager,
 	}
-	var engine common.Engine
-	engine, err = smeng.New(engineConfig)
+	smEngine, err := smeng.New(engineConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing snowman engine: %w", err)
 	}
+	var engine common.Engine = smEngine
 
 	if m.TracingEnabled {
 		engine = common.TraceEngine(engine, m.Tracer)
@@ -1361,6 +1467,8 @@ func (m *manager) createSnowmanChain(
 		BootstrapTracker:               sb,
 		PeerTracker:                    peerTracker,
 		AncestorsMaxContainersReceived: m.BootstrapAncestorsMaxContainersReceived,
+		MaxOutstandingRequests:         m.BootstrapMaxOutstandingRequests,
+		ParseWorkers:                   m.bootstrapParseWorkers(vm),
 		DB:                             bootstrappingDB,
 		VM:                             vm,
 		Bootstrapped:                   bootstrapFunc,
@@ -1388,6 +1496,8 @@ func (m *manager) createSnowmanChain(
 		sampleK,
 		bootstrapWeight/2+1, // must be > 50%
 		m.StateSyncBeacons,
+		chainConfig.Checkpoint,
+		stateSyncDB,
 		vm,
 	)
 	if err != nil {
@@ -1421,6 +1531,233 @@ func (m *manager) createSnowmanChain(
 		Context: ctx,
 		VM:      vm,
 		Handler: h,
+		Engine:  smEngine,
+	}, nil
+}
+
+// createSimplexChain creates a linear chain that runs the Simplex consensus
+// protocol. The chain's validator set is the subnet's validator set when the
+// chain is created.
+func (m *manager) createSimplexChain(
+	ctx *snow.ConsensusContext,
+	genesisData []byte,
+	vdrs validators.Manager,
+	vm block.ChainVM,
+	fxs []*common.Fx,
+	sb subnets.Subnet,
+) (*chain, error) {
+	ctx.Lock.Lock()
+	defer ctx.Lock.Unlock()
+
+	ctx.State.Set(snow.EngineState{
+		Type:  p2ppb.EngineType_ENGINE_TYPE_CHAIN,
+		State: snow.Initializing,
+	})
+
+	primaryAlias := m.PrimaryAliasOrDefault(ctx.ChainID)
+	meterDBReg, err := metrics.MakeAndRegister(
+		m.MeterDBMetrics,
+		primaryAlias,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	meterDB, err := meterdb.New(meterDBReg, m.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixDB := prefixdb.New(ctx.ChainID[:], meterDB)
+	vmDB := prefixdb.New(VMDBPrefix, prefixDB)
+	simplexDB := prefixdb.New(SimplexDBPrefix, prefixDB)
+	simplexEvidenceDB := prefixdb.New(SimplexEvidenceDBPrefix, prefixDB)
+
+	// Passes app messages from the VM to the network
+	messageSender, err := sender.New(
+		ctx,
+		m.MsgCreator,
+		m.Net,
+		m.ManagerConfig.Router,
+		m.TimeoutManager,
+		p2ppb.EngineType_ENGINE_TYPE_CHAIN,
+		sb,
+		ctx.Registerer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't initialize sender: %w", err)
+	}
+
+	if m.TracingEnabled {
+		messageSender = sender.Trace(messageSender, m.Tracer)
+	}
+
+	chainConfig, err := m.getChainConfig(ctx.ChainID)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching chain config: %w", err)
+	}
+
+	// Simplex elects its own block proposers, so the VM isn't wrapped in the
+	// proposervm.
+	if m.MeterVMEnabled {
+		meterchainvmReg, err := metrics.MakeAndRegister(
+			m.meterChainVMGatherer,
+			primaryAlias,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		vm = metervm.NewBlockVM(vm, meterchainvmReg)
+	}
+	if m.TracingEnabled {
+		vm = tracedvm.NewBlockVM(vm, primaryAlias, m.Tracer)
+	}
+
+	cn := &block.ChangeNotifier{
+		ChainVM: vm,
+	}
+	vm = cn
+
+	if err := vm.Initialize(
+		context.TODO(),
+		ctx.Context,
+		vmDB,
+		genesisData,
+		chainConfig.Upgrade,
+		chainConfig.Config,
+		fxs,
+		messageSender,
+	); err != nil {
+		return nil, err
+	}
+
+	stakeReg, err := metrics.MakeAndRegister(
+		m.stakeGatherer,
+		primaryAlias,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	connectedValidators, err := tracker.NewMeteredPeers(stakeReg)
+	if err != nil {
+		return nil, fmt.Errorf("error creating peer tracker: %w", err)
+	}
+	vdrs.RegisterSetCallbackListener(ctx.SubnetID, connectedValidators)
+
+	p2pReg, err := metrics.MakeAndRegister(
+		m.p2pGatherer,
+		primaryAlias,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	peerTracker, err := p2p.NewPeerTracker(
+		ctx.Log,
+		"peer_tracker",
+		p2pReg,
+		set.Of(ctx.NodeID),
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating peer tracker: %w", err)
+	}
+
+	handlerReg, err := metrics.MakeAndRegister(
+		m.handlerGatherer,
+		primaryAlias,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var halter common.Halter
+
+	// Asynchronously passes messages from the network to the consensus engine
+	h, err := handler.New(
+		ctx,
+		cn,
+		vm.WaitForEvent,
+		vdrs,
+		m.FrontierPollFrequency,
+		m.ConsensusAppConcurrency,
+		m.ResourceTracker,
+		sb,
+		connectedValidators,
+		peerTracker,
+		handlerReg,
+		halter.Halt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't initialize message handler: %w", err)
+	}
+
+	snowGetHandler, err := snowgetter.New(
+		vm,
+		messageSender,
+		ctx.Log,
+		m.BootstrapMaxTimeGetAncestors,
+		m.BootstrapAncestorsMaxContainersSent,
+		nil,
+		ctx.Registerer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't initialize snow base message handler: %w", err)
+	}
+
+	wal, err := simplexwal.New(filepath.Join(ctx.ChainDataDir, "simplex.wal"))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't open simplex WAL: %w", err)
+	}
+
+	engine, err := simplex.NewEngine(&simplex.Config{
+		Ctx: simplex.SimplexChainContext{
+			NodeID:    ctx.NodeID,
+			ChainID:   ctx.ChainID,
+			SubnetID:  ctx.SubnetID,
+			NetworkID: ctx.NetworkID,
+		},
+		Log:                 ctx.Log,
+		Sender:              m.Net,
+		OutboundMsgBuilder:  m.MsgCreator,
+		GenesisPChainHeight: sb.Config().SimplexGenesisPChainHeight,
+		ValidatorState:      ctx.ValidatorState,
+		VM:                  vm,
+		DB:                  simplexDB,
+		SignBLS:             m.StakingBLSKey.Sign,
+		Lock:                &ctx.Lock,
+		State:               &ctx.State,
+		BootstrapTracker:    sb,
+		AllGetsServer:       snowGetHandler,
+		WAL:                 wal,
+		EvidenceDB:          simplexEvidenceDB,
+		Registerer:          ctx.Registerer,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error initializing simplex engine: %w", err)
+	}
+
+	h.SetEngineManager(&handler.EngineManager{
+		DAG: nil,
+		Chain: &handler.Engine{
+			Bootstrapper: engine,
+			Consensus:    engine,
+		},
+	})
+
+	// Register health checks
+	if err := m.Health.RegisterHealthCheck(primaryAlias, h, ctx.SubnetID.String()); err != nil {
+		return nil, fmt.Errorf("couldn't add health check for chain %s: %w", primaryAlias, err)
+	}
+
+	return &chain{
+		Name:          primaryAlias,
+		Context:       ctx,
+		VM:            vm,
+		Handler:       h,
+		SimplexEngine: engine,
 	}, nil
 }
 
@@ -1435,6 +1772,73 @@ func (m *manager) IsBootstrapped(id ids.ID) bool {
 	return chain.Context().State.Get().State == snow.NormalOp
 }
 
+func (m *manager) ConsensusState(id ids.ID) (smeng.State, error) {
+	m.chainsLock.Lock()
+	chain, exists := m.chains[id]
+	engine, isSnowman := m.snowmanEngines[id]
+	m.chainsLock.Unlock()
+	if !exists {
+		return smeng.State{}, fmt.Errorf("%w: %s", errUnknownChain, id)
+	}
+	if !isSnowman {
+		return smeng.State{}, fmt.Errorf("%w: %s", errNotSnowmanChain, id)
+	}
+
+	ctx := chain.Context()
+	if !tryLock(&ctx.Lock, consensusStateLockTimeout) {
+		return smeng.State{}, fmt.Errorf("%w: %s didn't release its lock within %s", errChainBusy, id, consensusStateLockTimeout)
+	}
+	defer ctx.Lock.Unlock()
+
+	return engine.State(), nil
+}
+
+// tryLock attempts to acquire [lock] until [timeout] has passed. Returns true
+// if the lock was acquired.
+//
+// The lock is acquired by a goroutine, so the caller waits for the lock like
+// any other writer rather than polling it. If [timeout] passes first, the
+// goroutine releases the lock as soon as it acquires it.
+func tryLock(lock sync.Locker, timeout time.Duration) bool {
+	var (
+		acquired  = make(chan struct{})
+		abandoned = make(chan struct{})
+	)
+	go func() {
+		lock.Lock()
+		select {
+		case acquired <- struct{}{}:
+		case <-abandoned:
+			lock.Unlock()
+		}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-acquired:
+		return true
+	case <-timer.C:
+		close(abandoned)
+		return false
+	}
+}
+
+func (m *manager) SimplexEquivocations(id ids.ID, nodeID ids.NodeID) ([]*simplex.Equivocation, error) {
+	m.chainsLock.Lock()
+	_, exists := m.chains[id]
+	engine, isSimplex := m.simplexEngines[id]
+	m.chainsLock.Unlock()
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", errUnknownChain, id)
+	}
+	if !isSimplex {
+		return nil, fmt.Errorf("%w: %s", errNotSimplexChain, id)
+	}
+	return engine.Equivocations(nodeID)
+}
+
 func (m *manager) registerBootstrappedHealthChecks() error {
 	bootstrappedCheck := health.CheckerFunc(func(context.Context) (interface{}, error) {
 		if subnetIDs := m.Subnets.Bootstrapping(); len(subnetIDs) != 0 {
@@ -1578,3 +1982,31 @@ func (m *manager) getOrMakeVMGatherer(vmID ids.ID) (metrics.MultiGatherer, error
 	m.vmGatherer[vmID] = vmGatherer
 	return vmGatherer, nil
 }
+
+// bootstrapParseWorkers returns the number of goroutines parsing the fetched
+// blocks of a chain ahead of their execution. Blocks are only parsed ahead of
+// their execution if [vm] advertises that it supports concurrent parsing.
+func (m *manager) bootstrapParseWorkers(vm block.ChainVM) int {
+	parser, ok := vm.(block.ConcurrentParser)
+	if !ok || !parser.SupportsConcurrentParsing() {
+		return 0
+	}
+	return m.BootstrapParseWorkers
+}
+
+// newWindower returns the constructor of the proposer selection strategy
+// named by [selection], which activates at the P-chain [activationHeight].
+func newWindower(selection string, activationHeight uint64) func(validators.State, ids.ID, ids.ID) proposer.Windower {
+	switch selection {
+	case subnets.RoundRobinProposerSelection:
+		return func(state validators.State, subnetID, chainID ids.ID) proposer.Windower {
+			return proposer.NewRoundRobin(state, subnetID, chainID, activationHeight)
+		}
+	case subnets.VRFProposerSelection:
+		return func(state validators.State, subnetID, chainID ids.ID) proposer.Windower {
+			return proposer.NewVRF(state, subnetID, chainID, activationHeight)
+		}
+	default:
+		return proposer.New
+	}
+}
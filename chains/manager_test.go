@@ -0,0 +1,34 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package chains
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTryLock(t *testing.T) {
+	require := require.New(t)
+
+	var lock sync.RWMutex
+	require.True(tryLock(&lock, time.Second))
+
+	// The lock isn't acquired while it is held.
+	require.False(tryLock(&lock, time.Millisecond))
+	lock.Unlock()
+
+	// The abandoned attempt releases the lock once it acquires it.
+	require.True(tryLock(&lock, time.Second))
+
+	// The lock is acquired once it is released within the timeout.
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		lock.Unlock()
+	}()
+	require.True(tryLock(&lock, time.Minute))
+	lock.Unlock()
+}
@@ -3,7 +3,12 @@
 
 package chains
 
-import "github.com/ava-labs/avalanchego/ids"
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/simplex"
+
+	smeng "github.com/ava-labs/avalanchego/snow/engine/snowman"
+)
 
 // TestManager implements Manager but does nothing. Always returns nil error.
 // To be used only in tests
@@ -43,6 +48,14 @@ func (testManager) IsBootstrapped(ids.ID) bool {
 	return false
 }
 
+func (testManager) ConsensusState(ids.ID) (smeng.State, error) {
+	return smeng.State{}, nil
+}
+
+func (testManager) SimplexEquivocations(ids.ID, ids.NodeID) ([]*simplex.Equivocation, error) {
+	return nil, nil
+}
+
 func (testManager) Lookup(s string) (ids.ID, error) {
 	return ids.FromString(s)
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/network/dialer"
+	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
@@ -51,9 +52,10 @@ import (
 )
 
 const (
-	chainConfigFileName  = "config"
-	chainUpgradeFileName = "upgrade"
-	subnetConfigFileExt  = ".json"
+	chainConfigFileName     = "config"
+	chainUpgradeFileName    = "upgrade"
+	chainCheckpointFileName = "checkpoint"
+	subnetConfigFileExt     = ".json"
 
 	maxDiskSpaceThreshold = 50
 )
@@ -105,6 +107,15 @@ func getConsensusConfig(v *viper.Viper) snowball.Parameters {
 		p.AlphaPreference = v.GetInt(SnowQuorumSizeKey)
 		p.AlphaConfidence = p.AlphaPreference
 	}
+	if v.GetBool(SnowAdaptiveEnabledKey) {
+		p.Adaptive = &snowball.AdaptiveParameters{
+			MinConcurrentRepolls: v.GetInt(SnowAdaptiveMinConcurrentRepollsKey),
+			MaxConcurrentRepolls: v.GetInt(SnowAdaptiveMaxConcurrentRepollsKey),
+			TargetPollLatency:    v.GetDuration(SnowAdaptiveTargetPollLatencyKey),
+			MaxPollFailureRate:   v.GetFloat64(SnowAdaptiveMaxPollFailureRateKey),
+			MaxNetworkTimeout:    v.GetDuration(SnowAdaptiveMaxNetworkTimeoutKey),
+		}
+	}
 	return p
 }
 
@@ -380,6 +391,13 @@ func getNetworkConfig(
 		RequireValidatorToConnect: v.GetBool(NetworkRequireValidatorToConnectKey),
 		PeerReadBufferSize:        int(v.GetUint(NetworkPeerReadBufferSizeKey)),
 		PeerWriteBufferSize:       int(v.GetUint(NetworkPeerWriteBufferSizeKey)),
+
+		OutboundMessageQueueConfig: peer.PrioritizedMessageQueueConfig{
+			Enabled:             v.GetBool(NetworkOutboundQueuePrioritizationEnabledKey),
+			ConsensusWeight:     v.GetUint64(NetworkOutboundQueueConsensusWeightKey),
+			BootstrappingWeight: v.GetUint64(NetworkOutboundQueueBootstrappingWeightKey),
+			AppWeight:           v.GetUint64(NetworkOutboundQueueAppWeightKey),
+		},
 	}
 
 	switch {
@@ -418,6 +436,9 @@ func getNetworkConfig(
 	case config.MaxClockDifference < 0:
 		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkMaxClockDifferenceKey)
 	}
+	if err := config.OutboundMessageQueueConfig.Verify(); err != nil {
+		return network.Config{}, fmt.Errorf("invalid outbound message queue config: %w", err)
+	}
 	return config, nil
 }
 
@@ -486,6 +507,8 @@ func getBootstrapConfig(v *viper.Viper, networkID uint32) (node.BootstrapConfig,
 		BootstrapMaxTimeGetAncestors:            v.GetDuration(BootstrapMaxTimeGetAncestorsKey),
 		BootstrapAncestorsMaxContainersSent:     int(v.GetUint(BootstrapAncestorsMaxContainersSentKey)),
 		BootstrapAncestorsMaxContainersReceived: int(v.GetUint(BootstrapAncestorsMaxContainersReceivedKey)),
+		BootstrapMaxOutstandingRequests:         int(v.GetUint(BootstrapMaxOutstandingRequestsKey)),
+		BootstrapParseWorkers:                   int(v.GetUint(BootstrapParseWorkersKey)),
 	}
 
 	// TODO: Add a "BootstrappersKey" flag to more clearly enforce ID and IP
@@ -551,6 +574,9 @@ func getIPConfig(v *viper.Viper) (node.IPConfig, error) {
 		PublicIPResolutionFreq:    v.GetDuration(PublicIPResolutionFreqKey),
 		ListenHost:                v.GetString(StakingHostKey),
 		ListenPort:                uint16(v.GetUint(StakingPortKey)),
+
+		PublicIPv6:                  v.GetString(PublicIPv6Key),
+		PublicIPv6ResolutionService: v.GetString(PublicIPv6ResolutionServiceKey),
 	}
 	if ipConfig.PublicIPResolutionFreq <= 0 {
 		return node.IPConfig{}, fmt.Errorf("%q must be > 0", PublicIPResolutionFreqKey)
@@ -558,6 +584,9 @@ func getIPConfig(v *viper.Viper) (node.IPConfig, error) {
 	if ipConfig.PublicIP != "" && ipConfig.PublicIPResolutionService != "" {
 		return node.IPConfig{}, fmt.Errorf("only one of --%s and --%s can be given", PublicIPKey, PublicIPResolutionServiceKey)
 	}
+	if ipConfig.PublicIPv6 != "" && ipConfig.PublicIPv6ResolutionService != "" {
+		return node.IPConfig{}, fmt.Errorf("only one of --%s and --%s can be given", PublicIPv6Key, PublicIPv6ResolutionServiceKey)
+	}
 	return ipConfig, nil
 }
 
@@ -997,9 +1026,16 @@ func readChainConfigPath(chainConfigPath string) (map[string]chains.ChainConfig,
 			return chainConfigMap, err
 		}
 
+		// chainconfigdir/chainId/checkpoint.*
+		checkpointData, err := storage.ReadFileWithName(chainDir, chainCheckpointFileName)
+		if err != nil {
+			return chainConfigMap, err
+		}
+
 		chainConfigMap[dirInfo.Name()] = chains.ChainConfig{
-			Config:  configData,
-			Upgrade: upgradeData,
+			Config:     configData,
+			Upgrade:    upgradeData,
+			Checkpoint: checkpointData,
 		}
 	}
 	return chainConfigMap, nil
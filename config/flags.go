@@ -152,6 +152,8 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.String(PublicIPKey, "", "Public IP of this node for P2P communication")
 	fs.Duration(PublicIPResolutionFreqKey, 5*time.Minute, "Frequency at which this node resolves/updates its public IP and renew NAT mappings, if applicable")
 	fs.String(PublicIPResolutionServiceKey, "", fmt.Sprintf("Only acceptable values are %q, %q or %q. When provided, the node will use that service to periodically resolve/update its public IP", dynamicip.OpenDNSName, dynamicip.IFConfigCoName, dynamicip.IFConfigMeName))
+	fs.String(PublicIPv6Key, "", "Public IPv6 address of this node for P2P communication. When provided, the public IP must be an IPv4 address and the node advertises both addresses")
+	fs.String(PublicIPv6ResolutionServiceKey, "", fmt.Sprintf("Only acceptable values are %q, %q or %q. When provided, the node will use that service to periodically resolve/update its public IPv6 address", dynamicip.OpenDNSName, dynamicip.IFConfigCoName, dynamicip.IFConfigMeName))
 
 	// Inbound Connection Throttling
 	fs.Duration(NetworkInboundConnUpgradeThrottlerCooldownKey, constants.DefaultInboundConnUpgradeThrottlerCooldown, "Upgrade an inbound connection from a given IP at most once per this duration. If 0, don't rate-limit inbound connection upgrades")
@@ -170,7 +172,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Duration(NetworkPingTimeoutKey, constants.DefaultPingPongTimeout, "Timeout value for Ping-Pong with a peer")
 	fs.Duration(NetworkPingFrequencyKey, constants.DefaultPingFrequency, "Frequency of pinging other peers")
 	fs.Duration(NetworkNoIngressValidatorConnectionsGracePeriodKey, constants.DefaultNoIngressValidatorConnectionGracePeriod, "Time after which nodes are expected to be connected to us if we are a primary network validator, otherwise a health check fails")
-	fs.String(NetworkCompressionTypeKey, constants.DefaultNetworkCompressionType.String(), fmt.Sprintf("Compression type for outbound messages. Must be one of [%s, %s]", compression.TypeZstd, compression.TypeNone))
+	fs.String(NetworkCompressionTypeKey, constants.DefaultNetworkCompressionType.String(), fmt.Sprintf("Compression type for outbound messages. Must be one of [%s, %s, %s]", compression.TypeZstd, compression.TypeZstdDict, compression.TypeNone))
 
 	fs.Duration(NetworkMaxClockDifferenceKey, constants.DefaultNetworkMaxClockDifference, "Max allowed clock difference value between this node and peers")
 	// Note: The default value is set to false here because the default
@@ -191,6 +193,11 @@ func addNodeFlags(fs *pflag.FlagSet) {
 
 	fs.String(NetworkTLSKeyLogFileKey, "", "TLS key log file path. Should only be specified for debugging")
 
+	fs.Bool(NetworkOutboundQueuePrioritizationEnabledKey, constants.DefaultNetworkOutboundQueuePrioritizationEnabled, "If true, outbound messages to each peer are queued in separate consensus, bootstrapping and app lanes that are dequeued in proportion to their weights")
+	fs.Uint64(NetworkOutboundQueueConsensusWeightKey, constants.DefaultNetworkOutboundQueueConsensusWeight, "Relative weight of the consensus lane of the outbound message queue. Must be > 0")
+	fs.Uint64(NetworkOutboundQueueBootstrappingWeightKey, constants.DefaultNetworkOutboundQueueBootstrappingWeight, "Relative weight of the bootstrapping lane of the outbound message queue. Must be > 0")
+	fs.Uint64(NetworkOutboundQueueAppWeightKey, constants.DefaultNetworkOutboundQueueAppWeight, "Relative weight of the app lane of the outbound message queue. Must be > 0")
+
 	// Benchlist
 	fs.Int(BenchlistFailThresholdKey, constants.DefaultBenchlistFailThreshold, "Number of consecutive failed queries before benchlisting a node")
 	fs.Duration(BenchlistDurationKey, constants.DefaultBenchlistDuration, "Max amount of time a peer is benchlisted after surpassing the threshold")
@@ -301,6 +308,8 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Duration(BootstrapMaxTimeGetAncestorsKey, 50*time.Millisecond, "Max Time to spend fetching a container and its ancestors when responding to a GetAncestors")
 	fs.Uint(BootstrapAncestorsMaxContainersSentKey, 2000, "Max number of containers in an Ancestors message sent by this node")
 	fs.Uint(BootstrapAncestorsMaxContainersReceivedKey, 2000, "This node reads at most this many containers from an incoming Ancestors message")
+	fs.Uint(BootstrapMaxOutstandingRequestsKey, 0, "Max number of GetAncestors requests outstanding at once while bootstrapping a chain. If greater than 1, disjoint intervals of blocks are fetched by height from different peers concurrently. If 0, the number of requests is unlimited")
+	fs.Uint(BootstrapParseWorkersKey, 0, "Number of goroutines parsing fetched blocks ahead of their execution while bootstrapping a chain whose VM supports concurrent parsing. If 0, blocks are parsed when they are executed")
 
 	// Consensus
 	fs.Int(SnowSampleSizeKey, snowball.DefaultParameters.K, "Number of nodes to query for each network poll")
@@ -314,6 +323,12 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Int(SnowOptimalProcessingKey, snowball.DefaultParameters.OptimalProcessing, "Optimal number of processing containers in consensus")
 	fs.Int(SnowMaxProcessingKey, snowball.DefaultParameters.MaxOutstandingItems, "Maximum number of processing items to be considered healthy")
 	fs.Duration(SnowMaxTimeProcessingKey, snowball.DefaultParameters.MaxItemProcessingTime, "Maximum amount of time an item should be processing and still be healthy")
+	fs.Bool(SnowAdaptiveEnabledKey, false, "Tune the number of concurrent polls and the maximum network timeout from the observed poll latencies and failure rates")
+	fs.Int(SnowAdaptiveMinConcurrentRepollsKey, snowball.DefaultAdaptiveParameters.MinConcurrentRepolls, fmt.Sprintf("Minimum number of concurrent polls targeted while polls are failing. Ignored if %s is false", SnowAdaptiveEnabledKey))
+	fs.Int(SnowAdaptiveMaxConcurrentRepollsKey, snowball.DefaultAdaptiveParameters.MaxConcurrentRepolls, fmt.Sprintf("Maximum number of concurrent polls targeted while polls are slow. Ignored if %s is false", SnowAdaptiveEnabledKey))
+	fs.Duration(SnowAdaptiveTargetPollLatencyKey, snowball.DefaultAdaptiveParameters.TargetPollLatency, fmt.Sprintf("Poll latency above which additional concurrent polls are issued. Ignored if %s is false", SnowAdaptiveEnabledKey))
+	fs.Float64(SnowAdaptiveMaxPollFailureRateKey, snowball.DefaultAdaptiveParameters.MaxPollFailureRate, fmt.Sprintf("Fraction of failed queries above which fewer concurrent polls are issued. Ignored if %s is false", SnowAdaptiveEnabledKey))
+	fs.Duration(SnowAdaptiveMaxNetworkTimeoutKey, snowball.DefaultAdaptiveParameters.MaxNetworkTimeout, fmt.Sprintf("Maximum network timeout that the maximum network timeout may be raised to while queries are failing. If 0, the network timeout isn't tuned. Ignored if %s is false", SnowAdaptiveEnabledKey))
 
 	// ProposerVM
 	fs.Bool(ProposerVMUseCurrentHeightKey, false, "Have the ProposerVM always report the last accepted P-chain block height")
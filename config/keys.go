@@ -55,6 +55,8 @@ const (
 	PublicIPKey                              = "public-ip"
 	PublicIPResolutionFreqKey                = "public-ip-resolution-frequency"
 	PublicIPResolutionServiceKey             = "public-ip-resolution-service"
+	PublicIPv6Key                            = "public-ipv6"
+	PublicIPv6ResolutionServiceKey           = "public-ipv6-resolution-service"
 	HTTPHostKey                              = "http-host"
 	HTTPPortKey                              = "http-port"
 	HTTPSEnabledKey                          = "http-tls-enabled"
@@ -122,6 +124,10 @@ const (
 	NetworkOutboundConnectionThrottlingRpsKey            = "network-outbound-connection-throttling-rps"
 	NetworkOutboundConnectionTimeoutKey                  = "network-outbound-connection-timeout"
 	NetworkNoIngressValidatorConnectionsGracePeriodKey   = "network-no-ingress-connections-grace-period"
+	NetworkOutboundQueuePrioritizationEnabledKey         = "network-outbound-queue-prioritization-enabled"
+	NetworkOutboundQueueConsensusWeightKey               = "network-outbound-queue-consensus-weight"
+	NetworkOutboundQueueBootstrappingWeightKey           = "network-outbound-queue-bootstrapping-weight"
+	NetworkOutboundQueueAppWeightKey                     = "network-outbound-queue-app-weight"
 	BenchlistFailThresholdKey                            = "benchlist-fail-threshold"
 	BenchlistDurationKey                                 = "benchlist-duration"
 	BenchlistMinFailingDurationKey                       = "benchlist-min-failing-duration"
@@ -143,6 +149,12 @@ const (
 	SnowOptimalProcessingKey                             = "snow-optimal-processing"
 	SnowMaxProcessingKey                                 = "snow-max-processing"
 	SnowMaxTimeProcessingKey                             = "snow-max-time-processing"
+	SnowAdaptiveEnabledKey                               = "snow-adaptive-enabled"
+	SnowAdaptiveMinConcurrentRepollsKey                  = "snow-adaptive-min-concurrent-repolls"
+	SnowAdaptiveMaxConcurrentRepollsKey                  = "snow-adaptive-max-concurrent-repolls"
+	SnowAdaptiveTargetPollLatencyKey                     = "snow-adaptive-target-poll-latency"
+	SnowAdaptiveMaxPollFailureRateKey                    = "snow-adaptive-max-poll-failure-rate"
+	SnowAdaptiveMaxNetworkTimeoutKey                     = "snow-adaptive-max-network-timeout"
 	PartialSyncPrimaryNetworkKey                         = "partial-sync-primary-network"
 	TrackSubnetsKey                                      = "track-subnets"
 	AdminAPIEnabledKey                                   = "api-admin-enabled"
@@ -167,6 +179,8 @@ const (
 	BootstrapMaxTimeGetAncestorsKey                      = "bootstrap-max-time-get-ancestors"
 	BootstrapAncestorsMaxContainersSentKey               = "bootstrap-ancestors-max-containers-sent"
 	BootstrapAncestorsMaxContainersReceivedKey           = "bootstrap-ancestors-max-containers-received"
+	BootstrapMaxOutstandingRequestsKey                   = "bootstrap-max-outstanding-requests"
+	BootstrapParseWorkersKey                             = "bootstrap-parse-workers"
 	ChainDataDirKey                                      = "chain-data-dir"
 	ChainConfigDirKey                                    = "chain-config-dir"
 	ChainConfigContentKey                                = "chain-config-content"
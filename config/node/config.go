@@ -61,6 +61,10 @@ type IPConfig struct {
 	PublicIP                  string        `json:"publicIP"`
 	PublicIPResolutionService string        `json:"publicIPResolutionService"`
 	PublicIPResolutionFreq    time.Duration `json:"publicIPResolutionFreq"`
+	// PublicIPv6 and PublicIPv6ResolutionService optionally configure the
+	// IPv6 address of a dual-stack node.
+	PublicIPv6                  string `json:"publicIPv6"`
+	PublicIPv6ResolutionService string `json:"publicIPv6ResolutionService"`
 	// The host portion of the address to listen on. The port to
 	// listen on will be sourced from IPPort.
 	//
@@ -105,6 +109,14 @@ type BootstrapConfig struct {
 	// containers in an ancestors message it receives.
 	BootstrapAncestorsMaxContainersReceived int `json:"bootstrapAncestorsMaxContainersReceived"`
 
+	// Max number of GetAncestors requests outstanding at once. If 0, the
+	// number of requests is unlimited.
+	BootstrapMaxOutstandingRequests int `json:"bootstrapMaxOutstandingRequests"`
+
+	// Number of goroutines parsing fetched blocks ahead of their execution. If
+	// 0, blocks are parsed when they are executed.
+	BootstrapParseWorkers int `json:"bootstrapParseWorkers"`
+
 	// Max time to spend fetching a container and its
 	// ancestors while responding to a GetAncestors message
 	BootstrapMaxTimeGetAncestors time.Duration `json:"bootstrapMaxTimeGetAncestors"`
@@ -0,0 +1,123 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: platformvm/service.proto
+
+package platformvmconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	platformvm "github.com/ava-labs/avalanchego/connectproto/pb/platformvm"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PlatformVMName is the fully-qualified name of the PlatformVM service.
+	PlatformVMName = "platformvm.PlatformVM"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PlatformVMSubscribeEventsProcedure is the fully-qualified name of the PlatformVM's
+	// SubscribeEvents RPC.
+	PlatformVMSubscribeEventsProcedure = "/platformvm.PlatformVM/SubscribeEvents"
+)
+
+// PlatformVMClient is a client for the platformvm.PlatformVM service.
+type PlatformVMClient interface {
+	// SubscribeEvents streams the events of every block accepted after the
+	// subscription is established. The stream is closed if the subscriber falls
+	// too far behind the accepted blocks.
+	//
+	// A closed stream can't be resumed. The subscriber must subscribe again and
+	// resync the state it tracks, as the events of the blocks accepted in the
+	// meantime are not replayed.
+	SubscribeEvents(context.Context, *connect.Request[platformvm.SubscribeEventsRequest]) (*connect.ServerStreamForClient[platformvm.Event], error)
+}
+
+// NewPlatformVMClient constructs a client for the platformvm.PlatformVM service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPlatformVMClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PlatformVMClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	platformVMMethods := platformvm.File_platformvm_service_proto.Services().ByName("PlatformVM").Methods()
+	return &platformVMClient{
+		subscribeEvents: connect.NewClient[platformvm.SubscribeEventsRequest, platformvm.Event](
+			httpClient,
+			baseURL+PlatformVMSubscribeEventsProcedure,
+			connect.WithSchema(platformVMMethods.ByName("SubscribeEvents")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// platformVMClient implements PlatformVMClient.
+type platformVMClient struct {
+	subscribeEvents *connect.Client[platformvm.SubscribeEventsRequest, platformvm.Event]
+}
+
+// SubscribeEvents calls platformvm.PlatformVM.SubscribeEvents.
+func (c *platformVMClient) SubscribeEvents(ctx context.Context, req *connect.Request[platformvm.SubscribeEventsRequest]) (*connect.ServerStreamForClient[platformvm.Event], error) {
+	return c.subscribeEvents.CallServerStream(ctx, req)
+}
+
+// PlatformVMHandler is an implementation of the platformvm.PlatformVM service.
+type PlatformVMHandler interface {
+	// SubscribeEvents streams the events of every block accepted after the
+	// subscription is established. The stream is closed if the subscriber falls
+	// too far behind the accepted blocks.
+	//
+	// A closed stream can't be resumed. The subscriber must subscribe again and
+	// resync the state it tracks, as the events of the blocks accepted in the
+	// meantime are not replayed.
+	SubscribeEvents(context.Context, *connect.Request[platformvm.SubscribeEventsRequest], *connect.ServerStream[platformvm.Event]) error
+}
+
+// NewPlatformVMHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPlatformVMHandler(svc PlatformVMHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	platformVMMethods := platformvm.File_platformvm_service_proto.Services().ByName("PlatformVM").Methods()
+	platformVMSubscribeEventsHandler := connect.NewServerStreamHandler(
+		PlatformVMSubscribeEventsProcedure,
+		svc.SubscribeEvents,
+		connect.WithSchema(platformVMMethods.ByName("SubscribeEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/platformvm.PlatformVM/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PlatformVMSubscribeEventsProcedure:
+			platformVMSubscribeEventsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPlatformVMHandler returns CodeUnimplemented from all methods.
+type UnimplementedPlatformVMHandler struct{}
+
+func (UnimplementedPlatformVMHandler) SubscribeEvents(context.Context, *connect.Request[platformvm.SubscribeEventsRequest], *connect.ServerStream[platformvm.Event]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("platformvm.PlatformVM.SubscribeEvents is not implemented"))
+}
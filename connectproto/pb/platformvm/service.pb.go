@@ -0,0 +1,1091 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: platformvm/service.proto
+
+package platformvm
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Request to subscribe to P-Chain events.
+type SubscribeEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// If set, only events related to this subnet are streamed. Block accepted
+	// events are always streamed.
+	SubnetId      []byte `protobuf:"bytes,1,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeEventsRequest) Reset() {
+	*x = SubscribeEventsRequest{}
+	mi := &file_platformvm_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platformvm_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeEventsRequest) Descriptor() ([]byte, []int) {
+	return file_platformvm_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeEventsRequest) GetSubnetId() []byte {
+	if x != nil {
+		return x.SubnetId
+	}
+	return nil
+}
+
+// Event describes a change to the P-Chain state made by an accepted block.
+type Event struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the block that made the change.
+	BlockId []byte `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	// Height of the block that made the change.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Timestamp of the block that made the change in Unix time (seconds).
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*Event_BlockAccepted
+	//	*Event_StakerAdded
+	//	*Event_StakerRemoved
+	//	*Event_L1ValidatorRegistered
+	//	*Event_L1ValidatorWeightChanged
+	//	*Event_L1ValidatorDisabled
+	//	*Event_SubnetCreated
+	//	*Event_SubnetConverted
+	//	*Event_ChainCreated
+	Event         isEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_platformvm_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_platformvm_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_platformvm_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Event) GetBlockId() []byte {
+	if x != nil {
+		return x.BlockId
+	}
+	return nil
+}
+
+func (x *Event) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Event) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Event) GetEvent() isEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *Event) GetBlockAccepted() *BlockAccepted {
+	if x != nil {
+		if x, ok := x.Event.(*Event_BlockAccepted); ok {
+			return x.BlockAccepted
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetStakerAdded() *StakerAdded {
+	if x != nil {
+		if x, ok := x.Event.(*Event_StakerAdded); ok {
+			return x.StakerAdded
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetStakerRemoved() *StakerRemoved {
+	if x != nil {
+		if x, ok := x.Event.(*Event_StakerRemoved); ok {
+			return x.StakerRemoved
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetL1ValidatorRegistered() *L1ValidatorRegistered {
+	if x != nil {
+		if x, ok := x.Event.(*Event_L1ValidatorRegistered); ok {
+			return x.L1ValidatorRegistered
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetL1ValidatorWeightChanged() *L1ValidatorWeightChanged {
+	if x != nil {
+		if x, ok := x.Event.(*Event_L1ValidatorWeightChanged); ok {
+			return x.L1ValidatorWeightChanged
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetL1ValidatorDisabled() *L1ValidatorDisabled {
+	if x != nil {
+		if x, ok := x.Event.(*Event_L1ValidatorDisabled); ok {
+			return x.L1ValidatorDisabled
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetSubnetCreated() *SubnetCreated {
+	if x != nil {
+		if x, ok := x.Event.(*Event_SubnetCreated); ok {
+			return x.SubnetCreated
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetSubnetConverted() *SubnetConverted {
+	if x != nil {
+		if x, ok := x.Event.(*Event_SubnetConverted); ok {
+			return x.SubnetConverted
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetChainCreated() *ChainCreated {
+	if x != nil {
+		if x, ok := x.Event.(*Event_ChainCreated); ok {
+			return x.ChainCreated
+		}
+	}
+	return nil
+}
+
+type isEvent_Event interface {
+	isEvent_Event()
+}
+
+type Event_BlockAccepted struct {
+	BlockAccepted *BlockAccepted `protobuf:"bytes,4,opt,name=block_accepted,json=blockAccepted,proto3,oneof"`
+}
+
+type Event_StakerAdded struct {
+	StakerAdded *StakerAdded `protobuf:"bytes,5,opt,name=staker_added,json=stakerAdded,proto3,oneof"`
+}
+
+type Event_StakerRemoved struct {
+	StakerRemoved *StakerRemoved `protobuf:"bytes,6,opt,name=staker_removed,json=stakerRemoved,proto3,oneof"`
+}
+
+type Event_L1ValidatorRegistered struct {
+	L1ValidatorRegistered *L1ValidatorRegistered `protobuf:"bytes,7,opt,name=l1_validator_registered,json=l1ValidatorRegistered,proto3,oneof"`
+}
+
+type Event_L1ValidatorWeightChanged struct {
+	L1ValidatorWeightChanged *L1ValidatorWeightChanged `protobuf:"bytes,8,opt,name=l1_validator_weight_changed,json=l1ValidatorWeightChanged,proto3,oneof"`
+}
+
+type Event_L1ValidatorDisabled struct {
+	L1ValidatorDisabled *L1ValidatorDisabled `protobuf:"bytes,9,opt,name=l1_validator_disabled,json=l1ValidatorDisabled,proto3,oneof"`
+}
+
+type Event_SubnetCreated struct {
+	SubnetCreated *SubnetCreated `protobuf:"bytes,10,opt,name=subnet_created,json=subnetCreated,proto3,oneof"`
+}
+
+type Event_SubnetConverted struct {
+	SubnetConverted *SubnetConverted `protobuf:"bytes,11,opt,name=subnet_converted,json=subnetConverted,proto3,oneof"`
+}
+
+type Event_ChainCreated struct {
+	ChainCreated *ChainCreated `protobuf:"bytes,12,opt,name=chain_created,json=chainCreated,proto3,oneof"`
+}
+
+func (*Event_BlockAccepted) isEvent_Event() {}
+
+func (*Event_StakerAdded) isEvent_Event() {}
+
+func (*Event_StakerRemoved) isEvent_Event() {}
+
+func (*Event_L1ValidatorRegistered) isEvent_Event() {}
+
+func (*Event_L1ValidatorWeightChanged) isEvent_Event() {}
+
+func (*Event_L1ValidatorDisabled) isEvent_Event() {}
+
+func (*Event_SubnetCreated) isEvent_Event() {}
+
+func (*Event_SubnetConverted) isEvent_Event() {}
+
+func (*Event_ChainCreated) isEvent_Event() {}
+
+// BlockAccepted is sent before the other events of a block.
+type BlockAccepted struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the parent of the block.
+	ParentId []byte `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// IDs of the transactions included in the block.
+	TxIds         [][]byte `protobuf:"bytes,2,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockAccepted) Reset() {
+	*x = BlockAccepted{}
+	mi := &file_platformvm_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockAccepted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockAccepted) ProtoMessage() {}
+
+func (x *BlockAccepted) ProtoReflect() protoreflect.Message {
+	mi := &file_platformvm_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockAccepted.ProtoReflect.Descriptor instead.
+func (*BlockAccepted) Descriptor() ([]byte, []int) {
+	return file_platformvm_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BlockAccepted) GetParentId() []byte {
+	if x != nil {
+		return x.ParentId
+	}
+	return nil
+}
+
+func (x *BlockAccepted) GetTxIds() [][]byte {
+	if x != nil {
+		return x.TxIds
+	}
+	return nil
+}
+
+// Staker is a validator or delegator of a subnet that isn't an L1.
+type Staker struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the transaction that added the staker.
+	TxId     []byte `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	NodeId   []byte `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	SubnetId []byte `protobuf:"bytes,3,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	Weight   uint64 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Start time of the staking period in Unix time (seconds).
+	StartTime int64 `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End time of the staking period in Unix time (seconds).
+	EndTime int64 `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// True if the staker is a delegator.
+	Delegator bool `protobuf:"varint,7,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// True if the staker is in the pending set rather than the current set.
+	Pending       bool `protobuf:"varint,8,opt,name=pending,proto3" json:"pending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Staker) Reset() {
+	*x = Staker{}
+	mi := &file_platformvm_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Staker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Staker) ProtoMessage() {}
+
+func (x *Staker) ProtoReflect() protoreflect.Message {
+	mi := &file_platformvm_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Staker.ProtoReflect.Descriptor instead.
+func (*Staker) Descriptor() ([]byte, []int) {
+	return file_platformvm_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Staker) GetTxId() []byte {
+	if x != nil {
+		return x.TxId
+	}
+	return nil
+}
+
+func (x *Staker) GetNodeId() []byte {
+	if x != nil {
+		return x.NodeId
+	}
+	return nil
+}
+
+func (x *Staker) GetSubnetId() []byte {
+	if x != nil {
+		return x.SubnetId
+	}
+	return nil
+}
+
+func (x *Staker) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Staker) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *Staker) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *Staker) GetDelegator() bool {
+	if x != nil {
+		return x.Delegator
+	}
+	return false
+}
+
+func (x *Staker) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+// StakerAdded is sent when a staker is added to the current or pending set.
+type StakerAdded struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Staker        *Staker                `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StakerAdded) Reset() {
+	*x = StakerAdded{}
+	mi := &file_platformvm_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StakerAdded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakerAdded) ProtoMessage() {}
+
+func (x *StakerAdded) ProtoReflect() protoreflect.Message {
+	mi := &file_platformvm_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakerAdded.ProtoReflect.Descriptor instead.
+func (*StakerAdded) Descriptor() ([]byte, []int) {
+	return file_platformvm_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StakerAdded) GetStaker() *Staker {
+	if x != nil {
+		return x.Staker
+	}
+	return nil
+}
+
+// StakerRemoved is sent when a staker is removed from the current or pending
+// set.
+type StakerRemoved struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Staker        *Staker                `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StakerRemoved) Reset() {
+	*x = StakerRemoved{}
+	mi := &file_platformvm_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StakerRemoved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakerRemoved) ProtoMessage() {}
+
+func (x *StakerRemoved) ProtoReflect() protoreflect.Message {
+	mi := &file_platformvm_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakerRemoved.ProtoReflect.Descriptor instead.
+func (*StakerRemoved) Descriptor() ([]byte, []int) {
+	return file_platformvm_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StakerRemoved) GetStaker() *Staker {
+	if x != nil {
+		return x.Staker
+	}
+	return nil
+}
+
+// L1ValidatorRegistered is sent when a validator is added to an L1.
+type L1ValidatorRegistered struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ValidationId  []byte                 `protobuf:"bytes,1,opt,name=validation_id,json=validationId,proto3" json:"validation_id,omitempty"`
+	SubnetId      []byte                 `protobuf:"bytes,2,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	NodeId        []byte                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Weight        uint64                 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *L1ValidatorRegistered) Reset() {
+	*x = L1ValidatorRegistered{}
+	mi := &file_platformvm_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *L1ValidatorRegistered) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L1ValidatorRegistered) ProtoMessage() {}
+
+func (x *L1ValidatorRegistered) ProtoReflect() protoreflect.Message {
+	mi := &file_platformvm_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L1ValidatorRegistered.ProtoReflect.Descriptor instead.
+func (*L1ValidatorRegistered) Descriptor() ([]byte, []int) {
+	return file_platformvm_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *L1ValidatorRegistered) GetValidationId() []byte {
+	if x != nil {
+		return x.ValidationId
+	}
+	return nil
+}
+
+func (x *L1ValidatorRegistered) GetSubnetId() []byte {
+	if x != nil {
+		return x.SubnetId
+	}
+	return nil
+}
+
+func (x *L1ValidatorRegistered) GetNodeId() []byte {
+	if x != nil {
+		return x.NodeId
+	}
+	return nil
+}
+
+func (x *L1ValidatorRegistered) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// L1ValidatorWeightChanged is sent when the weight of an L1 validator is
+// modified. A weight of 0 means that the validator was removed.
+type L1ValidatorWeightChanged struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ValidationId   []byte                 `protobuf:"bytes,1,opt,name=validation_id,json=validationId,proto3" json:"validation_id,omitempty"`
+	SubnetId       []byte                 `protobuf:"bytes,2,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	NodeId         []byte                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	PreviousWeight uint64                 `protobuf:"varint,4,opt,name=previous_weight,json=previousWeight,proto3" json:"previous_weight,omitempty"`
+	Weight         uint64                 `protobuf:"varint,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *L1ValidatorWeightChanged) Reset() {
+	*x = L1ValidatorWeightChanged{}
+	mi := &file_platformvm_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *L1ValidatorWeightChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L1ValidatorWeightChanged) ProtoMessage() {}
+
+func (x *L1ValidatorWeightChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_platformvm_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L1ValidatorWeightChanged.ProtoReflect.Descriptor instead.
+func (*L1ValidatorWeightChanged) Descriptor() ([]byte, []int) {
+	return file_platformvm_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *L1ValidatorWeightChanged) GetValidationId() []byte {
+	if x != nil {
+		return x.ValidationId
+	}
+	return nil
+}
+
+func (x *L1ValidatorWeightChanged) GetSubnetId() []byte {
+	if x != nil {
+		return x.SubnetId
+	}
+	return nil
+}
+
+func (x *L1ValidatorWeightChanged) GetNodeId() []byte {
+	if x != nil {
+		return x.NodeId
+	}
+	return nil
+}
+
+func (x *L1ValidatorWeightChanged) GetPreviousWeight() uint64 {
+	if x != nil {
+		return x.PreviousWeight
+	}
+	return 0
+}
+
+func (x *L1ValidatorWeightChanged) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// L1ValidatorDisabled is sent when an L1 validator becomes inactive, either
+// because it was disabled or because its balance was exhausted.
+type L1ValidatorDisabled struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ValidationId  []byte                 `protobuf:"bytes,1,opt,name=validation_id,json=validationId,proto3" json:"validation_id,omitempty"`
+	SubnetId      []byte                 `protobuf:"bytes,2,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	NodeId        []byte                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *L1ValidatorDisabled) Reset() {
+	*x = L1ValidatorDisabled{}
+	mi := &file_platformvm_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *L1ValidatorDisabled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*L1ValidatorDisabled) ProtoMessage() {}
+
+func (x *L1ValidatorDisabled) ProtoReflect() protoreflect.Message {
+	mi := &file_platformvm_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use L1ValidatorDisabled.ProtoReflect.Descriptor instead.
+func (*L1ValidatorDisabled) Descriptor() ([]byte, []int) {
+	return file_platformvm_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *L1ValidatorDisabled) GetValidationId() []byte {
+	if x != nil {
+		return x.ValidationId
+	}
+	return nil
+}
+
+func (x *L1ValidatorDisabled) GetSubnetId() []byte {
+	if x != nil {
+		return x.SubnetId
+	}
+	return nil
+}
+
+func (x *L1ValidatorDisabled) GetNodeId() []byte {
+	if x != nil {
+		return x.NodeId
+	}
+	return nil
+}
+
+// SubnetCreated is sent when a subnet is created.
+type SubnetCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubnetId      []byte                 `protobuf:"bytes,1,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubnetCreated) Reset() {
+	*x = SubnetCreated{}
+	mi := &file_platformvm_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubnetCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubnetCreated) ProtoMessage() {}
+
+func (x *SubnetCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_platformvm_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubnetCreated.ProtoReflect.Descriptor instead.
+func (*SubnetCreated) Descriptor() ([]byte, []int) {
+	return file_platformvm_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SubnetCreated) GetSubnetId() []byte {
+	if x != nil {
+		return x.SubnetId
+	}
+	return nil
+}
+
+// SubnetConverted is sent when a subnet is converted into an L1.
+type SubnetConverted struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SubnetId     []byte                 `protobuf:"bytes,1,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	ConversionId []byte                 `protobuf:"bytes,2,opt,name=conversion_id,json=conversionId,proto3" json:"conversion_id,omitempty"`
+	// ID of the chain where the validator manager is deployed.
+	ChainId []byte `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Address of the validator manager.
+	Address       []byte `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubnetConverted) Reset() {
+	*x = SubnetConverted{}
+	mi := &file_platformvm_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubnetConverted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubnetConverted) ProtoMessage() {}
+
+func (x *SubnetConverted) ProtoReflect() protoreflect.Message {
+	mi := &file_platformvm_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubnetConverted.ProtoReflect.Descriptor instead.
+func (*SubnetConverted) Descriptor() ([]byte, []int) {
+	return file_platformvm_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SubnetConverted) GetSubnetId() []byte {
+	if x != nil {
+		return x.SubnetId
+	}
+	return nil
+}
+
+func (x *SubnetConverted) GetConversionId() []byte {
+	if x != nil {
+		return x.ConversionId
+	}
+	return nil
+}
+
+func (x *SubnetConverted) GetChainId() []byte {
+	if x != nil {
+		return x.ChainId
+	}
+	return nil
+}
+
+func (x *SubnetConverted) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+// ChainCreated is sent when a chain is created.
+type ChainCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       []byte                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	SubnetId      []byte                 `protobuf:"bytes,2,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	VmId          []byte                 `protobuf:"bytes,3,opt,name=vm_id,json=vmId,proto3" json:"vm_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChainCreated) Reset() {
+	*x = ChainCreated{}
+	mi := &file_platformvm_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainCreated) ProtoMessage() {}
+
+func (x *ChainCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_platformvm_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainCreated.ProtoReflect.Descriptor instead.
+func (*ChainCreated) Descriptor() ([]byte, []int) {
+	return file_platformvm_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChainCreated) GetChainId() []byte {
+	if x != nil {
+		return x.ChainId
+	}
+	return nil
+}
+
+func (x *ChainCreated) GetSubnetId() []byte {
+	if x != nil {
+		return x.SubnetId
+	}
+	return nil
+}
+
+func (x *ChainCreated) GetVmId() []byte {
+	if x != nil {
+		return x.VmId
+	}
+	return nil
+}
+
+func (x *ChainCreated) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_platformvm_service_proto protoreflect.FileDescriptor
+
+const file_platformvm_service_proto_rawDesc = "" +
+	"\n" +
+	"\x18platformvm/service.proto\x12\n" +
+	"platformvm\"5\n" +
+	"\x16SubscribeEventsRequest\x12\x1b\n" +
+	"\tsubnet_id\x18\x01 \x01(\fR\bsubnetId\"\x91\x06\n" +
+	"\x05Event\x12\x19\n" +
+	"\bblock_id\x18\x01 \x01(\fR\ablockId\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x04R\x06height\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x12B\n" +
+	"\x0eblock_accepted\x18\x04 \x01(\v2\x19.platformvm.BlockAcceptedH\x00R\rblockAccepted\x12<\n" +
+	"\fstaker_added\x18\x05 \x01(\v2\x17.platformvm.StakerAddedH\x00R\vstakerAdded\x12B\n" +
+	"\x0estaker_removed\x18\x06 \x01(\v2\x19.platformvm.StakerRemovedH\x00R\rstakerRemoved\x12[\n" +
+	"\x17l1_validator_registered\x18\a \x01(\v2!.platformvm.L1ValidatorRegisteredH\x00R\x15l1ValidatorRegistered\x12e\n" +
+	"\x1bl1_validator_weight_changed\x18\b \x01(\v2$.platformvm.L1ValidatorWeightChangedH\x00R\x18l1ValidatorWeightChanged\x12U\n" +
+	"\x15l1_validator_disabled\x18\t \x01(\v2\x1f.platformvm.L1ValidatorDisabledH\x00R\x13l1ValidatorDisabled\x12B\n" +
+	"\x0esubnet_created\x18\n" +
+	" \x01(\v2\x19.platformvm.SubnetCreatedH\x00R\rsubnetCreated\x12H\n" +
+	"\x10subnet_converted\x18\v \x01(\v2\x1b.platformvm.SubnetConvertedH\x00R\x0fsubnetConverted\x12?\n" +
+	"\rchain_created\x18\f \x01(\v2\x18.platformvm.ChainCreatedH\x00R\fchainCreatedB\a\n" +
+	"\x05event\"C\n" +
+	"\rBlockAccepted\x12\x1b\n" +
+	"\tparent_id\x18\x01 \x01(\fR\bparentId\x12\x15\n" +
+	"\x06tx_ids\x18\x02 \x03(\fR\x05txIds\"\xdd\x01\n" +
+	"\x06Staker\x12\x13\n" +
+	"\x05tx_id\x18\x01 \x01(\fR\x04txId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\fR\x06nodeId\x12\x1b\n" +
+	"\tsubnet_id\x18\x03 \x01(\fR\bsubnetId\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x04R\x06weight\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x06 \x01(\x03R\aendTime\x12\x1c\n" +
+	"\tdelegator\x18\a \x01(\bR\tdelegator\x12\x18\n" +
+	"\apending\x18\b \x01(\bR\apending\"9\n" +
+	"\vStakerAdded\x12*\n" +
+	"\x06staker\x18\x01 \x01(\v2\x12.platformvm.StakerR\x06staker\";\n" +
+	"\rStakerRemoved\x12*\n" +
+	"\x06staker\x18\x01 \x01(\v2\x12.platformvm.StakerR\x06staker\"\x8a\x01\n" +
+	"\x15L1ValidatorRegistered\x12#\n" +
+	"\rvalidation_id\x18\x01 \x01(\fR\fvalidationId\x12\x1b\n" +
+	"\tsubnet_id\x18\x02 \x01(\fR\bsubnetId\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\fR\x06nodeId\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x04R\x06weight\"\xb6\x01\n" +
+	"\x18L1ValidatorWeightChanged\x12#\n" +
+	"\rvalidation_id\x18\x01 \x01(\fR\fvalidationId\x12\x1b\n" +
+	"\tsubnet_id\x18\x02 \x01(\fR\bsubnetId\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\fR\x06nodeId\x12'\n" +
+	"\x0fprevious_weight\x18\x04 \x01(\x04R\x0epreviousWeight\x12\x16\n" +
+	"\x06weight\x18\x05 \x01(\x04R\x06weight\"p\n" +
+	"\x13L1ValidatorDisabled\x12#\n" +
+	"\rvalidation_id\x18\x01 \x01(\fR\fvalidationId\x12\x1b\n" +
+	"\tsubnet_id\x18\x02 \x01(\fR\bsubnetId\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\fR\x06nodeId\",\n" +
+	"\rSubnetCreated\x12\x1b\n" +
+	"\tsubnet_id\x18\x01 \x01(\fR\bsubnetId\"\x88\x01\n" +
+	"\x0fSubnetConverted\x12\x1b\n" +
+	"\tsubnet_id\x18\x01 \x01(\fR\bsubnetId\x12#\n" +
+	"\rconversion_id\x18\x02 \x01(\fR\fconversionId\x12\x19\n" +
+	"\bchain_id\x18\x03 \x01(\fR\achainId\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\fR\aaddress\"o\n" +
+	"\fChainCreated\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\fR\achainId\x12\x1b\n" +
+	"\tsubnet_id\x18\x02 \x01(\fR\bsubnetId\x12\x13\n" +
+	"\x05vm_id\x18\x03 \x01(\fR\x04vmId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name2X\n" +
+	"\n" +
+	"PlatformVM\x12J\n" +
+	"\x0fSubscribeEvents\x12\".platformvm.SubscribeEventsRequest\x1a\x11.platformvm.Event0\x01B<Z:github.com/ava-labs/avalanchego/connectproto/pb/platformvmb\x06proto3"
+
+var (
+	file_platformvm_service_proto_rawDescOnce sync.Once
+	file_platformvm_service_proto_rawDescData []byte
+)
+
+func file_platformvm_service_proto_rawDescGZIP() []byte {
+	file_platformvm_service_proto_rawDescOnce.Do(func() {
+		file_platformvm_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_platformvm_service_proto_rawDesc), len(file_platformvm_service_proto_rawDesc)))
+	})
+	return file_platformvm_service_proto_rawDescData
+}
+
+var file_platformvm_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_platformvm_service_proto_goTypes = []any{
+	(*SubscribeEventsRequest)(nil),   // 0: platformvm.SubscribeEventsRequest
+	(*Event)(nil),                    // 1: platformvm.Event
+	(*BlockAccepted)(nil),            // 2: platformvm.BlockAccepted
+	(*Staker)(nil),                   // 3: platformvm.Staker
+	(*StakerAdded)(nil),              // 4: platformvm.StakerAdded
+	(*StakerRemoved)(nil),            // 5: platformvm.StakerRemoved
+	(*L1ValidatorRegistered)(nil),    // 6: platformvm.L1ValidatorRegistered
+	(*L1ValidatorWeightChanged)(nil), // 7: platformvm.L1ValidatorWeightChanged
+	(*L1ValidatorDisabled)(nil),      // 8: platformvm.L1ValidatorDisabled
+	(*SubnetCreated)(nil),            // 9: platformvm.SubnetCreated
+	(*SubnetConverted)(nil),          // 10: platformvm.SubnetConverted
+	(*ChainCreated)(nil),             // 11: platformvm.ChainCreated
+}
+var file_platformvm_service_proto_depIdxs = []int32{
+	2,  // 0: platformvm.Event.block_accepted:type_name -> platformvm.BlockAccepted
+	4,  // 1: platformvm.Event.staker_added:type_name -> platformvm.StakerAdded
+	5,  // 2: platformvm.Event.staker_removed:type_name -> platformvm.StakerRemoved
+	6,  // 3: platformvm.Event.l1_validator_registered:type_name -> platformvm.L1ValidatorRegistered
+	7,  // 4: platformvm.Event.l1_validator_weight_changed:type_name -> platformvm.L1ValidatorWeightChanged
+	8,  // 5: platformvm.Event.l1_validator_disabled:type_name -> platformvm.L1ValidatorDisabled
+	9,  // 6: platformvm.Event.subnet_created:type_name -> platformvm.SubnetCreated
+	10, // 7: platformvm.Event.subnet_converted:type_name -> platformvm.SubnetConverted
+	11, // 8: platformvm.Event.chain_created:type_name -> platformvm.ChainCreated
+	3,  // 9: platformvm.StakerAdded.staker:type_name -> platformvm.Staker
+	3,  // 10: platformvm.StakerRemoved.staker:type_name -> platformvm.Staker
+	0,  // 11: platformvm.PlatformVM.SubscribeEvents:input_type -> platformvm.SubscribeEventsRequest
+	1,  // 12: platformvm.PlatformVM.SubscribeEvents:output_type -> platformvm.Event
+	12, // [12:13] is the sub-list for method output_type
+	11, // [11:12] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_platformvm_service_proto_init() }
+func file_platformvm_service_proto_init() {
+	if File_platformvm_service_proto != nil {
+		return
+	}
+	file_platformvm_service_proto_msgTypes[1].OneofWrappers = []any{
+		(*Event_BlockAccepted)(nil),
+		(*Event_StakerAdded)(nil),
+		(*Event_StakerRemoved)(nil),
+		(*Event_L1ValidatorRegistered)(nil),
+		(*Event_L1ValidatorWeightChanged)(nil),
+		(*Event_L1ValidatorDisabled)(nil),
+		(*Event_SubnetCreated)(nil),
+		(*Event_SubnetConverted)(nil),
+		(*Event_ChainCreated)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_platformvm_service_proto_rawDesc), len(file_platformvm_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_platformvm_service_proto_goTypes,
+		DependencyIndexes: file_platformvm_service_proto_depIdxs,
+		MessageInfos:      file_platformvm_service_proto_msgTypes,
+	}.Build()
+	File_platformvm_service_proto = out.File
+	file_platformvm_service_proto_goTypes = nil
+	file_platformvm_service_proto_depIdxs = nil
+}
@@ -36,6 +36,7 @@ type Config struct {
 	Log                   logging.Logger
 	StateSyncNodes        []ids.NodeID
 	Registerer            prometheus.Registerer
+	PeerTracker           *p2p.PeerTracker
 }
 
 func NewSyncer(config Config, db *ffi.Database, targetRoot ids.ID, rangeProofClient *p2p.Client, changeProofClient *p2p.Client) (*xsync.Syncer[*RangeProof, struct{}], error) {
@@ -60,6 +61,7 @@ func NewSyncer(config Config, db *ffi.Database, targetRoot ids.ID, rangeProofCli
 			Log:                   config.Log,
 			TargetRoot:            targetRoot,
 			StateSyncNodes:        config.StateSyncNodes,
+			PeerTracker:           config.PeerTracker,
 		},
 		config.Registerer,
 	)
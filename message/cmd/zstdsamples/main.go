@@ -0,0 +1,281 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// zstdsamples writes uncompressed p2p messages that are used as training
+// samples for the zstd dictionaries in utils/compression. See
+// utils/compression/README.md.
+package main
+
+import (
+	"crypto"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/proto/pb/p2p"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/perms"
+	"github.com/ava-labs/avalanchego/vms/proposervm/block"
+)
+
+func main() {
+	var (
+		outputDir = flag.String("output", "", "Directory to write the samples into")
+		count     = flag.Int("count", 1000, "Number of samples to write for each message type")
+		seed      = flag.Int64("seed", 0, "Seed of the pseudo-random message contents, excluding the certificate and signatures")
+	)
+	flag.Parse()
+
+	if *outputDir == "" {
+		log.Fatal("--output must be provided")
+	}
+	if err := os.MkdirAll(*outputDir, perms.ReadWriteExecute); err != nil {
+		log.Fatalf("failed to create output directory: %v", err)
+	}
+
+	g, err := newGenerator(*seed)
+	if err != nil {
+		log.Fatalf("failed to create generator: %v", err)
+	}
+
+	// The builders are ordered so that the pseudo-random contents of the
+	// samples only depend on [seed]. The staking certificate and the block
+	// signatures are generated by each run, so the samples that include them
+	// aren't reproducible.
+	builders := []struct {
+		name  string
+		build func() (*p2p.Message, error)
+	}{
+		{name: "chits", build: g.chits},
+		{name: "pull_query", build: g.pullQuery},
+		{name: "push_query", build: g.pushQuery},
+		{name: "put", build: g.put},
+		{name: "ancestors", build: g.ancestors},
+		{name: "accepted", build: g.accepted},
+		{name: "peer_list", build: g.peerList},
+		{name: "app_gossip", build: g.appGossip},
+	}
+	for _, builder := range builders {
+		name := builder.name
+		for i := 0; i < *count; i++ {
+			msg, err := builder.build()
+			if err != nil {
+				log.Fatalf("failed to build %s: %v", name, err)
+			}
+			msgBytes, err := proto.Marshal(msg)
+			if err != nil {
+				log.Fatalf("failed to marshal %s: %v", name, err)
+			}
+			path := filepath.Join(*outputDir, fmt.Sprintf("%s_%05d", name, i))
+			if err := os.WriteFile(path, msgBytes, perms.ReadWrite); err != nil {
+				log.Fatalf("failed to write %s: %v", path, err)
+			}
+		}
+	}
+}
+
+type generator struct {
+	rand    *rand.Rand
+	chainID ids.ID
+	cert    *staking.Certificate
+	signer  crypto.Signer
+	height  uint64
+}
+
+func newGenerator(seed int64) (*generator, error) {
+	tlsCert, err := staking.NewTLSCert()
+	if err != nil {
+		return nil, err
+	}
+	cert, err := staking.ParseCertificate(tlsCert.Leaf.Raw)
+	if err != nil {
+		return nil, err
+	}
+	return &generator{
+		rand:    rand.New(rand.NewSource(seed)), //#nosec G404
+		chainID: constants.PlatformChainID,
+		cert:    cert,
+		signer:  tlsCert.PrivateKey.(crypto.Signer),
+		height:  1_000_000,
+	}, nil
+}
+
+func (g *generator) id() ids.ID {
+	var id ids.ID
+	_, _ = g.rand.Read(id[:])
+	return id
+}
+
+func (g *generator) bytes(maxLen int) []byte {
+	b := make([]byte, 1+g.rand.Intn(maxLen))
+	_, _ = g.rand.Read(b)
+	return b
+}
+
+func (g *generator) container() ([]byte, error) {
+	g.height++
+	blk, err := block.Build(
+		g.id(),
+		time.Unix(1_700_000_000+int64(g.height), 0),
+		g.height,
+		block.Epoch{},
+		g.cert,
+		g.bytes(1024),
+		g.chainID,
+		g.signer,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return blk.Bytes(), nil
+}
+
+func (g *generator) chits() (*p2p.Message, error) {
+	var (
+		preferredID = g.id()
+		acceptedID  = g.id()
+	)
+	return &p2p.Message{
+		Message: &p2p.Message_Chits{
+			Chits: &p2p.Chits{
+				ChainId:             g.chainID[:],
+				RequestId:           g.rand.Uint32(),
+				PreferredId:         preferredID[:],
+				PreferredIdAtHeight: preferredID[:],
+				AcceptedId:          acceptedID[:],
+				AcceptedHeight:      g.height,
+			},
+		},
+	}, nil
+}
+
+func (g *generator) pullQuery() (*p2p.Message, error) {
+	containerID := g.id()
+	return &p2p.Message{
+		Message: &p2p.Message_PullQuery{
+			PullQuery: &p2p.PullQuery{
+				ChainId:         g.chainID[:],
+				RequestId:       g.rand.Uint32(),
+				Deadline:        uint64(2 * time.Second),
+				ContainerId:     containerID[:],
+				RequestedHeight: g.height,
+			},
+		},
+	}, nil
+}
+
+func (g *generator) pushQuery() (*p2p.Message, error) {
+	container, err := g.container()
+	if err != nil {
+		return nil, err
+	}
+	return &p2p.Message{
+		Message: &p2p.Message_PushQuery{
+			PushQuery: &p2p.PushQuery{
+				ChainId:         g.chainID[:],
+				RequestId:       g.rand.Uint32(),
+				Deadline:        uint64(2 * time.Second),
+				Container:       container,
+				RequestedHeight: g.height,
+			},
+		},
+	}, nil
+}
+
+func (g *generator) put() (*p2p.Message, error) {
+	container, err := g.container()
+	if err != nil {
+		return nil, err
+	}
+	return &p2p.Message{
+		Message: &p2p.Message_Put{
+			Put: &p2p.Put{
+				ChainId:   g.chainID[:],
+				RequestId: g.rand.Uint32(),
+				Container: container,
+			},
+		},
+	}, nil
+}
+
+func (g *generator) ancestors() (*p2p.Message, error) {
+	containers := make([][]byte, 1+g.rand.Intn(8))
+	for i := range containers {
+		container, err := g.container()
+		if err != nil {
+			return nil, err
+		}
+		containers[i] = container
+	}
+	return &p2p.Message{
+		Message: &p2p.Message_Ancestors_{
+			Ancestors_: &p2p.Ancestors{
+				ChainId:    g.chainID[:],
+				RequestId:  g.rand.Uint32(),
+				Containers: containers,
+			},
+		},
+	}, nil
+}
+
+func (g *generator) accepted() (*p2p.Message, error) {
+	containerIDs := make([][]byte, 1+g.rand.Intn(4))
+	for i := range containerIDs {
+		containerID := g.id()
+		containerIDs[i] = containerID[:]
+	}
+	return &p2p.Message{
+		Message: &p2p.Message_Accepted_{
+			Accepted_: &p2p.Accepted{
+				ChainId:      g.chainID[:],
+				RequestId:    g.rand.Uint32(),
+				ContainerIds: containerIDs,
+			},
+		},
+	}, nil
+}
+
+func (g *generator) peerList() (*p2p.Message, error) {
+	claimedIPPorts := make([]*p2p.ClaimedIpPort, 1+g.rand.Intn(16))
+	for i := range claimedIPPorts {
+		var (
+			txID   = g.id()
+			ipAddr = make([]byte, 16)
+		)
+		_, _ = g.rand.Read(ipAddr)
+		claimedIPPorts[i] = &p2p.ClaimedIpPort{
+			X509Certificate: g.cert.Raw,
+			IpAddr:          ipAddr,
+			IpPort:          uint32(g.rand.Intn(1 << 16)),
+			Timestamp:       uint64(1_700_000_000 + g.rand.Intn(1_000_000)),
+			Signature:       g.bytes(256),
+			TxId:            txID[:],
+		}
+	}
+	return &p2p.Message{
+		Message: &p2p.Message_PeerList_{
+			PeerList_: &p2p.PeerList{
+				ClaimedIpPorts: claimedIPPorts,
+			},
+		},
+	}, nil
+}
+
+func (g *generator) appGossip() (*p2p.Message, error) {
+	return &p2p.Message{
+		Message: &p2p.Message_AppGossip{
+			AppGossip: &p2p.AppGossip{
+				ChainId:  g.chainID[:],
+				AppBytes: g.bytes(1024),
+			},
+		},
+	}, nil
+}
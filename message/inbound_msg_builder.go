@@ -58,15 +58,17 @@ func InboundStateSummaryFrontier(
 	chainID ids.ID,
 	requestID uint32,
 	summary []byte,
+	checkpoint []byte,
 	nodeID ids.NodeID,
 ) InboundMessage {
 	return &inboundMessage{
 		nodeID: nodeID,
 		op:     StateSummaryFrontierOp,
 		message: &p2p.StateSummaryFrontier{
-			ChainId:   chainID[:],
-			RequestId: requestID,
-			Summary:   summary,
+			ChainId:    chainID[:],
+			RequestId:  requestID,
+			Summary:    summary,
+			Checkpoint: checkpoint,
 		},
 		expiration: mockable.MaxTime,
 	}
@@ -34,6 +34,7 @@ func TestInboundMsgBuilder(t *testing.T) {
 		requestID           uint32 = 12345
 		deadline                   = time.Hour
 		nodeID                     = ids.GenerateTestNodeID()
+		checkpoint                 = []byte{6, 5, 4}
 		summary                    = []byte{9, 8, 7}
 		appBytes                   = []byte{1, 3, 3, 7}
 		container                  = []byte{1, 2, 3, 4, 5, 6, 7, 8, 9}
@@ -78,6 +79,7 @@ func TestInboundMsgBuilder(t *testing.T) {
 				chainID,
 				requestID,
 				summary,
+				checkpoint,
 				nodeID,
 			)
 
@@ -89,6 +91,7 @@ func TestInboundMsgBuilder(t *testing.T) {
 			require.Equal(chainID[:], innerMsg.ChainId)
 			require.Equal(requestID, innerMsg.RequestId)
 			require.Equal(summary, innerMsg.Summary)
+			require.Equal(checkpoint, innerMsg.Checkpoint)
 		},
 	)
 
@@ -17,6 +17,7 @@ import (
 	ids "github.com/ava-labs/avalanchego/ids"
 	message "github.com/ava-labs/avalanchego/message"
 	p2p "github.com/ava-labs/avalanchego/proto/pb/p2p"
+	compression "github.com/ava-labs/avalanchego/utils/compression"
 	ips "github.com/ava-labs/avalanchego/utils/ips"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -255,6 +256,21 @@ func (mr *OutboundMsgBuilderMockRecorder) GetAncestors(chainID, requestID, deadl
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAncestors", reflect.TypeOf((*OutboundMsgBuilder)(nil).GetAncestors), chainID, requestID, deadline, containerID, engineType)
 }
 
+// GetAncestorsAtHeight mocks base method.
+func (m *OutboundMsgBuilder) GetAncestorsAtHeight(chainID ids.ID, requestID uint32, deadline time.Duration, height uint64, engineType p2p.EngineType) (*message.OutboundMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAncestorsAtHeight", chainID, requestID, deadline, height, engineType)
+	ret0, _ := ret[0].(*message.OutboundMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAncestorsAtHeight indicates an expected call of GetAncestorsAtHeight.
+func (mr *OutboundMsgBuilderMockRecorder) GetAncestorsAtHeight(chainID, requestID, deadline, height, engineType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAncestorsAtHeight", reflect.TypeOf((*OutboundMsgBuilder)(nil).GetAncestorsAtHeight), chainID, requestID, deadline, height, engineType)
+}
+
 // GetPeerList mocks base method.
 func (m *OutboundMsgBuilder) GetPeerList(knownPeersFilter, knownPeersSalt []byte, requestAllSubnetIPs bool) (*message.OutboundMessage, error) {
 	m.ctrl.T.Helper()
@@ -286,18 +302,18 @@ func (mr *OutboundMsgBuilderMockRecorder) GetStateSummaryFrontier(chainID, reque
 }
 
 // Handshake mocks base method.
-func (m *OutboundMsgBuilder) Handshake(networkID uint32, myTime uint64, ip netip.AddrPort, client string, major, minor, patch uint32, upgradeTime, ipSigningTime uint64, ipNodeIDSig, ipBLSSig []byte, trackedSubnets []ids.ID, supportedACPs, objectedACPs []uint32, knownPeersFilter, knownPeersSalt []byte, requestAllSubnetIPs bool) (*message.OutboundMessage, error) {
+func (m *OutboundMsgBuilder) Handshake(networkID uint32, myTime uint64, ip netip.AddrPort, client string, major, minor, patch uint32, upgradeTime, ipSigningTime uint64, ipNodeIDSig, ipBLSSig []byte, trackedSubnets []ids.ID, supportedACPs, objectedACPs []uint32, knownPeersFilter, knownPeersSalt []byte, requestAllSubnetIPs bool, zstdDictIDs []uint32, ipv6 netip.AddrPort, ipv6SigningTime uint64, ipv6NodeIDSig []byte) (*message.OutboundMessage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Handshake", networkID, myTime, ip, client, major, minor, patch, upgradeTime, ipSigningTime, ipNodeIDSig, ipBLSSig, trackedSubnets, supportedACPs, objectedACPs, knownPeersFilter, knownPeersSalt, requestAllSubnetIPs)
+	ret := m.ctrl.Call(m, "Handshake", networkID, myTime, ip, client, major, minor, patch, upgradeTime, ipSigningTime, ipNodeIDSig, ipBLSSig, trackedSubnets, supportedACPs, objectedACPs, knownPeersFilter, knownPeersSalt, requestAllSubnetIPs, zstdDictIDs, ipv6, ipv6SigningTime, ipv6NodeIDSig)
 	ret0, _ := ret[0].(*message.OutboundMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Handshake indicates an expected call of Handshake.
-func (mr *OutboundMsgBuilderMockRecorder) Handshake(networkID, myTime, ip, client, major, minor, patch, upgradeTime, ipSigningTime, ipNodeIDSig, ipBLSSig, trackedSubnets, supportedACPs, objectedACPs, knownPeersFilter, knownPeersSalt, requestAllSubnetIPs any) *gomock.Call {
+func (mr *OutboundMsgBuilderMockRecorder) Handshake(networkID, myTime, ip, client, major, minor, patch, upgradeTime, ipSigningTime, ipNodeIDSig, ipBLSSig, trackedSubnets, supportedACPs, objectedACPs, knownPeersFilter, knownPeersSalt, requestAllSubnetIPs, zstdDictIDs, ipv6, ipv6SigningTime, ipv6NodeIDSig any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handshake", reflect.TypeOf((*OutboundMsgBuilder)(nil).Handshake), networkID, myTime, ip, client, major, minor, patch, upgradeTime, ipSigningTime, ipNodeIDSig, ipBLSSig, trackedSubnets, supportedACPs, objectedACPs, knownPeersFilter, knownPeersSalt, requestAllSubnetIPs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handshake", reflect.TypeOf((*OutboundMsgBuilder)(nil).Handshake), networkID, myTime, ip, client, major, minor, patch, upgradeTime, ipSigningTime, ipNodeIDSig, ipBLSSig, trackedSubnets, supportedACPs, objectedACPs, knownPeersFilter, knownPeersSalt, requestAllSubnetIPs, zstdDictIDs, ipv6, ipv6SigningTime, ipv6NodeIDSig)
 }
 
 // PeerList mocks base method.
@@ -390,6 +406,21 @@ func (mr *OutboundMsgBuilderMockRecorder) Put(chainID, requestID, container any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*OutboundMsgBuilder)(nil).Put), chainID, requestID, container)
 }
 
+// Recompress mocks base method.
+func (m *OutboundMsgBuilder) Recompress(msg *message.OutboundMessage, compressionType compression.Type) (*message.OutboundMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recompress", msg, compressionType)
+	ret0, _ := ret[0].(*message.OutboundMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recompress indicates an expected call of Recompress.
+func (mr *OutboundMsgBuilderMockRecorder) Recompress(msg, compressionType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recompress", reflect.TypeOf((*OutboundMsgBuilder)(nil).Recompress), msg, compressionType)
+}
+
 // SimplexMessage mocks base method.
 func (m *OutboundMsgBuilder) SimplexMessage(msg *p2p.Simplex) (*message.OutboundMessage, error) {
 	m.ctrl.T.Helper()
@@ -406,16 +437,16 @@ func (mr *OutboundMsgBuilderMockRecorder) SimplexMessage(msg any) *gomock.Call {
 }
 
 // StateSummaryFrontier mocks base method.
-func (m *OutboundMsgBuilder) StateSummaryFrontier(chainID ids.ID, requestID uint32, summary []byte) (*message.OutboundMessage, error) {
+func (m *OutboundMsgBuilder) StateSummaryFrontier(chainID ids.ID, requestID uint32, summary, checkpoint []byte) (*message.OutboundMessage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StateSummaryFrontier", chainID, requestID, summary)
+	ret := m.ctrl.Call(m, "StateSummaryFrontier", chainID, requestID, summary, checkpoint)
 	ret0, _ := ret[0].(*message.OutboundMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // StateSummaryFrontier indicates an expected call of StateSummaryFrontier.
-func (mr *OutboundMsgBuilderMockRecorder) StateSummaryFrontier(chainID, requestID, summary any) *gomock.Call {
+func (mr *OutboundMsgBuilderMockRecorder) StateSummaryFrontier(chainID, requestID, summary, checkpoint any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateSummaryFrontier", reflect.TypeOf((*OutboundMsgBuilder)(nil).StateSummaryFrontier), chainID, requestID, summary)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateSummaryFrontier", reflect.TypeOf((*OutboundMsgBuilder)(nil).StateSummaryFrontier), chainID, requestID, summary, checkpoint)
 }
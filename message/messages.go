@@ -6,6 +6,7 @@ package message
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -102,17 +103,30 @@ type OutboundMessage struct {
 	// any outbound message throttling
 	BypassThrottling bool
 	Op               Op
-	Bytes            []byte
+	// ChainID is the chain this message is sent on behalf of, or
+	// [ids.Empty] if this message isn't specific to a chain
+	ChainID ids.ID
+	Bytes   []byte
 	// BytesSavedCompression stores the amount of bytes that this message saved
 	// due to being compressed
 	BytesSavedCompression int
+	// CompressionType is the compression type that was used to compress this
+	// message
+	CompressionType compression.Type
+
+	// recompressedLock protects [recompressed], which caches this message
+	// compressed with other compression types. The same message is commonly
+	// sent to many peers, so each recompression is only done once.
+	recompressedLock sync.Mutex
+	recompressed     map[compression.Type]*OutboundMessage
 }
 
 // TODO: add other compression algorithms with extended interface
 type msgBuilder struct {
-	zstdCompressor compression.Compressor
-	count          *prometheus.CounterVec // type + op + direction
-	duration       *prometheus.GaugeVec   // type + op + direction
+	zstdCompressor     compression.Compressor
+	zstdDictCompressor compression.Compressor
+	count              *prometheus.CounterVec // type + op + direction
+	duration           *prometheus.GaugeVec   // type + op + direction
 
 	maxMessageTimeout time.Duration
 }
@@ -125,9 +139,14 @@ func newMsgBuilder(
 	if err != nil {
 		return nil, err
 	}
+	zstdDictCompressor, err := compression.NewZstdDictCompressor(constants.DefaultMaxMessageSize)
+	if err != nil {
+		return nil, err
+	}
 
 	mb := &msgBuilder{
-		zstdCompressor: zstdCompressor,
+		zstdCompressor:     zstdCompressor,
+		zstdDictCompressor: zstdDictCompressor,
 		count: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
 				Name: "codec_compressed_count",
@@ -188,6 +207,16 @@ func (mb *msgBuilder) marshal(
 				CompressedZstd: compressedBytes,
 			},
 		}
+	case compression.TypeZstdDict:
+		compressedBytes, err := mb.zstdDictCompressor.Compress(uncompressedMsgBytes)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		compressedMsg = p2p.Message{
+			Message: &p2p.Message_CompressedZstdDict{
+				CompressedZstdDict: compressedBytes,
+			},
+		}
 	default:
 		return nil, 0, 0, errUnknownCompressionType
 	}
@@ -218,14 +247,21 @@ func (mb *msgBuilder) unmarshal(b []byte) (*p2p.Message, int, Op, error) {
 
 	// Figure out what compression type, if any, was used to compress the message.
 	var (
-		compressor      compression.Compressor
-		compressedBytes []byte
-		zstdCompressed  = m.GetCompressedZstd()
+		compressionType    compression.Type
+		compressor         compression.Compressor
+		compressedBytes    []byte
+		zstdCompressed     = m.GetCompressedZstd()
+		zstdDictCompressed = m.GetCompressedZstdDict()
 	)
 	switch {
 	case len(zstdCompressed) > 0:
+		compressionType = compression.TypeZstd
 		compressor = mb.zstdCompressor
 		compressedBytes = zstdCompressed
+	case len(zstdDictCompressed) > 0:
+		compressionType = compression.TypeZstdDict
+		compressor = mb.zstdDictCompressor
+		compressedBytes = zstdDictCompressed
 	default:
 		// The message wasn't compressed
 		op, err := ToOp(m)
@@ -252,7 +288,7 @@ func (mb *msgBuilder) unmarshal(b []byte) (*p2p.Message, int, Op, error) {
 	}
 
 	labels := prometheus.Labels{
-		typeLabel:      compression.TypeZstd.String(),
+		typeLabel:      compressionType.String(),
 		opLabel:        op.String(),
 		directionLabel: decompressionLabel,
 	}
@@ -268,14 +304,57 @@ func (mb *msgBuilder) createOutbound(m *p2p.Message, compressionType compression
 		return nil, err
 	}
 
+	// Messages that aren't specific to a chain, such as network messages,
+	// don't have a chainID.
+	var chainID ids.ID
+	if msg, err := Unwrap(m); err == nil {
+		chainID, _ = GetChainID(msg)
+	}
+
 	return &OutboundMessage{
 		BypassThrottling:      bypassThrottling,
 		Op:                    op,
+		ChainID:               chainID,
 		Bytes:                 b,
 		BytesSavedCompression: saved,
+		CompressionType:       compressionType,
 	}, nil
 }
 
+// recompress returns [msg] compressed with [compressionType]. This allows a
+// message that was compressed with an algorithm that a peer doesn't support to
+// still be sent to that peer.
+//
+// The result is cached in [msg], so a message is recompressed at most once per
+// compression type regardless of the number of peers it is sent to.
+func (mb *msgBuilder) recompress(msg *OutboundMessage, compressionType compression.Type) (*OutboundMessage, error) {
+	if msg.CompressionType == compressionType {
+		return msg, nil
+	}
+
+	msg.recompressedLock.Lock()
+	defer msg.recompressedLock.Unlock()
+
+	if recompressedMsg, ok := msg.recompressed[compressionType]; ok {
+		return recompressedMsg, nil
+	}
+
+	m, _, _, err := mb.unmarshal(msg.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	recompressedMsg, err := mb.createOutbound(m, compressionType, msg.BypassThrottling)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.recompressed == nil {
+		msg.recompressed = make(map[compression.Type]*OutboundMessage, 1)
+	}
+	msg.recompressed[compressionType] = recompressedMsg
+	return recompressedMsg, nil
+}
+
 func (mb *msgBuilder) parseInbound(
 	bytes []byte,
 	nodeID ids.NodeID,
@@ -4,6 +4,8 @@
 package message
 
 import (
+	"crypto"
+	"fmt"
 	"net"
 	"os"
 	"testing"
@@ -15,7 +17,11 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/proto/pb/p2p"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/compression"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/proposervm/block"
 )
 
 var (
@@ -132,3 +138,104 @@ func BenchmarkUnmarshalHandshake(b *testing.B) {
 		}
 	}
 }
+
+// Benchmarks the size of typical consensus messages when compressed with each
+// compression type. The average size of the marshalled messages is reported
+// in the "bytes/msg" metric.
+//
+// e.g.,
+//
+//	$ go test -run=NONE -bench=BenchmarkCompressedMessageSize
+func BenchmarkCompressedMessageSize(b *testing.B) {
+	codec, err := newMsgBuilder(prometheus.NewRegistry(), 10*time.Second)
+	require.NoError(b, err)
+
+	tlsCert, err := staking.NewTLSCert()
+	require.NoError(b, err)
+	cert, err := staking.ParseCertificate(tlsCert.Leaf.Raw)
+	require.NoError(b, err)
+
+	var (
+		chainID  = constants.PlatformChainID
+		blkID    = ids.GenerateTestID()
+		parentID = ids.GenerateTestID()
+		acceptID = ids.GenerateTestID()
+	)
+	blk, err := block.Build(
+		parentID,
+		time.Now(),
+		1_000_000,
+		block.Epoch{},
+		cert,
+		utils.RandomBytes(256),
+		chainID,
+		tlsCert.PrivateKey.(crypto.Signer),
+	)
+	require.NoError(b, err)
+	container := blk.Bytes()
+
+	msgs := map[string]*p2p.Message{
+		"chits": {
+			Message: &p2p.Message_Chits{
+				Chits: &p2p.Chits{
+					ChainId:             chainID[:],
+					RequestId:           1337,
+					PreferredId:         blkID[:],
+					PreferredIdAtHeight: parentID[:],
+					AcceptedId:          acceptID[:],
+					AcceptedHeight:      1_000_000,
+				},
+			},
+		},
+		"pull_query": {
+			Message: &p2p.Message_PullQuery{
+				PullQuery: &p2p.PullQuery{
+					ChainId:         chainID[:],
+					RequestId:       1337,
+					Deadline:        uint64(2 * time.Second),
+					ContainerId:     blkID[:],
+					RequestedHeight: 1_000_001,
+				},
+			},
+		},
+		"push_query": {
+			Message: &p2p.Message_PushQuery{
+				PushQuery: &p2p.PushQuery{
+					ChainId:         chainID[:],
+					RequestId:       1337,
+					Deadline:        uint64(2 * time.Second),
+					Container:       container,
+					RequestedHeight: 1_000_001,
+				},
+			},
+		},
+		"accepted": {
+			Message: &p2p.Message_Accepted_{
+				Accepted_: &p2p.Accepted{
+					ChainId:      chainID[:],
+					RequestId:    1337,
+					ContainerIds: [][]byte{blkID[:], parentID[:]},
+				},
+			},
+		},
+	}
+
+	compressionTypes := []compression.Type{
+		compression.TypeNone,
+		compression.TypeZstd,
+		compression.TypeZstdDict,
+	}
+	for name, msg := range msgs {
+		for _, compressionType := range compressionTypes {
+			b.Run(fmt.Sprintf("%s_%s", name, compressionType), func(b *testing.B) {
+				var totalBytes int
+				for i := 0; i < b.N; i++ {
+					outMsg, err := codec.createOutbound(msg, compressionType, false)
+					require.NoError(b, err)
+					totalBytes += len(outMsg.Bytes)
+				}
+				b.ReportMetric(float64(totalBytes)/float64(b.N), "bytes/msg")
+			})
+		}
+	}
+}
@@ -500,6 +500,23 @@ func TestMessage(t *testing.T) {
 			bypassThrottling: true,
 			bytesSaved:       true,
 		},
+		{
+			desc: "push_query message with zstd dict compression",
+			op:   PushQueryOp,
+			msg: &p2p.Message{
+				Message: &p2p.Message_PushQuery{
+					PushQuery: &p2p.PushQuery{
+						ChainId:   testID[:],
+						RequestId: 1,
+						Deadline:  1,
+						Container: compressibleContainers[0],
+					},
+				},
+			},
+			compressionType:  compression.TypeZstdDict,
+			bypassThrottling: true,
+			bytesSaved:       true,
+		},
 		{
 			desc: "pull_query message with no compression",
 			op:   PullQueryOp,
@@ -533,6 +550,22 @@ func TestMessage(t *testing.T) {
 			bypassThrottling: true,
 			bytesSaved:       false,
 		},
+		{
+			desc: "chits message with zstd dict compression",
+			op:   ChitsOp,
+			msg: &p2p.Message{
+				Message: &p2p.Message_Chits{
+					Chits: &p2p.Chits{
+						ChainId:     testID[:],
+						RequestId:   1,
+						PreferredId: testID[:],
+					},
+				},
+			},
+			compressionType:  compression.TypeZstdDict,
+			bypassThrottling: true,
+			bytesSaved:       false,
+		},
 		{
 			desc: "app_request message with no compression",
 			op:   AppRequestOp,
@@ -660,6 +693,7 @@ func TestMessage(t *testing.T) {
 
 			require.Equal(tv.bypassThrottling, encodedMsg.BypassThrottling)
 			require.Equal(tv.op, encodedMsg.Op)
+			require.Equal(tv.compressionType, encodedMsg.CompressionType)
 
 			if bytesSaved := encodedMsg.BytesSavedCompression; tv.bytesSaved {
 				require.Positive(bytesSaved)
@@ -672,6 +706,55 @@ func TestMessage(t *testing.T) {
 	}
 }
 
+func TestRecompress(t *testing.T) {
+	t.Parallel()
+
+	require := require.New(t)
+
+	mb, err := newMsgBuilder(
+		prometheus.NewRegistry(),
+		5*time.Second,
+	)
+	require.NoError(err)
+
+	testID := ids.GenerateTestID()
+	msg := &p2p.Message{
+		Message: &p2p.Message_Chits{
+			Chits: &p2p.Chits{
+				ChainId:     testID[:],
+				RequestId:   1,
+				PreferredId: testID[:],
+			},
+		},
+	}
+	zstdDictMsg, err := mb.createOutbound(msg, compression.TypeZstdDict, true)
+	require.NoError(err)
+
+	sameMsg, err := mb.recompress(zstdDictMsg, compression.TypeZstdDict)
+	require.NoError(err)
+	require.Same(zstdDictMsg, sameMsg)
+
+	zstdMsg, err := mb.recompress(zstdDictMsg, compression.TypeZstd)
+	require.NoError(err)
+	require.Equal(compression.TypeZstd, zstdMsg.CompressionType)
+	require.Equal(zstdDictMsg.Op, zstdMsg.Op)
+	require.Equal(zstdDictMsg.BypassThrottling, zstdMsg.BypassThrottling)
+
+	// Recompressing the same message again must return the cached result.
+	cachedMsg, err := mb.recompress(zstdDictMsg, compression.TypeZstd)
+	require.NoError(err)
+	require.Same(zstdMsg, cachedMsg)
+
+	var compressedMsg p2p.Message
+	require.NoError(proto.Unmarshal(zstdMsg.Bytes, &compressedMsg))
+	require.NotEmpty(compressedMsg.GetCompressedZstd())
+
+	parsedMsg, err := mb.parseInbound(zstdMsg.Bytes, ids.EmptyNodeID, func() {})
+	require.NoError(err)
+	require.Equal(ChitsOp, parsedMsg.Op())
+	require.True(proto.Equal(msg.GetChits(), parsedMsg.Message().(*p2p.Chits)))
+}
+
 // Tests the Stringer interface on inbound messages
 func TestInboundMessageToString(t *testing.T) {
 	t.Parallel()
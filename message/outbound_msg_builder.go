@@ -37,6 +37,10 @@ type OutboundMsgBuilder interface {
 		knownPeersFilter []byte,
 		knownPeersSalt []byte,
 		requestAllSubnetIPs bool,
+		zstdDictIDs []uint32,
+		ipv6 netip.AddrPort,
+		ipv6SigningTime uint64,
+		ipv6NodeIDSig []byte,
 	) (*OutboundMessage, error)
 
 	GetPeerList(
@@ -66,6 +70,7 @@ type OutboundMsgBuilder interface {
 		chainID ids.ID,
 		requestID uint32,
 		summary []byte,
+		checkpoint []byte,
 	) (*OutboundMessage, error)
 
 	GetAcceptedStateSummary(
@@ -114,6 +119,14 @@ type OutboundMsgBuilder interface {
 		engineType p2p.EngineType,
 	) (*OutboundMessage, error)
 
+	GetAncestorsAtHeight(
+		chainID ids.ID,
+		requestID uint32,
+		deadline time.Duration,
+		height uint64,
+		engineType p2p.EngineType,
+	) (*OutboundMessage, error)
+
 	Ancestors(
 		chainID ids.ID,
 		requestID uint32,
@@ -186,6 +199,13 @@ type OutboundMsgBuilder interface {
 	SimplexMessage(
 		msg *p2p.Simplex,
 	) (*OutboundMessage, error)
+
+	// Recompress returns [msg] compressed with [compressionType]. If [msg] is
+	// already compressed with [compressionType], [msg] is returned.
+	Recompress(
+		msg *OutboundMessage,
+		compressionType compression.Type,
+	) (*OutboundMessage, error)
 }
 
 type outMsgBuilder struct {
@@ -249,6 +269,10 @@ func (b *outMsgBuilder) Handshake(
 	knownPeersFilter []byte,
 	knownPeersSalt []byte,
 	requestAllSubnetIPs bool,
+	zstdDictIDs []uint32,
+	ipv6 netip.AddrPort,
+	ipv6SigningTime uint64,
+	ipv6NodeIDSig []byte,
 ) (*OutboundMessage, error) {
 	subnetIDBytes := make([][]byte, len(trackedSubnets))
 	encodeIDs(trackedSubnets, subnetIDBytes)
@@ -276,8 +300,13 @@ func (b *outMsgBuilder) Handshake(
 						Filter: knownPeersFilter,
 						Salt:   knownPeersSalt,
 					},
-					IpBlsSig:   ipBLSSig,
-					AllSubnets: requestAllSubnetIPs,
+					IpBlsSig:        ipBLSSig,
+					AllSubnets:      requestAllSubnetIPs,
+					ZstdDictIds:     zstdDictIDs,
+					Ipv6Addr:        ipv6.Addr().AsSlice(),
+					Ipv6Port:        uint32(ipv6.Port()),
+					Ipv6SigningTime: ipv6SigningTime,
+					Ipv6NodeIdSig:   ipv6NodeIDSig,
 				},
 			},
 		},
@@ -319,6 +348,11 @@ func (b *outMsgBuilder) PeerList(peers []*ips.ClaimedIPPort, bypassThrottling bo
 			Signature:       p.Signature,
 			TxId:            ids.Empty[:],
 		}
+		if p.IPv6AddrPort.IsValid() {
+			claimIPPorts[i].Ipv6Addr = p.IPv6AddrPort.Addr().AsSlice()
+			claimIPPorts[i].Ipv6Port = uint32(p.IPv6AddrPort.Port())
+			claimIPPorts[i].Ipv6Signature = p.IPv6Signature
+		}
 	}
 	return b.builder.createOutbound(
 		&p2p.Message{
@@ -357,14 +391,16 @@ func (b *outMsgBuilder) StateSummaryFrontier(
 	chainID ids.ID,
 	requestID uint32,
 	summary []byte,
+	checkpoint []byte,
 ) (*OutboundMessage, error) {
 	return b.builder.createOutbound(
 		&p2p.Message{
 			Message: &p2p.Message_StateSummaryFrontier_{
 				StateSummaryFrontier_: &p2p.StateSummaryFrontier{
-					ChainId:   chainID[:],
-					RequestId: requestID,
-					Summary:   summary,
+					ChainId:    chainID[:],
+					RequestId:  requestID,
+					Summary:    summary,
+					Checkpoint: checkpoint,
 				},
 			},
 		},
@@ -527,6 +563,30 @@ func (b *outMsgBuilder) GetAncestors(
 	)
 }
 
+func (b *outMsgBuilder) GetAncestorsAtHeight(
+	chainID ids.ID,
+	requestID uint32,
+	deadline time.Duration,
+	height uint64,
+	engineType p2p.EngineType,
+) (*OutboundMessage, error) {
+	return b.builder.createOutbound(
+		&p2p.Message{
+			Message: &p2p.Message_GetAncestors{
+				GetAncestors: &p2p.GetAncestors{
+					ChainId:    chainID[:],
+					RequestId:  requestID,
+					Deadline:   uint64(deadline),
+					EngineType: engineType,
+					Height:     height,
+				},
+			},
+		},
+		compression.TypeNone,
+		false,
+	)
+}
+
 func (b *outMsgBuilder) Ancestors(
 	chainID ids.ID,
 	requestID uint32,
@@ -744,3 +804,10 @@ func (b *outMsgBuilder) SimplexMessage(msg *p2p.Simplex) (*OutboundMessage, erro
 		false,
 	)
 }
+
+func (b *outMsgBuilder) Recompress(
+	msg *OutboundMessage,
+	compressionType compression.Type,
+) (*OutboundMessage, error) {
+	return b.builder.recompress(msg, compressionType)
+}
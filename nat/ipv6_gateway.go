@@ -0,0 +1,56 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package nat
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"net/netip"
+	"strings"
+)
+
+const (
+	ipv6RouteDefaultDest      = "00000000000000000000000000000000"
+	ipv6RouteDefaultPrefixLen = "00"
+)
+
+var errNoIPv6Gateway = errors.New("no IPv6 gateway found")
+
+// parseIPv6Routes returns the next hop of the default route from the contents
+// of /proc/net/ipv6_route.
+func parseIPv6Routes(routes []byte) (netip.Addr, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(routes))
+	for scanner.Scan() {
+		// Each line is formatted as:
+		// dest destPrefixLen src srcPrefixLen nextHop metric refCount useCount flags iface
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 {
+			continue
+		}
+		if fields[0] != ipv6RouteDefaultDest || fields[1] != ipv6RouteDefaultPrefixLen {
+			continue
+		}
+
+		nextHopBytes, err := hex.DecodeString(fields[4])
+		if err != nil {
+			continue
+		}
+		nextHop, ok := netip.AddrFromSlice(nextHopBytes)
+		if !ok || nextHop.IsUnspecified() {
+			continue
+		}
+		// Gateways are typically link-local addresses, which can only be
+		// dialed through the interface of the route.
+		if nextHop.IsLinkLocalUnicast() && len(fields) >= 10 {
+			nextHop = nextHop.WithZone(fields[9])
+		}
+		return nextHop, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return netip.Addr{}, err
+	}
+	return netip.Addr{}, errNoIPv6Gateway
+}
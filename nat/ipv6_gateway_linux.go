@@ -0,0 +1,22 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+//go:build linux
+// +build linux
+
+package nat
+
+import (
+	"net/netip"
+	"os"
+)
+
+const ipv6RoutesFile = "/proc/net/ipv6_route"
+
+func discoverIPv6Gateway() (netip.Addr, error) {
+	routes, err := os.ReadFile(ipv6RoutesFile)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	return parseIPv6Routes(routes)
+}
@@ -0,0 +1,13 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+//go:build !linux
+// +build !linux
+
+package nat
+
+import "net/netip"
+
+func discoverIPv6Gateway() (netip.Addr, error) {
+	return netip.Addr{}, errNoIPv6Gateway
+}
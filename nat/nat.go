@@ -44,6 +44,19 @@ func GetRouter() Router {
 	return NewNoRouter()
 }
 
+// GetIPv6Router returns a router that is able to open pinholes in the IPv6
+// firewall of the current network. PCP is the only supported protocol.
+func GetIPv6Router() Router {
+	gatewayIP, err := discoverIPv6Gateway()
+	if err != nil {
+		return NewNoRouter()
+	}
+	if r := getPCPRouter(gatewayIP); r != nil {
+		return r
+	}
+	return NewNoRouter()
+}
+
 // Mapper attempts to open a set of ports on a router
 type Mapper struct {
 	log    logging.Logger
@@ -0,0 +1,237 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package nat
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+)
+
+// See RFC 6887 for the Port Control Protocol (PCP) specification.
+const (
+	pcpVersion       = 2
+	pcpServerPort    = 5351
+	pcpClientTimeout = 500 * time.Millisecond
+
+	pcpOpAnnounce   = 0
+	pcpOpMap        = 1
+	pcpResponseFlag = 0x80
+
+	pcpResultSuccess = 0
+	pcpProtocolTCP   = 6
+
+	pcpHeaderLen   = 24
+	pcpMapLen      = 36
+	pcpNonceLen    = 12
+	pcpMaxRespLen  = 1100 // Max size of a PCP message
+	pcpAddrLen     = 16
+	pcpResultIndex = 3
+)
+
+var (
+	_ Router = (*pcpRouter)(nil)
+
+	errInvalidPCPResponse = errors.New("invalid PCP response")
+	errPCPRequestFailed   = errors.New("PCP request failed")
+	errNoExternalIP       = errors.New("no port has been mapped")
+)
+
+// pcpRouter adapts the Port Control Protocol so it conforms to the common
+// interface. It is primarily used to open pinholes in IPv6 firewalls, where no
+// address translation is performed.
+type pcpRouter struct {
+	server  netip.AddrPort
+	timeout time.Duration
+
+	lock sync.Mutex
+	// Internal port --> Nonce of the mapping. PCP servers require the same
+	// nonce to be used to renew or delete a mapping.
+	nonces map[uint16][pcpNonceLen]byte
+	// externalIP is the external IP assigned by the most recent mapping.
+	externalIP netip.Addr
+}
+
+func newPCPRouter(server netip.Addr) *pcpRouter {
+	return &pcpRouter{
+		server:  netip.AddrPortFrom(server, pcpServerPort),
+		timeout: pcpClientTimeout,
+		nonces:  make(map[uint16][pcpNonceLen]byte),
+	}
+}
+
+func (*pcpRouter) SupportsNAT() bool {
+	return true
+}
+
+func (r *pcpRouter) MapPort(
+	intPort uint16,
+	extPort uint16,
+	_ string,
+	mappingDuration time.Duration,
+) error {
+	lifetime := mappingDuration.Seconds()
+	if lifetime < 0 || lifetime > math.MaxUint32 {
+		return errInvalidLifetime
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	nonce, ok := r.nonces[intPort]
+	if !ok {
+		if _, err := rand.Read(nonce[:]); err != nil {
+			return err
+		}
+	}
+
+	externalIP, err := r.mapPort(nonce, intPort, extPort, uint32(lifetime))
+	if err != nil {
+		return err
+	}
+
+	r.nonces[intPort] = nonce
+	r.externalIP = externalIP
+	return nil
+}
+
+func (r *pcpRouter) UnmapPort(intPort uint16, extPort uint16) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	nonce, ok := r.nonces[intPort]
+	if !ok {
+		return nil
+	}
+
+	// A mapping is deleted by requesting a lifetime of 0.
+	if _, err := r.mapPort(nonce, intPort, extPort, 0); err != nil {
+		return err
+	}
+
+	delete(r.nonces, intPort)
+	return nil
+}
+
+func (r *pcpRouter) ExternalIP() (netip.Addr, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if !r.externalIP.IsValid() {
+		return netip.Addr{}, errNoExternalIP
+	}
+	return r.externalIP, nil
+}
+
+// announce verifies that the server supports PCP.
+func (r *pcpRouter) announce() error {
+	_, err := r.request(pcpOpAnnounce, 0, nil)
+	return err
+}
+
+// mapPort requests a mapping of [intPort] to [extPort] and returns the
+// assigned external IP.
+//
+// Assumes [r.lock] is held.
+func (r *pcpRouter) mapPort(
+	nonce [pcpNonceLen]byte,
+	intPort uint16,
+	extPort uint16,
+	lifetime uint32,
+) (netip.Addr, error) {
+	payload := make([]byte, pcpMapLen)
+	copy(payload, nonce[:])
+	payload[12] = pcpProtocolTCP
+	binary.BigEndian.PutUint16(payload[16:], intPort)
+	binary.BigEndian.PutUint16(payload[18:], extPort)
+	// An unspecified suggested external IP lets the server choose. IPv4
+	// addresses are represented as IPv4-mapped IPv6 addresses.
+	suggestedIP := netip.IPv6Unspecified()
+	if r.server.Addr().Is4() {
+		suggestedIP = netip.IPv4Unspecified()
+	}
+	copy(payload[20:], asPCPAddr(suggestedIP))
+
+	resp, err := r.request(pcpOpMap, lifetime, payload)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	if len(resp) < pcpMapLen {
+		return netip.Addr{}, fmt.Errorf("%w: map response length %d", errInvalidPCPResponse, len(resp))
+	}
+	if [pcpNonceLen]byte(resp[:pcpNonceLen]) != nonce {
+		return netip.Addr{}, fmt.Errorf("%w: unexpected nonce", errInvalidPCPResponse)
+	}
+
+	externalIP := netip.AddrFrom16([pcpAddrLen]byte(resp[20:36]))
+	return externalIP.Unmap(), nil
+}
+
+// request sends a request for [opcode] to the server and returns the opcode
+// specific payload of the response.
+func (r *pcpRouter) request(opcode byte, lifetime uint32, payload []byte) ([]byte, error) {
+	conn, err := net.DialUDP("udp", nil, net.UDPAddrFromAddrPort(r.server))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// The server verifies that the client IP in the request matches the
+	// source address of the packet.
+	clientIP := conn.LocalAddr().(*net.UDPAddr).AddrPort().Addr()
+
+	req := make([]byte, pcpHeaderLen, pcpHeaderLen+len(payload))
+	req[0] = pcpVersion
+	req[1] = opcode
+	binary.BigEndian.PutUint32(req[4:], lifetime)
+	copy(req[8:], asPCPAddr(clientIP))
+	req = append(req, payload...)
+
+	if err := conn.SetDeadline(time.Now().Add(r.timeout)); err != nil {
+		return nil, err
+	}
+	if _, err := conn.Write(req); err != nil {
+		return nil, err
+	}
+
+	resp := make([]byte, pcpMaxRespLen)
+	n, err := conn.Read(resp)
+	if err != nil {
+		return nil, err
+	}
+	resp = resp[:n]
+
+	switch {
+	case len(resp) < pcpHeaderLen:
+		return nil, fmt.Errorf("%w: response length %d", errInvalidPCPResponse, len(resp))
+	case resp[0] != pcpVersion:
+		return nil, fmt.Errorf("%w: version %d", errInvalidPCPResponse, resp[0])
+	case resp[1] != pcpResponseFlag|opcode:
+		return nil, fmt.Errorf("%w: opcode %d", errInvalidPCPResponse, resp[1])
+	case resp[pcpResultIndex] != pcpResultSuccess:
+		return nil, fmt.Errorf("%w: result code %d", errPCPRequestFailed, resp[pcpResultIndex])
+	default:
+		return resp[pcpHeaderLen:], nil
+	}
+}
+
+// asPCPAddr returns the 16 byte representation of [addr] used by PCP.
+func asPCPAddr(addr netip.Addr) []byte {
+	b := addr.As16()
+	return b[:]
+}
+
+func getPCPRouter(server netip.Addr) *pcpRouter {
+	pcp := newPCPRouter(server)
+	if err := pcp.announce(); err != nil {
+		return nil
+	}
+	return pcp
+}
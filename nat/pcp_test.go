@@ -0,0 +1,185 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package nat
+
+import (
+	"encoding/binary"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// startTestPCPServer responds to each request with [respond], which is passed
+// the request and returns the response.
+func startTestPCPServer(t *testing.T, respond func(req []byte) []byte) *pcpRouter {
+	t.Helper()
+
+	conn, err := net.ListenUDP("udp", net.UDPAddrFromAddrPort(netip.AddrPortFrom(netip.IPv6Loopback(), 0)))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	go func() {
+		buf := make([]byte, pcpMaxRespLen)
+		for {
+			n, addr, err := conn.ReadFromUDPAddrPort(buf)
+			if err != nil {
+				return
+			}
+			if _, err := conn.WriteToUDPAddrPort(respond(buf[:n]), addr); err != nil {
+				return
+			}
+		}
+	}()
+
+	return &pcpRouter{
+		server:  conn.LocalAddr().(*net.UDPAddr).AddrPort(),
+		timeout: time.Second,
+		nonces:  make(map[uint16][pcpNonceLen]byte),
+	}
+}
+
+// pcpResponse returns the response to [req] with [resultCode]. If [req] is a
+// map request, the assigned external IP is set to [externalIP].
+func pcpResponse(req []byte, resultCode byte, externalIP netip.Addr) []byte {
+	resp := make([]byte, len(req))
+	copy(resp, req)
+	resp[1] |= pcpResponseFlag
+	resp[2] = 0
+	resp[pcpResultIndex] = resultCode
+	clear(resp[8:pcpHeaderLen])
+	if len(resp) == pcpHeaderLen+pcpMapLen {
+		copy(resp[pcpHeaderLen+20:], asPCPAddr(externalIP))
+	}
+	return resp
+}
+
+func TestPCPRouter(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		externalIP = netip.MustParseAddr("2001:db8::1")
+		requests   = make(chan []byte, 4)
+	)
+	r := startTestPCPServer(t, func(req []byte) []byte {
+		requests <- append([]byte(nil), req...)
+		return pcpResponse(req, pcpResultSuccess, externalIP)
+	})
+
+	require.NoError(r.announce())
+	req := <-requests
+	require.Len(req, pcpHeaderLen)
+	require.Equal(byte(pcpVersion), req[0])
+	require.Equal(byte(pcpOpAnnounce), req[1])
+	require.Equal(asPCPAddr(netip.IPv6Loopback()), req[8:pcpHeaderLen])
+
+	_, err := r.ExternalIP()
+	require.ErrorIs(err, errNoExternalIP)
+
+	require.NoError(r.MapPort(9651, 9651, "staking", time.Minute))
+	req = <-requests
+	require.Len(req, pcpHeaderLen+pcpMapLen)
+	require.Equal(byte(pcpOpMap), req[1])
+	require.Equal(uint32(60), binary.BigEndian.Uint32(req[4:]))
+	mapReq := req[pcpHeaderLen:]
+	nonce := mapReq[:pcpNonceLen]
+	require.Equal(byte(pcpProtocolTCP), mapReq[12])
+	require.Equal(uint16(9651), binary.BigEndian.Uint16(mapReq[16:]))
+	require.Equal(uint16(9651), binary.BigEndian.Uint16(mapReq[18:]))
+
+	ip, err := r.ExternalIP()
+	require.NoError(err)
+	require.Equal(externalIP, ip)
+
+	// Renewing the mapping must reuse the nonce.
+	require.NoError(r.MapPort(9651, 9651, "staking", time.Minute))
+	req = <-requests
+	require.Equal(nonce, req[pcpHeaderLen:pcpHeaderLen+pcpNonceLen])
+
+	// Deleting the mapping must reuse the nonce and request a lifetime of 0.
+	require.NoError(r.UnmapPort(9651, 9651))
+	req = <-requests
+	require.Zero(binary.BigEndian.Uint32(req[4:]))
+	require.Equal(nonce, req[pcpHeaderLen:pcpHeaderLen+pcpNonceLen])
+	require.Empty(r.nonces)
+}
+
+func TestPCPRouterErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		respond     func(req []byte) []byte
+		expectedErr error
+	}{
+		{
+			name: "request failed",
+			respond: func(req []byte) []byte {
+				return pcpResponse(req, 2, netip.Addr{}) // NOT_AUTHORIZED
+			},
+			expectedErr: errPCPRequestFailed,
+		},
+		{
+			name: "short response",
+			respond: func(req []byte) []byte {
+				return pcpResponse(req, pcpResultSuccess, netip.Addr{})[:pcpHeaderLen-1]
+			},
+			expectedErr: errInvalidPCPResponse,
+		},
+		{
+			name: "unexpected nonce",
+			respond: func(req []byte) []byte {
+				resp := pcpResponse(req, pcpResultSuccess, netip.IPv6Loopback())
+				resp[pcpHeaderLen]++
+				return resp
+			},
+			expectedErr: errInvalidPCPResponse,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := startTestPCPServer(t, test.respond)
+			err := r.MapPort(9651, 9651, "staking", time.Minute)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestParseIPv6Routes(t *testing.T) {
+	tests := []struct {
+		name        string
+		routes      string
+		expectedIP  netip.Addr
+		expectedErr error
+	}{
+		{
+			name: "default route",
+			routes: `20010db8000000000000000000000000 40 00000000000000000000000000000000 00 00000000000000000000000000000000 00000100 00000001 00000000 00000001     eth0
+00000000000000000000000000000000 00 00000000000000000000000000000000 00 fe800000000000000000000000000001 00000400 00000001 00000000 00000003     eth0
+`,
+			expectedIP: netip.MustParseAddr("fe80::1%eth0"),
+		},
+		{
+			name:        "no default route",
+			routes:      "20010db8000000000000000000000000 40 00000000000000000000000000000000 00 00000000000000000000000000000000 00000100 00000001 00000000 00000001     eth0\n",
+			expectedErr: errNoIPv6Gateway,
+		},
+		{
+			name:        "unreachable default route",
+			routes:      "00000000000000000000000000000000 00 00000000000000000000000000000000 00 00000000000000000000000000000000 ffffffff 00000001 00000000 00200200       lo\n",
+			expectedErr: errNoIPv6Gateway,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			ip, err := parseIPv6Routes([]byte(test.routes))
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedIP, ip)
+		})
+	}
+}
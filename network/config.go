@@ -11,6 +11,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network/dialer"
+	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
 	"github.com/ava-labs/avalanchego/snow/uptime"
@@ -122,13 +123,23 @@ type Config struct {
 	PingFrequency      time.Duration                 `json:"pingFrequency"`
 	AllowPrivateIPs    bool                          `json:"allowPrivateIPs"`
 
+	// MyIPv6Port is the IPv6 address of a dual-stack node. If nil, this node
+	// only advertises [MyIPPort]. Otherwise, [MyIPPort] must be an IPv4
+	// address.
+	MyIPv6Port *utils.Atomic[netip.AddrPort] `json:"myIPv6"`
+
 	SupportedACPs set.Set[uint32] `json:"supportedACPs"`
 	ObjectedACPs  set.Set[uint32] `json:"objectedACPs"`
 
 	// The compression type to use when compressing outbound messages.
-	// Assumes all peers support this compression type.
+	// Assumes all peers support this compression type, other than
+	// [compression.TypeZstdDict], which is negotiated during the handshake.
 	CompressionType compression.Type `json:"compressionType"`
 
+	// OutboundMessageQueueConfig configures whether outbound messages to each
+	// peer are queued in separate consensus, bootstrapping, and app lanes.
+	OutboundMessageQueueConfig peer.PrioritizedMessageQueueConfig `json:"outboundMessageQueueConfig"`
+
 	// TLSKey is this node's TLS key that is used to sign IPs.
 	TLSKey crypto.Signer `json:"-"`
 	// BLSKey is this node's BLS key that is used to sign IPs.
@@ -182,6 +193,10 @@ type Config struct {
 	// we rate-limit them.
 	DiskTargeter tracker.Targeter `json:"-"`
 
+	// Accounts for the bandwidth used by each chain and enforces the bandwidth
+	// quotas of each subnet.
+	SubnetBandwidthThrottler throttling.SubnetBandwidthThrottler `json:"-"`
+
 	// If true, connects to all validators regardless of primary network validator
 	// status or of configured tracked subnets.
 	ConnectToAllValidators bool `json:"connectToAllValidators"`
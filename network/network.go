@@ -217,6 +217,7 @@ func NewNetwork(
 		config.ResourceTracker,
 		config.CPUTargeter,
 		config.DiskTargeter,
+		config.SubnetBandwidthThrottler,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("initializing inbound message throttler failed with: %w", err)
@@ -227,6 +228,7 @@ func NewNetwork(
 		metricsRegisterer,
 		config.Validators,
 		config.ThrottlerConfig.OutboundMsgThrottlerConfig,
+		config.SubnetBandwidthThrottler,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("initializing outbound message throttler failed with: %w", err)
@@ -281,7 +283,7 @@ func NewNetwork(
 		ObjectedACPs:           config.ObjectedACPs.List(),
 		ResourceTracker:        config.ResourceTracker,
 		UptimeCalculator:       config.UptimeCalculator,
-		IPSigner:               peer.NewIPSigner(config.MyIPPort, config.TLSKey, config.BLSKey),
+		IPSigner:               peer.NewIPSigner(config.MyIPPort, config.MyIPv6Port, config.TLSKey, config.BLSKey),
 		ConnectToAllValidators: config.ConnectToAllValidators,
 	}
 
@@ -481,6 +483,12 @@ func (n *network) Connected(nodeID ids.NodeID) {
 		peerIP.Timestamp,
 		peerIP.TLSSignature,
 	)
+	// The IPv6 address of a dual-stack peer can only be gossiped if it was
+	// claimed at the same time as its IP.
+	if peerIPv6 := peer.IPv6(); peerIPv6 != nil && peerIPv6.Timestamp == peerIP.Timestamp {
+		newIP.IPv6AddrPort = peerIPv6.AddrPort
+		newIP.IPv6Signature = peerIPv6.TLSSignature
+	}
 	trackedSubnets := peer.TrackedSubnets()
 	n.ipTracker.Connected(newIP, trackedSubnets)
 
@@ -722,6 +730,18 @@ func (n *network) track(ip *ips.ClaimedIPPort, trackAllSubnets bool) error {
 	if err := signedIP.Verify(ip.Cert, maxTimestamp); err != nil {
 		return err
 	}
+	if ip.IPv6AddrPort.IsValid() {
+		signedIPv6 := peer.SignedIP{
+			UnsignedIP: peer.UnsignedIP{
+				AddrPort:  ip.IPv6AddrPort,
+				Timestamp: ip.Timestamp,
+			},
+			TLSSignature: ip.IPv6Signature,
+		}
+		if err := signedIPv6.Verify(ip.Cert, maxTimestamp); err != nil {
+			return err
+		}
+	}
 
 	n.peersLock.Lock()
 	defer n.peersLock.Unlock()
@@ -736,12 +756,13 @@ func (n *network) track(ip *ips.ClaimedIPPort, trackAllSubnets bool) error {
 		return nil
 	}
 
+	dialIP := n.dialableIP(ip)
 	tracked, isTracked := n.trackedIPs[ip.NodeID]
 	if isTracked {
 		// Stop tracking the old IP and start tracking the new one.
-		tracked = tracked.trackNewIP(ip.AddrPort)
+		tracked = tracked.trackNewIP(dialIP)
 	} else {
-		tracked = newTrackedIP(ip.AddrPort)
+		tracked = newTrackedIP(dialIP)
 	}
 	n.trackedIPs[ip.NodeID] = tracked
 	n.dial(ip.NodeID, tracked)
@@ -870,7 +891,7 @@ func (n *network) disconnectedFromConnected(peer peer.Peer, nodeID ids.NodeID) {
 
 	// The peer that is disconnecting from us finished the handshake
 	if ip, wantsConnection := n.ipTracker.GetIP(nodeID); wantsConnection {
-		tracked := newTrackedIP(ip.AddrPort)
+		tracked := newTrackedIP(n.dialableIP(ip))
 		n.trackedIPs[nodeID] = tracked
 		n.dial(nodeID, tracked)
 	}
@@ -878,6 +899,27 @@ func (n *network) disconnectedFromConnected(peer peer.Peer, nodeID ids.NodeID) {
 	n.metrics.markDisconnected(peer)
 }
 
+// dialableIP returns the address that should be used to dial the peer that
+// claimed [ip]. If this node can't reach the address family of [ip.AddrPort],
+// but can reach the IPv6 address advertised by the dual-stack peer, the IPv6
+// address is preferred.
+func (n *network) dialableIP(ip *ips.ClaimedIPPort) netip.AddrPort {
+	if !ip.IPv6AddrPort.IsValid() || n.canReach(ip.AddrPort.Addr()) || !n.canReach(ip.IPv6AddrPort.Addr()) {
+		return ip.AddrPort
+	}
+	return ip.IPv6AddrPort
+}
+
+// canReach returns true if this node has a public IP in the address family of
+// [addr].
+func (n *network) canReach(addr netip.Addr) bool {
+	myAddr := n.config.MyIPPort.Get().Addr()
+	if addr.Is4() {
+		return myAddr.Is4()
+	}
+	return myAddr.Is6() || n.config.MyIPv6Port != nil
+}
+
 // dial will spin up a new goroutine and attempt to establish a connection with
 // [nodeID] at [ip].
 //
@@ -1109,17 +1151,29 @@ func (n *network) upgrade(conn net.Conn, upgrader peer.Upgrader, isIngress bool)
 	// peer.Start requires there is only ever one peer instance running with the
 	// same [peerConfig.InboundMsgThrottler]. This is guaranteed by the above
 	// de-duplications for [connectingPeers] and [connectedPeers].
+	var messageQueue peer.MessageQueue
+	if n.config.OutboundMessageQueueConfig.Enabled {
+		messageQueue = peer.NewPrioritizedMessageQueue(
+			n.config.OutboundMessageQueueConfig,
+			n.peerConfig.Metrics,
+			nodeID,
+			n.peerConfig.Log,
+			n.outboundMsgThrottler,
+		)
+	} else {
+		messageQueue = peer.NewThrottledMessageQueue(
+			n.peerConfig.Metrics,
+			nodeID,
+			n.peerConfig.Log,
+			n.outboundMsgThrottler,
+		)
+	}
 	peer := peer.Start(
 		n.peerConfig,
 		tlsConn,
 		cert,
 		nodeID,
-		peer.NewThrottledMessageQueue(
-			n.peerConfig.Metrics,
-			nodeID,
-			n.peerConfig.Log,
-			n.outboundMsgThrottler,
-		),
+		messageQueue,
 		isIngress,
 	)
 	n.connectingPeers.Add(peer)
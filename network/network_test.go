@@ -125,6 +125,7 @@ var (
 		ResourceTracker:              newDefaultResourceTracker(),
 		CPUTargeter:                  nil, // Set in init
 		DiskTargeter:                 nil, // Set in init
+		SubnetBandwidthThrottler:     throttling.NewNoSubnetBandwidthThrottler(),
 	}
 )
 
@@ -493,6 +494,58 @@ func TestTrackVerifiesSignatures(t *testing.T) {
 	require.NoError(eg.Wait())
 }
 
+func TestTrackVerifiesIPv6Signature(t *testing.T) {
+	require := require.New(t)
+
+	_, networks, eg := newFullyConnectedTestNetwork(t, []router.InboundHandler{nil})
+
+	network := networks[0]
+
+	tlsCert, err := staking.NewTLSCert()
+	require.NoError(err)
+
+	cert, err := staking.ParseCertificate(tlsCert.Leaf.Raw)
+	require.NoError(err)
+	nodeID := ids.NodeIDFromCert(cert)
+
+	require.NoError(network.config.Validators.AddStaker(constants.PrimaryNetworkID, nodeID, nil, ids.Empty, 1))
+
+	blsKey, err := localsigner.New()
+	require.NoError(err)
+
+	unsignedIP := peer.UnsignedIP{
+		AddrPort: netip.AddrPortFrom(
+			netip.AddrFrom4([4]byte{123, 132, 123, 123}),
+			10000,
+		),
+		Timestamp: 1000,
+	}
+	signedIP, err := unsignedIP.Sign(tlsCert.PrivateKey.(crypto.Signer), blsKey)
+	require.NoError(err)
+
+	ip := ips.NewClaimedIPPort(
+		cert,
+		unsignedIP.AddrPort,
+		unsignedIP.Timestamp,
+		signedIP.TLSSignature,
+	)
+	ip.IPv6AddrPort = netip.AddrPortFrom(netip.MustParseAddr("2001:db8::1"), 10000)
+	// The IPv4 signature doesn't cover the IPv6 address.
+	ip.IPv6Signature = signedIP.TLSSignature
+
+	err = network.Track([]*ips.ClaimedIPPort{ip})
+	require.ErrorIs(err, staking.ErrECDSAVerificationFailure)
+
+	network.peersLock.RLock()
+	require.Empty(network.trackedIPs)
+	network.peersLock.RUnlock()
+
+	for _, net := range networks {
+		net.StartClose()
+	}
+	require.NoError(eg.Wait())
+}
+
 func TestTrackDoesNotDialPrivateIPs(t *testing.T) {
 	require := require.New(t)
 
@@ -611,7 +664,7 @@ func testDialDeletesNonValidators(t *testing.T, connectToAllValidators bool) {
 	}
 
 	config := configs[0]
-	signer := peer.NewIPSigner(config.MyIPPort, config.TLSKey, config.BLSKey)
+	signer := peer.NewIPSigner(config.MyIPPort, config.MyIPv6Port, config.TLSKey, config.BLSKey)
 	ip, err := signer.GetSignedIP()
 	require.NoError(err)
 
@@ -864,3 +917,66 @@ func TestGetAllPeers(t *testing.T) {
 	}
 	require.NoError(eg.Wait())
 }
+
+func TestDialableIP(t *testing.T) {
+	var (
+		ipv4     = netip.AddrPortFrom(netip.AddrFrom4([4]byte{1, 2, 3, 4}), 1)
+		ipv6     = netip.AddrPortFrom(netip.MustParseAddr("2001:db8::1"), 1)
+		peerIPv6 = netip.AddrPortFrom(netip.MustParseAddr("2001:db8::2"), 1)
+	)
+	tests := []struct {
+		name       string
+		myIP       netip.AddrPort
+		myIPv6     *utils.Atomic[netip.AddrPort]
+		ip         *ips.ClaimedIPPort
+		expectedIP netip.AddrPort
+	}{
+		{
+			name: "single stack peer",
+			myIP: ipv6,
+			ip: &ips.ClaimedIPPort{
+				AddrPort: ipv4,
+			},
+			expectedIP: ipv4,
+		},
+		{
+			name: "reachable tracked IP",
+			myIP: ipv4,
+			ip: &ips.ClaimedIPPort{
+				AddrPort:     ipv4,
+				IPv6AddrPort: peerIPv6,
+			},
+			expectedIP: ipv4,
+		},
+		{
+			name:   "dual stack node prefers tracked IP",
+			myIP:   ipv4,
+			myIPv6: utils.NewAtomic(ipv6),
+			ip: &ips.ClaimedIPPort{
+				AddrPort:     ipv4,
+				IPv6AddrPort: peerIPv6,
+			},
+			expectedIP: ipv4,
+		},
+		{
+			name: "IPv6 only node prefers IPv6",
+			myIP: ipv6,
+			ip: &ips.ClaimedIPPort{
+				AddrPort:     ipv4,
+				IPv6AddrPort: peerIPv6,
+			},
+			expectedIP: peerIPv6,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			n := &network{
+				config: &Config{
+					MyIPPort:   utils.NewAtomic(test.myIP),
+					MyIPv6Port: test.myIPv6,
+				},
+			}
+			require.Equal(t, test.expectedIP, n.dialableIP(test.ip))
+		})
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p2p
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+// anyRequest is an AppRequestAny that may be sent to multiple nodes, either
+// because a hedged request was sent or because a failed request was retried.
+// The first successful response is provided to the callback and any other
+// outstanding requests are cancelled.
+type anyRequest struct {
+	client          *Client
+	ctx             context.Context
+	appRequestBytes []byte
+	onResponse      AppResponseCallback
+
+	lock sync.Mutex
+	// Nodes that have been sent the request
+	attempted set.Set[ids.NodeID]
+	// IDs of the requests that have been sent
+	requestIDs []uint32
+	// Number of requests that haven't been responded to
+	numPending  int
+	retriesLeft int
+	// done is set once [onResponse] has been invoked, after which all
+	// responses are dropped.
+	done       bool
+	hedgeTimer *time.Timer
+}
+
+func (r *anyRequest) start() error {
+	if err := r.send(); err != nil {
+		return err
+	}
+	if r.client.hedging == nil {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if !r.done {
+		r.hedgeTimer = time.AfterFunc(r.client.hedging.delay(), r.hedge)
+	}
+	return nil
+}
+
+// send issues the request to a node that hasn't been sent the request yet.
+//
+// Assumes [r.lock] isn't held.
+func (r *anyRequest) send() error {
+	r.lock.Lock()
+	nodeID, ok := r.sample()
+	if !ok {
+		r.lock.Unlock()
+		return ErrNoPeers
+	}
+	r.attempted.Add(nodeID)
+	r.numPending++
+	r.lock.Unlock()
+
+	sent := time.Now()
+	requestID, err := r.client.appRequest(
+		r.ctx,
+		nodeID,
+		r.appRequestBytes,
+		func(ctx context.Context, nodeID ids.NodeID, responseBytes []byte, err error) {
+			r.handleResponse(ctx, nodeID, sent, responseBytes, err)
+		},
+	)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if err != nil {
+		r.numPending--
+		return err
+	}
+	if r.done {
+		// Another request finished while this request was being sent.
+		r.client.router.cancelAppRequest(requestID)
+		return nil
+	}
+	r.requestIDs = append(r.requestIDs, requestID)
+	return nil
+}
+
+// sample returns a node that hasn't been sent the request yet.
+//
+// Assumes [r.lock] is held.
+func (r *anyRequest) sample() (ids.NodeID, bool) {
+	// Sampling one more node than has been attempted guarantees that an
+	// unattempted node is sampled, if one is available.
+	sampled := r.client.nodeSampler.Sample(r.ctx, r.attempted.Len()+1)
+	for _, nodeID := range sampled {
+		if !r.attempted.Contains(nodeID) {
+			return nodeID, true
+		}
+	}
+	return ids.EmptyNodeID, false
+}
+
+// hedge sends the request to an additional node if no response has been
+// received yet.
+func (r *anyRequest) hedge() {
+	r.lock.Lock()
+	shouldHedge := !r.done && r.ctx.Err() == nil
+	r.lock.Unlock()
+	if !shouldHedge {
+		return
+	}
+
+	if err := r.send(); err != nil {
+		r.client.router.log.Debug("failed to send hedged request",
+			zap.String("handlerID", r.client.handlerIDStr),
+			zap.Error(err),
+		)
+	}
+}
+
+func (r *anyRequest) handleResponse(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	sent time.Time,
+	responseBytes []byte,
+	err error,
+) {
+	r.lock.Lock()
+	r.numPending--
+	if r.done {
+		// Another request already finished, so this request was cancelled.
+		r.lock.Unlock()
+		return
+	}
+
+	if err == nil {
+		if r.client.hedging != nil {
+			r.client.hedging.peerTracker.RegisterLatency(time.Since(sent))
+		}
+		r.finish()
+		r.lock.Unlock()
+
+		r.onResponse(ctx, nodeID, responseBytes, nil)
+		return
+	}
+
+	shouldRetry := r.retriesLeft > 0 && r.ctx.Err() == nil
+	if shouldRetry {
+		r.retriesLeft--
+		// The retry is counted as pending while it is being sent so that a
+		// concurrent failure of a hedged request doesn't finish the request.
+		r.numPending++
+	}
+	r.lock.Unlock()
+
+	if shouldRetry {
+		retryErr := r.send()
+
+		r.lock.Lock()
+		r.numPending--
+		r.lock.Unlock()
+		if retryErr == nil {
+			return
+		}
+
+		r.client.router.log.Debug("failed to retry request",
+			zap.String("handlerID", r.client.handlerIDStr),
+			zap.Stringer("nodeID", nodeID),
+			zap.Error(retryErr),
+		)
+	}
+
+	r.lock.Lock()
+	// If a hedged request is still outstanding, it may still succeed.
+	if r.done || r.numPending > 0 {
+		r.lock.Unlock()
+		return
+	}
+
+	r.finish()
+	r.lock.Unlock()
+
+	r.onResponse(ctx, nodeID, nil, err)
+}
+
+// finish marks the request as done and cancels any outstanding requests.
+//
+// Assumes [r.lock] is held.
+func (r *anyRequest) finish() {
+	r.done = true
+	if r.hedgeTimer != nil {
+		r.hedgeTimer.Stop()
+	}
+	for _, requestID := range r.requestIDs {
+		r.client.router.cancelAppRequest(requestID)
+	}
+	r.requestIDs = nil
+}
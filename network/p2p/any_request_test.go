@@ -0,0 +1,222 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p2p
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/engine/enginetest"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/version"
+)
+
+type sentAppRequest struct {
+	nodeID    ids.NodeID
+	requestID uint32
+}
+
+type appResponse struct {
+	nodeID        ids.NodeID
+	responseBytes []byte
+	err           error
+}
+
+// newAnyRequestTest returns a Network connected to [numPeers] peers and a
+// channel of the requests sent by the Network.
+func newAnyRequestTest(t *testing.T, numPeers int) (*Network, *Peers, chan sentAppRequest) {
+	t.Helper()
+	require := require.New(t)
+
+	sent := make(chan sentAppRequest, numPeers)
+	sender := &enginetest.Sender{
+		SendAppRequestF: func(_ context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, _ []byte) error {
+			for nodeID := range nodeIDs {
+				sent <- sentAppRequest{
+					nodeID:    nodeID,
+					requestID: requestID,
+				}
+			}
+			return nil
+		},
+	}
+
+	peers := &Peers{}
+	network, err := NewNetwork(
+		logging.NoLog{},
+		sender,
+		prometheus.NewRegistry(),
+		"",
+		peers,
+	)
+	require.NoError(err)
+	for range numPeers {
+		require.NoError(network.Connected(t.Context(), ids.GenerateTestNodeID(), &version.Application{}))
+	}
+	return network, peers, sent
+}
+
+func TestAppRequestAnyRetries(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxRetries   int
+		numFailures  int
+		wantRequests int
+		wantErr      error
+	}{
+		{
+			name:         "retry succeeds",
+			maxRetries:   2,
+			numFailures:  1,
+			wantRequests: 2,
+		},
+		{
+			name:         "retries exhausted",
+			maxRetries:   1,
+			numFailures:  2,
+			wantRequests: 2,
+			wantErr:      errFoo,
+		},
+		{
+			name:         "no peers left",
+			maxRetries:   5,
+			numFailures:  3,
+			wantRequests: 3,
+			wantErr:      errFoo,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := t.Context()
+
+			network, peers, sent := newAnyRequestTest(t, 3)
+			client := network.NewClient(handlerID, PeerSampler{Peers: peers}, WithRetries(tt.maxRetries))
+
+			responses := make(chan appResponse, 1)
+			require.NoError(client.AppRequestAny(ctx, []byte("request"), func(_ context.Context, nodeID ids.NodeID, responseBytes []byte, err error) {
+				responses <- appResponse{
+					nodeID:        nodeID,
+					responseBytes: responseBytes,
+					err:           err,
+				}
+			}))
+
+			requested := set.Set[ids.NodeID]{}
+			for i := range tt.wantRequests {
+				request := <-sent
+				require.False(requested.Contains(request.nodeID))
+				requested.Add(request.nodeID)
+
+				if i < tt.numFailures {
+					require.NoError(network.AppRequestFailed(ctx, request.nodeID, request.requestID, errFoo))
+					continue
+				}
+
+				require.NoError(network.AppResponse(ctx, request.nodeID, request.requestID, []byte("response")))
+				response := <-responses
+				require.Equal(request.nodeID, response.nodeID)
+				require.NoError(response.err)
+				require.Equal([]byte("response"), response.responseBytes)
+			}
+
+			if tt.wantErr != nil {
+				response := <-responses
+				require.ErrorIs(response.err, tt.wantErr)
+				require.Nil(response.responseBytes)
+			}
+			require.Empty(sent)
+		})
+	}
+}
+
+// Tests that a hedged request is sent to a second peer if the first peer is
+// slow and that the response from the slower peer is dropped.
+func TestAppRequestAnyHedging(t *testing.T) {
+	require := require.New(t)
+	ctx := t.Context()
+
+	network, peers, sent := newAnyRequestTest(t, 2)
+	peerTracker, err := NewPeerTracker(
+		logging.NoLog{},
+		"",
+		prometheus.NewRegistry(),
+		nil,
+		nil,
+	)
+	require.NoError(err)
+	client := network.NewClient(
+		handlerID,
+		PeerSampler{Peers: peers},
+		WithHedging(peerTracker, 0.9, time.Millisecond),
+	)
+
+	responses := make(chan appResponse, 2)
+	require.NoError(client.AppRequestAny(ctx, []byte("request"), func(_ context.Context, nodeID ids.NodeID, responseBytes []byte, err error) {
+		responses <- appResponse{
+			nodeID:        nodeID,
+			responseBytes: responseBytes,
+			err:           err,
+		}
+	}))
+
+	slow := <-sent
+	fast := <-sent
+	require.NotEqual(slow.nodeID, fast.nodeID)
+
+	require.NoError(network.AppResponse(ctx, fast.nodeID, fast.requestID, []byte("fast")))
+	response := <-responses
+	require.Equal(fast.nodeID, response.nodeID)
+	require.NoError(response.err)
+	require.Equal([]byte("fast"), response.responseBytes)
+
+	// The slow request must be cancelled and its response dropped.
+	network.router.lock.RLock()
+	pending, ok := network.router.pendingAppRequests[slow.requestID]
+	network.router.lock.RUnlock()
+	require.True(ok)
+	require.Equal(reflect.ValueOf(dropAppResponse).Pointer(), reflect.ValueOf(pending.callback).Pointer())
+
+	require.NoError(network.AppResponse(ctx, slow.nodeID, slow.requestID, []byte("slow")))
+	require.Empty(responses)
+
+	_, ok = peerTracker.LatencyPercentile(0.9)
+	require.True(ok)
+}
+
+// Tests that hedged requests are not sent after the context is cancelled.
+func TestAppRequestAnyHedgingCancelledContext(t *testing.T) {
+	require := require.New(t)
+
+	network, peers, sent := newAnyRequestTest(t, 2)
+	peerTracker, err := NewPeerTracker(
+		logging.NoLog{},
+		"",
+		prometheus.NewRegistry(),
+		nil,
+		nil,
+	)
+	require.NoError(err)
+	client := network.NewClient(
+		handlerID,
+		PeerSampler{Peers: peers},
+		WithHedging(peerTracker, 0.9, time.Millisecond),
+	)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	require.NoError(client.AppRequestAny(ctx, []byte("request"), func(context.Context, ids.NodeID, []byte, error) {}))
+	<-sent
+	cancel()
+
+	time.Sleep(10 * time.Millisecond)
+	require.Empty(sent)
+}
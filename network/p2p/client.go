@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -31,6 +32,49 @@ type AppResponseCallback func(
 	err error,
 )
 
+// ClientOption configures optional behavior of a Client.
+type ClientOption func(*Client)
+
+// WithHedging enables hedged requests in Client.AppRequestAny. If no response
+// is received within the [percentile] response latency tracked by
+// [peerTracker], the request is additionally sent to a different node. Until
+// [peerTracker] has observed a response latency, [defaultDelay] is used.
+//
+// Response latencies observed by the Client are registered with
+// [peerTracker].
+func WithHedging(peerTracker *PeerTracker, percentile float64, defaultDelay time.Duration) ClientOption {
+	return func(c *Client) {
+		c.hedging = &hedgingConfig{
+			peerTracker:  peerTracker,
+			percentile:   percentile,
+			defaultDelay: defaultDelay,
+		}
+	}
+}
+
+// WithRetries enables retries in Client.AppRequestAny. A failed request is
+// retried with a different node up to [maxRetries] times.
+func WithRetries(maxRetries int) ClientOption {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+	}
+}
+
+type hedgingConfig struct {
+	peerTracker  *PeerTracker
+	percentile   float64
+	defaultDelay time.Duration
+}
+
+// delay returns how long to wait for a response before sending a hedged
+// request.
+func (h *hedgingConfig) delay() time.Duration {
+	if latency, ok := h.peerTracker.LatencyPercentile(h.percentile); ok {
+		return latency
+	}
+	return h.defaultDelay
+}
+
 type Client struct {
 	handlerIDStr  string
 	handlerPrefix []byte
@@ -38,16 +82,46 @@ type Client struct {
 	sender        common.AppSender
 	// nodeSampler is used to select nodes to route Client.AppRequestAny to
 	nodeSampler NodeSampler
+
+	// hedging is nil if hedged requests are disabled
+	hedging    *hedgingConfig
+	maxRetries int
+}
+
+// WithOptions returns a copy of the Client with [options] applied.
+func (c *Client) WithOptions(options ...ClientOption) *Client {
+	client := *c
+	for _, option := range options {
+		option(&client)
+	}
+	return &client
 }
 
 // AppRequestAny issues an AppRequest to an arbitrary node decided by Client.
 // If a specific node needs to be requested, use AppRequest instead.
 // See AppRequest for more docs.
+//
+// If the Client was configured with hedging or retries, the request may be
+// sent to multiple nodes. [onResponse] is invoked exactly once, with the first
+// successful response or, if every request failed, with the last failure.
+// Responses to the other requests are dropped. No additional requests are sent
+// after [ctx] is cancelled.
 func (c *Client) AppRequestAny(
 	ctx context.Context,
 	appRequestBytes []byte,
 	onResponse AppResponseCallback,
 ) error {
+	if c.hedging != nil || c.maxRetries > 0 {
+		r := &anyRequest{
+			client:          c,
+			ctx:             ctx,
+			appRequestBytes: appRequestBytes,
+			onResponse:      onResponse,
+			retriesLeft:     c.maxRetries,
+		}
+		return r.start()
+	}
+
 	sampled := c.nodeSampler.Sample(ctx, 1)
 	if len(sampled) != 1 {
 		return ErrNoPeers
@@ -78,38 +152,74 @@ func (c *Client) AppRequest(
 
 	appRequestBytes = PrefixMessage(c.handlerPrefix, appRequestBytes)
 	for nodeID := range nodeIDs {
-		requestID := c.router.requestID
-		if _, ok := c.router.pendingAppRequests[requestID]; ok {
-			return fmt.Errorf(
-				"failed to issue request with request id %d: %w",
-				requestID,
-				ErrRequestPending,
-			)
+		if _, err := c.sendAppRequest(ctxWithoutCancel, nodeID, appRequestBytes, onResponse); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		if err := c.sender.SendAppRequest(
-			ctxWithoutCancel,
-			set.Of(nodeID),
+// appRequest issues an AppRequest to [nodeID] and returns its request ID.
+// See AppRequest for more docs.
+func (c *Client) appRequest(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	appRequestBytes []byte,
+	onResponse AppResponseCallback,
+) (uint32, error) {
+	ctxWithoutCancel := context.WithoutCancel(ctx)
+
+	c.router.lock.Lock()
+	defer c.router.lock.Unlock()
+
+	return c.sendAppRequest(
+		ctxWithoutCancel,
+		nodeID,
+		PrefixMessage(c.handlerPrefix, appRequestBytes),
+		onResponse,
+	)
+}
+
+// sendAppRequest sends the prefixed [appRequestBytes] to [nodeID] and returns
+// its request ID.
+//
+// Assumes [c.router.lock] is held.
+func (c *Client) sendAppRequest(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	appRequestBytes []byte,
+	onResponse AppResponseCallback,
+) (uint32, error) {
+	requestID := c.router.requestID
+	if _, ok := c.router.pendingAppRequests[requestID]; ok {
+		return 0, fmt.Errorf(
+			"failed to issue request with request id %d: %w",
 			requestID,
-			appRequestBytes,
-		); err != nil {
-			c.router.log.Error("unexpected error when sending message",
-				zap.Stringer("op", message.AppRequestOp),
-				zap.Stringer("nodeID", nodeID),
-				zap.Uint32("requestID", requestID),
-				zap.Error(err),
-			)
-			return err
-		}
+			ErrRequestPending,
+		)
+	}
 
-		c.router.pendingAppRequests[requestID] = pendingAppRequest{
-			handlerID: c.handlerIDStr,
-			callback:  onResponse,
-		}
-		c.router.requestID += 2
+	if err := c.sender.SendAppRequest(
+		ctx,
+		set.Of(nodeID),
+		requestID,
+		appRequestBytes,
+	); err != nil {
+		c.router.log.Error("unexpected error when sending message",
+			zap.Stringer("op", message.AppRequestOp),
+			zap.Stringer("nodeID", nodeID),
+			zap.Uint32("requestID", requestID),
+			zap.Error(err),
+		)
+		return 0, err
 	}
 
-	return nil
+	c.router.pendingAppRequests[requestID] = pendingAppRequest{
+		handlerID: c.handlerIDStr,
+		callback:  onResponse,
+	}
+	c.router.requestID += 2
+	return requestID, nil
 }
 
 // AppGossip sends a gossip message to a random set of peers.
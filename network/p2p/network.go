@@ -125,14 +125,18 @@ func (n *Network) Disconnected(_ context.Context, nodeID ids.NodeID) error {
 
 // NewClient returns a Client that can be used to send messages for the
 // corresponding protocol.
-func (n *Network) NewClient(handlerID uint64, nodeSampler NodeSampler) *Client {
-	return &Client{
+func (n *Network) NewClient(handlerID uint64, nodeSampler NodeSampler, options ...ClientOption) *Client {
+	c := &Client{
 		handlerIDStr:  strconv.FormatUint(handlerID, 10),
 		handlerPrefix: ProtocolPrefix(handlerID),
 		sender:        n.sender,
 		router:        n.router,
 		nodeSampler:   nodeSampler,
 	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
 }
 
 // AddHandler reserves an identifier for an application protocol
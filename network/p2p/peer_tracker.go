@@ -7,6 +7,7 @@ import (
 	"errors"
 	"math"
 	"math/rand"
+	"slices"
 	"sync"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/buffer"
 	"github.com/ava-labs/avalanchego/utils/heap"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
@@ -33,6 +35,10 @@ const (
 	// The probability that, when we select a peer, we select randomly rather
 	// than based on their performance.
 	randomPeerProbability = 0.2
+
+	// Max number of recent response latencies used to calculate latency
+	// percentiles.
+	latencyWindowSize = 256
 )
 
 // Tracks the bandwidth of responses coming from peers,
@@ -57,6 +63,10 @@ type PeerTracker struct {
 	bandwidthHeap heap.Map[ids.NodeID, safemath.Averager]
 	// Average bandwidth is only used for metrics.
 	averageBandwidth safemath.Averager
+	// Most recently observed response latencies.
+	latencies buffer.Queue[time.Duration]
+	// Versions of the peers that we're connected to.
+	versions map[ids.NodeID]*version.Application
 
 	// The below fields are assumed to be constant and are not protected by the
 	// lock.
@@ -79,12 +89,19 @@ func NewPeerTracker(
 	ignoredNodes set.Set[ids.NodeID],
 	minVersion *version.Application,
 ) (*PeerTracker, error) {
+	latencies, err := buffer.NewBoundedQueue[time.Duration](latencyWindowSize, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	t := &PeerTracker{
 		peerBandwidth: make(map[ids.NodeID]safemath.Averager),
 		bandwidthHeap: heap.NewMap[ids.NodeID, safemath.Averager](func(a, b safemath.Averager) bool {
 			return a.Read() > b.Read()
 		}),
 		averageBandwidth: safemath.NewAverager(0, bandwidthHalflife, time.Now()),
+		latencies:        latencies,
+		versions:         make(map[ids.NodeID]*version.Application),
 		log:              log,
 		ignoredNodes:     ignoredNodes,
 		minVersion:       minVersion,
@@ -113,7 +130,7 @@ func NewPeerTracker(
 		},
 	}
 
-	err := errors.Join(
+	err = errors.Join(
 		registerer.Register(t.metrics.numTrackedPeers),
 		registerer.Register(t.metrics.numResponsivePeers),
 		registerer.Register(t.metrics.averageBandwidth),
@@ -238,6 +255,33 @@ func (p *PeerTracker) RegisterFailure(nodeID ids.NodeID) {
 	p.updateBandwidth(nodeID, 0, false)
 }
 
+// RegisterLatency records that a response was received [latency] after its
+// request was sent.
+func (p *PeerTracker) RegisterLatency(latency time.Duration) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.latencies.Push(latency)
+}
+
+// LatencyPercentile returns the [percentile], in [0, 1], of the most recently
+// registered response latencies. If no latencies have been registered, false
+// is returned.
+func (p *PeerTracker) LatencyPercentile(percentile float64) (time.Duration, bool) {
+	p.lock.RLock()
+	latencies := p.latencies.List()
+	p.lock.RUnlock()
+
+	if len(latencies) == 0 {
+		return 0, false
+	}
+
+	slices.Sort(latencies)
+	percentile = min(max(percentile, 0), 1)
+	index := int(math.Ceil(percentile*float64(len(latencies)))) - 1
+	return latencies[max(index, 0)], true
+}
+
 func (p *PeerTracker) updateBandwidth(nodeID ids.NodeID, bandwidth float64, responsive bool) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
@@ -287,6 +331,7 @@ func (p *PeerTracker) Connected(nodeID ids.NodeID, nodeVersion *version.Applicat
 	defer p.lock.Unlock()
 
 	p.untrackedPeers.Add(nodeID)
+	p.versions[nodeID] = nodeVersion
 }
 
 // Disconnected should be called when [nodeID] disconnects from this node.
@@ -303,11 +348,22 @@ func (p *PeerTracker) Disconnected(nodeID ids.NodeID) {
 	p.responsivePeers.Remove(nodeID)
 	delete(p.peerBandwidth, nodeID)
 	p.bandwidthHeap.Remove(nodeID)
+	delete(p.versions, nodeID)
 
 	p.metrics.numTrackedPeers.Set(float64(p.trackedPeers.Len()))
 	p.metrics.numResponsivePeers.Set(float64(p.responsivePeers.Len()))
 }
 
+// Version returns the version of [nodeID]. Returns false if [nodeID] isn't
+// connected.
+func (p *PeerTracker) Version(nodeID ids.NodeID) (*version.Application, bool) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	nodeVersion, ok := p.versions[nodeID]
+	return nodeVersion, ok
+}
+
 // Returns the number of peers the node is connected to.
 func (p *PeerTracker) Size() int {
 	p.lock.RLock()
@@ -5,6 +5,7 @@ package p2p
 
 import (
 	"testing"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
@@ -102,3 +103,62 @@ func TestPeerTracker(t *testing.T) {
 	require.True(ok)
 	require.Falsef(responsive, "expected connecting to a non-responsive peer, but got a peer that was responsive: peer %s", peer)
 }
+
+func TestPeerTrackerLatencyPercentile(t *testing.T) {
+	require := require.New(t)
+	p, err := NewPeerTracker(
+		logging.NoLog{},
+		"",
+		prometheus.NewRegistry(),
+		nil,
+		nil,
+	)
+	require.NoError(err)
+
+	_, ok := p.LatencyPercentile(0.5)
+	require.False(ok)
+
+	for i := latencyWindowSize + 100; i > 0; i-- {
+		p.RegisterLatency(time.Duration(i) * time.Millisecond)
+	}
+
+	// Only the most recent [latencyWindowSize] latencies are considered.
+	tests := []struct {
+		percentile float64
+		expected   time.Duration
+	}{
+		{percentile: 0, expected: time.Millisecond},
+		{percentile: 0.5, expected: latencyWindowSize / 2 * time.Millisecond},
+		{percentile: 1, expected: latencyWindowSize * time.Millisecond},
+	}
+	for _, test := range tests {
+		latency, ok := p.LatencyPercentile(test.percentile)
+		require.True(ok)
+		require.Equal(test.expected, latency)
+	}
+}
+
+func TestPeerTrackerVersion(t *testing.T) {
+	require := require.New(t)
+	p, err := NewPeerTracker(
+		logging.NoLog{},
+		"",
+		prometheus.NewRegistry(),
+		nil,
+		nil,
+	)
+	require.NoError(err)
+
+	nodeID := ids.GenerateTestNodeID()
+	_, ok := p.Version(nodeID)
+	require.False(ok)
+
+	p.Connected(nodeID, version.Current)
+	nodeVersion, ok := p.Version(nodeID)
+	require.True(ok)
+	require.Equal(version.Current, nodeVersion)
+
+	p.Disconnected(nodeID)
+	_, ok = p.Version(nodeID)
+	require.False(ok)
+}
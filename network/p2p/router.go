@@ -251,6 +251,25 @@ func (r *router) clearAppRequest(requestID uint32) (pendingAppRequest, bool) {
 	return callback, ok
 }
 
+// cancelAppRequest drops the response to the pending request [requestID], if
+// any. Because there is no message to cancel a request sent to a peer, the
+// request remains pending until the peer responds or the request times out.
+//
+// Invariant: Assumes [r.lock] isn't held.
+func (r *router) cancelAppRequest(requestID uint32) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	pending, ok := r.pendingAppRequests[requestID]
+	if !ok {
+		return
+	}
+	pending.callback = dropAppResponse
+	r.pendingAppRequests[requestID] = pending
+}
+
+func dropAppResponse(context.Context, ids.NodeID, []byte, error) {}
+
 // Parse a gossip or request message.
 //
 // Returns:
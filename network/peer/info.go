@@ -15,6 +15,7 @@ import (
 type Info struct {
 	IP             netip.AddrPort  `json:"ip"`
 	PublicIP       netip.AddrPort  `json:"publicIP,omitempty"`
+	PublicIPv6     netip.AddrPort  `json:"publicIPv6,omitzero"`
 	ID             ids.NodeID      `json:"nodeID"`
 	Version        string          `json:"version"`
 	UpgradeTime    uint64          `json:"upgradeTime"`
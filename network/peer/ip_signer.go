@@ -14,26 +14,33 @@ import (
 )
 
 // IPSigner will return a signedIP for the current value of our dynamic IP.
+// Dual-stack nodes additionally sign their IPv6 address.
 type IPSigner struct {
 	ip        *utils.Atomic[netip.AddrPort]
+	ipv6      *utils.Atomic[netip.AddrPort]
 	clock     mockable.Clock
 	tlsSigner crypto.Signer
 	blsSigner bls.Signer
 
-	// Must be held while accessing [signedIP]
+	// Must be held while accessing [signedIP] and [signedIPv6]
 	signedIPLock sync.RWMutex
-	// Note that the values in [*signedIP] are constants and can be inspected
-	// without holding [signedIPLock].
-	signedIP *SignedIP
+	// Note that the values in [*signedIP] and [*signedIPv6] are constants and
+	// can be inspected without holding [signedIPLock].
+	signedIP   *SignedIP
+	signedIPv6 *SignedIP
 }
 
+// NewIPSigner returns a signer of [ip]. If [ipv6] is non-nil, it is the IPv6
+// address of a node whose [ip] is an IPv4 address.
 func NewIPSigner(
 	ip *utils.Atomic[netip.AddrPort],
+	ipv6 *utils.Atomic[netip.AddrPort],
 	tlsSigner crypto.Signer,
 	blsSigner bls.Signer,
 ) *IPSigner {
 	return &IPSigner{
 		ip:        ip,
+		ipv6:      ipv6,
 		tlsSigner: tlsSigner,
 		blsSigner: blsSigner,
 	}
@@ -45,38 +52,86 @@ func NewIPSigner(
 //
 // It's safe for multiple goroutines to concurrently call GetSignedIP.
 func (s *IPSigner) GetSignedIP() (*SignedIP, error) {
-	// Optimistically, the IP should already be signed. By grabbing a read lock
-	// here we enable full concurrency of new connections.
+	signedIP, _, err := s.getSignedIPs()
+	return signedIP, err
+}
+
+// GetSignedIPv6 returns the signedIP of the current value of the provided
+// IPv6 address. If no IPv6 address was provided, false is returned.
+//
+// The IPv6 address is always signed at the same timestamp as the IP returned
+// by GetSignedIP, so that peers can gossip both addresses as a single claim.
+// If either address changes, both are signed again.
+//
+// It's safe for multiple goroutines to concurrently call GetSignedIPv6.
+func (s *IPSigner) GetSignedIPv6() (*SignedIP, bool, error) {
+	if s.ipv6 == nil {
+		return nil, false, nil
+	}
+	_, signedIPv6, err := s.getSignedIPs()
+	return signedIPv6, err == nil, err
+}
+
+func (s *IPSigner) getSignedIPs() (*SignedIP, *SignedIP, error) {
+	ip := s.ip.Get()
+	var ipv6 netip.AddrPort
+	if s.ipv6 != nil {
+		ipv6 = s.ipv6.Get()
+	}
+
+	// Optimistically, the IPs should already be signed. By grabbing a read
+	// lock here we enable full concurrency of new connections.
 	s.signedIPLock.RLock()
-	signedIP := s.signedIP
+	signedIP, signedIPv6 := s.signedIP, s.signedIPv6
 	s.signedIPLock.RUnlock()
-	ip := s.ip.Get()
-	if signedIP != nil && signedIP.AddrPort == ip {
-		return signedIP, nil
+	if s.isSigned(signedIP, signedIPv6, ip, ipv6) {
+		return signedIP, signedIPv6, nil
 	}
 
-	// If our current IP hasn't been signed yet - then we should sign it.
+	// If our current IPs haven't been signed yet - then we should sign them.
 	s.signedIPLock.Lock()
 	defer s.signedIPLock.Unlock()
 
 	// It's possible that multiple threads read [n.signedIP] as incorrect at the
 	// same time, we should verify that we are the first thread to attempt to
 	// update it.
-	signedIP = s.signedIP
-	if signedIP != nil && signedIP.AddrPort == ip {
-		return signedIP, nil
+	signedIP, signedIPv6 = s.signedIP, s.signedIPv6
+	if s.isSigned(signedIP, signedIPv6, ip, ipv6) {
+		return signedIP, signedIPv6, nil
 	}
 
-	// We should now sign our new IP at the current timestamp.
+	// We should now sign our new IPs at the current timestamp.
+	timestamp := s.clock.Unix()
 	unsignedIP := UnsignedIP{
 		AddrPort:  ip,
-		Timestamp: s.clock.Unix(),
+		Timestamp: timestamp,
 	}
 	signedIP, err := unsignedIP.Sign(s.tlsSigner, s.blsSigner)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if s.ipv6 != nil {
+		unsignedIPv6 := UnsignedIP{
+			AddrPort:  ipv6,
+			Timestamp: timestamp,
+		}
+		signedIPv6, err = unsignedIPv6.Sign(s.tlsSigner, s.blsSigner)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	s.signedIP = signedIP
-	return s.signedIP, nil
+	s.signedIPv6 = signedIPv6
+	return signedIP, signedIPv6, nil
+}
+
+// isSigned returns true if [signedIP] and [signedIPv6] are the signatures of
+// [ip] and, for dual-stack nodes, [ipv6].
+func (s *IPSigner) isSigned(signedIP, signedIPv6 *SignedIP, ip, ipv6 netip.AddrPort) bool {
+	if signedIP == nil || signedIP.AddrPort != ip {
+		return false
+	}
+	return s.ipv6 == nil || (signedIPv6 != nil && signedIPv6.AddrPort == ipv6)
 }
@@ -31,7 +31,7 @@ func TestIPSigner(t *testing.T) {
 	blsKey, err := localsigner.New()
 	require.NoError(err)
 
-	s := NewIPSigner(dynIP, tlsKey, blsKey)
+	s := NewIPSigner(dynIP, nil, tlsKey, blsKey)
 
 	s.clock.Set(time.Unix(10, 0))
 
@@ -59,3 +59,60 @@ func TestIPSigner(t *testing.T) {
 	require.Equal(uint64(11), signedIP3.Timestamp)
 	require.NotEqual(signedIP2.TLSSignature, signedIP3.TLSSignature)
 }
+
+func TestIPSignerIPv6(t *testing.T) {
+	require := require.New(t)
+
+	dynIP := utils.NewAtomic(netip.AddrPortFrom(
+		netip.AddrFrom4([4]byte{1, 2, 3, 4}),
+		1,
+	))
+
+	tlsCert, err := staking.NewTLSCert()
+	require.NoError(err)
+
+	tlsKey := tlsCert.PrivateKey.(crypto.Signer)
+	blsKey, err := localsigner.New()
+	require.NoError(err)
+
+	// Without an IPv6 address, only the primary IP is signed.
+	s := NewIPSigner(dynIP, nil, tlsKey, blsKey)
+	_, hasIPv6, err := s.GetSignedIPv6()
+	require.NoError(err)
+	require.False(hasIPv6)
+
+	dynIPv6 := utils.NewAtomic(netip.AddrPortFrom(
+		netip.IPv6Loopback(),
+		1,
+	))
+	s = NewIPSigner(dynIP, dynIPv6, tlsKey, blsKey)
+	s.clock.Set(time.Unix(10, 0))
+
+	signedIP, err := s.GetSignedIP()
+	require.NoError(err)
+	require.Equal(dynIP.Get(), signedIP.AddrPort)
+
+	signedIPv6, hasIPv6, err := s.GetSignedIPv6()
+	require.NoError(err)
+	require.True(hasIPv6)
+	require.Equal(dynIPv6.Get(), signedIPv6.AddrPort)
+	require.Equal(uint64(10), signedIPv6.Timestamp)
+
+	// Updating the IPv6 address signs both addresses at the new timestamp.
+	s.clock.Set(time.Unix(11, 0))
+	dynIPv6.Set(netip.AddrPortFrom(
+		netip.MustParseAddr("2001:db8::1"),
+		1,
+	))
+
+	newSignedIPv6, hasIPv6, err := s.GetSignedIPv6()
+	require.NoError(err)
+	require.True(hasIPv6)
+	require.Equal(dynIPv6.Get(), newSignedIPv6.AddrPort)
+	require.Equal(uint64(11), newSignedIPv6.Timestamp)
+
+	newSignedIP, err := s.GetSignedIP()
+	require.NoError(err)
+	require.Equal(signedIP.AddrPort, newSignedIP.AddrPort)
+	require.Equal(uint64(11), newSignedIP.Timestamp)
+}
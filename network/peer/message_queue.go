@@ -111,9 +111,9 @@ func (q *throttledMessageQueue) Push(ctx context.Context, msg *message.OutboundM
 		return false
 	}
 
-	// Invariant: must call q.outboundMsgThrottler.Release(msg, q.id) when [msg]
-	// is popped or, if this queue closes before [msg] is popped, when this
-	// queue closes.
+	// Invariant: must call q.outboundMsgThrottler.Release(msg, q.id, ...) when
+	// [msg] is popped or, if this queue closes before [msg] is popped, when
+	// this queue closes.
 
 	q.cond.L.Lock()
 	defer q.cond.L.Unlock()
@@ -125,7 +125,7 @@ func (q *throttledMessageQueue) Push(ctx context.Context, msg *message.OutboundM
 			zap.Stringer("messageOp", msg.Op),
 			zap.Stringer("nodeID", q.id),
 		)
-		q.outboundMsgThrottler.Release(msg, q.id)
+		q.outboundMsgThrottler.Release(msg, q.id, false)
 		q.onFailed.SendFailed(msg)
 		return false
 	}
@@ -169,7 +169,7 @@ func (q *throttledMessageQueue) PopNow() (*message.OutboundMessage, bool) {
 func (q *throttledMessageQueue) pop() *message.OutboundMessage {
 	msg, _ := q.queue.PopLeft()
 
-	q.outboundMsgThrottler.Release(msg, q.id)
+	q.outboundMsgThrottler.Release(msg, q.id, true)
 	return msg
 }
 
@@ -185,7 +185,7 @@ func (q *throttledMessageQueue) Close() {
 
 	for q.queue.Len() > 0 {
 		msg, _ := q.queue.PopLeft()
-		q.outboundMsgThrottler.Release(msg, q.id)
+		q.outboundMsgThrottler.Release(msg, q.id, false)
 		q.onFailed.SendFailed(msg)
 	}
 	q.queue = nil
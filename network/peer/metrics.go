@@ -6,6 +6,7 @@ package peer
 import (
 	"errors"
 	"strconv"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -16,6 +17,7 @@ const (
 	ioLabel         = "io"
 	opLabel         = "op"
 	compressedLabel = "compressed"
+	laneLabel       = "lane"
 
 	sentLabel     = "sent"
 	receivedLabel = "received"
@@ -25,6 +27,7 @@ var (
 	opLabels             = []string{opLabel}
 	ioOpLabels           = []string{ioLabel, opLabel}
 	ioOpCompressedLabels = []string{ioLabel, opLabel, compressedLabel}
+	laneLabels           = []string{laneLabel}
 )
 
 type Metrics struct {
@@ -40,6 +43,9 @@ type Metrics struct {
 	Messages   *prometheus.CounterVec // io + op + compressed
 	Bytes      *prometheus.CounterVec // io + op
 	BytesSaved *prometheus.GaugeVec   // io + op
+
+	QueueTimeCount *prometheus.CounterVec // lane
+	QueueTimeSum   *prometheus.GaugeVec   // lane
 }
 
 func NewMetrics(registerer prometheus.Registerer) (*Metrics, error) {
@@ -92,6 +98,20 @@ func NewMetrics(registerer prometheus.Registerer) (*Metrics, error) {
 			},
 			ioOpLabels,
 		),
+		QueueTimeCount: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "outbound_queue_time_count",
+				Help: "number of outbound messages removed from a prioritized queue (n)",
+			},
+			laneLabels,
+		),
+		QueueTimeSum: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "outbound_queue_time_sum",
+				Help: "time outbound messages spent in a prioritized queue (ns)",
+			},
+			laneLabels,
+		),
 	}
 	return m, errors.Join(
 		registerer.Register(m.RTTCount),
@@ -103,6 +123,8 @@ func NewMetrics(registerer prometheus.Registerer) (*Metrics, error) {
 		registerer.Register(m.Messages),
 		registerer.Register(m.Bytes),
 		registerer.Register(m.BytesSaved),
+		registerer.Register(m.QueueTimeCount),
+		registerer.Register(m.QueueTimeSum),
 	)
 }
 
@@ -141,6 +163,16 @@ func (m *Metrics) SendFailed(msg *message.OutboundMessage) {
 	}).Inc()
 }
 
+// QueuedFor updates the metrics for a message having spent [duration] in
+// [lane] before being sent.
+func (m *Metrics) QueuedFor(lane Lane, duration time.Duration) {
+	labels := prometheus.Labels{
+		laneLabel: lane.String(),
+	}
+	m.QueueTimeCount.With(labels).Inc()
+	m.QueueTimeSum.With(labels).Add(float64(duration))
+}
+
 func (m *Metrics) Received(msg message.InboundMessage, msgLen uint32) {
 	op := msg.Op().String()
 	saved := msg.BytesSavedCompression()
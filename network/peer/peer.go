@@ -11,6 +11,7 @@ import (
 	"math"
 	"net"
 	"net/netip"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -23,6 +24,7 @@ import (
 	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/bloom"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/ips"
@@ -86,6 +88,11 @@ type Peer interface {
 	// handshake. It should only be called after [Ready] returns true.
 	IP() *SignedIP
 
+	// IPv6 returns the claimed IPv6 address and signature provided by a
+	// dual-stack peer during the handshake, or nil if the peer didn't provide
+	// one. It should only be called after [Ready] returns true.
+	IPv6() *SignedIP
+
 	// Version returns the claimed node version this peer is running. It should
 	// only be called after [Ready] returns true.
 	Version() *version.Application
@@ -140,6 +147,9 @@ type peer struct {
 
 	// ip is the claimed IP the peer gave us in the Handshake message.
 	ip *SignedIP
+	// ipv6 is the claimed IPv6 address the peer gave us in the Handshake
+	// message, if the peer is dual-stack.
+	ipv6 *SignedIP
 	// version is the claimed version the peer is running that we received in
 	// the Handshake message.
 	version *version.Application
@@ -152,6 +162,11 @@ type peer struct {
 	// options of ACPs provided in the Handshake message.
 	supportedACPs set.Set[uint32]
 	objectedACPs  set.Set[uint32]
+	// supportsZstdDict is true if the peer provided the ID of our zstd
+	// dictionary in the Handshake message. Messages compressed with the
+	// dictionary are recompressed without it before being sent to peers that
+	// don't support it.
+	supportsZstdDict utils.Atomic[bool]
 
 	// txIDOfVerifiedBLSKey is the txID that added the BLS key that was most
 	// recently verified to have signed the IP.
@@ -286,9 +301,14 @@ func (p *peer) Info() Info {
 	primaryUptime := p.ObservedUptime()
 
 	ip, _ := ips.ParseAddrPort(p.conn.RemoteAddr().String())
+	var publicIPv6 netip.AddrPort
+	if p.ipv6 != nil {
+		publicIPv6 = p.ipv6.AddrPort
+	}
 	return Info{
 		IP:             ip,
 		PublicIP:       p.ip.AddrPort,
+		PublicIPv6:     publicIPv6,
 		ID:             p.id,
 		Version:        p.version.String(),
 		UpgradeTime:    p.upgradeTime,
@@ -305,6 +325,10 @@ func (p *peer) IP() *SignedIP {
 	return p.ip
 }
 
+func (p *peer) IPv6() *SignedIP {
+	return p.ipv6
+}
+
 func (p *peer) Version() *version.Application {
 	return p.version
 }
@@ -500,6 +524,21 @@ func (p *peer) readMessages() {
 		p.storeLastReceived(now)
 		p.Metrics.Received(msg, msgLen)
 
+		// Drop the message if it exceeds the bandwidth quota of its chain's
+		// subnet. This releases the message's inbound allocations before it is
+		// queued for the chain.
+		if chainID, err := message.GetChainID(msg.Message()); err == nil && !p.InboundMsgThrottler.AcquireChain(chainID, uint64(msgLen)) {
+			p.Log.Debug("dropping message",
+				zap.String("reason", "subnet bandwidth quota"),
+				zap.Stringer("nodeID", p.id),
+				zap.Stringer("messageOp", msg.Op()),
+				zap.Stringer("chainID", chainID),
+			)
+			msg.OnFinishedHandling()
+			p.ResourceTracker.StopProcessing(p.id, p.Clock.Time())
+			continue
+		}
+
 		// Handle the message. Note that when we are done handling this message,
 		// we must call [msg.OnFinishedHandling()].
 		p.handle(msg)
@@ -532,6 +571,19 @@ func (p *peer) writeMessages() {
 		return
 	}
 
+	// Dual-stack nodes additionally advertise their IPv6 address.
+	mySignedIPv6, hasIPv6, err := p.IPSigner.GetSignedIPv6()
+	if err != nil {
+		p.Log.Error("failed to get signed IPv6",
+			zap.Stringer("nodeID", p.id),
+			zap.Error(err),
+		)
+		return
+	}
+	if !hasIPv6 {
+		mySignedIPv6 = &SignedIP{}
+	}
+
 	myVersion := p.VersionCompatibility.Current
 	knownPeersFilter, knownPeersSalt := p.Network.KnownPeers()
 
@@ -555,6 +607,10 @@ func (p *peer) writeMessages() {
 		knownPeersFilter,
 		knownPeersSalt,
 		requestAllSubnetIPs,
+		compression.ZstdDictIDs(),
+		mySignedIPv6.AddrPort,
+		mySignedIPv6.Timestamp,
+		mySignedIPv6.TLSSignature,
 	)
 	if err != nil {
 		p.Log.Error(failedToCreateMessageLog,
@@ -595,6 +651,19 @@ func (p *peer) writeMessages() {
 }
 
 func (p *peer) writeMessage(writer io.Writer, msg *message.OutboundMessage) {
+	if msg.CompressionType == compression.TypeZstdDict && !p.supportsZstdDict.Get() {
+		recompressedMsg, err := p.MessageCreator.Recompress(msg, compression.TypeZstd)
+		if err != nil {
+			p.Log.Error("failed to recompress message",
+				zap.Stringer("nodeID", p.id),
+				zap.Stringer("messageOp", msg.Op),
+				zap.Error(err),
+			)
+			return
+		}
+		msg = recompressedMsg
+	}
+
 	msgBytes := msg.Bytes
 	p.Log.Verbo("sending message",
 		zap.Stringer("op", msg.Op),
@@ -955,6 +1024,8 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 		}
 	}
 
+	p.supportsZstdDict.Set(slices.Contains(msg.ZstdDictIds, compression.ZstdDictID))
+
 	if p.supportedACPs.Overlaps(p.objectedACPs) {
 		p.Log.Debug(malformedMessageLog,
 			zap.Stringer("nodeID", p.id),
@@ -1066,6 +1137,55 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 	p.ip.BLSSignature = signature
 	p.ip.BLSSignatureBytes = msg.IpBlsSig
 
+	if len(msg.Ipv6Addr) != 0 {
+		addr, ok := ips.AddrFromSlice(msg.Ipv6Addr)
+		if !ok || !addr.Is6() || !p.ip.AddrPort.Addr().Is4() {
+			p.Log.Debug(malformedMessageLog,
+				zap.Stringer("nodeID", p.id),
+				zap.Stringer("messageOp", message.HandshakeOp),
+				zap.String("field", "ipv6"),
+				zap.Int("ipLen", len(msg.Ipv6Addr)),
+			)
+			p.StartClose()
+			return
+		}
+
+		port := uint16(msg.Ipv6Port)
+		if msg.Ipv6Port == 0 {
+			p.Log.Debug(malformedMessageLog,
+				zap.Stringer("nodeID", p.id),
+				zap.Stringer("messageOp", message.HandshakeOp),
+				zap.String("field", "ipv6Port"),
+				zap.Uint16("port", port),
+			)
+			p.StartClose()
+			return
+		}
+
+		p.ipv6 = &SignedIP{
+			UnsignedIP: UnsignedIP{
+				AddrPort: netip.AddrPortFrom(
+					addr,
+					port,
+				),
+				Timestamp: msg.Ipv6SigningTime,
+			},
+			TLSSignature: msg.Ipv6NodeIdSig,
+		}
+		if err := p.ipv6.Verify(p.cert, maxTimestamp); err != nil {
+			p.Log.Debug(malformedMessageLog,
+				zap.Stringer("nodeID", p.id),
+				zap.Stringer("messageOp", message.HandshakeOp),
+				zap.String("field", "ipv6TLSSignature"),
+				zap.Uint64("peerTime", msg.MyTime),
+				zap.Uint64("localTime", localUnixTime),
+				zap.Error(err),
+			)
+			p.StartClose()
+			return
+		}
+	}
+
 	// If the peer is running an incompatible version or has an invalid BLS
 	// signature, disconnect from them prior to marking the handshake as
 	// completed.
@@ -1219,6 +1339,26 @@ func (p *peer) handlePeerList(msg *p2p.PeerList) {
 			claimedIPPort.Timestamp,
 			claimedIPPort.Signature,
 		)
+
+		if len(claimedIPPort.Ipv6Addr) == 0 {
+			continue
+		}
+
+		ipv6, ok := ips.AddrFromSlice(claimedIPPort.Ipv6Addr)
+		ipv6Port := uint16(claimedIPPort.Ipv6Port)
+		if !ok || !ipv6.Is6() || !addr.Is4() || ipv6Port == 0 {
+			p.Log.Debug(malformedMessageLog,
+				zap.Stringer("nodeID", p.id),
+				zap.Stringer("messageOp", message.PeerListOp),
+				zap.String("field", "ipv6"),
+				zap.Int("ipLen", len(claimedIPPort.Ipv6Addr)),
+				zap.Uint16("port", ipv6Port),
+			)
+			p.StartClose()
+			return
+		}
+		discoveredIPs[i].IPv6AddrPort = netip.AddrPortFrom(ipv6, ipv6Port)
+		discoveredIPs[i].IPv6Signature = claimedIPPort.Ipv6Signature
 	}
 
 	if err := p.Network.Track(discoveredIPs); err != nil {
@@ -24,6 +24,7 @@ import (
 	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/upgrade"
 	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/compression"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
@@ -114,7 +115,7 @@ func newRawTestPeer(t *testing.T, config *Config) *rawTestPeer {
 	bls, err := localsigner.New()
 	require.NoError(err)
 
-	config.IPSigner = NewIPSigner(ip, tls, bls)
+	config.IPSigner = NewIPSigner(ip, nil, tls, bls)
 
 	inboundMsgChan := make(chan message.InboundMessage)
 	config.Router = router.InboundHandlerFunc(func(_ context.Context, msg message.InboundMessage) {
@@ -218,6 +219,44 @@ func TestSend(t *testing.T) {
 	require.NoError(peer1.AwaitClosed(t.Context()))
 }
 
+func TestSendZstdDict(t *testing.T) {
+	require := require.New(t)
+
+	config0 := newConfig(t)
+	config1 := newConfig(t)
+
+	mc, err := message.NewCreator(
+		prometheus.NewRegistry(),
+		compression.TypeZstdDict,
+		10*time.Second,
+	)
+	require.NoError(err)
+	config0.MessageCreator = mc
+
+	rawPeer0 := newRawTestPeer(t, config0)
+	rawPeer1 := newRawTestPeer(t, config1)
+
+	peer0, peer1 := startTestPeers(rawPeer0, rawPeer1)
+	awaitReady(t, peer0, peer1)
+
+	require.True(peer0.Peer.(*peer).supportsZstdDict.Get())
+	require.True(peer1.Peer.(*peer).supportsZstdDict.Get())
+
+	outboundPutMsg, err := config0.MessageCreator.Put(ids.Empty, 1, make([]byte, 1024))
+	require.NoError(err)
+	require.Equal(compression.TypeZstdDict, outboundPutMsg.CompressionType)
+
+	require.True(peer0.Send(t.Context(), outboundPutMsg))
+
+	inboundPutMsg := <-peer1.inboundMsgChan
+	require.Equal(message.PutOp, inboundPutMsg.Op())
+	require.Positive(inboundPutMsg.BytesSavedCompression())
+
+	peer1.StartClose()
+	require.NoError(peer0.AwaitClosed(t.Context()))
+	require.NoError(peer1.AwaitClosed(t.Context()))
+}
+
 func TestPingUptimes(t *testing.T) {
 	config0 := newConfig(t)
 	config1 := newConfig(t)
@@ -356,6 +395,65 @@ func TestInvalidBLSKeyDisconnects(t *testing.T) {
 	require.NoError(peer1.AwaitClosed(t.Context()))
 }
 
+func TestDualStackIPs(t *testing.T) {
+	require := require.New(t)
+
+	config0 := newConfig(t)
+	config1 := newConfig(t)
+
+	rawPeer0 := newRawTestPeer(t, config0)
+	rawPeer1 := newRawTestPeer(t, config1)
+
+	ipv6 := netip.AddrPortFrom(netip.IPv6Loopback(), 2)
+	signer0 := rawPeer0.config.IPSigner
+	rawPeer0.config.IPSigner = NewIPSigner(
+		utils.NewAtomic(netip.AddrPortFrom(
+			netip.AddrFrom4([4]byte{127, 0, 0, 1}),
+			1,
+		)),
+		utils.NewAtomic(ipv6),
+		signer0.tlsSigner,
+		signer0.blsSigner,
+	)
+
+	peer0, peer1 := startTestPeers(rawPeer0, rawPeer1)
+	awaitReady(t, peer0, peer1)
+
+	// peer1 is the connection to rawPeer0, which is dual-stack.
+	require.Equal(ipv6, peer1.IPv6().AddrPort)
+	require.Equal(ipv6, peer1.Info().PublicIPv6)
+	require.Nil(peer0.IPv6())
+
+	peer0.StartClose()
+	require.NoError(peer0.AwaitClosed(t.Context()))
+	require.NoError(peer1.AwaitClosed(t.Context()))
+}
+
+func TestDualStackRequiresIPv4Disconnects(t *testing.T) {
+	require := require.New(t)
+
+	config0 := newConfig(t)
+	config1 := newConfig(t)
+
+	rawPeer0 := newRawTestPeer(t, config0)
+	rawPeer1 := newRawTestPeer(t, config1)
+
+	// The primary IP of rawPeer0 is an IPv6 address, so it must not advertise
+	// an additional IPv6 address.
+	signer0 := rawPeer0.config.IPSigner
+	rawPeer0.config.IPSigner = NewIPSigner(
+		signer0.ip,
+		utils.NewAtomic(netip.AddrPortFrom(netip.IPv6Loopback(), 2)),
+		signer0.tlsSigner,
+		signer0.blsSigner,
+	)
+
+	peer0, peer1 := startTestPeers(rawPeer0, rawPeer1)
+
+	require.NoError(peer0.AwaitClosed(t.Context()))
+	require.NoError(peer1.AwaitClosed(t.Context()))
+}
+
 // Test that the upgrade time is exchanged and exposed correctly after the
 // handshake finishes.
 func TestUpgradeTime(t *testing.T) {
@@ -0,0 +1,331 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/throttling"
+	"github.com/ava-labs/avalanchego/utils/buffer"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+const (
+	ConsensusLane Lane = iota
+	BootstrappingLane
+	AppLane
+
+	numLanes = int(AppLane) + 1
+
+	// laneQuantum is the number of bytes a lane is allowed to send per unit of
+	// weight every time it is visited by the scheduler.
+	laneQuantum = 64 * units.KiB
+)
+
+var (
+	_ MessageQueue = (*prioritizedMessageQueue)(nil)
+
+	errZeroLaneWeight = errors.New("lane weight must be non-zero")
+)
+
+// Lane is a class of outbound traffic that is queued separately from other
+// classes of traffic.
+type Lane int
+
+func (l Lane) String() string {
+	switch l {
+	case ConsensusLane:
+		return "consensus"
+	case BootstrappingLane:
+		return "bootstrapping"
+	case AppLane:
+		return "app"
+	default:
+		return "unknown"
+	}
+}
+
+// LaneOf returns the lane that messages with [op] are queued in.
+//
+// Network messages are small and latency sensitive, so they share the
+// consensus lane.
+func LaneOf(op message.Op) Lane {
+	switch op {
+	case message.GetStateSummaryFrontierOp,
+		message.StateSummaryFrontierOp,
+		message.GetAcceptedStateSummaryOp,
+		message.AcceptedStateSummaryOp,
+		message.GetAcceptedFrontierOp,
+		message.AcceptedFrontierOp,
+		message.GetAcceptedOp,
+		message.AcceptedOp,
+		message.GetAncestorsOp,
+		message.AncestorsOp:
+		return BootstrappingLane
+	case message.AppRequestOp,
+		message.AppErrorOp,
+		message.AppResponseOp,
+		message.AppGossipOp:
+		return AppLane
+	default:
+		return ConsensusLane
+	}
+}
+
+// PrioritizedMessageQueueConfig configures the per-lane weights of a
+// prioritized message queue.
+type PrioritizedMessageQueueConfig struct {
+	// Enabled is true if outbound messages should be queued in separate lanes
+	// rather than in a single FIFO queue.
+	Enabled bool `json:"enabled"`
+
+	// ConsensusWeight, BootstrappingWeight, and AppWeight are the relative
+	// shares of outbound bandwidth that are given to each lane when multiple
+	// lanes have pending messages.
+	ConsensusWeight     uint64 `json:"consensusWeight"`
+	BootstrappingWeight uint64 `json:"bootstrappingWeight"`
+	AppWeight           uint64 `json:"appWeight"`
+}
+
+func (c *PrioritizedMessageQueueConfig) Verify() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.ConsensusWeight == 0 || c.BootstrappingWeight == 0 || c.AppWeight == 0 {
+		return errZeroLaneWeight
+	}
+	return nil
+}
+
+type queuedMessage struct {
+	msg      *message.OutboundMessage
+	queuedAt time.Time
+}
+
+type lane struct {
+	// quantum is the number of bytes added to [deficit] every time this lane
+	// is visited by the scheduler.
+	quantum int
+	// deficit is the number of bytes this lane is currently allowed to send.
+	deficit int
+	// credited is true if [quantum] has already been added to [deficit] during
+	// the current visit of the scheduler.
+	credited bool
+	queue    buffer.Deque[queuedMessage]
+}
+
+// prioritizedMessageQueue is a [MessageQueue] that places messages into lanes
+// based on their op and dequeues from the lanes using deficit round robin.
+//
+// This prevents a burst of large messages in one lane, such as app gossip,
+// from delaying latency sensitive messages in another lane, such as votes,
+// while still guaranteeing that every lane makes progress.
+type prioritizedMessageQueue struct {
+	metrics *Metrics
+	// [id] of the peer we're sending messages to
+	id                   ids.NodeID
+	log                  logging.Logger
+	outboundMsgThrottler throttling.OutboundMsgThrottler
+
+	// Signalled when a message is added to the queue and when Close() is
+	// called.
+	cond *sync.Cond
+
+	// closed flags whether the send queue has been closed.
+	// [cond.L] must be held while accessing [closed].
+	closed bool
+
+	// [cond.L] must be held while accessing [lanes], [current], and [size].
+	lanes [numLanes]*lane
+	// current is the index of the lane the scheduler is visiting
+	current int
+	// size is the total number of messages in all lanes
+	size int
+}
+
+func NewPrioritizedMessageQueue(
+	config PrioritizedMessageQueueConfig,
+	metrics *Metrics,
+	id ids.NodeID,
+	log logging.Logger,
+	outboundMsgThrottler throttling.OutboundMsgThrottler,
+) MessageQueue {
+	q := &prioritizedMessageQueue{
+		metrics:              metrics,
+		id:                   id,
+		log:                  log,
+		outboundMsgThrottler: outboundMsgThrottler,
+		cond:                 sync.NewCond(&sync.Mutex{}),
+	}
+	weights := [numLanes]uint64{
+		ConsensusLane:     config.ConsensusWeight,
+		BootstrappingLane: config.BootstrappingWeight,
+		AppLane:           config.AppWeight,
+	}
+	for i, weight := range weights {
+		q.lanes[i] = &lane{
+			quantum: int(weight) * laneQuantum,
+			queue:   buffer.NewUnboundedDeque[queuedMessage](initialQueueSize),
+		}
+	}
+	return q
+}
+
+func (q *prioritizedMessageQueue) Push(ctx context.Context, msg *message.OutboundMessage) bool {
+	if err := ctx.Err(); err != nil {
+		q.log.Debug(
+			"dropping outgoing message",
+			zap.Stringer("messageOp", msg.Op),
+			zap.Stringer("nodeID", q.id),
+			zap.Error(err),
+		)
+		q.metrics.SendFailed(msg)
+		return false
+	}
+
+	// Acquire space on the outbound message queue, or drop [msg] if we can't.
+	if !q.outboundMsgThrottler.Acquire(msg, q.id) {
+		q.log.Debug(
+			"dropping outgoing message",
+			zap.String("reason", "rate-limiting"),
+			zap.Stringer("messageOp", msg.Op),
+			zap.Stringer("nodeID", q.id),
+		)
+		q.metrics.SendFailed(msg)
+		return false
+	}
+
+	// Invariant: must call q.outboundMsgThrottler.Release(msg, q.id, ...) when
+	// [msg] is popped or, if this queue closes before [msg] is popped, when
+	// this queue closes.
+
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+
+	if q.closed {
+		q.log.Debug(
+			"dropping outgoing message",
+			zap.String("reason", "closed queue"),
+			zap.Stringer("messageOp", msg.Op),
+			zap.Stringer("nodeID", q.id),
+		)
+		q.outboundMsgThrottler.Release(msg, q.id, false)
+		q.metrics.SendFailed(msg)
+		return false
+	}
+
+	q.lanes[LaneOf(msg.Op)].queue.PushRight(queuedMessage{
+		msg:      msg,
+		queuedAt: time.Now(),
+	})
+	q.size++
+	q.cond.Signal()
+	return true
+}
+
+func (q *prioritizedMessageQueue) Pop() (*message.OutboundMessage, bool) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+
+	for {
+		if q.closed {
+			return nil, false
+		}
+		if q.size > 0 {
+			// There is a message
+			break
+		}
+		// Wait until there is a message
+		q.cond.Wait()
+	}
+
+	return q.pop(), true
+}
+
+func (q *prioritizedMessageQueue) PopNow() (*message.OutboundMessage, bool) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+
+	if q.closed || q.size == 0 {
+		// There isn't a message
+		return nil, false
+	}
+
+	return q.pop(), true
+}
+
+// pop removes the next message to send using deficit round robin.
+//
+// Invariant: [q.size] must be > 0.
+func (q *prioritizedMessageQueue) pop() *message.OutboundMessage {
+	for {
+		l := q.lanes[q.current]
+		next, ok := l.queue.PeekLeft()
+		if !ok {
+			// Empty lanes do not accumulate credit.
+			l.deficit = 0
+			q.advance()
+			continue
+		}
+
+		if !l.credited {
+			l.deficit += l.quantum
+			l.credited = true
+		}
+
+		size := len(next.msg.Bytes)
+		if size > l.deficit {
+			q.advance()
+			continue
+		}
+
+		_, _ = l.queue.PopLeft()
+		q.size--
+		l.deficit -= size
+		if l.queue.Len() == 0 {
+			l.deficit = 0
+		}
+
+		q.outboundMsgThrottler.Release(next.msg, q.id, true)
+		q.metrics.QueuedFor(Lane(q.current), time.Since(next.queuedAt))
+		return next.msg
+	}
+}
+
+// advance moves the scheduler to the next lane.
+func (q *prioritizedMessageQueue) advance() {
+	q.lanes[q.current].credited = false
+	q.current = (q.current + 1) % numLanes
+}
+
+func (q *prioritizedMessageQueue) Close() {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+
+	if q.closed {
+		return
+	}
+
+	q.closed = true
+
+	for _, l := range q.lanes {
+		for l.queue.Len() > 0 {
+			next, _ := l.queue.PopLeft()
+			q.outboundMsgThrottler.Release(next.msg, q.id, false)
+			q.metrics.SendFailed(next.msg)
+		}
+		l.queue = nil
+	}
+	q.size = 0
+
+	q.cond.Broadcast()
+}
@@ -0,0 +1,229 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/throttling"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+func newTestPrioritizedMessageQueue(t *testing.T, config PrioritizedMessageQueueConfig) (MessageQueue, *Metrics) {
+	metrics, err := NewMetrics(prometheus.NewRegistry())
+	require.NoError(t, err)
+
+	return NewPrioritizedMessageQueue(
+		config,
+		metrics,
+		ids.EmptyNodeID,
+		logging.NoLog{},
+		throttling.NewNoOutboundThrottler(),
+	), metrics
+}
+
+func newTestOutboundMessage(op message.Op, size int) *message.OutboundMessage {
+	return &message.OutboundMessage{
+		Op:    op,
+		Bytes: make([]byte, size),
+	}
+}
+
+func TestLaneOf(t *testing.T) {
+	tests := []struct {
+		op   message.Op
+		lane Lane
+	}{
+		{op: message.PingOp, lane: ConsensusLane},
+		{op: message.HandshakeOp, lane: ConsensusLane},
+		{op: message.PushQueryOp, lane: ConsensusLane},
+		{op: message.ChitsOp, lane: ConsensusLane},
+		{op: message.PutOp, lane: ConsensusLane},
+		{op: message.GetAncestorsOp, lane: BootstrappingLane},
+		{op: message.AncestorsOp, lane: BootstrappingLane},
+		{op: message.GetAcceptedFrontierOp, lane: BootstrappingLane},
+		{op: message.StateSummaryFrontierOp, lane: BootstrappingLane},
+		{op: message.AppGossipOp, lane: AppLane},
+		{op: message.AppRequestOp, lane: AppLane},
+		{op: message.AppResponseOp, lane: AppLane},
+	}
+	for _, test := range tests {
+		t.Run(test.op.String(), func(t *testing.T) {
+			require.Equal(t, test.lane, LaneOf(test.op))
+		})
+	}
+}
+
+func TestPrioritizedMessageQueueConfigVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      PrioritizedMessageQueueConfig
+		expectedErr error
+	}{
+		{
+			name:   "disabled",
+			config: PrioritizedMessageQueueConfig{},
+		},
+		{
+			name: "valid",
+			config: PrioritizedMessageQueueConfig{
+				Enabled:             true,
+				ConsensusWeight:     4,
+				BootstrappingWeight: 2,
+				AppWeight:           1,
+			},
+		},
+		{
+			name: "zero weight",
+			config: PrioritizedMessageQueueConfig{
+				Enabled:             true,
+				ConsensusWeight:     4,
+				BootstrappingWeight: 2,
+			},
+			expectedErr: errZeroLaneWeight,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.ErrorIs(t, test.config.Verify(), test.expectedErr)
+		})
+	}
+}
+
+func TestPrioritizedMessageQueueLanes(t *testing.T) {
+	require := require.New(t)
+
+	q, metrics := newTestPrioritizedMessageQueue(t, PrioritizedMessageQueueConfig{
+		Enabled:             true,
+		ConsensusWeight:     1,
+		BootstrappingWeight: 1,
+		AppWeight:           1,
+	})
+
+	// Fill the app lane with a burst of large gossip messages and start
+	// sending them.
+	for range 10 {
+		require.True(q.Push(t.Context(), newTestOutboundMessage(message.AppGossipOp, laneQuantum)))
+	}
+	msg, ok := q.PopNow()
+	require.True(ok)
+	require.Equal(message.AppGossipOp, msg.Op)
+
+	// A vote queued behind the burst should be sent next.
+	chits := newTestOutboundMessage(message.ChitsOp, 100)
+	require.True(q.Push(t.Context(), chits))
+
+	msg, ok = q.PopNow()
+	require.True(ok)
+	require.Equal(chits, msg)
+
+	for range 9 {
+		msg, ok := q.Pop()
+		require.True(ok)
+		require.Equal(message.AppGossipOp, msg.Op)
+	}
+
+	_, ok = q.PopNow()
+	require.False(ok)
+
+	require.InDelta(1, testutil.ToFloat64(metrics.QueueTimeCount.WithLabelValues(ConsensusLane.String())), 0)
+	require.InDelta(10, testutil.ToFloat64(metrics.QueueTimeCount.WithLabelValues(AppLane.String())), 0)
+}
+
+func TestPrioritizedMessageQueueWeights(t *testing.T) {
+	require := require.New(t)
+
+	q, _ := newTestPrioritizedMessageQueue(t, PrioritizedMessageQueueConfig{
+		Enabled:             true,
+		ConsensusWeight:     3,
+		BootstrappingWeight: 1,
+		AppWeight:           1,
+	})
+
+	const numMessages = 30
+	for range numMessages {
+		require.True(q.Push(t.Context(), newTestOutboundMessage(message.PushQueryOp, laneQuantum)))
+		require.True(q.Push(t.Context(), newTestOutboundMessage(message.AncestorsOp, laneQuantum)))
+		require.True(q.Push(t.Context(), newTestOutboundMessage(message.AppGossipOp, laneQuantum)))
+	}
+
+	// While every lane is backlogged, bytes are dequeued in proportion to the
+	// lane weights.
+	counts := make(map[Lane]int)
+	for range 25 {
+		msg, ok := q.PopNow()
+		require.True(ok)
+		counts[LaneOf(msg.Op)]++
+	}
+	require.Equal(map[Lane]int{
+		ConsensusLane:     15,
+		BootstrappingLane: 5,
+		AppLane:           5,
+	}, counts)
+
+	// Every message is eventually dequeued.
+	for range 3*numMessages - 25 {
+		_, ok := q.PopNow()
+		require.True(ok)
+	}
+	_, ok := q.PopNow()
+	require.False(ok)
+}
+
+func TestPrioritizedMessageQueueOversizedMessage(t *testing.T) {
+	require := require.New(t)
+
+	q, _ := newTestPrioritizedMessageQueue(t, PrioritizedMessageQueueConfig{
+		Enabled:             true,
+		ConsensusWeight:     1,
+		BootstrappingWeight: 1,
+		AppWeight:           1,
+	})
+
+	// A message larger than the lane's quantum must still be sent once the
+	// lane has accumulated enough credit.
+	large := newTestOutboundMessage(message.AncestorsOp, 3*laneQuantum)
+	require.True(q.Push(t.Context(), large))
+
+	msg, ok := q.PopNow()
+	require.True(ok)
+	require.Equal(large, msg)
+}
+
+func TestPrioritizedMessageQueueClose(t *testing.T) {
+	require := require.New(t)
+
+	q, metrics := newTestPrioritizedMessageQueue(t, PrioritizedMessageQueueConfig{
+		Enabled:             true,
+		ConsensusWeight:     1,
+		BootstrappingWeight: 1,
+		AppWeight:           1,
+	})
+
+	// Assert that Push returns false when the context is canceled
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	require.False(q.Push(ctx, newTestOutboundMessage(message.ChitsOp, 1)))
+
+	require.True(q.Push(t.Context(), newTestOutboundMessage(message.ChitsOp, 1)))
+	require.True(q.Push(t.Context(), newTestOutboundMessage(message.AppGossipOp, 1)))
+
+	// Assert that Pop is unblocked when the queue is closed
+	q.Close()
+	_, ok := q.Pop()
+	require.False(ok)
+
+	// Assert that Push returns false when the queue is closed
+	require.False(q.Push(t.Context(), newTestOutboundMessage(message.ChitsOp, 1)))
+
+	require.InDelta(3, testutil.ToFloat64(metrics.NumSendFailed.WithLabelValues(message.ChitsOp.String())), 0)
+	require.InDelta(1, testutil.ToFloat64(metrics.NumSendFailed.WithLabelValues(message.AppGossipOp.String())), 0)
+}
@@ -128,6 +128,7 @@ func StartTestPeer(
 					netip.IPv6Loopback(),
 					1,
 				)),
+				nil,
 				tlsKey,
 				blsKey,
 			),
@@ -202,6 +202,7 @@ func NewTestNetworkConfig(
 			currentValidators,
 			resourceTracker.DiskTracker(),
 		),
+		SubnetBandwidthThrottler: throttling.NewNoSubnetBandwidthThrottler(),
 	}, nil
 }
 
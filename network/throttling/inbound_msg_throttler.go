@@ -28,6 +28,14 @@ type InboundMsgThrottler interface {
 	//            given nodeID. Callers must enforce this invariant.
 	Acquire(ctx context.Context, msgSize uint64, nodeID ids.NodeID) ReleaseFunc
 
+	// AcquireChain returns true if a message of [msgSize] bytes, that was
+	// previously acquired with Acquire, can be handled by [chainID].
+	// Returns false if the message exceeds the inbound bandwidth quota of the
+	// chain's subnet and should be dropped.
+	// The chain of a message is only known once the message has been parsed,
+	// so this must be called after parsing and before handling the message.
+	AcquireChain(chainID ids.ID, msgSize uint64) bool
+
 	// Add a new node to this throttler.
 	// Must be called before Acquire(..., [nodeID]) is called.
 	// RemoveNode([nodeID]) must have been called since the last time
@@ -59,6 +67,7 @@ func NewInboundMsgThrottler(
 	resourceTracker tracker.ResourceTracker,
 	cpuTargeter tracker.Targeter,
 	diskTargeter tracker.Targeter,
+	subnetThrottler SubnetBandwidthThrottler,
 ) (InboundMsgThrottler, error) {
 	byteThrottler, err := newInboundMsgByteThrottler(
 		log,
@@ -110,6 +119,7 @@ func NewInboundMsgThrottler(
 		bandwidthThrottler: bandwidthThrottler,
 		cpuThrottler:       cpuThrottler,
 		diskThrottler:      diskThrottler,
+		subnetThrottler:    subnetThrottler,
 	}, nil
 }
 
@@ -128,6 +138,9 @@ func NewInboundMsgThrottler(
 // A call to Acquire([msgSize], [nodeID]) blocks until we've secured
 // enough of both these resources to read a message of size [msgSize] from
 // [nodeID].
+//
+// Once a message has been parsed, AcquireChain drops it if it exceeds the
+// bandwidth quota of its chain's subnet. See SubnetBandwidthThrottler.
 type inboundMsgThrottler struct {
 	// Rate-limits based on number of messages from a given node that we're
 	// currently processing.
@@ -141,6 +154,8 @@ type inboundMsgThrottler struct {
 	cpuThrottler SystemThrottler
 	// Rate-limits based on disk usage caused by a given node.
 	diskThrottler SystemThrottler
+	// Rate-limits based on the recent bandwidth usage of a subnet's chains.
+	subnetThrottler SubnetBandwidthThrottler
 }
 
 // Returns when we can read a message of size [msgSize] from node [nodeID].
@@ -165,6 +180,11 @@ func (t *inboundMsgThrottler) Acquire(ctx context.Context, msgSize uint64, nodeI
 	}
 }
 
+// See SubnetBandwidthThrottler.
+func (t *inboundMsgThrottler) AcquireChain(chainID ids.ID, msgSize uint64) bool {
+	return t.subnetThrottler.AcquireInbound(chainID, msgSize)
+}
+
 // See BandwidthThrottler.
 func (t *inboundMsgThrottler) AddNode(nodeID ids.NodeID) {
 	t.bandwidthThrottler.AddNode(nodeID)
@@ -16,13 +16,17 @@ func NewNoInboundThrottler() InboundMsgThrottler {
 	return &noInboundMsgThrottler{}
 }
 
-// [Acquire] always returns immediately.
+// [Acquire] always returns immediately. [AcquireChain] always returns true.
 type noInboundMsgThrottler struct{}
 
 func (*noInboundMsgThrottler) Acquire(context.Context, uint64, ids.NodeID) ReleaseFunc {
 	return noopRelease
 }
 
+func (*noInboundMsgThrottler) AcquireChain(ids.ID, uint64) bool {
+	return true
+}
+
 func (*noInboundMsgThrottler) AddNode(ids.NodeID) {}
 
 func (*noInboundMsgThrottler) RemoveNode(ids.NodeID) {}
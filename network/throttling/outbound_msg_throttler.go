@@ -25,20 +25,24 @@ var (
 type OutboundMsgThrottler interface {
 	// Returns true if we can queue the message [msg] to be sent to node [nodeID].
 	// Returns false if the message should be dropped (not sent to [nodeID]).
-	// If this method returns true, Release([msg], [nodeID]) must be called (!) when
-	// the message is sent (or when we give up trying to send the message, if applicable.)
+	// If this method returns true, Release([msg], [nodeID], ...) must be called (!)
+	// when the message is sent (or when we give up trying to send the message, if
+	// applicable.)
 	// If this method returns false, do not make a corresponding call to Release.
 	Acquire(msg *message.OutboundMessage, nodeID ids.NodeID) bool
 
 	// Mark that a message [msg] has been sent to [nodeID] or we have given up
-	// sending the message. Must correspond to a previous call to
-	// Acquire([msg], [nodeID]) that returned true.
-	Release(msg *message.OutboundMessage, nodeID ids.NodeID)
+	// sending the message. [sent] is false if we gave up, in which case the
+	// bandwidth charged to the subnet of [msg] is refunded. Must correspond to
+	// a previous call to Acquire([msg], [nodeID]) that returned true.
+	Release(msg *message.OutboundMessage, nodeID ids.NodeID, sent bool)
 }
 
 type outboundMsgThrottler struct {
 	commonMsgThrottler
-	metrics outboundMsgThrottlerMetrics
+	// Enforces the outbound bandwidth quota of each subnet
+	subnetThrottler SubnetBandwidthThrottler
+	metrics         outboundMsgThrottlerMetrics
 }
 
 func NewSybilOutboundMsgThrottler(
@@ -46,6 +50,7 @@ func NewSybilOutboundMsgThrottler(
 	registerer prometheus.Registerer,
 	vdrs validators.Manager,
 	config MsgByteThrottlerConfig,
+	subnetThrottler SubnetBandwidthThrottler,
 ) (OutboundMsgThrottler, error) {
 	t := &outboundMsgThrottler{
 		commonMsgThrottler: commonMsgThrottler{
@@ -58,21 +63,38 @@ func NewSybilOutboundMsgThrottler(
 			nodeToVdrBytesUsed:     make(map[ids.NodeID]uint64),
 			nodeToAtLargeBytesUsed: make(map[ids.NodeID]uint64),
 		},
+		subnetThrottler: subnetThrottler,
 	}
 	return t, t.metrics.initialize(registerer)
 }
 
 func (t *outboundMsgThrottler) Acquire(msg *message.OutboundMessage, nodeID ids.NodeID) bool {
+	// Enforce the outbound bandwidth quota of the subnet of [msg].
+	msgSize := uint64(len(msg.Bytes))
+	if !t.subnetThrottler.AcquireOutbound(msg.ChainID, msgSize) {
+		t.metrics.acquireFailures.Inc()
+		return false
+	}
+
 	// no need to acquire for this message
 	if msg.BypassThrottling {
 		return true
 	}
 
+	if !t.acquireBytes(msgSize, nodeID) {
+		// Refund the subnet's quota, as the message won't be sent.
+		t.subnetThrottler.ReleaseOutbound(msg.ChainID, msgSize, false)
+		return false
+	}
+	return true
+}
+
+func (t *outboundMsgThrottler) acquireBytes(msgSize uint64, nodeID ids.NodeID) bool {
 	t.lock.Lock()
 	defer t.lock.Unlock()
 
 	// Take as many bytes as we can from the at-large allocation.
-	bytesNeeded := uint64(len(msg.Bytes))
+	bytesNeeded := msgSize
 	atLargeBytesUsed := min(
 		// only give as many bytes as needed
 		bytesNeeded,
@@ -132,7 +154,10 @@ func (t *outboundMsgThrottler) Acquire(msg *message.OutboundMessage, nodeID ids.
 	return true
 }
 
-func (t *outboundMsgThrottler) Release(msg *message.OutboundMessage, nodeID ids.NodeID) {
+func (t *outboundMsgThrottler) Release(msg *message.OutboundMessage, nodeID ids.NodeID, sent bool) {
+	msgSize := uint64(len(msg.Bytes))
+	t.subnetThrottler.ReleaseOutbound(msg.ChainID, msgSize, sent)
+
 	// no need to release for this message
 	if msg.BypassThrottling {
 		return
@@ -149,7 +174,6 @@ func (t *outboundMsgThrottler) Release(msg *message.OutboundMessage, nodeID ids.
 	// [vdrBytesToReturn] is the number of bytes from [msgSize]
 	// that will be given back to [nodeID]'s validator allocation.
 	vdrBytesUsed := t.nodeToVdrBytesUsed[nodeID]
-	msgSize := uint64(len(msg.Bytes))
 	vdrBytesToReturn := min(msgSize, vdrBytesUsed)
 	t.nodeToVdrBytesUsed[nodeID] -= vdrBytesToReturn
 	if t.nodeToVdrBytesUsed[nodeID] == 0 {
@@ -217,4 +241,4 @@ func (*noOutboundMsgThrottler) Acquire(*message.OutboundMessage, ids.NodeID) boo
 	return true
 }
 
-func (*noOutboundMsgThrottler) Release(*message.OutboundMessage, ids.NodeID) {}
+func (*noOutboundMsgThrottler) Release(*message.OutboundMessage, ids.NodeID, bool) {}
@@ -33,6 +33,7 @@ func TestSybilOutboundMsgThrottler(t *testing.T) {
 		prometheus.NewRegistry(),
 		vdrs,
 		config,
+		NewNoSubnetBandwidthThrottler(),
 	)
 	require.NoError(err)
 
@@ -56,7 +57,7 @@ func TestSybilOutboundMsgThrottler(t *testing.T) {
 	require.Equal(uint64(1), throttler.nodeToAtLargeBytesUsed[vdr1ID])
 
 	// Release the bytes
-	throttlerIntf.Release(msg, vdr1ID)
+	throttlerIntf.Release(msg, vdr1ID, true)
 	require.Equal(config.AtLargeAllocSize, throttler.remainingAtLargeBytes)
 	require.Equal(config.VdrAllocSize, throttler.remainingVdrBytes)
 	require.Empty(throttler.nodeToVdrBytesUsed)
@@ -109,7 +110,7 @@ func TestSybilOutboundMsgThrottler(t *testing.T) {
 	// rather than the at-large allocation.
 	// vdr1 at-large bytes used: 511. Validator bytes used: 0
 	msg = testMsgWithSize(config.AtLargeAllocSize + 1)
-	throttlerIntf.Release(msg, vdr1ID)
+	throttlerIntf.Release(msg, vdr1ID, true)
 
 	require.Equal(config.NodeMaxAtLargeBytes/2, throttler.remainingVdrBytes)
 	require.Len(throttler.nodeToAtLargeBytesUsed, 1) // vdr1
@@ -133,7 +134,7 @@ func TestSybilOutboundMsgThrottler(t *testing.T) {
 
 	// Release all of vdr2's messages
 	msg = testMsgWithSize(config.AtLargeAllocSize / 2)
-	throttlerIntf.Release(msg, vdr2ID)
+	throttlerIntf.Release(msg, vdr2ID, true)
 	require.Zero(throttler.nodeToAtLargeBytesUsed[vdr2ID])
 	require.Equal(config.VdrAllocSize, throttler.remainingVdrBytes)
 	require.Empty(throttler.nodeToVdrBytesUsed)
@@ -141,7 +142,7 @@ func TestSybilOutboundMsgThrottler(t *testing.T) {
 
 	// Release all of vdr1's messages
 	msg = testMsgWithSize(config.VdrAllocSize/2 - 1)
-	throttlerIntf.Release(msg, vdr1ID)
+	throttlerIntf.Release(msg, vdr1ID, true)
 	require.Empty(throttler.nodeToVdrBytesUsed)
 	require.Equal(config.VdrAllocSize, throttler.remainingVdrBytes)
 	require.Equal(config.AtLargeAllocSize/2-1, throttler.remainingAtLargeBytes)
@@ -149,7 +150,7 @@ func TestSybilOutboundMsgThrottler(t *testing.T) {
 
 	// Release nonVdr's messages
 	msg = testMsgWithSize(config.AtLargeAllocSize/2 + 1)
-	throttlerIntf.Release(msg, nonVdrID)
+	throttlerIntf.Release(msg, nonVdrID, true)
 	require.Empty(throttler.nodeToVdrBytesUsed)
 	require.Equal(config.VdrAllocSize, throttler.remainingVdrBytes)
 	require.Equal(config.AtLargeAllocSize, throttler.remainingAtLargeBytes)
@@ -173,6 +174,7 @@ func TestSybilOutboundMsgThrottlerMaxNonVdr(t *testing.T) {
 		prometheus.NewRegistry(),
 		vdrs,
 		config,
+		NewNoSubnetBandwidthThrottler(),
 	)
 	require.NoError(err)
 	throttler := throttlerIntf.(*outboundMsgThrottler)
@@ -218,6 +220,7 @@ func TestBypassThrottling(t *testing.T) {
 		prometheus.NewRegistry(),
 		vdrs,
 		config,
+		NewNoSubnetBandwidthThrottler(),
 	)
 	require.NoError(err)
 	throttler := throttlerIntf.(*outboundMsgThrottler)
@@ -257,6 +260,64 @@ func TestBypassThrottling(t *testing.T) {
 	require.Equal(config.AtLargeAllocSize-1, throttler.remainingAtLargeBytes)
 }
 
+// Ensure that the subnet bandwidth quota is enforced and refunded for
+// messages that aren't sent
+func TestSybilOutboundMsgThrottlerSubnetQuota(t *testing.T) {
+	require := require.New(t)
+	config := MsgByteThrottlerConfig{
+		AtLargeAllocSize:    1024,
+		NodeMaxAtLargeBytes: 1024,
+	}
+	var (
+		subnetID = ids.GenerateTestID()
+		chainID  = ids.GenerateTestID()
+		nodeID   = ids.GenerateTestNodeID()
+	)
+	subnetThrottlerIntf, err := NewSubnetBandwidthThrottler(
+		prometheus.NewRegistry(),
+		map[ids.ID]SubnetBandwidthQuota{
+			subnetID: {
+				OutboundRefillRate:   1,
+				OutboundMaxBurstSize: constants.DefaultMaxMessageSize,
+			},
+		},
+	)
+	require.NoError(err)
+	subnetThrottlerIntf.AddChain(chainID, subnetID)
+	subnetThrottler := subnetThrottlerIntf.(*subnetBandwidthThrottler)
+	subnetThrottler.clock.Set(subnetThrottler.clock.Time())
+	bucket := subnetThrottler.outbound[subnetID]
+
+	throttlerIntf, err := NewSybilOutboundMsgThrottler(
+		logging.NoLog{},
+		prometheus.NewRegistry(),
+		validators.NewManager(),
+		config,
+		subnetThrottlerIntf,
+	)
+	require.NoError(err)
+
+	// The quota is refunded if the byte allocation can't be acquired.
+	msg := testMsgWithSize(config.AtLargeAllocSize + 1)
+	msg.ChainID = chainID
+	require.False(throttlerIntf.Acquire(msg, nodeID))
+	require.InDelta(float64(constants.DefaultMaxMessageSize), bucket.tokens, 0)
+
+	// The quota is refunded if the message isn't sent.
+	msg = testMsgWithSize(config.AtLargeAllocSize)
+	msg.ChainID = chainID
+	require.True(throttlerIntf.Acquire(msg, nodeID))
+	require.InDelta(float64(constants.DefaultMaxMessageSize-config.AtLargeAllocSize), bucket.tokens, 0)
+	throttlerIntf.Release(msg, nodeID, false)
+	require.InDelta(float64(constants.DefaultMaxMessageSize), bucket.tokens, 0)
+
+	// The quota is consumed if the message is sent.
+	require.True(throttlerIntf.Acquire(msg, nodeID))
+	throttlerIntf.Release(msg, nodeID, true)
+	require.InDelta(float64(constants.DefaultMaxMessageSize-config.AtLargeAllocSize), bucket.tokens, 0)
+	require.Equal(config.AtLargeAllocSize, subnetThrottlerIntf.Usage()[chainID].BytesSent)
+}
+
 func testMsgWithSize(size uint64) *message.OutboundMessage {
 	return &message.OutboundMessage{
 		BypassThrottling: false,
@@ -0,0 +1,345 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package throttling
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+const (
+	ioLabel       = "io"
+	receivedLabel = "received"
+	sentLabel     = "sent"
+)
+
+var (
+	_ SubnetBandwidthThrottler = (*subnetBandwidthThrottler)(nil)
+	_ SubnetBandwidthThrottler = (*noSubnetBandwidthThrottler)(nil)
+
+	errBurstSizeTooSmall = errors.New("max burst size must be at least the max message size")
+)
+
+// SubnetBandwidthQuota limits the bandwidth that the chains of a subnet may
+// consume. Each direction is rate-limited using a token bucket, where each
+// token is 1 byte. A refill rate of 0 disables the quota for that direction.
+type SubnetBandwidthQuota struct {
+	// Rate, in bytes per second, at which the inbound allocation replenishes
+	InboundRefillRate uint64 `json:"inboundRefillRate" yaml:"inboundRefillRate"`
+	// Max number of inbound bytes that can accumulate in the allocation
+	InboundMaxBurstSize uint64 `json:"inboundMaxBurstSize" yaml:"inboundMaxBurstSize"`
+	// Rate, in bytes per second, at which the outbound allocation replenishes
+	OutboundRefillRate uint64 `json:"outboundRefillRate" yaml:"outboundRefillRate"`
+	// Max number of outbound bytes that can accumulate in the allocation
+	OutboundMaxBurstSize uint64 `json:"outboundMaxBurstSize" yaml:"outboundMaxBurstSize"`
+}
+
+func (q *SubnetBandwidthQuota) Verify() error {
+	switch {
+	case q.InboundRefillRate != 0 && q.InboundMaxBurstSize < constants.DefaultMaxMessageSize:
+		return fmt.Errorf("inbound %w: %d < %d", errBurstSizeTooSmall, q.InboundMaxBurstSize, constants.DefaultMaxMessageSize)
+	case q.OutboundRefillRate != 0 && q.OutboundMaxBurstSize < constants.DefaultMaxMessageSize:
+		return fmt.Errorf("outbound %w: %d < %d", errBurstSizeTooSmall, q.OutboundMaxBurstSize, constants.DefaultMaxMessageSize)
+	default:
+		return nil
+	}
+}
+
+// ChainBandwidthUsage is the bandwidth consumed on behalf of a chain since
+// this node started.
+type ChainBandwidthUsage struct {
+	SubnetID ids.ID
+	// Bytes received that were handed to the chain
+	BytesReceived uint64
+	// Bytes sent on behalf of the chain
+	BytesSent uint64
+	// Bytes received that were dropped due to the subnet's inbound quota
+	BytesReceivedDropped uint64
+	// Bytes that weren't sent due to the subnet's outbound quota
+	BytesSentDropped uint64
+}
+
+// SubnetBandwidthThrottler accounts for the bandwidth used by each chain and
+// enforces the bandwidth quotas of each subnet.
+//
+// It isn't used directly by the network. Instead, the inbound message
+// throttler checks the inbound quota once a message has been parsed, and the
+// outbound message throttler checks the outbound quota when a message is
+// queued to be sent.
+type SubnetBandwidthThrottler interface {
+	// AddChain registers that [chainID] is validated by [subnetID]. Messages
+	// for chains that haven't been added are neither accounted for nor
+	// throttled.
+	AddChain(chainID ids.ID, subnetID ids.ID)
+
+	// AcquireInbound records that a message of [msgSize] bytes was received
+	// for [chainID]. Returns false if the message exceeds the inbound quota of
+	// the chain's subnet and should be dropped.
+	AcquireInbound(chainID ids.ID, msgSize uint64) bool
+
+	// AcquireOutbound returns true if a message of [msgSize] bytes may be sent
+	// for [chainID]. Returns false if the message exceeds the outbound quota
+	// of the chain's subnet and should be dropped.
+	// If this method returns true, ReleaseOutbound must be called (!) once the
+	// message is sent or once we give up sending it.
+	AcquireOutbound(chainID ids.ID, msgSize uint64) bool
+
+	// ReleaseOutbound marks that a message previously acquired with
+	// AcquireOutbound was sent. If [sent] is false, the message was dropped
+	// and its bytes are refunded to the outbound quota of the chain's subnet.
+	ReleaseOutbound(chainID ids.ID, msgSize uint64, sent bool)
+
+	// Usage returns the bandwidth used by every chain that has been added.
+	Usage() map[ids.ID]ChainBandwidthUsage
+}
+
+// chainBandwidth is the bandwidth used by a chain. Its counters are updated
+// atomically so that messages of chains without a quota never take a lock.
+type chainBandwidth struct {
+	subnetID ids.ID
+	// Token buckets of the subnet's quota. Nil if the subnet doesn't have a
+	// quota in that direction.
+	inbound  *tokenBucket
+	outbound *tokenBucket
+
+	bytesReceived        atomic.Uint64
+	bytesSent            atomic.Uint64
+	bytesReceivedDropped atomic.Uint64
+	bytesSentDropped     atomic.Uint64
+}
+
+type subnetBandwidthThrottler struct {
+	clock mockable.Clock
+
+	// Subnet ID --> Token buckets shared by the chains of the subnet. Only
+	// contains subnets with a quota and is only written during construction.
+	inbound  map[ids.ID]*tokenBucket
+	outbound map[ids.ID]*tokenBucket
+
+	// Serializes calls to AddChain
+	chainsLock sync.Mutex
+	// Chain ID --> Bandwidth used by the chain. The map is replaced, rather
+	// than modified, when a chain is added so that messages can be accounted
+	// for without taking a lock.
+	chains atomic.Pointer[map[ids.ID]*chainBandwidth]
+
+	bytesReceived        prometheus.Counter
+	bytesSent            prometheus.Counter
+	bytesReceivedDropped prometheus.Counter
+	bytesSentDropped     prometheus.Counter
+}
+
+// NewSubnetBandwidthThrottler returns a throttler that enforces [quotas],
+// which maps a subnet ID to the bandwidth quota of the subnet.
+func NewSubnetBandwidthThrottler(
+	registerer prometheus.Registerer,
+	quotas map[ids.ID]SubnetBandwidthQuota,
+) (SubnetBandwidthThrottler, error) {
+	var (
+		bytes = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "throttler_subnet_bandwidth_bytes",
+				Help: "Bytes sent or received on behalf of chains",
+			},
+			[]string{ioLabel},
+		)
+		bytesDropped = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "throttler_subnet_bandwidth_bytes_dropped",
+				Help: "Bytes dropped due to subnet bandwidth quotas",
+			},
+			[]string{ioLabel},
+		)
+		t = &subnetBandwidthThrottler{
+			inbound:              make(map[ids.ID]*tokenBucket),
+			outbound:             make(map[ids.ID]*tokenBucket),
+			bytesReceived:        bytes.WithLabelValues(receivedLabel),
+			bytesSent:            bytes.WithLabelValues(sentLabel),
+			bytesReceivedDropped: bytesDropped.WithLabelValues(receivedLabel),
+			bytesSentDropped:     bytesDropped.WithLabelValues(sentLabel),
+		}
+		now    = t.clock.Time()
+		chains = make(map[ids.ID]*chainBandwidth)
+	)
+	t.chains.Store(&chains)
+	for subnetID, quota := range quotas {
+		if err := quota.Verify(); err != nil {
+			return nil, fmt.Errorf("invalid bandwidth quota for subnet %s: %w", subnetID, err)
+		}
+
+		if quota.InboundRefillRate != 0 {
+			t.inbound[subnetID] = newTokenBucket(now, quota.InboundRefillRate, quota.InboundMaxBurstSize)
+		}
+		if quota.OutboundRefillRate != 0 {
+			t.outbound[subnetID] = newTokenBucket(now, quota.OutboundRefillRate, quota.OutboundMaxBurstSize)
+		}
+	}
+	return t, errors.Join(
+		registerer.Register(bytes),
+		registerer.Register(bytesDropped),
+	)
+}
+
+func (t *subnetBandwidthThrottler) AddChain(chainID ids.ID, subnetID ids.ID) {
+	t.chainsLock.Lock()
+	defer t.chainsLock.Unlock()
+
+	chains := *t.chains.Load()
+	if _, ok := chains[chainID]; ok {
+		return
+	}
+
+	newChains := maps.Clone(chains)
+	newChains[chainID] = &chainBandwidth{
+		subnetID: subnetID,
+		inbound:  t.inbound[subnetID],
+		outbound: t.outbound[subnetID],
+	}
+	t.chains.Store(&newChains)
+}
+
+func (t *subnetBandwidthThrottler) AcquireInbound(chainID ids.ID, msgSize uint64) bool {
+	chain, ok := t.getChain(chainID)
+	if !ok {
+		return true
+	}
+
+	if chain.inbound != nil && !chain.inbound.acquire(t.clock.Time(), msgSize) {
+		chain.bytesReceivedDropped.Add(msgSize)
+		t.bytesReceivedDropped.Add(float64(msgSize))
+		return false
+	}
+	chain.bytesReceived.Add(msgSize)
+	t.bytesReceived.Add(float64(msgSize))
+	return true
+}
+
+func (t *subnetBandwidthThrottler) AcquireOutbound(chainID ids.ID, msgSize uint64) bool {
+	chain, ok := t.getChain(chainID)
+	if !ok || chain.outbound == nil {
+		return true
+	}
+
+	if !chain.outbound.acquire(t.clock.Time(), msgSize) {
+		chain.bytesSentDropped.Add(msgSize)
+		t.bytesSentDropped.Add(float64(msgSize))
+		return false
+	}
+	return true
+}
+
+func (t *subnetBandwidthThrottler) ReleaseOutbound(chainID ids.ID, msgSize uint64, sent bool) {
+	chain, ok := t.getChain(chainID)
+	if !ok {
+		return
+	}
+
+	if sent {
+		chain.bytesSent.Add(msgSize)
+		t.bytesSent.Add(float64(msgSize))
+		return
+	}
+	if chain.outbound != nil {
+		chain.outbound.refund(msgSize)
+	}
+}
+
+func (t *subnetBandwidthThrottler) Usage() map[ids.ID]ChainBandwidthUsage {
+	chains := *t.chains.Load()
+	usage := make(map[ids.ID]ChainBandwidthUsage, len(chains))
+	for chainID, chain := range chains {
+		usage[chainID] = ChainBandwidthUsage{
+			SubnetID:             chain.subnetID,
+			BytesReceived:        chain.bytesReceived.Load(),
+			BytesSent:            chain.bytesSent.Load(),
+			BytesReceivedDropped: chain.bytesReceivedDropped.Load(),
+			BytesSentDropped:     chain.bytesSentDropped.Load(),
+		}
+	}
+	return usage
+}
+
+func (t *subnetBandwidthThrottler) getChain(chainID ids.ID) (*chainBandwidth, bool) {
+	chain, ok := (*t.chains.Load())[chainID]
+	return chain, ok
+}
+
+// tokenBucket is a token bucket, where each token is 1 byte. Unlike
+// [rate.Limiter], tokens can be refunded when a message is dropped after its
+// tokens were acquired.
+type tokenBucket struct {
+	lock       sync.Mutex
+	refillRate float64 // tokens per second
+	maxTokens  float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+func newTokenBucket(now time.Time, refillRate uint64, maxTokens uint64) *tokenBucket {
+	return &tokenBucket{
+		refillRate: float64(refillRate),
+		maxTokens:  float64(maxTokens),
+		tokens:     float64(maxTokens),
+		lastRefill: now,
+	}
+}
+
+// acquire consumes [n] tokens and returns true if [n] tokens are available.
+// Otherwise, no tokens are consumed and false is returned.
+func (b *tokenBucket) acquire(now time.Time, n uint64) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if elapsed := now.Sub(b.lastRefill); elapsed > 0 {
+		b.tokens = min(b.maxTokens, b.tokens+elapsed.Seconds()*b.refillRate)
+		b.lastRefill = now
+	}
+	if b.tokens < float64(n) {
+		return false
+	}
+	b.tokens -= float64(n)
+	return true
+}
+
+// refund returns [n] previously acquired tokens to the bucket.
+func (b *tokenBucket) refund(n uint64) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.tokens = min(b.maxTokens, b.tokens+float64(n))
+}
+
+// NewNoSubnetBandwidthThrottler returns a throttler that never drops messages
+// and doesn't account for bandwidth usage.
+func NewNoSubnetBandwidthThrottler() SubnetBandwidthThrottler {
+	return noSubnetBandwidthThrottler{}
+}
+
+type noSubnetBandwidthThrottler struct{}
+
+func (noSubnetBandwidthThrottler) AddChain(ids.ID, ids.ID) {}
+
+func (noSubnetBandwidthThrottler) AcquireInbound(ids.ID, uint64) bool {
+	return true
+}
+
+func (noSubnetBandwidthThrottler) AcquireOutbound(ids.ID, uint64) bool {
+	return true
+}
+
+func (noSubnetBandwidthThrottler) ReleaseOutbound(ids.ID, uint64, bool) {}
+
+func (noSubnetBandwidthThrottler) Usage() map[ids.ID]ChainBandwidthUsage {
+	return nil
+}
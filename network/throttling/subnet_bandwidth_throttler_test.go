@@ -0,0 +1,136 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package throttling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+)
+
+func TestSubnetBandwidthQuotaVerify(t *testing.T) {
+	tests := []struct {
+		name        string
+		quota       SubnetBandwidthQuota
+		expectedErr error
+	}{
+		{
+			name:  "no quota",
+			quota: SubnetBandwidthQuota{},
+		},
+		{
+			name: "valid quota",
+			quota: SubnetBandwidthQuota{
+				InboundRefillRate:    1,
+				InboundMaxBurstSize:  constants.DefaultMaxMessageSize,
+				OutboundRefillRate:   1,
+				OutboundMaxBurstSize: constants.DefaultMaxMessageSize,
+			},
+		},
+		{
+			name: "inbound burst too small",
+			quota: SubnetBandwidthQuota{
+				InboundRefillRate:   1,
+				InboundMaxBurstSize: constants.DefaultMaxMessageSize - 1,
+			},
+			expectedErr: errBurstSizeTooSmall,
+		},
+		{
+			name: "outbound burst too small",
+			quota: SubnetBandwidthQuota{
+				OutboundRefillRate:   1,
+				OutboundMaxBurstSize: constants.DefaultMaxMessageSize - 1,
+			},
+			expectedErr: errBurstSizeTooSmall,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.ErrorIs(t, test.quota.Verify(), test.expectedErr)
+		})
+	}
+}
+
+func TestSubnetBandwidthThrottler(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		limitedSubnetID   = ids.GenerateTestID()
+		unlimitedSubnetID = ids.GenerateTestID()
+		limitedChainID    = ids.GenerateTestID()
+		unlimitedChainID  = ids.GenerateTestID()
+		unknownChainID    = ids.GenerateTestID()
+	)
+	throttlerIntf, err := NewSubnetBandwidthThrottler(
+		prometheus.NewRegistry(),
+		map[ids.ID]SubnetBandwidthQuota{
+			limitedSubnetID: {
+				InboundRefillRate:    1024,
+				InboundMaxBurstSize:  constants.DefaultMaxMessageSize,
+				OutboundRefillRate:   2048,
+				OutboundMaxBurstSize: constants.DefaultMaxMessageSize,
+			},
+		},
+	)
+	require.NoError(err)
+	throttler := throttlerIntf.(*subnetBandwidthThrottler)
+	throttler.AddChain(limitedChainID, limitedSubnetID)
+	throttler.AddChain(unlimitedChainID, unlimitedSubnetID)
+
+	now := time.Now()
+	throttler.clock.Set(now)
+
+	// The full burst can be consumed immediately.
+	require.True(throttler.AcquireInbound(limitedChainID, constants.DefaultMaxMessageSize))
+	require.True(throttler.AcquireOutbound(limitedChainID, constants.DefaultMaxMessageSize))
+	throttler.ReleaseOutbound(limitedChainID, constants.DefaultMaxMessageSize, true)
+
+	// The quota is exhausted.
+	require.False(throttler.AcquireInbound(limitedChainID, 1024))
+	require.False(throttler.AcquireOutbound(limitedChainID, 2048))
+
+	// Subnets without a quota are never throttled.
+	require.True(throttler.AcquireInbound(unlimitedChainID, constants.DefaultMaxMessageSize))
+	require.True(throttler.AcquireOutbound(unlimitedChainID, constants.DefaultMaxMessageSize))
+	throttler.ReleaseOutbound(unlimitedChainID, constants.DefaultMaxMessageSize, true)
+
+	// Chains that weren't added are neither throttled nor accounted for.
+	require.True(throttler.AcquireInbound(unknownChainID, constants.DefaultMaxMessageSize))
+	require.True(throttler.AcquireOutbound(unknownChainID, constants.DefaultMaxMessageSize))
+	throttler.ReleaseOutbound(unknownChainID, constants.DefaultMaxMessageSize, true)
+
+	// The quota refills over time.
+	throttler.clock.Set(now.Add(time.Second))
+	require.True(throttler.AcquireInbound(limitedChainID, 1024))
+	require.True(throttler.AcquireOutbound(limitedChainID, 2048))
+
+	// Messages that aren't sent are refunded.
+	require.False(throttler.AcquireOutbound(limitedChainID, 2048))
+	throttler.ReleaseOutbound(limitedChainID, 2048, false)
+	require.True(throttler.AcquireOutbound(limitedChainID, 2048))
+	throttler.ReleaseOutbound(limitedChainID, 2048, true)
+
+	require.Equal(
+		map[ids.ID]ChainBandwidthUsage{
+			limitedChainID: {
+				SubnetID:             limitedSubnetID,
+				BytesReceived:        constants.DefaultMaxMessageSize + 1024,
+				BytesSent:            constants.DefaultMaxMessageSize + 2048,
+				BytesReceivedDropped: 1024,
+				BytesSentDropped:     2 * 2048,
+			},
+			unlimitedChainID: {
+				SubnetID:      unlimitedSubnetID,
+				BytesReceived: constants.DefaultMaxMessageSize,
+				BytesSent:     constants.DefaultMaxMessageSize,
+			},
+		},
+		throttler.Usage(),
+	)
+}
@@ -117,6 +117,8 @@ var (
 	indexerDBPrefix = []byte{0x00}
 
 	errInvalidTLSKey        = errors.New("invalid TLS key")
+	errInvalidPublicIPv6    = errors.New("public IPv6 address is not an IPv6 address")
+	errIPv6RequiresIPv4     = errors.New("public IPv6 address can only be provided when the public IP is an IPv4 address")
 	errShuttingDown         = errors.New("server shutting down")
 	errNoValidators         = errors.New("no validators in the current validator set")
 	errUpgradeNeeded        = errors.New("unknown network upgrade detected")
@@ -309,6 +311,10 @@ type Node struct {
 	portMapper *nat.Mapper
 	ipUpdater  dynamicip.Updater
 
+	// Maps ports and updates the IPv6 address of a dual-stack node
+	ipv6PortMapper *nat.Mapper
+	ipv6Updater    dynamicip.Updater
+
 	chainRouter router.Router
 
 	// Profiles the process. Nil if continuous profiling is disabled.
@@ -406,6 +412,10 @@ type Node struct {
 	// we rate-limit them.
 	diskTargeter tracker.Targeter
 
+	// Accounts for the bandwidth used by each chain and enforces the bandwidth
+	// quotas of each subnet.
+	bandwidthThrottler throttling.SubnetBandwidthThrottler
+
 	// Closed when a sufficient amount of bootstrap nodes are connected to
 	onSufficientlyConnected chan struct{}
 }
@@ -504,6 +514,11 @@ func (n *Node) initNetworking(reg prometheus.Registerer) error {
 		)
 	}
 
+	atomicIPv6, err := n.initIPv6(publicAddr, stakingPort)
+	if err != nil {
+		return err
+	}
+
 	// Regularly update our public IP and port mappings.
 	n.portMapper.Map(
 		stakingPort,
@@ -517,6 +532,11 @@ func (n *Node) initNetworking(reg prometheus.Registerer) error {
 	n.Log.Info("initializing networking",
 		zap.Stringer("ip", atomicIP.Get()),
 	)
+	if atomicIPv6 != nil {
+		n.Log.Info("advertising IPv6 address",
+			zap.Stringer("ip", atomicIPv6.Get()),
+		)
+	}
 
 	tlsKey, ok := n.Config.StakingTLSCert.PrivateKey.(crypto.Signer)
 	if !ok {
@@ -622,6 +642,7 @@ func (n *Node) initNetworking(reg prometheus.Registerer) error {
 	// add node configs to network config
 	n.Config.NetworkConfig.MyNodeID = n.ID
 	n.Config.NetworkConfig.MyIPPort = atomicIP
+	n.Config.NetworkConfig.MyIPv6Port = atomicIPv6
 	n.Config.NetworkConfig.NetworkID = n.Config.NetworkID
 	n.Config.NetworkConfig.Validators = n.vdrs
 	n.Config.NetworkConfig.Beacons = n.bootstrappers
@@ -635,6 +656,16 @@ func (n *Node) initNetworking(reg prometheus.Registerer) error {
 	n.Config.NetworkConfig.CPUTargeter = n.cpuTargeter
 	n.Config.NetworkConfig.DiskTargeter = n.diskTargeter
 
+	bandwidthQuotas := make(map[ids.ID]throttling.SubnetBandwidthQuota, len(n.Config.SubnetConfigs))
+	for subnetID, config := range n.Config.SubnetConfigs {
+		bandwidthQuotas[subnetID] = config.BandwidthQuota
+	}
+	n.bandwidthThrottler, err = throttling.NewSubnetBandwidthThrottler(reg, bandwidthQuotas)
+	if err != nil {
+		return fmt.Errorf("initializing subnet bandwidth throttler failed with: %w", err)
+	}
+	n.Config.NetworkConfig.SubnetBandwidthThrottler = n.bandwidthThrottler
+
 	n.Net, err = network.NewNetwork(
 		&n.Config.NetworkConfig,
 		n.Config.UpgradeConfig.GraniteTime,
@@ -935,6 +966,75 @@ func (n *Node) initMetrics() error {
 	)
 }
 
+// initIPv6 returns the IPv6 address of this node, or nil if this node isn't
+// configured to be dual-stack. If this node is dual-stack, the staking port is
+// opened in the IPv6 firewall when supported by the gateway.
+func (n *Node) initIPv6(publicAddr netip.Addr, stakingPort uint16) (*utils.Atomic[netip.AddrPort], error) {
+	var (
+		publicIPv6 netip.Addr
+		resolver   dynamicip.Resolver
+		err        error
+	)
+	switch {
+	case n.Config.PublicIPv6 != "":
+		// Use the specified public IPv6 address.
+		publicIPv6, err = ips.ParseAddr(n.Config.PublicIPv6)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public IPv6 address %q: %w", n.Config.PublicIPv6, err)
+		}
+	case n.Config.PublicIPv6ResolutionService != "":
+		// Use dynamic IPv6 address resolution.
+		resolver, err = dynamicip.NewIPv6Resolver(n.Config.PublicIPv6ResolutionService)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't create IPv6 resolver: %w", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), ipResolutionTimeout)
+		publicIPv6, err = resolver.Resolve(ctx)
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("couldn't resolve public IPv6 address: %w", err)
+		}
+	default:
+		n.ipv6PortMapper = nat.NewPortMapper(n.Log, nat.NewNoRouter())
+		n.ipv6Updater = dynamicip.NewNoUpdater()
+		return nil, nil
+	}
+
+	if !publicIPv6.Is6() {
+		return nil, fmt.Errorf("%w: %s", errInvalidPublicIPv6, publicIPv6)
+	}
+	if !publicAddr.Is4() {
+		return nil, fmt.Errorf("%w: %s", errIPv6RequiresIPv4, publicAddr)
+	}
+
+	atomicIPv6 := utils.NewAtomic(netip.AddrPortFrom(
+		publicIPv6,
+		stakingPort,
+	))
+	n.ipv6Updater = dynamicip.NewNoUpdater()
+	if resolver != nil {
+		n.ipv6Updater = dynamicip.NewUpdater(atomicIPv6, resolver, n.Config.PublicIPResolutionFreq)
+	}
+
+	// IPv6 addresses aren't translated, so the mapping only opens a pinhole
+	// and never modifies [atomicIPv6].
+	ipv6Router := nat.GetIPv6Router()
+	if !ipv6Router.SupportsNAT() {
+		n.Log.Info("PCP router attach failed, the IPv6 firewall may need to be configured manually")
+	}
+	n.ipv6PortMapper = nat.NewPortMapper(n.Log, ipv6Router)
+	n.ipv6PortMapper.Map(
+		stakingPort,
+		stakingPort,
+		stakingPortName,
+		nil,
+		n.Config.PublicIPResolutionFreq,
+	)
+	go n.ipv6Updater.Dispatch(n.Log)
+	return atomicIPv6, nil
+}
+
 func (n *Node) initNAT() {
 	n.Log.Info("initializing NAT")
 
@@ -1119,6 +1219,7 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 		n.Config.TrackedSubnets,
 		n.Shutdown,
 		n.Config.RouterHealthConfig,
+		n.bandwidthThrottler,
 		requestsReg,
 	)
 	if err != nil {
@@ -1169,6 +1270,8 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 			BootstrapMaxTimeGetAncestors:            n.Config.BootstrapMaxTimeGetAncestors,
 			BootstrapAncestorsMaxContainersSent:     n.Config.BootstrapAncestorsMaxContainersSent,
 			BootstrapAncestorsMaxContainersReceived: n.Config.BootstrapAncestorsMaxContainersReceived,
+			BootstrapMaxOutstandingRequests:         n.Config.BootstrapMaxOutstandingRequests,
+			BootstrapParseWorkers:                   n.Config.BootstrapParseWorkers,
 			Upgrades:                                n.Config.UpgradeConfig,
 			ResourceTracker:                         n.resourceTracker,
 			StateSyncBeacons:                        n.Config.StateSyncIDs,
@@ -1222,6 +1325,7 @@ func (n *Node) initVMs() error {
 				RewardConfig:              n.Config.RewardConfig,
 				UpgradeConfig:             n.Config.UpgradeConfig,
 				UseCurrentHeight:          n.Config.UseCurrentHeight,
+				AdminAPIEnabled:           n.Config.AdminAPIEnabled,
 			},
 		}),
 		n.VMManager.RegisterFactory(context.TODO(), constants.AVMID, &avm.Factory{
@@ -1403,6 +1507,7 @@ func (n *Node) initInfoAPI() error {
 		n.Config.NetworkConfig.MyIPPort,
 		n.Net,
 		n.benchlistManager,
+		n.bandwidthThrottler,
 	)
 	if err != nil {
 		return err
@@ -1879,6 +1984,10 @@ func (n *Node) shutdown() {
 	}
 	n.portMapper.UnmapAllPorts()
 	n.ipUpdater.Stop()
+	if n.ipv6Updater != nil {
+		n.ipv6PortMapper.UnmapAllPorts()
+		n.ipv6Updater.Stop()
+	}
 	if err := n.indexer.Close(); err != nil {
 		n.Log.Debug("error closing tx indexer",
 			zap.Error(err),
@@ -83,6 +83,7 @@ type Message struct {
 	// Types that are valid to be assigned to Message:
 	//
 	//	*Message_CompressedZstd
+	//	*Message_CompressedZstdDict
 	//	*Message_Ping
 	//	*Message_Pong
 	//	*Message_Handshake
@@ -159,6 +160,15 @@ func (x *Message) GetCompressedZstd() []byte {
 	return nil
 }
 
+func (x *Message) GetCompressedZstdDict() []byte {
+	if x != nil {
+		if x, ok := x.Message.(*Message_CompressedZstdDict); ok {
+			return x.CompressedZstdDict
+		}
+	}
+	return nil
+}
+
 func (x *Message) GetPing() *Ping {
 	if x != nil {
 		if x, ok := x.Message.(*Message_Ping); ok {
@@ -395,6 +405,16 @@ type Message_CompressedZstd struct {
 	CompressedZstd []byte `protobuf:"bytes,2,opt,name=compressed_zstd,json=compressedZstd,proto3,oneof"`
 }
 
+type Message_CompressedZstdDict struct {
+	// zstd-compressed bytes, using a shared dictionary, of a "p2p.Message"
+	// whose "oneof" "message" field is NOT compressed_* BUT one of the message
+	// types (e.g. ping, pong, etc.). The dictionary used is specified in the
+	// zstd frame header.
+	// This field is only set if the message type supports compression and the
+	// peer has advertised support for the dictionary in its handshake.
+	CompressedZstdDict []byte `protobuf:"bytes,3,opt,name=compressed_zstd_dict,json=compressedZstdDict,proto3,oneof"`
+}
+
 type Message_Ping struct {
 	// Network messages:
 	Ping *Ping `protobuf:"bytes,11,opt,name=ping,proto3,oneof"`
@@ -503,6 +523,8 @@ type Message_Simplex struct {
 
 func (*Message_CompressedZstd) isMessage_Message() {}
 
+func (*Message_CompressedZstdDict) isMessage_Message() {}
+
 func (*Message_Ping) isMessage_Message() {}
 
 func (*Message_Pong) isMessage_Message() {}
@@ -674,7 +696,21 @@ type Handshake struct {
 	IpBlsSig []byte `protobuf:"bytes,13,opt,name=ip_bls_sig,json=ipBlsSig,proto3" json:"ip_bls_sig,omitempty"`
 	// To avoid sending IPs that the client isn't interested in tracking, the
 	// server expects the client to confirm that it is tracking all subnets.
-	AllSubnets    bool `protobuf:"varint,14,opt,name=all_subnets,json=allSubnets,proto3" json:"all_subnets,omitempty"`
+	AllSubnets bool `protobuf:"varint,14,opt,name=all_subnets,json=allSubnets,proto3" json:"all_subnets,omitempty"`
+	// IDs of the zstd dictionaries the peer is able to decompress. Messages
+	// compressed with a dictionary that is not included here should not be sent
+	// to the peer.
+	ZstdDictIds []uint32 `protobuf:"varint,15,rep,packed,name=zstd_dict_ids,json=zstdDictIds,proto3" json:"zstd_dict_ids,omitempty"`
+	// IPv6 address of a dual-stack peer. Only set if ip_addr is an IPv4 address
+	// and the peer is also reachable over IPv6.
+	Ipv6Addr []byte `protobuf:"bytes,16,opt,name=ipv6_addr,json=ipv6Addr,proto3" json:"ipv6_addr,omitempty"`
+	// IPv6 port of the peer
+	Ipv6Port uint32 `protobuf:"varint,17,opt,name=ipv6_port,json=ipv6Port,proto3" json:"ipv6_port,omitempty"`
+	// Timestamp of the IPv6 address
+	Ipv6SigningTime uint64 `protobuf:"varint,18,opt,name=ipv6_signing_time,json=ipv6SigningTime,proto3" json:"ipv6_signing_time,omitempty"`
+	// Signature of the peer IPv6 port pair at a provided timestamp with the TLS
+	// key.
+	Ipv6NodeIdSig []byte `protobuf:"bytes,19,opt,name=ipv6_node_id_sig,json=ipv6NodeIdSig,proto3" json:"ipv6_node_id_sig,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -807,6 +843,41 @@ func (x *Handshake) GetAllSubnets() bool {
 	return false
 }
 
+func (x *Handshake) GetZstdDictIds() []uint32 {
+	if x != nil {
+		return x.ZstdDictIds
+	}
+	return nil
+}
+
+func (x *Handshake) GetIpv6Addr() []byte {
+	if x != nil {
+		return x.Ipv6Addr
+	}
+	return nil
+}
+
+func (x *Handshake) GetIpv6Port() uint32 {
+	if x != nil {
+		return x.Ipv6Port
+	}
+	return 0
+}
+
+func (x *Handshake) GetIpv6SigningTime() uint64 {
+	if x != nil {
+		return x.Ipv6SigningTime
+	}
+	return 0
+}
+
+func (x *Handshake) GetIpv6NodeIdSig() []byte {
+	if x != nil {
+		return x.Ipv6NodeIdSig
+	}
+	return nil
+}
+
 // Metadata about a peer's P2P client used to determine compatibility
 type Client struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -945,7 +1016,14 @@ type ClaimedIpPort struct {
 	// Signature of the IP port pair at a provided timestamp
 	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
 	// P-Chain transaction that added this peer to the validator set
-	TxId          []byte `protobuf:"bytes,6,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	TxId []byte `protobuf:"bytes,6,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// IPv6 address of a dual-stack peer. Only set if ip_addr is an IPv4 address
+	// and the peer is also reachable over IPv6.
+	Ipv6Addr []byte `protobuf:"bytes,7,opt,name=ipv6_addr,json=ipv6Addr,proto3" json:"ipv6_addr,omitempty"`
+	// IPv6 port of the peer
+	Ipv6Port uint32 `protobuf:"varint,8,opt,name=ipv6_port,json=ipv6Port,proto3" json:"ipv6_port,omitempty"`
+	// Signature of the IPv6 address + port pair at the provided timestamp
+	Ipv6Signature []byte `protobuf:"bytes,9,opt,name=ipv6_signature,json=ipv6Signature,proto3" json:"ipv6_signature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1022,6 +1100,27 @@ func (x *ClaimedIpPort) GetTxId() []byte {
 	return nil
 }
 
+func (x *ClaimedIpPort) GetIpv6Addr() []byte {
+	if x != nil {
+		return x.Ipv6Addr
+	}
+	return nil
+}
+
+func (x *ClaimedIpPort) GetIpv6Port() uint32 {
+	if x != nil {
+		return x.Ipv6Port
+	}
+	return 0
+}
+
+func (x *ClaimedIpPort) GetIpv6Signature() []byte {
+	if x != nil {
+		return x.Ipv6Signature
+	}
+	return nil
+}
+
 // GetPeerList contains a bloom filter of the currently known validator IPs.
 //
 // GetPeerList must not be responded to until finishing the handshake. After the
@@ -1206,7 +1305,10 @@ type StateSummaryFrontier struct {
 	// Request id of the original GetStateSummaryFrontier request
 	RequestId uint32 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	// The requested state summary
-	Summary       []byte `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Summary []byte `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	// Optional warp message, signed by the chain's validators, attesting to a
+	// checkpoint that the responder trusts
+	Checkpoint    []byte `protobuf:"bytes,4,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1262,6 +1364,13 @@ func (x *StateSummaryFrontier) GetSummary() []byte {
 	return nil
 }
 
+func (x *StateSummaryFrontier) GetCheckpoint() []byte {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return nil
+}
+
 // GetAcceptedStateSummary requests a set of state summaries at a set of
 // block heights
 type GetAcceptedStateSummary struct {
@@ -1689,7 +1798,10 @@ type GetAncestors struct {
 	// Container for which ancestors are being requested
 	ContainerId []byte `protobuf:"bytes,4,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 	// Consensus type to handle this message
-	EngineType    EngineType `protobuf:"varint,5,opt,name=engine_type,json=engineType,proto3,enum=p2p.EngineType" json:"engine_type,omitempty"`
+	EngineType EngineType `protobuf:"varint,5,opt,name=engine_type,json=engineType,proto3,enum=p2p.EngineType" json:"engine_type,omitempty"`
+	// If container_id is empty, the container accepted at this height and its
+	// ancestors are being requested
+	Height        uint64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1759,6 +1871,13 @@ func (x *GetAncestors) GetEngineType() EngineType {
 	return EngineType_ENGINE_TYPE_UNSPECIFIED
 }
 
+func (x *GetAncestors) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
 // Ancestors is sent in response to GetAncestors.
 //
 // Ancestors contains a contiguous ancestry of containers for the requested
@@ -2507,6 +2626,7 @@ type Simplex struct {
 	//	*Simplex_Finalization
 	//	*Simplex_ReplicationRequest
 	//	*Simplex_ReplicationResponse
+	//	*Simplex_NextEpochApproval
 	Message       isSimplex_Message `protobuf_oneof:"message"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -2637,6 +2757,15 @@ func (x *Simplex) GetReplicationResponse() *ReplicationResponse {
 	return nil
 }
 
+func (x *Simplex) GetNextEpochApproval() *NextEpochApproval {
+	if x != nil {
+		if x, ok := x.Message.(*Simplex_NextEpochApproval); ok {
+			return x.NextEpochApproval
+		}
+	}
+	return nil
+}
+
 type isSimplex_Message interface {
 	isSimplex_Message()
 }
@@ -2677,6 +2806,10 @@ type Simplex_ReplicationResponse struct {
 	ReplicationResponse *ReplicationResponse `protobuf:"bytes,10,opt,name=replication_response,json=replicationResponse,proto3,oneof"`
 }
 
+type Simplex_NextEpochApproval struct {
+	NextEpochApproval *NextEpochApproval `protobuf:"bytes,11,opt,name=next_epoch_approval,json=nextEpochApproval,proto3,oneof"`
+}
+
 func (*Simplex_BlockProposal) isSimplex_Message() {}
 
 func (*Simplex_Vote) isSimplex_Message() {}
@@ -2695,6 +2828,8 @@ func (*Simplex_ReplicationRequest) isSimplex_Message() {}
 
 func (*Simplex_ReplicationResponse) isSimplex_Message() {}
 
+func (*Simplex_NextEpochApproval) isSimplex_Message() {}
+
 type BlockProposal struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Block         []byte                 `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
@@ -3302,6 +3437,63 @@ func (x *ReplicationResponse) GetLatestRound() *QuorumRound {
 	return nil
 }
 
+// NextEpochApproval is sent by a validator of the next epoch to the validators
+// of the current epoch once it is ready to start the next epoch.
+type NextEpochApproval struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// next_p_chain_reference_height is the P-chain height that the validator set
+	// of the next epoch is derived from.
+	NextPChainReferenceHeight uint64 `protobuf:"varint,1,opt,name=next_p_chain_reference_height,json=nextPChainReferenceHeight,proto3" json:"next_p_chain_reference_height,omitempty"`
+	// signature over the next_p_chain_reference_height by the sender.
+	Signature     []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextEpochApproval) Reset() {
+	*x = NextEpochApproval{}
+	mi := &file_p2p_p2p_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextEpochApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextEpochApproval) ProtoMessage() {}
+
+func (x *NextEpochApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_p2p_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextEpochApproval.ProtoReflect.Descriptor instead.
+func (*NextEpochApproval) Descriptor() ([]byte, []int) {
+	return file_p2p_p2p_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *NextEpochApproval) GetNextPChainReferenceHeight() uint64 {
+	if x != nil {
+		return x.NextPChainReferenceHeight
+	}
+	return 0
+}
+
+func (x *NextEpochApproval) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 // QuorumRound represents a round that has acheived quorum on either
 // (empty notarization), (block & notarization), or (block, finalization certificate)
 type QuorumRound struct {
@@ -3316,7 +3508,7 @@ type QuorumRound struct {
 
 func (x *QuorumRound) Reset() {
 	*x = QuorumRound{}
-	mi := &file_p2p_p2p_proto_msgTypes[40]
+	mi := &file_p2p_p2p_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3328,7 +3520,7 @@ func (x *QuorumRound) String() string {
 func (*QuorumRound) ProtoMessage() {}
 
 func (x *QuorumRound) ProtoReflect() protoreflect.Message {
-	mi := &file_p2p_p2p_proto_msgTypes[40]
+	mi := &file_p2p_p2p_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3341,7 +3533,7 @@ func (x *QuorumRound) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuorumRound.ProtoReflect.Descriptor instead.
 func (*QuorumRound) Descriptor() ([]byte, []int) {
-	return file_p2p_p2p_proto_rawDescGZIP(), []int{40}
+	return file_p2p_p2p_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *QuorumRound) GetBlock() []byte {
@@ -3376,9 +3568,10 @@ var File_p2p_p2p_proto protoreflect.FileDescriptor
 
 const file_p2p_p2p_proto_rawDesc = "" +
 	"\n" +
-	"\rp2p/p2p.proto\x12\x03p2p\"\x9d\v\n" +
+	"\rp2p/p2p.proto\x12\x03p2p\"\xd1\v\n" +
 	"\aMessage\x12)\n" +
-	"\x0fcompressed_zstd\x18\x02 \x01(\fH\x00R\x0ecompressedZstd\x12\x1f\n" +
+	"\x0fcompressed_zstd\x18\x02 \x01(\fH\x00R\x0ecompressedZstd\x122\n" +
+	"\x14compressed_zstd_dict\x18\x03 \x01(\fH\x00R\x12compressedZstdDict\x12\x1f\n" +
 	"\x04ping\x18\v \x01(\v2\t.p2p.PingH\x00R\x04ping\x12\x1f\n" +
 	"\x04pong\x18\f \x01(\v2\t.p2p.PongH\x00R\x04pong\x12.\n" +
 	"\thandshake\x18\r \x01(\v2\x0e.p2p.HandshakeH\x00R\thandshake\x126\n" +
@@ -3412,7 +3605,7 @@ const file_p2p_p2p_proto_rawDesc = "" +
 	"\amessageJ\x04\b\x01\x10\x02J\x04\b%\x10&\"$\n" +
 	"\x04Ping\x12\x16\n" +
 	"\x06uptime\x18\x01 \x01(\rR\x06uptimeJ\x04\b\x02\x10\x03\"\x12\n" +
-	"\x04PongJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03\"\xf1\x03\n" +
+	"\x04PongJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03\"\xa4\x05\n" +
 	"\tHandshake\x12\x1d\n" +
 	"\n" +
 	"network_id\x18\x01 \x01(\rR\tnetworkId\x12\x17\n" +
@@ -3432,7 +3625,12 @@ const file_p2p_p2p_proto_rawDesc = "" +
 	"\n" +
 	"ip_bls_sig\x18\r \x01(\fR\bipBlsSig\x12\x1f\n" +
 	"\vall_subnets\x18\x0e \x01(\bR\n" +
-	"allSubnets\"^\n" +
+	"allSubnets\x12\"\n" +
+	"\rzstd_dict_ids\x18\x0f \x03(\rR\vzstdDictIds\x12\x1b\n" +
+	"\tipv6_addr\x18\x10 \x01(\fR\bipv6Addr\x12\x1b\n" +
+	"\tipv6_port\x18\x11 \x01(\rR\bipv6Port\x12*\n" +
+	"\x11ipv6_signing_time\x18\x12 \x01(\x04R\x0fipv6SigningTime\x12'\n" +
+	"\x10ipv6_node_id_sig\x18\x13 \x01(\fR\ripv6NodeIdSig\"^\n" +
 	"\x06Client\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05major\x18\x02 \x01(\rR\x05major\x12\x14\n" +
@@ -3440,14 +3638,17 @@ const file_p2p_p2p_proto_rawDesc = "" +
 	"\x05patch\x18\x04 \x01(\rR\x05patch\"9\n" +
 	"\vBloomFilter\x12\x16\n" +
 	"\x06filter\x18\x01 \x01(\fR\x06filter\x12\x12\n" +
-	"\x04salt\x18\x02 \x01(\fR\x04salt\"\xbd\x01\n" +
+	"\x04salt\x18\x02 \x01(\fR\x04salt\"\x9e\x02\n" +
 	"\rClaimedIpPort\x12)\n" +
 	"\x10x509_certificate\x18\x01 \x01(\fR\x0fx509Certificate\x12\x17\n" +
 	"\aip_addr\x18\x02 \x01(\fR\x06ipAddr\x12\x17\n" +
 	"\aip_port\x18\x03 \x01(\rR\x06ipPort\x12\x1c\n" +
 	"\ttimestamp\x18\x04 \x01(\x04R\ttimestamp\x12\x1c\n" +
 	"\tsignature\x18\x05 \x01(\fR\tsignature\x12\x13\n" +
-	"\x05tx_id\x18\x06 \x01(\fR\x04txId\"a\n" +
+	"\x05tx_id\x18\x06 \x01(\fR\x04txId\x12\x1b\n" +
+	"\tipv6_addr\x18\a \x01(\fR\bipv6Addr\x12\x1b\n" +
+	"\tipv6_port\x18\b \x01(\rR\bipv6Port\x12%\n" +
+	"\x0eipv6_signature\x18\t \x01(\fR\ripv6Signature\"a\n" +
 	"\vGetPeerList\x121\n" +
 	"\vknown_peers\x18\x01 \x01(\v2\x10.p2p.BloomFilterR\n" +
 	"knownPeers\x12\x1f\n" +
@@ -3459,12 +3660,15 @@ const file_p2p_p2p_proto_rawDesc = "" +
 	"\bchain_id\x18\x01 \x01(\fR\achainId\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x02 \x01(\rR\trequestId\x12\x1a\n" +
-	"\bdeadline\x18\x03 \x01(\x04R\bdeadline\"j\n" +
+	"\bdeadline\x18\x03 \x01(\x04R\bdeadline\"\x8a\x01\n" +
 	"\x14StateSummaryFrontier\x12\x19\n" +
 	"\bchain_id\x18\x01 \x01(\fR\achainId\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x02 \x01(\rR\trequestId\x12\x18\n" +
-	"\asummary\x18\x03 \x01(\fR\asummary\"\x89\x01\n" +
+	"\asummary\x18\x03 \x01(\fR\asummary\x12\x1e\n" +
+	"\n" +
+	"checkpoint\x18\x04 \x01(\fR\n" +
+	"checkpoint\"\x89\x01\n" +
 	"\x17GetAcceptedStateSummary\x12\x19\n" +
 	"\bchain_id\x18\x01 \x01(\fR\achainId\x12\x1d\n" +
 	"\n" +
@@ -3497,7 +3701,7 @@ const file_p2p_p2p_proto_rawDesc = "" +
 	"\bchain_id\x18\x01 \x01(\fR\achainId\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x02 \x01(\rR\trequestId\x12#\n" +
-	"\rcontainer_ids\x18\x03 \x03(\fR\fcontainerIds\"\xb9\x01\n" +
+	"\rcontainer_ids\x18\x03 \x03(\fR\fcontainerIds\"\xd1\x01\n" +
 	"\fGetAncestors\x12\x19\n" +
 	"\bchain_id\x18\x01 \x01(\fR\achainId\x12\x1d\n" +
 	"\n" +
@@ -3505,7 +3709,8 @@ const file_p2p_p2p_proto_rawDesc = "" +
 	"\bdeadline\x18\x03 \x01(\x04R\bdeadline\x12!\n" +
 	"\fcontainer_id\x18\x04 \x01(\fR\vcontainerId\x120\n" +
 	"\vengine_type\x18\x05 \x01(\x0e2\x0f.p2p.EngineTypeR\n" +
-	"engineType\"e\n" +
+	"engineType\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x04R\x06height\"e\n" +
 	"\tAncestors\x12\x19\n" +
 	"\bchain_id\x18\x01 \x01(\fR\achainId\x12\x1d\n" +
 	"\n" +
@@ -3568,7 +3773,7 @@ const file_p2p_p2p_proto_rawDesc = "" +
 	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"C\n" +
 	"\tAppGossip\x12\x19\n" +
 	"\bchain_id\x18\x01 \x01(\fR\achainId\x12\x1b\n" +
-	"\tapp_bytes\x18\x02 \x01(\fR\bappBytes\"\xd0\x04\n" +
+	"\tapp_bytes\x18\x02 \x01(\fR\bappBytes\"\x9a\x05\n" +
 	"\aSimplex\x12\x19\n" +
 	"\bchain_id\x18\x01 \x01(\fR\achainId\x12;\n" +
 	"\x0eblock_proposal\x18\x02 \x01(\v2\x12.p2p.BlockProposalH\x00R\rblockProposal\x12\x1f\n" +
@@ -3581,7 +3786,8 @@ const file_p2p_p2p_proto_rawDesc = "" +
 	"\ffinalization\x18\b \x01(\v2\x16.p2p.QuorumCertificateH\x00R\ffinalization\x12J\n" +
 	"\x13replication_request\x18\t \x01(\v2\x17.p2p.ReplicationRequestH\x00R\x12replicationRequest\x12M\n" +
 	"\x14replication_response\x18\n" +
-	" \x01(\v2\x18.p2p.ReplicationResponseH\x00R\x13replicationResponseB\t\n" +
+	" \x01(\v2\x18.p2p.ReplicationResponseH\x00R\x13replicationResponse\x12H\n" +
+	"\x13next_epoch_approval\x18\v \x01(\v2\x16.p2p.NextEpochApprovalH\x00R\x11nextEpochApprovalB\t\n" +
 	"\amessage\"D\n" +
 	"\rBlockProposal\x12\x14\n" +
 	"\x05block\x18\x01 \x01(\fR\x05block\x12\x1d\n" +
@@ -3618,7 +3824,10 @@ const file_p2p_p2p_proto_rawDesc = "" +
 	"\flatest_round\x18\x02 \x01(\x04R\vlatestRound\"p\n" +
 	"\x13ReplicationResponse\x12$\n" +
 	"\x04data\x18\x01 \x03(\v2\x10.p2p.QuorumRoundR\x04data\x123\n" +
-	"\flatest_round\x18\x02 \x01(\v2\x10.p2p.QuorumRoundR\vlatestRound\"\xe2\x01\n" +
+	"\flatest_round\x18\x02 \x01(\v2\x10.p2p.QuorumRoundR\vlatestRound\"s\n" +
+	"\x11NextEpochApproval\x12@\n" +
+	"\x1dnext_p_chain_reference_height\x18\x01 \x01(\x04R\x19nextPChainReferenceHeight\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\fR\tsignature\"\xe2\x01\n" +
 	"\vQuorumRound\x12\x14\n" +
 	"\x05block\x18\x01 \x01(\fR\x05block\x12:\n" +
 	"\fnotarization\x18\x02 \x01(\v2\x16.p2p.QuorumCertificateR\fnotarization\x12E\n" +
@@ -3643,7 +3852,7 @@ func file_p2p_p2p_proto_rawDescGZIP() []byte {
 }
 
 var file_p2p_p2p_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_p2p_p2p_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_p2p_p2p_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_p2p_p2p_proto_goTypes = []any{
 	(EngineType)(0),                 // 0: p2p.EngineType
 	(*Message)(nil),                 // 1: p2p.Message
@@ -3686,7 +3895,8 @@ var file_p2p_p2p_proto_goTypes = []any{
 	(*EmptyNotarization)(nil),       // 38: p2p.EmptyNotarization
 	(*ReplicationRequest)(nil),      // 39: p2p.ReplicationRequest
 	(*ReplicationResponse)(nil),     // 40: p2p.ReplicationResponse
-	(*QuorumRound)(nil),             // 41: p2p.QuorumRound
+	(*NextEpochApproval)(nil),       // 41: p2p.NextEpochApproval
+	(*QuorumRound)(nil),             // 42: p2p.QuorumRound
 }
 var file_p2p_p2p_proto_depIdxs = []int32{
 	2,  // 0: p2p.Message.ping:type_name -> p2p.Ping
@@ -3728,24 +3938,25 @@ var file_p2p_p2p_proto_depIdxs = []int32{
 	37, // 36: p2p.Simplex.finalization:type_name -> p2p.QuorumCertificate
 	39, // 37: p2p.Simplex.replication_request:type_name -> p2p.ReplicationRequest
 	40, // 38: p2p.Simplex.replication_response:type_name -> p2p.ReplicationResponse
-	35, // 39: p2p.BlockProposal.vote:type_name -> p2p.Vote
-	31, // 40: p2p.BlockHeader.metadata:type_name -> p2p.ProtocolMetadata
-	33, // 41: p2p.Vote.block_header:type_name -> p2p.BlockHeader
-	34, // 42: p2p.Vote.signature:type_name -> p2p.Signature
-	32, // 43: p2p.EmptyVote.metadata:type_name -> p2p.EmptyVoteMetadata
-	34, // 44: p2p.EmptyVote.signature:type_name -> p2p.Signature
-	33, // 45: p2p.QuorumCertificate.block_header:type_name -> p2p.BlockHeader
-	32, // 46: p2p.EmptyNotarization.metadata:type_name -> p2p.EmptyVoteMetadata
-	41, // 47: p2p.ReplicationResponse.data:type_name -> p2p.QuorumRound
-	41, // 48: p2p.ReplicationResponse.latest_round:type_name -> p2p.QuorumRound
-	37, // 49: p2p.QuorumRound.notarization:type_name -> p2p.QuorumCertificate
-	38, // 50: p2p.QuorumRound.empty_notarization:type_name -> p2p.EmptyNotarization
-	37, // 51: p2p.QuorumRound.finalization:type_name -> p2p.QuorumCertificate
-	52, // [52:52] is the sub-list for method output_type
-	52, // [52:52] is the sub-list for method input_type
-	52, // [52:52] is the sub-list for extension type_name
-	52, // [52:52] is the sub-list for extension extendee
-	0,  // [0:52] is the sub-list for field type_name
+	41, // 39: p2p.Simplex.next_epoch_approval:type_name -> p2p.NextEpochApproval
+	35, // 40: p2p.BlockProposal.vote:type_name -> p2p.Vote
+	31, // 41: p2p.BlockHeader.metadata:type_name -> p2p.ProtocolMetadata
+	33, // 42: p2p.Vote.block_header:type_name -> p2p.BlockHeader
+	34, // 43: p2p.Vote.signature:type_name -> p2p.Signature
+	32, // 44: p2p.EmptyVote.metadata:type_name -> p2p.EmptyVoteMetadata
+	34, // 45: p2p.EmptyVote.signature:type_name -> p2p.Signature
+	33, // 46: p2p.QuorumCertificate.block_header:type_name -> p2p.BlockHeader
+	32, // 47: p2p.EmptyNotarization.metadata:type_name -> p2p.EmptyVoteMetadata
+	42, // 48: p2p.ReplicationResponse.data:type_name -> p2p.QuorumRound
+	42, // 49: p2p.ReplicationResponse.latest_round:type_name -> p2p.QuorumRound
+	37, // 50: p2p.QuorumRound.notarization:type_name -> p2p.QuorumCertificate
+	38, // 51: p2p.QuorumRound.empty_notarization:type_name -> p2p.EmptyNotarization
+	37, // 52: p2p.QuorumRound.finalization:type_name -> p2p.QuorumCertificate
+	53, // [53:53] is the sub-list for method output_type
+	53, // [53:53] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_p2p_p2p_proto_init() }
@@ -3755,6 +3966,7 @@ func file_p2p_p2p_proto_init() {
 	}
 	file_p2p_p2p_proto_msgTypes[0].OneofWrappers = []any{
 		(*Message_CompressedZstd)(nil),
+		(*Message_CompressedZstdDict)(nil),
 		(*Message_Ping)(nil),
 		(*Message_Pong)(nil),
 		(*Message_Handshake)(nil),
@@ -3791,6 +4003,7 @@ func file_p2p_p2p_proto_init() {
 		(*Simplex_Finalization)(nil),
 		(*Simplex_ReplicationRequest)(nil),
 		(*Simplex_ReplicationResponse)(nil),
+		(*Simplex_NextEpochApproval)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3798,7 +4011,7 @@ func file_p2p_p2p_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_p2p_p2p_proto_rawDesc), len(file_p2p_p2p_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   41,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,43 @@
+#!/usr/bin/env bash
+
+set -euo pipefail
+
+# Trains a zstd dictionary for p2p message compression.
+#
+# Usage: ./scripts/train_zstd_dict.sh <dictionary ID> <output file> [samples directory]
+#
+# If no samples directory is provided, synthetic samples are generated with
+# ./message/cmd/zstdsamples. See utils/compression/README.md.
+
+if ! [[ "$0" =~ scripts/train_zstd_dict.sh ]]; then
+  echo "must be run from repository root"
+  exit 255
+fi
+
+if [[ $# -lt 2 ]]; then
+  echo "usage: $0 <dictionary ID> <output file> [samples directory]"
+  exit 255
+fi
+
+DICT_ID="$1"
+OUTPUT="$2"
+SAMPLES_DIR="${3:-}"
+MAX_DICT_SIZE="${MAX_DICT_SIZE:-16384}"
+
+if ! command -v zstd &> /dev/null; then
+  echo "zstd must be installed"
+  exit 255
+fi
+
+if [[ -z "${SAMPLES_DIR}" ]]; then
+  SAMPLES_DIR="$(mktemp -d)"
+  trap 'rm -rf "${SAMPLES_DIR}"' EXIT
+  echo "Generating samples into ${SAMPLES_DIR}..."
+  go run ./message/cmd/zstdsamples --output="${SAMPLES_DIR}" --seed=0
+fi
+
+echo "Training dictionary ${DICT_ID} into ${OUTPUT}..."
+zstd --train -r "${SAMPLES_DIR}" \
+  --maxdict="${MAX_DICT_SIZE}" \
+  --dictID="${DICT_ID}" \
+  -o "${OUTPUT}"
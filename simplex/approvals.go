@@ -0,0 +1,270 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simplex
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+var (
+	errUnobservedNextEpoch = errors.New("next epoch hasn't been observed")
+	errEmptyApprovals      = errors.New("next epoch approvals without approvers")
+	errApprovalsRemoved    = errors.New("next epoch approvals removed approvers of the parent")
+)
+
+// approvalPrefix separates the approvals of the next epoch from the other
+// messages signed by the validators, such as votes.
+var approvalPrefix = []byte("simplex next epoch approval")
+
+// approvalMessage returns the message that the validators of the next epoch
+// sign to approve the epoch change to the validator set at [height].
+func approvalMessage(height uint64) []byte {
+	return binary.BigEndian.AppendUint64(slices.Clone(approvalPrefix), height)
+}
+
+// approvalQuorum returns the number of validators of the next epoch that must
+// approve the epoch change before the epoch can be sealed. It is n-f, where f
+// is the number of faulty validators that the next epoch tolerates.
+func approvalQuorum(n int) int {
+	return n - (n-1)/3
+}
+
+// numApprovers returns the number of validators that approved [approvals].
+func numApprovers(approvals *nextEpochApprovals) int {
+	if approvals == nil {
+		return 0
+	}
+	return set.BitsFromBytes(approvals.NodeIDs).Len()
+}
+
+// approvalPool holds the verified approvals of the next epochs that were
+// observed in the blocks of the current epoch.
+//
+// Approvals are only accepted for observed heights, so that peers can't make
+// the pool grow unboundedly.
+type approvalPool struct {
+	lock sync.Mutex
+	// approvals maps each observed next P-chain reference height to the
+	// approvals of the validators of the next epoch.
+	approvals map[uint64]map[ids.NodeID]*bls.Signature
+}
+
+// observe records that a block of the current epoch proposed to change the
+// validator set to the one at [height].
+func (p *approvalPool) observe(height uint64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.approvals == nil {
+		p.approvals = make(map[uint64]map[ids.NodeID]*bls.Signature)
+	}
+	if _, ok := p.approvals[height]; !ok {
+		p.approvals[height] = make(map[ids.NodeID]*bls.Signature)
+	}
+}
+
+// heights returns the observed next P-chain reference heights in ascending
+// order.
+func (p *approvalPool) heights() []uint64 {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	heights := make([]uint64, 0, len(p.approvals))
+	for height := range p.approvals {
+		heights = append(heights, height)
+	}
+	slices.Sort(heights)
+	return heights
+}
+
+// observed returns true if [height] was observed.
+func (p *approvalPool) observed(height uint64) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	_, ok := p.approvals[height]
+	return ok
+}
+
+// add records the approval of the next epoch at [height] by [nodeID]. The
+// signature must already be verified.
+func (p *approvalPool) add(height uint64, nodeID ids.NodeID, sig *bls.Signature) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if approvals, ok := p.approvals[height]; ok {
+		approvals[nodeID] = sig
+	}
+}
+
+// get returns the approvals of the next epoch at [height].
+func (p *approvalPool) get(height uint64) map[ids.NodeID]*bls.Signature {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	approvals := make(map[ids.NodeID]*bls.Signature, len(p.approvals[height]))
+	for nodeID, sig := range p.approvals[height] {
+		approvals[nodeID] = sig
+	}
+	return approvals
+}
+
+// addApproval verifies that [nodeID] is a validator of the next epoch at
+// [height] and that [signature] approves it. The approval is then included in
+// the blocks built by this node until the epoch is sealed.
+func (m *metadataStateMachine) addApproval(ctx context.Context, nodeID ids.NodeID, height uint64, signature []byte) error {
+	if !m.approvals.observed(height) {
+		return fmt.Errorf("%w: %d", errUnobservedNextEpoch, height)
+	}
+
+	vdrs, err := m.validatorState.GetValidatorSet(ctx, height, m.subnetID)
+	if err != nil {
+		return fmt.Errorf("failed to get validator set at height %d: %w", height, err)
+	}
+	vdr, ok := vdrs[nodeID]
+	if !ok || vdr.PublicKey == nil {
+		return fmt.Errorf("%w: %s", errSignerNotFound, nodeID)
+	}
+
+	sig, err := bls.SignatureFromBytes(signature)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errFailedToParseSignature, err)
+	}
+	msg, err := encodeMessageToSign(approvalMessage(height), m.chainID, m.networkID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errEncodingMessageToSign, err)
+	}
+	if !bls.Verify(vdr.PublicKey, sig, msg) {
+		return errSignatureVerificationFailed
+	}
+
+	m.approvals.add(height, nodeID, sig)
+	return nil
+}
+
+// buildNextEpochApprovals returns the approvals of [parent] extended with the
+// pooled approvals of the members of [descriptor].
+func (m *metadataStateMachine) buildNextEpochApprovals(
+	descriptor *blockValidationDescriptor,
+	height uint64,
+	parent *nextEpochApprovals,
+) (*nextEpochApprovals, error) {
+	var (
+		approvers = set.NewBits()
+		sigs      []*bls.Signature
+	)
+	if parent != nil {
+		approvers = set.BitsFromBytes(parent.NodeIDs)
+		sig, err := bls.SignatureFromBytes(parent.Signature)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errFailedToParseSignature, err)
+		}
+		sigs = append(sigs, sig)
+	}
+
+	pooled := m.approvals.get(height)
+	for i, member := range descriptor.AggregatedMembership.Members {
+		if approvers.Contains(i) {
+			continue
+		}
+		sig, ok := pooled[member.NodeID]
+		if !ok {
+			continue
+		}
+		approvers.Add(i)
+		sigs = append(sigs, sig)
+	}
+	if len(sigs) == 0 {
+		return nil, nil
+	}
+
+	sig, err := bls.AggregateSignatures(sigs)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errSignatureAggregation, err)
+	}
+	return &nextEpochApprovals{
+		NodeIDs:   approvers.Bytes(),
+		Signature: bls.SignatureToBytes(sig),
+	}, nil
+}
+
+// verifyNextEpochApprovals verifies that [approvals] were signed by the
+// members of [descriptor] and include every approver of [parent].
+func (m *metadataStateMachine) verifyNextEpochApprovals(
+	descriptor *blockValidationDescriptor,
+	height uint64,
+	parent *nextEpochApprovals,
+	approvals *nextEpochApprovals,
+) (*nextEpochApprovals, error) {
+	switch {
+	case approvals == nil && parent == nil:
+		return nil, nil
+	case approvals == nil:
+		return nil, errApprovalsRemoved
+	case parent != nil && approvals.equal(parent):
+		// The parent's approvals were already verified.
+		return approvals, nil
+	}
+
+	approvers := set.BitsFromBytes(approvals.NodeIDs)
+	if !bytes.Equal(approvers.Bytes(), approvals.NodeIDs) {
+		return nil, errInvalidBitSet
+	}
+	if approvers.Len() == 0 {
+		return nil, errEmptyApprovals
+	}
+	if parent != nil {
+		parentApprovers := set.BitsFromBytes(parent.NodeIDs)
+		parentApprovers.Difference(approvers)
+		if parentApprovers.Len() != 0 {
+			return nil, errApprovalsRemoved
+		}
+	}
+
+	members := descriptor.AggregatedMembership.Members
+	if approvers.BitLen() > len(members) {
+		return nil, fmt.Errorf("%w: %d approvers of %d members", errInvalidBitSet, approvers.BitLen(), len(members))
+	}
+	pks := make([]*bls.PublicKey, 0, approvers.Len())
+	for i, member := range members {
+		if !approvers.Contains(i) {
+			continue
+		}
+		pk, err := bls.PublicKeyFromCompressedBytes(member.BLSKey[:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid BLS key of %s: %w", member.NodeID, err)
+		}
+		pks = append(pks, pk)
+	}
+	pk, err := bls.AggregatePublicKeys(pks)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errSignatureAggregation, err)
+	}
+
+	sig, err := bls.SignatureFromBytes(approvals.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errFailedToParseSignature, err)
+	}
+	if !bytes.Equal(bls.SignatureToBytes(sig), approvals.Signature) {
+		return nil, fmt.Errorf("%w: non-canonical signature", errFailedToParseSignature)
+	}
+	msg, err := encodeMessageToSign(approvalMessage(height), m.chainID, m.networkID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errEncodingMessageToSign, err)
+	}
+	if !bls.Verify(pk, sig, msg) {
+		return nil, errSignatureVerificationFailed
+	}
+	return approvals, nil
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simplex
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/networking/sender/sendermock"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+func TestMetadataStateMachineAddApproval(t *testing.T) {
+	nodes := generateTestNodes(t, 4)
+	signApproval := func(node *testNode, height uint64) []byte {
+		signer := BLSSigner{signBLS: node.signFunc}
+		sig, err := signer.Sign(approvalMessage(height))
+		require.NoError(t, err)
+		return sig
+	}
+
+	tests := []struct {
+		name        string
+		node        *testNode
+		height      uint64
+		signature   []byte
+		expectedErr error
+	}{
+		{
+			name:      "valid approval",
+			node:      nodes[0],
+			height:    10,
+			signature: signApproval(nodes[0], 10),
+		},
+		{
+			name:        "unobserved height",
+			node:        nodes[0],
+			height:      11,
+			signature:   signApproval(nodes[0], 11),
+			expectedErr: errUnobservedNextEpoch,
+		},
+		{
+			name:        "not a validator of the next epoch",
+			node:        nodes[3],
+			height:      10,
+			signature:   signApproval(nodes[3], 10),
+			expectedErr: errSignerNotFound,
+		},
+		{
+			name:        "signature of another validator",
+			node:        nodes[0],
+			height:      10,
+			signature:   signApproval(nodes[1], 10),
+			expectedErr: errSignatureVerificationFailed,
+		},
+		{
+			name:        "malformed signature",
+			node:        nodes[0],
+			height:      10,
+			signature:   []byte{1, 2, 3},
+			expectedErr: errFailedToParseSignature,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msm := newTestMetadataStateMachine(nil)
+			msm.validatorState = newTestValidatorState(11, map[uint64][]*testNode{
+				10: nodes[:3],
+				11: nodes[:3],
+			})
+			msm.approvals.observe(10)
+
+			err := msm.addApproval(t.Context(), test.node.validator.NodeID, test.height, test.signature)
+			require.ErrorIs(t, err, test.expectedErr)
+
+			_, approved := msm.approvals.get(test.height)[test.node.validator.NodeID]
+			require.Equal(t, test.expectedErr == nil, approved)
+		})
+	}
+}
+
+func TestEngineApproveNextEpochs(t *testing.T) {
+	require := require.New(t)
+
+	nodes := generateTestNodes(t, 3)
+	configs := newTestConfigs(ids.GenerateTestID(), nodes)
+	config := configs[0]
+
+	ctrl := gomock.NewController(t)
+	sender := sendermock.NewExternalSender(ctrl)
+	mc, err := message.NewCreator(
+		prometheus.NewRegistry(),
+		constants.DefaultNetworkCompressionType,
+		10*time.Second,
+	)
+	require.NoError(err)
+	config.OutboundMsgBuilder = mc
+	config.Sender = sender
+	config.ValidatorState = newTestValidatorState(10, map[uint64][]*testNode{
+		10: nodes[:2],
+		20: nodes[1:],
+	})
+
+	engine, err := NewEngine(config)
+	require.NoError(err)
+	comm, err := NewComm(config)
+	require.NoError(err)
+	signer, _ := NewBLSAuth(config)
+
+	msm := newTestMetadataStateMachine(nil)
+	msm.networkID = config.Ctx.NetworkID
+	msm.chainID = config.Ctx.ChainID
+	msm.validatorState = config.ValidatorState
+	current := &runningEpoch{
+		msm:       msm,
+		comm:      comm,
+		signer:    signer,
+		approvals: make(map[uint64][]byte),
+	}
+
+	// This node is a validator of the next epoch at height 10, but not of the
+	// next epoch at height 20.
+	msm.approvals.observe(10)
+	msm.approvals.observe(20)
+
+	expectedSendConfig := common.SendConfig{
+		NodeIDs: set.Of(nodes[1].validator.NodeID, nodes[2].validator.NodeID),
+	}
+	sender.EXPECT().Send(gomock.Any(), expectedSendConfig, comm.subnetID, gomock.Any())
+
+	now := time.Now()
+	engine.approveNextEpochs(t.Context(), current, now)
+	require.Contains(msm.approvals.get(10), config.Ctx.NodeID)
+	require.NotContains(msm.approvals.get(20), config.Ctx.NodeID)
+	require.NotNil(current.approvals[10])
+	require.Nil(current.approvals[20])
+
+	// The approvals aren't rebroadcast before the rebroadcast timeout.
+	engine.approveNextEpochs(t.Context(), current, now.Add(tickInterval))
+
+	sender.EXPECT().Send(gomock.Any(), expectedSendConfig, comm.subnetID, gomock.Any())
+	engine.approveNextEpochs(t.Context(), current, now.Add(maxRebroadcastWait))
+}
@@ -29,9 +29,11 @@ var (
 const (
 	canoto__canotoSimplexBlock__Metadata   = 1
 	canoto__canotoSimplexBlock__InnerBlock = 2
+	canoto__canotoSimplexBlock__EpochInfo  = 3
 
 	canoto__canotoSimplexBlock__Metadata__tag   = "\x0a" // canoto.Tag(canoto__canotoSimplexBlock__Metadata, canoto.Len)
 	canoto__canotoSimplexBlock__InnerBlock__tag = "\x12" // canoto.Tag(canoto__canotoSimplexBlock__InnerBlock, canoto.Len)
+	canoto__canotoSimplexBlock__EpochInfo__tag  = "\x1a" // canoto.Tag(canoto__canotoSimplexBlock__EpochInfo, canoto.Len)
 )
 
 type canotoData_canotoSimplexBlock struct {
@@ -39,7 +41,9 @@ type canotoData_canotoSimplexBlock struct {
 }
 
 // CanotoSpec returns the specification of this canoto message.
-func (*canotoSimplexBlock) CanotoSpec(...reflect.Type) *canoto.Spec {
+func (*canotoSimplexBlock) CanotoSpec(types ...reflect.Type) *canoto.Spec {
+	types = append(types, reflect.TypeOf(canotoSimplexBlock{}))
+	var zero canotoSimplexBlock
 	s := &canoto.Spec{
 		Name: "canotoSimplexBlock",
 		Fields: []canoto.FieldType{
@@ -55,6 +59,15 @@ func (*canotoSimplexBlock) CanotoSpec(...reflect.Type) *canoto.Spec {
 				OneOf:       "",
 				TypeBytes:   true,
 			},
+			canoto.FieldTypeFromField(
+				/*type inference:*/ (&zero.EpochInfo),
+				/*FieldNumber:   */ canoto__canotoSimplexBlock__EpochInfo,
+				/*Name:          */ "EpochInfo",
+				/*FixedLength:   */ 0,
+				/*Repeated:      */ false,
+				/*OneOf:         */ "",
+				/*types:         */ types,
+			),
 		},
 	}
 	s.CalculateCanotoCache()
@@ -120,6 +133,30 @@ func (c *canotoSimplexBlock) UnmarshalCanotoFrom(r canoto.Reader) error {
 			if len(c.InnerBlock) == 0 {
 				return canoto.ErrZeroValue
 			}
+		case canoto__canotoSimplexBlock__EpochInfo:
+			if wireType != canoto.Len {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			// Read the bytes for the field.
+			originalUnsafe := r.Unsafe
+			r.Unsafe = true
+			var msgBytes []byte
+			if err := canoto.ReadBytes(&r, &msgBytes); err != nil {
+				return err
+			}
+			if len(msgBytes) == 0 {
+				return canoto.ErrZeroValue
+			}
+			r.Unsafe = originalUnsafe
+
+			// Unmarshal the field from the bytes.
+			remainingBytes := r.B
+			r.B = msgBytes
+			if err := (&c.EpochInfo).UnmarshalCanotoFrom(r); err != nil {
+				return err
+			}
+			r.B = remainingBytes
 		default:
 			return canoto.ErrUnknownField
 		}
@@ -140,6 +177,9 @@ func (c *canotoSimplexBlock) ValidCanoto() bool {
 	if c == nil {
 		return true
 	}
+	if !(&c.EpochInfo).ValidCanoto() {
+		return false
+	}
 	return true
 }
 
@@ -158,6 +198,10 @@ func (c *canotoSimplexBlock) CalculateCanotoCache() {
 	if len(c.InnerBlock) != 0 {
 		size += uint64(len(canoto__canotoSimplexBlock__InnerBlock__tag)) + canoto.SizeBytes(c.InnerBlock)
 	}
+	(&c.EpochInfo).CalculateCanotoCache()
+	if fieldSize := (&c.EpochInfo).CachedCanotoSize(); fieldSize != 0 {
+		size += uint64(len(canoto__canotoSimplexBlock__EpochInfo__tag)) + canoto.SizeUint(fieldSize) + fieldSize
+	}
 	atomic.StoreUint64(&c.canotoData.size, size)
 }
 
@@ -210,5 +254,10 @@ func (c *canotoSimplexBlock) MarshalCanotoInto(w canoto.Writer) canoto.Writer {
 		canoto.Append(&w, canoto__canotoSimplexBlock__InnerBlock__tag)
 		canoto.AppendBytes(&w, c.InnerBlock)
 	}
+	if fieldSize := (&c.EpochInfo).CachedCanotoSize(); fieldSize != 0 {
+		canoto.Append(&w, canoto__canotoSimplexBlock__EpochInfo__tag)
+		canoto.AppendUint(&w, fieldSize)
+		w = (&c.EpochInfo).MarshalCanotoInto(w)
+	}
 	return w
 }
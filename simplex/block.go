@@ -27,6 +27,7 @@ var (
 	errDigestNotFound       = errors.New("digest not found in block tracker")
 	errMismatchedPrevDigest = errors.New("prev digest does not match block parent")
 	errGenesisVerification  = errors.New("genesis block should not be verified")
+	errUnexpectedInnerBlock = errors.New("telock must not contain an inner block")
 )
 
 type Block struct {
@@ -35,15 +36,19 @@ type Block struct {
 	// metadata contains protocol metadata for the block
 	metadata simplex.ProtocolMetadata
 
-	// the parsed block
+	// epochInfo describes the Simplex epoch of the block
+	epochInfo simplexEpochInfo
+
+	// the parsed block, which is nil for telocks
 	vmBlock snowman.Block
 
 	blockTracker *blockTracker
 }
 
-func newBlock(metadata simplex.ProtocolMetadata, vmBlock snowman.Block, blockTracker *blockTracker) (*Block, error) {
+func newBlock(metadata simplex.ProtocolMetadata, epochInfo simplexEpochInfo, vmBlock snowman.Block, blockTracker *blockTracker) (*Block, error) {
 	block := &Block{
 		metadata:     metadata,
+		epochInfo:    epochInfo,
 		vmBlock:      vmBlock,
 		blockTracker: blockTracker,
 	}
@@ -57,8 +62,9 @@ func newBlock(metadata simplex.ProtocolMetadata, vmBlock snowman.Block, blockTra
 
 // CanotoSimplexBlock is the Canoto representation of a block
 type canotoSimplexBlock struct {
-	Metadata   []byte `canoto:"bytes,1"`
-	InnerBlock []byte `canoto:"bytes,2"`
+	Metadata   []byte           `canoto:"bytes,1"`
+	InnerBlock []byte           `canoto:"bytes,2"`
+	EpochInfo  simplexEpochInfo `canoto:"value,3"`
 
 	canotoData canotoData_canotoSimplexBlock
 }
@@ -74,8 +80,11 @@ func (b *Block) BlockHeader() simplex.BlockHeader {
 // Bytes returns the serialized bytes of the block.
 func (b *Block) Bytes() ([]byte, error) {
 	cBlock := &canotoSimplexBlock{
-		Metadata:   b.metadata.Bytes(),
-		InnerBlock: b.vmBlock.Bytes(),
+		Metadata:  b.metadata.Bytes(),
+		EpochInfo: b.epochInfo,
+	}
+	if b.vmBlock != nil {
+		cBlock.InnerBlock = b.vmBlock.Bytes()
 	}
 
 	return cBlock.MarshalCanoto(), nil
@@ -88,30 +97,29 @@ func (b *Block) Verify(ctx context.Context) (simplex.VerifiedBlock, error) {
 		return nil, errGenesisVerification
 	}
 
-	if err := b.verifyParentMatchesPrevBlock(); err != nil {
-		return nil, err
+	prevBlock, ok := b.blockTracker.getBlockByDigest(b.metadata.Prev)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errDigestNotFound, b.metadata.Prev)
 	}
 
-	if err := b.blockTracker.verifyAndTrackBlock(ctx, b); err != nil {
-		return nil, fmt.Errorf("failed to verify block: %w", err)
+	if err := b.blockTracker.msm.verifyEpochInfo(ctx, prevBlock, &b.epochInfo); err != nil {
+		return nil, fmt.Errorf("failed to verify epoch info: %w", err)
 	}
 
-	return b, nil
-}
-
-// verifyParentMatchesPrevBlock verifies that the previous block referenced in the current block's metadata
-// matches the parent of the current block's vmBlock.
-func (b *Block) verifyParentMatchesPrevBlock() error {
-	prevBlock, ok := b.blockTracker.getBlockByDigest(b.metadata.Prev)
-	if !ok {
-		return fmt.Errorf("%w: %s", errDigestNotFound, b.metadata.Prev)
+	if b.epochInfo.isTelock() {
+		b.blockTracker.trackTelock(b)
+		return b, nil
 	}
 
 	if b.vmBlock.Parent() != prevBlock.vmBlock.ID() {
-		return fmt.Errorf("%w: parentID %s, prevID %s", errMismatchedPrevDigest, b.vmBlock.Parent(), prevBlock.vmBlock.ID())
+		return nil, fmt.Errorf("%w: parentID %s, prevID %s", errMismatchedPrevDigest, b.vmBlock.Parent(), prevBlock.vmBlock.ID())
 	}
 
-	return nil
+	if err := b.blockTracker.verifyAndTrackBlock(ctx, b); err != nil {
+		return nil, fmt.Errorf("failed to verify block: %w", err)
+	}
+
+	return b, nil
 }
 
 func computeDigest(bytes []byte) simplex.Digest {
@@ -135,12 +143,19 @@ func (d *blockDeserializer) DeserializeBlock(ctx context.Context, bytes []byte)
 		return nil, fmt.Errorf("failed to parse protocol metadata: %w", err)
 	}
 
+	if canotoBlock.EpochInfo.isTelock() {
+		if len(canotoBlock.InnerBlock) != 0 {
+			return nil, errUnexpectedInnerBlock
+		}
+		return newBlock(*md, canotoBlock.EpochInfo, nil, d.blockTracker)
+	}
+
 	vmblock, err := d.parser.ParseBlock(ctx, canotoBlock.InnerBlock)
 	if err != nil {
 		return nil, err
 	}
 
-	return newBlock(*md, vmblock, d.blockTracker)
+	return newBlock(*md, canotoBlock.EpochInfo, vmblock, d.blockTracker)
 }
 
 // blockTracker is used to ensure that blocks are properly rejected, if competing blocks are accepted.
@@ -152,11 +167,15 @@ type blockTracker struct {
 
 	// handles block acceptance and rejection of inner blocks
 	tree tree.Tree
+
+	// determines the epoch info of blocks
+	msm *metadataStateMachine
 }
 
-func newBlockTracker(latestBlock *Block) *blockTracker {
+func newBlockTracker(latestBlock *Block, msm *metadataStateMachine) *blockTracker {
 	return &blockTracker{
 		tree: tree.New(),
+		msm:  msm,
 		simplexDigestsToBlock: map[simplex.Digest]*Block{
 			latestBlock.digest: latestBlock,
 		},
@@ -193,6 +212,15 @@ func (bt *blockTracker) verifyAndTrackBlock(ctx context.Context, block *Block) e
 	return nil
 }
 
+// trackTelock tracks the telock in the block tracker. Telocks don't wrap a VM
+// block, so there is nothing to verify.
+func (bt *blockTracker) trackTelock(block *Block) {
+	bt.lock.Lock()
+	defer bt.lock.Unlock()
+
+	bt.simplexDigestsToBlock[block.digest] = block
+}
+
 // indexBlock calls accept on the block with the given digest, and reject on competing blocks.
 func (bt *blockTracker) indexBlock(ctx context.Context, digest simplex.Digest) error {
 	bt.lock.Lock()
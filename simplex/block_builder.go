@@ -10,6 +10,7 @@ import (
 	"github.com/ava-labs/simplex"
 	"go.uber.org/zap"
 
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/utils/logging"
@@ -30,6 +31,8 @@ const (
 
 // BuildBlock continuously tries to build a block until the context is cancelled. If there are no blocks to be built, it will wait for an event from the VM.
 // It returns false if the context was cancelled, otherwise it returns the built block and true.
+//
+// If the epoch has been sealed, a telock is built without waiting for the VM.
 func (b *BlockBuilder) BuildBlock(ctx context.Context, metadata simplex.ProtocolMetadata) (simplex.VerifiedBlock, bool) {
 	for curWait := initBackoff; ; curWait = backoff(ctx, curWait) {
 		if ctx.Err() != nil {
@@ -37,17 +40,30 @@ func (b *BlockBuilder) BuildBlock(ctx context.Context, metadata simplex.Protocol
 			return nil, false
 		}
 
-		err := b.waitForPendingBlock(ctx)
-		if err != nil {
-			b.log.Debug("Error waiting for incoming block", zap.Error(err))
+		parent, ok := b.blockTracker.getBlockByDigest(metadata.Prev)
+		if !ok {
+			b.log.Debug("Unknown parent block", zap.Stringer("prev", metadata.Prev))
 			continue
 		}
-		vmBlock, err := b.vm.BuildBlock(ctx)
+		epochInfo, err := b.blockTracker.msm.buildEpochInfo(ctx, parent)
 		if err != nil {
-			b.log.Info("Error building block", zap.Error(err))
+			b.log.Warn("Error building epoch info", zap.Error(err))
 			continue
 		}
-		simplexBlock, err := newBlock(metadata, vmBlock, b.blockTracker)
+
+		var vmBlock snowman.Block
+		if !epochInfo.isTelock() {
+			if err := b.waitForPendingBlock(ctx); err != nil {
+				b.log.Debug("Error waiting for incoming block", zap.Error(err))
+				continue
+			}
+			vmBlock, err = b.vm.BuildBlock(ctx)
+			if err != nil {
+				b.log.Info("Error building block", zap.Error(err))
+				continue
+			}
+		}
+		simplexBlock, err := newBlock(metadata, epochInfo, vmBlock, b.blockTracker)
 		if err != nil {
 			b.log.Error("Error creating simplex block from built block", zap.Error(err))
 			return nil, false
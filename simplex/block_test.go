@@ -88,7 +88,7 @@ func TestBlockSerialization(t *testing.T) {
 			testVM.ParseBlockF = tt.parseFunc
 			deserializer := &blockDeserializer{
 				parser:       testVM,
-				blockTracker: newBlockTracker(genesisBlock),
+				blockTracker: newBlockTracker(genesisBlock, newTestMetadataStateMachine(nil)),
 			}
 
 			// Deserialize the block
@@ -251,3 +251,40 @@ func TestIndexBlockDigestNotFound(t *testing.T) {
 	err := genesis.blockTracker.indexBlock(ctx, simplex.Digest(unknownDigest))
 	require.ErrorIs(t, err, errDigestNotFound)
 }
+
+func TestTelockSerialization(t *testing.T) {
+	require := require.New(t)
+	ctx := t.Context()
+
+	genesis := newTestBlock(t, newBlockConfig{})
+	telock := newTestBlock(t, newBlockConfig{
+		prev: genesis,
+		epochInfo: &simplexEpochInfo{
+			SealingBlockSeq: 1,
+		},
+	})
+	telockBytes, err := telock.Bytes()
+	require.NoError(err)
+
+	deserializer := &blockDeserializer{
+		parser: &blocktest.VM{
+			VM: enginetest.VM{
+				T: t,
+			},
+		},
+		blockTracker: genesis.blockTracker,
+	}
+	deserializedBlock, err := deserializer.DeserializeBlock(ctx, telockBytes)
+	require.NoError(err)
+	require.Equal(telock.BlockHeader(), deserializedBlock.BlockHeader())
+	require.Nil(deserializedBlock.(*Block).vmBlock)
+
+	// Telocks must not contain a VM block
+	telockWithInnerBlock := &canotoSimplexBlock{
+		Metadata:   telock.metadata.Bytes(),
+		InnerBlock: snowmantest.Genesis.Bytes(),
+		EpochInfo:  telock.epochInfo,
+	}
+	_, err = deserializer.DeserializeBlock(ctx, telockWithInnerBlock.MarshalCanoto())
+	require.ErrorIs(err, errUnexpectedInnerBlock)
+}
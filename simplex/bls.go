@@ -10,6 +10,7 @@ import (
 	"github.com/ava-labs/simplex"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 )
@@ -114,14 +115,19 @@ func (v BLSVerifier) Verify(message []byte, signature []byte, signer simplex.Nod
 }
 
 func createVerifier(config *Config) BLSVerifier {
+	return newBLSVerifier(config.Ctx.NetworkID, config.Ctx.ChainID, config.Validators)
+}
+
+// newBLSVerifier returns a verifier of the signatures of [vdrs].
+func newBLSVerifier(networkID uint32, chainID ids.ID, vdrs map[ids.NodeID]*validators.GetValidatorOutput) BLSVerifier {
 	verifier := BLSVerifier{
 		nodeID2PK: make(map[ids.NodeID]*bls.PublicKey),
-		networkID: config.Ctx.NetworkID,
-		chainID:   config.Ctx.ChainID,
+		networkID: networkID,
+		chainID:   chainID,
 	}
 
-	nodeIDs := make([]ids.NodeID, 0, len(config.Validators))
-	for _, node := range config.Validators {
+	nodeIDs := make([]ids.NodeID, 0, len(vdrs))
+	for _, node := range vdrs {
 		verifier.nodeID2PK[node.NodeID] = node.PublicKey
 		nodeIDs = append(nodeIDs, node.NodeID)
 	}
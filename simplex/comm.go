@@ -101,6 +101,19 @@ func (c *Comm) Broadcast(msg *simplex.Message) {
 	c.sender.Send(outboundMsg, common.SendConfig{NodeIDs: c.broadcastNodes}, c.subnetID, subnets.NoOpAllower)
 }
 
+// BroadcastNextEpochApproval sends the approval of the next epoch, whose
+// validator set is derived from the P-chain at [height], to the validators of
+// the current epoch.
+func (c *Comm) BroadcastNextEpochApproval(height uint64, signature []byte) {
+	outboundMsg, err := c.msgBuilder.SimplexMessage(newNextEpochApproval(c.chainID, height, signature))
+	if err != nil {
+		c.logger.Error("Failed creating message", zap.Error(err))
+		return
+	}
+
+	c.sender.Send(outboundMsg, common.SendConfig{NodeIDs: c.broadcastNodes}, c.subnetID, subnets.NoOpAllower)
+}
+
 func (c *Comm) simplexMessageToOutboundMessage(msg *simplex.Message) (*message.OutboundMessage, error) {
 	var simplexMsg *p2p.Simplex
 	switch {
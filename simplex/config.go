@@ -4,12 +4,20 @@
 package simplex
 
 import (
+	"sync"
+
+	"github.com/ava-labs/simplex"
+	"github.com/prometheus/client_golang/prometheus"
+
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/snow/networking/sender"
 	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/logging"
 )
 
@@ -21,16 +29,53 @@ type Config struct {
 	Sender             sender.ExternalSender
 	OutboundMsgBuilder message.OutboundMsgBuilder
 
-	// Validators is a map of node IDs to their validator information.
-	// This tells the node about the current membership set, and should be consistent
-	// across all nodes in the subnet.
+	// Validators is the validator set of the running epoch. It is set by the
+	// Engine, which derives the validator set of the first epoch from the
+	// P-chain at [GenesisPChainHeight].
 	Validators map[ids.NodeID]*validators.GetValidatorOutput
 
+	// GenesisPChainHeight is the P-chain height of the validator set of the
+	// first epoch. It must be set, and every validator must use the same
+	// height. The height is persisted, so it can't change once the chain has
+	// started.
+	GenesisPChainHeight uint64
+
+	// ValidatorState is used to detect changes to the validator set of the
+	// subnet. Later epochs use the validator set at the P-chain height that
+	// was agreed upon when the previous epoch was sealed.
+	ValidatorState validators.State
+
 	VM block.ChainVM
 
 	DB database.KeyValueReaderWriter
 	// SignBLS is the signing function used for this node to sign messages.
 	SignBLS SignFunc
+
+	// The fields below are only used by the Engine.
+
+	// Lock must be held when calling into the VM.
+	Lock sync.Locker
+	// State is set to normal operation once the Engine starts.
+	State *utils.Atomic[snow.EngineState]
+	// BootstrapTracker is notified once the Engine starts, as Simplex doesn't
+	// require bootstrapping.
+	BootstrapTracker common.BootstrapTracker
+	// AllGetsServer responds to block requests from peers.
+	AllGetsServer common.AllGetsServer
+	// WAL persists the Engine's votes so that it can't equivocate after a
+	// restart. It is truncated when a new epoch starts.
+	WAL TruncatableWAL
+	// EvidenceDB stores the evidence of validators that voted for conflicting
+	// blocks in the same round.
+	EvidenceDB database.Database
+	// Registerer is used to register the Engine's metrics.
+	Registerer prometheus.Registerer
+}
+
+// TruncatableWAL is a simplex.WriteAheadLog that can be cleared.
+type TruncatableWAL interface {
+	simplex.WriteAheadLog
+	Truncate() error
 }
 
 // Context is information about the current execution.
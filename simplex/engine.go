@@ -0,0 +1,542 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simplex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"github.com/ava-labs/simplex"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/proto/pb/p2p"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/tree"
+	"github.com/ava-labs/avalanchego/version"
+)
+
+const (
+	// maxProposalWait is how long to wait for the leader's proposal before
+	// voting to skip the round.
+	maxProposalWait = 5 * time.Second
+	// maxRebroadcastWait is how long to wait before rebroadcasting votes for a
+	// round that hasn't progressed.
+	maxRebroadcastWait = 5 * time.Second
+	// tickInterval is how frequently the epoch is notified that time passed.
+	tickInterval = 100 * time.Millisecond
+	// maxPendingMessages is the number of messages that may be waiting to be
+	// handled by the epoch before additional messages are dropped.
+	maxPendingMessages = 1024
+)
+
+var (
+	_ common.BootstrapableEngine = (*Engine)(nil)
+
+	// walEpochKey is the key of the number of the epoch that the WAL contains
+	// the records of.
+	walEpochKey = []byte("walEpochNumber")
+	// genesisPChainHeightKey is the key of the P-chain height of the validator
+	// set of the first epoch.
+	genesisPChainHeightKey = []byte("genesisPChainHeight")
+
+	errNotStarted                    = errors.New("simplex engine not started")
+	errMissingGenesisPChainHeight    = errors.New("missing genesis P-chain height")
+	errMismatchedGenesisPChainHeight = errors.New("mismatched genesis P-chain height")
+)
+
+type inboundMessage struct {
+	nodeID ids.NodeID
+	msg    *p2p.Simplex
+}
+
+// runningEpoch is a started simplex.Epoch.
+type runningEpoch struct {
+	epoch  *simplex.Epoch
+	parser *messageParser
+	// sealed is signaled once the sealing block of the epoch is indexed, and
+	// the next epoch should be started.
+	sealed <-chan struct{}
+
+	msm    *metadataStateMachine
+	comm   *Comm
+	signer BLSSigner
+	// approvals are this node's approvals of the next epochs observed in the
+	// epoch, indexed by their P-chain reference height. A nil approval means
+	// that this node isn't a validator of the next epoch.
+	approvals map[uint64][]byte
+	// lastApprovalBroadcast is when the approvals were last broadcast.
+	lastApprovalBroadcast time.Time
+}
+
+// Engine runs the Simplex consensus protocol for a chain.
+//
+// Simplex doesn't require bootstrapping, as the epoch replicates missing
+// blocks from its peers. The Engine is therefore used as both the bootstrapper
+// and the consensus engine of the chain.
+//
+// The epoch calls into the VM from its own goroutines, so messages are handed
+// off to a goroutine that doesn't hold the chain's lock.
+//
+// Once the sealing block of an epoch is finalized, the epoch is stopped and the
+// next epoch is started with the validator set described by the sealing block.
+type Engine struct {
+	common.AllGetsServer
+	common.StateSummaryFrontierHandler
+	common.AcceptedStateSummaryHandler
+	common.AcceptedFrontierHandler
+	common.AcceptedHandler
+	common.AncestorsHandler
+	common.PutHandler
+	common.QueryHandler
+	common.ChitsHandler
+
+	config *Config
+
+	equivocations *equivocationDetector
+
+	messages chan inboundMessage
+	started  atomic.Bool
+	shutdown chan struct{}
+	done     chan struct{}
+
+	// genesis is the first epoch. It is loaded once the Engine starts.
+	genesis epochState
+
+	// err is the reason the epoch isn't running.
+	err utils.Atomic[error]
+}
+
+func NewEngine(config *Config) (*Engine, error) {
+	equivocations, err := newEquivocationDetector(config.Log, config.EvidenceDB, config.Registerer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create equivocation detector: %w", err)
+	}
+
+	e := &Engine{
+		AllGetsServer:               config.AllGetsServer,
+		StateSummaryFrontierHandler: common.NewNoOpStateSummaryFrontierHandler(config.Log),
+		AcceptedStateSummaryHandler: common.NewNoOpAcceptedStateSummaryHandler(config.Log),
+		AcceptedFrontierHandler:     common.NewNoOpAcceptedFrontierHandler(config.Log),
+		AcceptedHandler:             common.NewNoOpAcceptedHandler(config.Log),
+		AncestorsHandler:            common.NewNoOpAncestorsHandler(config.Log),
+		PutHandler:                  common.NewNoOpPutHandler(config.Log),
+		QueryHandler:                common.NewNoOpQueryHandler(config.Log),
+		ChitsHandler:                common.NewNoOpChitsHandler(config.Log),
+		config:                      config,
+		equivocations:               equivocations,
+		messages:                    make(chan inboundMessage, maxPendingMessages),
+		shutdown:                    make(chan struct{}),
+		done:                        make(chan struct{}),
+	}
+	e.err.Set(errNotStarted)
+	return e, nil
+}
+
+func (e *Engine) Start(context.Context, uint32) error {
+	e.config.State.Set(snow.EngineState{
+		Type:  p2p.EngineType_ENGINE_TYPE_CHAIN,
+		State: snow.NormalOp,
+	})
+	e.config.BootstrapTracker.Bootstrapped(e.config.Ctx.ChainID)
+
+	e.started.Store(true)
+	go e.config.Log.RecoverAndPanic(e.run)
+	return nil
+}
+
+// Clear is a no-op, as the Engine doesn't persist bootstrapping state.
+func (*Engine) Clear(context.Context) error {
+	return nil
+}
+
+// SimplexMessage queues [msg] to be handled by the epoch. If too many messages
+// are queued, [msg] is dropped.
+func (e *Engine) SimplexMessage(_ context.Context, nodeID ids.NodeID, msg *p2p.Simplex) error {
+	select {
+	case e.messages <- inboundMessage{nodeID: nodeID, msg: msg}:
+	default:
+		e.config.Log.Debug("dropping simplex message",
+			zap.String("reason", "too many pending messages"),
+			zap.Stringer("nodeID", nodeID),
+		)
+	}
+	return nil
+}
+
+func (e *Engine) run() {
+	defer close(e.done)
+
+	ctx := context.Background()
+	genesis, err := e.loadGenesisEpoch(ctx)
+	if err != nil {
+		e.config.Log.Error("failed to load genesis simplex epoch",
+			zap.Error(err),
+		)
+		e.err.Set(err)
+		return
+	}
+	e.genesis = genesis
+
+	current, err := e.newEpoch(ctx)
+	if err != nil {
+		e.config.Log.Error("failed to start simplex epoch",
+			zap.Error(err),
+		)
+		e.err.Set(err)
+		return
+	}
+	defer func() {
+		current.epoch.Stop()
+	}()
+	e.err.Set(nil)
+
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-e.shutdown:
+			return
+		case <-current.sealed:
+			current.epoch.Stop()
+			current, err = e.newEpoch(ctx)
+			if err != nil {
+				e.config.Log.Error("failed to start next simplex epoch",
+					zap.Error(err),
+				)
+				e.err.Set(err)
+				return
+			}
+		case now := <-ticker.C:
+			current.epoch.AdvanceTime(now)
+			e.approveNextEpochs(ctx, current, now)
+		case msg := <-e.messages:
+			if approval := msg.msg.GetNextEpochApproval(); approval != nil {
+				if err := current.msm.addApproval(ctx, msg.nodeID, approval.NextPChainReferenceHeight, approval.Signature); err != nil {
+					e.config.Log.Debug("dropping next epoch approval",
+						zap.Stringer("nodeID", msg.nodeID),
+						zap.Uint64("height", approval.NextPChainReferenceHeight),
+						zap.Error(err),
+					)
+				}
+				continue
+			}
+
+			simplexMsg, err := current.parser.parse(ctx, msg.msg)
+			if err != nil {
+				e.config.Log.Debug("failed to parse simplex message",
+					zap.Stringer("nodeID", msg.nodeID),
+					zap.Error(err),
+				)
+				continue
+			}
+			if err := e.equivocations.observe(simplexMsg); err != nil {
+				e.config.Log.Error("failed to record simplex equivocation",
+					zap.Stringer("nodeID", msg.nodeID),
+					zap.Error(err),
+				)
+			}
+			if err := current.epoch.HandleMessage(simplexMsg, msg.nodeID[:]); err != nil {
+				e.config.Log.Debug("failed to handle simplex message",
+					zap.Stringer("nodeID", msg.nodeID),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
+
+// newEpoch starts the epoch that the next block to be indexed belongs to.
+func (e *Engine) newEpoch(ctx context.Context) (*runningEpoch, error) {
+	config := *e.config
+	config.Validators = e.genesis.validators
+	config.GenesisPChainHeight = e.genesis.pChainReferenceHeight
+	config.VM = &lockedVM{
+		ChainVM: e.config.VM,
+		lock:    e.config.Lock,
+	}
+
+	// The block tracker must contain the last accepted block, which is loaded
+	// from storage.
+	blockTracker := &blockTracker{
+		tree:                  tree.New(),
+		simplexDigestsToBlock: make(map[simplex.Digest]*Block),
+	}
+	storage, err := newStorage(ctx, &config, blockTracker)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create storage: %w", err)
+	}
+	epochState, err := storage.currentEpoch()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load current epoch: %w", err)
+	}
+	if err := e.resetWAL(epochState.number); err != nil {
+		return nil, fmt.Errorf("failed to reset WAL: %w", err)
+	}
+	e.config.Log.Info("starting simplex epoch",
+		zap.Uint64("epoch", epochState.number),
+		zap.Uint64("pChainReferenceHeight", epochState.pChainReferenceHeight),
+		zap.Int("numValidators", len(epochState.validators)),
+	)
+
+	config.Validators = epochState.validators
+	msm := &metadataStateMachine{
+		log:            config.Log,
+		networkID:      config.Ctx.NetworkID,
+		chainID:        config.Ctx.ChainID,
+		subnetID:       config.Ctx.SubnetID,
+		validatorState: config.ValidatorState,
+		epoch:          epochState,
+	}
+	blockTracker.msm = msm
+
+	signer, verifier := NewBLSAuth(&config)
+	e.equivocations.verifier = verifier
+	qcDeserializer := &QCDeserializer{verifier: &verifier}
+
+	lastBlock, _, err := storage.Retrieve(storage.NumBlocks() - 1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve last accepted block: %w", err)
+	}
+	blockTracker.simplexDigestsToBlock[lastBlock.BlockHeader().Digest] = lastBlock.(*Block)
+
+	comm, err := NewComm(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	blockDeserializer := &blockDeserializer{
+		parser:       config.VM,
+		blockTracker: blockTracker,
+	}
+	epoch, err := simplex.NewEpoch(simplex.EpochConfig{
+		MaxProposalWait:     maxProposalWait,
+		MaxRebroadcastWait:  maxRebroadcastWait,
+		QCDeserializer:      qcDeserializer,
+		Logger:              config.Log,
+		ID:                  config.Ctx.NodeID[:],
+		Signer:              &signer,
+		Verifier:            verifier,
+		BlockDeserializer:   blockDeserializer,
+		SignatureAggregator: &SignatureAggregator{verifier: &verifier},
+		Comm:                comm,
+		Storage:             storage,
+		WAL:                 config.WAL,
+		BlockBuilder: &BlockBuilder{
+			log:          config.Log,
+			vm:           config.VM,
+			blockTracker: blockTracker,
+		},
+		StartTime:          time.Now(),
+		ReplicationEnabled: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create epoch: %w", err)
+	}
+	if err := epoch.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start epoch: %w", err)
+	}
+
+	return &runningEpoch{
+		epoch: epoch,
+		parser: &messageParser{
+			blockDeserializer: blockDeserializer,
+			qcDeserializer:    qcDeserializer,
+		},
+		sealed:    storage.sealed,
+		msm:       msm,
+		comm:      comm,
+		signer:    signer,
+		approvals: make(map[uint64][]byte),
+	}, nil
+}
+
+// approveNextEpochs approves the next epochs observed in [current] that this
+// node is a validator of, and broadcasts the approvals to the validators of
+// [current].
+//
+// The approvals are rebroadcast until the epoch is sealed, as the validators
+// of [current] drop the approvals of next epochs that they haven't observed
+// yet.
+func (e *Engine) approveNextEpochs(ctx context.Context, current *runningEpoch, now time.Time) {
+	approved := false
+	for _, height := range current.msm.approvals.heights() {
+		if _, ok := current.approvals[height]; ok {
+			continue
+		}
+
+		current.approvals[height] = nil
+		vdrs, err := e.config.ValidatorState.GetValidatorSet(ctx, height, e.config.Ctx.SubnetID)
+		if err != nil {
+			e.config.Log.Warn("failed to get validator set of the next epoch",
+				zap.Uint64("height", height),
+				zap.Error(err),
+			)
+			delete(current.approvals, height)
+			continue
+		}
+		if vdr, ok := vdrs[e.config.Ctx.NodeID]; !ok || vdr.PublicKey == nil {
+			continue
+		}
+
+		signature, err := current.signer.Sign(approvalMessage(height))
+		if err != nil {
+			e.config.Log.Error("failed to sign next epoch approval",
+				zap.Uint64("height", height),
+				zap.Error(err),
+			)
+			delete(current.approvals, height)
+			continue
+		}
+		if err := current.msm.addApproval(ctx, e.config.Ctx.NodeID, height, signature); err != nil {
+			e.config.Log.Error("failed to add our next epoch approval",
+				zap.Uint64("height", height),
+				zap.Error(err),
+			)
+			continue
+		}
+		current.approvals[height] = signature
+		approved = true
+	}
+
+	if !approved && now.Sub(current.lastApprovalBroadcast) < maxRebroadcastWait {
+		return
+	}
+	current.lastApprovalBroadcast = now
+	for height, signature := range current.approvals {
+		if signature == nil {
+			continue
+		}
+		current.comm.BroadcastNextEpochApproval(height, signature)
+	}
+}
+
+// loadGenesisEpoch returns the first epoch, whose validator set is the
+// subnet's validator set at the configured genesis P-chain height.
+//
+// Every validator must derive the same validator set, so the height must be
+// configured rather than sampled from the local view of the P-chain. The height
+// is persisted when the chain is first started so that it can't be changed
+// afterwards.
+func (e *Engine) loadGenesisEpoch(ctx context.Context) (epochState, error) {
+	if e.config.GenesisPChainHeight == 0 {
+		return epochState{}, errMissingGenesisPChainHeight
+	}
+
+	height, err := database.GetUInt64(e.config.DB, genesisPChainHeightKey)
+	switch {
+	case err == database.ErrNotFound:
+		height = e.config.GenesisPChainHeight
+		if err := database.PutUInt64(e.config.DB, genesisPChainHeightKey, height); err != nil {
+			return epochState{}, err
+		}
+	case err != nil:
+		return epochState{}, err
+	case e.config.GenesisPChainHeight != height:
+		return epochState{}, fmt.Errorf("%w: configured %d, persisted %d",
+			errMismatchedGenesisPChainHeight,
+			e.config.GenesisPChainHeight,
+			height,
+		)
+	}
+
+	vdrs, err := e.config.ValidatorState.GetValidatorSet(ctx, height, e.config.Ctx.SubnetID)
+	if err != nil {
+		return epochState{}, fmt.Errorf("failed to get validator set at height %d: %w", height, err)
+	}
+	return epochState{
+		pChainReferenceHeight: height,
+		validators:            vdrs,
+	}, nil
+}
+
+// resetWAL truncates the WAL if it contains the records of a previous epoch.
+// The epoch number is only persisted after the WAL is truncated, so the
+// records of the previous epoch are dropped even if the node crashes.
+func (e *Engine) resetWAL(epoch uint64) error {
+	walEpoch, err := database.WithDefault(database.GetUInt64, e.config.DB, walEpochKey, 0)
+	if err != nil {
+		return err
+	}
+	if walEpoch == epoch {
+		return nil
+	}
+
+	if err := e.config.WAL.Truncate(); err != nil {
+		return err
+	}
+	return database.PutUInt64(e.config.DB, walEpochKey, epoch)
+}
+
+func (e *Engine) AppRequest(ctx context.Context, nodeID ids.NodeID, requestID uint32, deadline time.Time, request []byte) error {
+	return e.config.VM.AppRequest(ctx, nodeID, requestID, deadline, request)
+}
+
+func (e *Engine) AppResponse(ctx context.Context, nodeID ids.NodeID, requestID uint32, response []byte) error {
+	return e.config.VM.AppResponse(ctx, nodeID, requestID, response)
+}
+
+func (e *Engine) AppRequestFailed(ctx context.Context, nodeID ids.NodeID, requestID uint32, appErr *common.AppError) error {
+	return e.config.VM.AppRequestFailed(ctx, nodeID, requestID, appErr)
+}
+
+func (e *Engine) AppGossip(ctx context.Context, nodeID ids.NodeID, msg []byte) error {
+	return e.config.VM.AppGossip(ctx, nodeID, msg)
+}
+
+func (e *Engine) Connected(ctx context.Context, nodeID ids.NodeID, nodeVersion *version.Application) error {
+	return e.config.VM.Connected(ctx, nodeID, nodeVersion)
+}
+
+func (e *Engine) Disconnected(ctx context.Context, nodeID ids.NodeID) error {
+	return e.config.VM.Disconnected(ctx, nodeID)
+}
+
+// Gossip is a no-op, as Simplex broadcasts its messages.
+func (*Engine) Gossip(context.Context) error {
+	return nil
+}
+
+// Notify is a no-op, as the epoch waits for VM events when building blocks.
+func (*Engine) Notify(context.Context, common.Message) error {
+	return nil
+}
+
+func (e *Engine) Shutdown(ctx context.Context) error {
+	e.config.Log.Info("shutting down simplex engine")
+
+	close(e.shutdown)
+	if e.started.Load() {
+		<-e.done
+	}
+
+	var walErr error
+	if wal, ok := e.config.WAL.(io.Closer); ok {
+		walErr = wal.Close()
+	}
+
+	e.config.Lock.Lock()
+	defer e.config.Lock.Unlock()
+
+	return errors.Join(walErr, e.config.VM.Shutdown(ctx))
+}
+
+// Equivocations returns the stored evidence of validators that voted for
+// conflicting blocks in the same round. If [nodeID] is empty, the evidence of
+// every validator is returned.
+func (e *Engine) Equivocations(nodeID ids.NodeID) ([]*Equivocation, error) {
+	return e.equivocations.equivocations(nodeID)
+}
+
+func (e *Engine) HealthCheck(context.Context) (interface{}, error) {
+	return nil, e.err.Get()
+}
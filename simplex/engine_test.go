@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simplex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/proto/pb/p2p"
+)
+
+func TestEngineDropsMessagesWhenFull(t *testing.T) {
+	require := require.New(t)
+
+	engine, err := NewEngine(newEngineConfig(t, 1))
+	require.NoError(err)
+
+	nodeID := ids.GenerateTestNodeID()
+	for range maxPendingMessages + 1 {
+		require.NoError(engine.SimplexMessage(t.Context(), nodeID, &p2p.Simplex{}))
+	}
+	require.Len(engine.messages, maxPendingMessages)
+}
+
+func TestEngineHealthCheckBeforeStart(t *testing.T) {
+	engine, err := NewEngine(newEngineConfig(t, 1))
+	require.NoError(t, err)
+
+	_, err = engine.HealthCheck(t.Context())
+	require.ErrorIs(t, err, errNotStarted)
+}
+
+func TestEngineLoadGenesisEpoch(t *testing.T) {
+	nodes := generateTestNodes(t, 4)
+	validatorState := newTestValidatorState(10, map[uint64][]*testNode{
+		5:  nodes[:3],
+		10: nodes,
+	})
+
+	tests := []struct {
+		name                string
+		persistedHeight     uint64
+		genesisPChainHeight uint64
+		expectedHeight      uint64
+		expectedValidators  []*testNode
+		expectedErr         error
+	}{
+		{
+			name:        "missing height",
+			expectedErr: errMissingGenesisPChainHeight,
+		},
+		{
+			name:            "missing height with persisted height",
+			persistedHeight: 5,
+			expectedErr:     errMissingGenesisPChainHeight,
+		},
+		{
+			name:                "configured height",
+			genesisPChainHeight: 5,
+			expectedHeight:      5,
+			expectedValidators:  nodes[:3],
+		},
+		{
+			name:                "configured height matches persisted height",
+			persistedHeight:     5,
+			genesisPChainHeight: 5,
+			expectedHeight:      5,
+			expectedValidators:  nodes[:3],
+		},
+		{
+			name:                "configured height differs from persisted height",
+			persistedHeight:     5,
+			genesisPChainHeight: 10,
+			expectedErr:         errMismatchedGenesisPChainHeight,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			config := newEngineConfig(t, 1)
+			config.ValidatorState = validatorState
+			config.GenesisPChainHeight = test.genesisPChainHeight
+			if test.persistedHeight != 0 {
+				require.NoError(database.PutUInt64(config.DB, genesisPChainHeightKey, test.persistedHeight))
+			}
+
+			engine, err := NewEngine(config)
+			require.NoError(err)
+
+			genesis, err := engine.loadGenesisEpoch(t.Context())
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Equal(test.expectedHeight, genesis.pChainReferenceHeight)
+			require.Equal(newTestValidatorInfo(test.expectedValidators), genesis.validators)
+
+			height, err := database.GetUInt64(config.DB, genesisPChainHeightKey)
+			require.NoError(err)
+			require.Equal(test.expectedHeight, height)
+		})
+	}
+}
@@ -0,0 +1,1249 @@
+// Code generated by canoto. DO NOT EDIT.
+// versions:
+// 	canoto v0.17.3
+// source: epoch_info.go
+
+package simplex
+
+import (
+	"io"
+	"reflect"
+	"sync/atomic"
+
+	"github.com/StephenButtolph/canoto"
+)
+
+// Ensure that the generated code is compatible with the library version.
+const (
+	_ uint = canoto.VersionCompatibility - 0
+	_ uint = 0 - canoto.VersionCompatibility
+)
+
+// Ensure that unused imports do not error
+var (
+	_ atomic.Uint64
+
+	_ = io.ErrUnexpectedEOF
+)
+
+const (
+	canoto__simplexEpochInfo__PChainReferenceHeight     = 1
+	canoto__simplexEpochInfo__EpochNumber               = 2
+	canoto__simplexEpochInfo__PrevSealingBlockHash      = 3
+	canoto__simplexEpochInfo__NextPChainReferenceHeight = 4
+	canoto__simplexEpochInfo__PrevVMBlockSeq            = 5
+	canoto__simplexEpochInfo__BlockValidationDescriptor = 6
+	canoto__simplexEpochInfo__NextEpochApprovals        = 7
+	canoto__simplexEpochInfo__SealingBlockSeq           = 8
+
+	canoto__simplexEpochInfo__PChainReferenceHeight__tag     = "\x08" // canoto.Tag(canoto__simplexEpochInfo__PChainReferenceHeight, canoto.Varint)
+	canoto__simplexEpochInfo__EpochNumber__tag               = "\x10" // canoto.Tag(canoto__simplexEpochInfo__EpochNumber, canoto.Varint)
+	canoto__simplexEpochInfo__PrevSealingBlockHash__tag      = "\x1a" // canoto.Tag(canoto__simplexEpochInfo__PrevSealingBlockHash, canoto.Len)
+	canoto__simplexEpochInfo__NextPChainReferenceHeight__tag = "\x20" // canoto.Tag(canoto__simplexEpochInfo__NextPChainReferenceHeight, canoto.Varint)
+	canoto__simplexEpochInfo__PrevVMBlockSeq__tag            = "\x28" // canoto.Tag(canoto__simplexEpochInfo__PrevVMBlockSeq, canoto.Varint)
+	canoto__simplexEpochInfo__BlockValidationDescriptor__tag = "\x32" // canoto.Tag(canoto__simplexEpochInfo__BlockValidationDescriptor, canoto.Len)
+	canoto__simplexEpochInfo__NextEpochApprovals__tag        = "\x3a" // canoto.Tag(canoto__simplexEpochInfo__NextEpochApprovals, canoto.Len)
+	canoto__simplexEpochInfo__SealingBlockSeq__tag           = "\x40" // canoto.Tag(canoto__simplexEpochInfo__SealingBlockSeq, canoto.Varint)
+)
+
+type canotoData_simplexEpochInfo struct {
+	size uint64
+}
+
+// CanotoSpec returns the specification of this canoto message.
+func (*simplexEpochInfo) CanotoSpec(types ...reflect.Type) *canoto.Spec {
+	types = append(types, reflect.TypeOf(simplexEpochInfo{}))
+	var zero simplexEpochInfo
+	s := &canoto.Spec{
+		Name: "simplexEpochInfo",
+		Fields: []canoto.FieldType{
+			{
+				FieldNumber: canoto__simplexEpochInfo__PChainReferenceHeight,
+				Name:        "PChainReferenceHeight",
+				OneOf:       "",
+				TypeUint:    canoto.SizeOf(zero.PChainReferenceHeight),
+			},
+			{
+				FieldNumber: canoto__simplexEpochInfo__EpochNumber,
+				Name:        "EpochNumber",
+				OneOf:       "",
+				TypeUint:    canoto.SizeOf(zero.EpochNumber),
+			},
+			{
+				FieldNumber:    canoto__simplexEpochInfo__PrevSealingBlockHash,
+				Name:           "PrevSealingBlockHash",
+				OneOf:          "",
+				TypeFixedBytes: uint64(len(zero.PrevSealingBlockHash)),
+			},
+			{
+				FieldNumber: canoto__simplexEpochInfo__NextPChainReferenceHeight,
+				Name:        "NextPChainReferenceHeight",
+				OneOf:       "",
+				TypeUint:    canoto.SizeOf(zero.NextPChainReferenceHeight),
+			},
+			{
+				FieldNumber: canoto__simplexEpochInfo__PrevVMBlockSeq,
+				Name:        "PrevVMBlockSeq",
+				OneOf:       "",
+				TypeUint:    canoto.SizeOf(zero.PrevVMBlockSeq),
+			},
+			canoto.FieldTypeFromField(
+				/*type inference:*/ (zero.BlockValidationDescriptor),
+				/*FieldNumber:   */ canoto__simplexEpochInfo__BlockValidationDescriptor,
+				/*Name:          */ "BlockValidationDescriptor",
+				/*FixedLength:   */ 0,
+				/*Repeated:      */ false,
+				/*OneOf:         */ "",
+				/*types:         */ types,
+			),
+			canoto.FieldTypeFromField(
+				/*type inference:*/ (zero.NextEpochApprovals),
+				/*FieldNumber:   */ canoto__simplexEpochInfo__NextEpochApprovals,
+				/*Name:          */ "NextEpochApprovals",
+				/*FixedLength:   */ 0,
+				/*Repeated:      */ false,
+				/*OneOf:         */ "",
+				/*types:         */ types,
+			),
+			{
+				FieldNumber: canoto__simplexEpochInfo__SealingBlockSeq,
+				Name:        "SealingBlockSeq",
+				OneOf:       "",
+				TypeUint:    canoto.SizeOf(zero.SealingBlockSeq),
+			},
+		},
+	}
+	s.CalculateCanotoCache()
+	return s
+}
+
+// MakeCanoto creates a new empty value.
+func (*simplexEpochInfo) MakeCanoto() *simplexEpochInfo {
+	return new(simplexEpochInfo)
+}
+
+// UnmarshalCanoto unmarshals a Canoto-encoded byte slice into the struct.
+//
+// During parsing, the canoto cache is saved.
+func (c *simplexEpochInfo) UnmarshalCanoto(bytes []byte) error {
+	r := canoto.Reader{
+		B: bytes,
+	}
+	return c.UnmarshalCanotoFrom(r)
+}
+
+// UnmarshalCanotoFrom populates the struct from a [canoto.Reader]. Most users
+// should just use UnmarshalCanoto.
+//
+// During parsing, the canoto cache is saved.
+//
+// This function enables configuration of reader options.
+func (c *simplexEpochInfo) UnmarshalCanotoFrom(r canoto.Reader) error {
+	// Zero the struct before unmarshaling.
+	*c = simplexEpochInfo{}
+	atomic.StoreUint64(&c.canotoData.size, uint64(len(r.B)))
+
+	var minField uint32
+	for canoto.HasNext(&r) {
+		field, wireType, err := canoto.ReadTag(&r)
+		if err != nil {
+			return err
+		}
+		if field < minField {
+			return canoto.ErrInvalidFieldOrder
+		}
+
+		switch field {
+		case canoto__simplexEpochInfo__PChainReferenceHeight:
+			if wireType != canoto.Varint {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			if err := canoto.ReadUint(&r, &c.PChainReferenceHeight); err != nil {
+				return err
+			}
+			if canoto.IsZero(c.PChainReferenceHeight) {
+				return canoto.ErrZeroValue
+			}
+		case canoto__simplexEpochInfo__EpochNumber:
+			if wireType != canoto.Varint {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			if err := canoto.ReadUint(&r, &c.EpochNumber); err != nil {
+				return err
+			}
+			if canoto.IsZero(c.EpochNumber) {
+				return canoto.ErrZeroValue
+			}
+		case canoto__simplexEpochInfo__PrevSealingBlockHash:
+			if wireType != canoto.Len {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			const (
+				expectedLength       = len(c.PrevSealingBlockHash)
+				expectedLengthUint64 = uint64(expectedLength)
+			)
+			var length uint64
+			if err := canoto.ReadUint(&r, &length); err != nil {
+				return err
+			}
+			if length != expectedLengthUint64 {
+				return canoto.ErrInvalidLength
+			}
+			if expectedLength > len(r.B) {
+				return io.ErrUnexpectedEOF
+			}
+
+			copy((&c.PrevSealingBlockHash)[:], r.B)
+			if canoto.IsZero(c.PrevSealingBlockHash) {
+				return canoto.ErrZeroValue
+			}
+			r.B = r.B[expectedLength:]
+		case canoto__simplexEpochInfo__NextPChainReferenceHeight:
+			if wireType != canoto.Varint {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			if err := canoto.ReadUint(&r, &c.NextPChainReferenceHeight); err != nil {
+				return err
+			}
+			if canoto.IsZero(c.NextPChainReferenceHeight) {
+				return canoto.ErrZeroValue
+			}
+		case canoto__simplexEpochInfo__PrevVMBlockSeq:
+			if wireType != canoto.Varint {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			if err := canoto.ReadUint(&r, &c.PrevVMBlockSeq); err != nil {
+				return err
+			}
+			if canoto.IsZero(c.PrevVMBlockSeq) {
+				return canoto.ErrZeroValue
+			}
+		case canoto__simplexEpochInfo__BlockValidationDescriptor:
+			if wireType != canoto.Len {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			// Read the bytes for the field.
+			originalUnsafe := r.Unsafe
+			r.Unsafe = true
+			var msgBytes []byte
+			if err := canoto.ReadBytes(&r, &msgBytes); err != nil {
+				return err
+			}
+			if len(msgBytes) == 0 {
+				return canoto.ErrZeroValue
+			}
+			r.Unsafe = originalUnsafe
+
+			// Unmarshal the field from the bytes.
+			remainingBytes := r.B
+			r.B = msgBytes
+			c.BlockValidationDescriptor = canoto.MakePointer(c.BlockValidationDescriptor)
+			if err := (c.BlockValidationDescriptor).UnmarshalCanotoFrom(r); err != nil {
+				return err
+			}
+			r.B = remainingBytes
+		case canoto__simplexEpochInfo__NextEpochApprovals:
+			if wireType != canoto.Len {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			// Read the bytes for the field.
+			originalUnsafe := r.Unsafe
+			r.Unsafe = true
+			var msgBytes []byte
+			if err := canoto.ReadBytes(&r, &msgBytes); err != nil {
+				return err
+			}
+			if len(msgBytes) == 0 {
+				return canoto.ErrZeroValue
+			}
+			r.Unsafe = originalUnsafe
+
+			// Unmarshal the field from the bytes.
+			remainingBytes := r.B
+			r.B = msgBytes
+			c.NextEpochApprovals = canoto.MakePointer(c.NextEpochApprovals)
+			if err := (c.NextEpochApprovals).UnmarshalCanotoFrom(r); err != nil {
+				return err
+			}
+			r.B = remainingBytes
+		case canoto__simplexEpochInfo__SealingBlockSeq:
+			if wireType != canoto.Varint {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			if err := canoto.ReadUint(&r, &c.SealingBlockSeq); err != nil {
+				return err
+			}
+			if canoto.IsZero(c.SealingBlockSeq) {
+				return canoto.ErrZeroValue
+			}
+		default:
+			return canoto.ErrUnknownField
+		}
+
+		minField = field + 1
+	}
+	return nil
+}
+
+// ValidCanoto validates that the struct can be correctly marshaled into the
+// Canoto format.
+//
+// Specifically, ValidCanoto ensures:
+// 1. All OneOfs are specified at most once.
+// 2. All strings are valid utf-8.
+// 3. All custom fields are ValidCanoto.
+func (c *simplexEpochInfo) ValidCanoto() bool {
+	if c == nil {
+		return true
+	}
+	if c.BlockValidationDescriptor != nil && !(c.BlockValidationDescriptor).ValidCanoto() {
+		return false
+	}
+	if c.NextEpochApprovals != nil && !(c.NextEpochApprovals).ValidCanoto() {
+		return false
+	}
+	return true
+}
+
+// CalculateCanotoCache populates size and OneOf caches based on the current
+// values in the struct.
+//
+// It is not safe to copy this struct concurrently.
+func (c *simplexEpochInfo) CalculateCanotoCache() {
+	if c == nil {
+		return
+	}
+	var size uint64
+	if !canoto.IsZero(c.PChainReferenceHeight) {
+		size += uint64(len(canoto__simplexEpochInfo__PChainReferenceHeight__tag)) + canoto.SizeUint(c.PChainReferenceHeight)
+	}
+	if !canoto.IsZero(c.EpochNumber) {
+		size += uint64(len(canoto__simplexEpochInfo__EpochNumber__tag)) + canoto.SizeUint(c.EpochNumber)
+	}
+	if !canoto.IsZero(c.PrevSealingBlockHash) {
+		size += uint64(len(canoto__simplexEpochInfo__PrevSealingBlockHash__tag)) + canoto.SizeBytes((&c.PrevSealingBlockHash)[:])
+	}
+	if !canoto.IsZero(c.NextPChainReferenceHeight) {
+		size += uint64(len(canoto__simplexEpochInfo__NextPChainReferenceHeight__tag)) + canoto.SizeUint(c.NextPChainReferenceHeight)
+	}
+	if !canoto.IsZero(c.PrevVMBlockSeq) {
+		size += uint64(len(canoto__simplexEpochInfo__PrevVMBlockSeq__tag)) + canoto.SizeUint(c.PrevVMBlockSeq)
+	}
+	if c.BlockValidationDescriptor != nil {
+		(c.BlockValidationDescriptor).CalculateCanotoCache()
+		if fieldSize := (c.BlockValidationDescriptor).CachedCanotoSize(); fieldSize != 0 {
+			size += uint64(len(canoto__simplexEpochInfo__BlockValidationDescriptor__tag)) + canoto.SizeUint(fieldSize) + fieldSize
+		}
+	}
+	if c.NextEpochApprovals != nil {
+		(c.NextEpochApprovals).CalculateCanotoCache()
+		if fieldSize := (c.NextEpochApprovals).CachedCanotoSize(); fieldSize != 0 {
+			size += uint64(len(canoto__simplexEpochInfo__NextEpochApprovals__tag)) + canoto.SizeUint(fieldSize) + fieldSize
+		}
+	}
+	if !canoto.IsZero(c.SealingBlockSeq) {
+		size += uint64(len(canoto__simplexEpochInfo__SealingBlockSeq__tag)) + canoto.SizeUint(c.SealingBlockSeq)
+	}
+	atomic.StoreUint64(&c.canotoData.size, size)
+}
+
+// CachedCanotoSize returns the previously calculated size of the Canoto
+// representation from CalculateCanotoCache.
+//
+// If CalculateCanotoCache has not yet been called, it will return 0.
+//
+// If the struct has been modified since the last call to CalculateCanotoCache,
+// the returned size may be incorrect.
+func (c *simplexEpochInfo) CachedCanotoSize() uint64 {
+	if c == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&c.canotoData.size)
+}
+
+// MarshalCanoto returns the Canoto representation of this struct.
+//
+// It is assumed that this struct is ValidCanoto.
+//
+// It is not safe to copy this struct concurrently.
+func (c *simplexEpochInfo) MarshalCanoto() []byte {
+	c.CalculateCanotoCache()
+	w := canoto.Writer{
+		B: make([]byte, 0, c.CachedCanotoSize()),
+	}
+	w = c.MarshalCanotoInto(w)
+	return w.B
+}
+
+// MarshalCanotoInto writes the struct into a [canoto.Writer] and returns the
+// resulting [canoto.Writer]. Most users should just use MarshalCanoto.
+//
+// It is assumed that CalculateCanotoCache has been called since the last
+// modification to this struct.
+//
+// It is assumed that this struct is ValidCanoto.
+//
+// It is not safe to copy this struct concurrently.
+func (c *simplexEpochInfo) MarshalCanotoInto(w canoto.Writer) canoto.Writer {
+	if c == nil {
+		return w
+	}
+	if !canoto.IsZero(c.PChainReferenceHeight) {
+		canoto.Append(&w, canoto__simplexEpochInfo__PChainReferenceHeight__tag)
+		canoto.AppendUint(&w, c.PChainReferenceHeight)
+	}
+	if !canoto.IsZero(c.EpochNumber) {
+		canoto.Append(&w, canoto__simplexEpochInfo__EpochNumber__tag)
+		canoto.AppendUint(&w, c.EpochNumber)
+	}
+	if !canoto.IsZero(c.PrevSealingBlockHash) {
+		canoto.Append(&w, canoto__simplexEpochInfo__PrevSealingBlockHash__tag)
+		canoto.AppendBytes(&w, (&c.PrevSealingBlockHash)[:])
+	}
+	if !canoto.IsZero(c.NextPChainReferenceHeight) {
+		canoto.Append(&w, canoto__simplexEpochInfo__NextPChainReferenceHeight__tag)
+		canoto.AppendUint(&w, c.NextPChainReferenceHeight)
+	}
+	if !canoto.IsZero(c.PrevVMBlockSeq) {
+		canoto.Append(&w, canoto__simplexEpochInfo__PrevVMBlockSeq__tag)
+		canoto.AppendUint(&w, c.PrevVMBlockSeq)
+	}
+	if c.BlockValidationDescriptor != nil {
+		if fieldSize := (c.BlockValidationDescriptor).CachedCanotoSize(); fieldSize != 0 {
+			canoto.Append(&w, canoto__simplexEpochInfo__BlockValidationDescriptor__tag)
+			canoto.AppendUint(&w, fieldSize)
+			w = (c.BlockValidationDescriptor).MarshalCanotoInto(w)
+		}
+	}
+	if c.NextEpochApprovals != nil {
+		if fieldSize := (c.NextEpochApprovals).CachedCanotoSize(); fieldSize != 0 {
+			canoto.Append(&w, canoto__simplexEpochInfo__NextEpochApprovals__tag)
+			canoto.AppendUint(&w, fieldSize)
+			w = (c.NextEpochApprovals).MarshalCanotoInto(w)
+		}
+	}
+	if !canoto.IsZero(c.SealingBlockSeq) {
+		canoto.Append(&w, canoto__simplexEpochInfo__SealingBlockSeq__tag)
+		canoto.AppendUint(&w, c.SealingBlockSeq)
+	}
+	return w
+}
+
+const (
+	canoto__blockValidationDescriptor__AggregatedMembership = 1
+
+	canoto__blockValidationDescriptor__AggregatedMembership__tag = "\x0a" // canoto.Tag(canoto__blockValidationDescriptor__AggregatedMembership, canoto.Len)
+)
+
+type canotoData_blockValidationDescriptor struct {
+	size uint64
+}
+
+// CanotoSpec returns the specification of this canoto message.
+func (*blockValidationDescriptor) CanotoSpec(types ...reflect.Type) *canoto.Spec {
+	types = append(types, reflect.TypeOf(blockValidationDescriptor{}))
+	var zero blockValidationDescriptor
+	s := &canoto.Spec{
+		Name: "blockValidationDescriptor",
+		Fields: []canoto.FieldType{
+			canoto.FieldTypeFromField(
+				/*type inference:*/ (&zero.AggregatedMembership),
+				/*FieldNumber:   */ canoto__blockValidationDescriptor__AggregatedMembership,
+				/*Name:          */ "AggregatedMembership",
+				/*FixedLength:   */ 0,
+				/*Repeated:      */ false,
+				/*OneOf:         */ "",
+				/*types:         */ types,
+			),
+		},
+	}
+	s.CalculateCanotoCache()
+	return s
+}
+
+// MakeCanoto creates a new empty value.
+func (*blockValidationDescriptor) MakeCanoto() *blockValidationDescriptor {
+	return new(blockValidationDescriptor)
+}
+
+// UnmarshalCanoto unmarshals a Canoto-encoded byte slice into the struct.
+//
+// During parsing, the canoto cache is saved.
+func (c *blockValidationDescriptor) UnmarshalCanoto(bytes []byte) error {
+	r := canoto.Reader{
+		B: bytes,
+	}
+	return c.UnmarshalCanotoFrom(r)
+}
+
+// UnmarshalCanotoFrom populates the struct from a [canoto.Reader]. Most users
+// should just use UnmarshalCanoto.
+//
+// During parsing, the canoto cache is saved.
+//
+// This function enables configuration of reader options.
+func (c *blockValidationDescriptor) UnmarshalCanotoFrom(r canoto.Reader) error {
+	// Zero the struct before unmarshaling.
+	*c = blockValidationDescriptor{}
+	atomic.StoreUint64(&c.canotoData.size, uint64(len(r.B)))
+
+	var minField uint32
+	for canoto.HasNext(&r) {
+		field, wireType, err := canoto.ReadTag(&r)
+		if err != nil {
+			return err
+		}
+		if field < minField {
+			return canoto.ErrInvalidFieldOrder
+		}
+
+		switch field {
+		case canoto__blockValidationDescriptor__AggregatedMembership:
+			if wireType != canoto.Len {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			// Read the bytes for the field.
+			originalUnsafe := r.Unsafe
+			r.Unsafe = true
+			var msgBytes []byte
+			if err := canoto.ReadBytes(&r, &msgBytes); err != nil {
+				return err
+			}
+			if len(msgBytes) == 0 {
+				return canoto.ErrZeroValue
+			}
+			r.Unsafe = originalUnsafe
+
+			// Unmarshal the field from the bytes.
+			remainingBytes := r.B
+			r.B = msgBytes
+			if err := (&c.AggregatedMembership).UnmarshalCanotoFrom(r); err != nil {
+				return err
+			}
+			r.B = remainingBytes
+		default:
+			return canoto.ErrUnknownField
+		}
+
+		minField = field + 1
+	}
+	return nil
+}
+
+// ValidCanoto validates that the struct can be correctly marshaled into the
+// Canoto format.
+//
+// Specifically, ValidCanoto ensures:
+// 1. All OneOfs are specified at most once.
+// 2. All strings are valid utf-8.
+// 3. All custom fields are ValidCanoto.
+func (c *blockValidationDescriptor) ValidCanoto() bool {
+	if c == nil {
+		return true
+	}
+	if !(&c.AggregatedMembership).ValidCanoto() {
+		return false
+	}
+	return true
+}
+
+// CalculateCanotoCache populates size and OneOf caches based on the current
+// values in the struct.
+//
+// It is not safe to copy this struct concurrently.
+func (c *blockValidationDescriptor) CalculateCanotoCache() {
+	if c == nil {
+		return
+	}
+	var size uint64
+	(&c.AggregatedMembership).CalculateCanotoCache()
+	if fieldSize := (&c.AggregatedMembership).CachedCanotoSize(); fieldSize != 0 {
+		size += uint64(len(canoto__blockValidationDescriptor__AggregatedMembership__tag)) + canoto.SizeUint(fieldSize) + fieldSize
+	}
+	atomic.StoreUint64(&c.canotoData.size, size)
+}
+
+// CachedCanotoSize returns the previously calculated size of the Canoto
+// representation from CalculateCanotoCache.
+//
+// If CalculateCanotoCache has not yet been called, it will return 0.
+//
+// If the struct has been modified since the last call to CalculateCanotoCache,
+// the returned size may be incorrect.
+func (c *blockValidationDescriptor) CachedCanotoSize() uint64 {
+	if c == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&c.canotoData.size)
+}
+
+// MarshalCanoto returns the Canoto representation of this struct.
+//
+// It is assumed that this struct is ValidCanoto.
+//
+// It is not safe to copy this struct concurrently.
+func (c *blockValidationDescriptor) MarshalCanoto() []byte {
+	c.CalculateCanotoCache()
+	w := canoto.Writer{
+		B: make([]byte, 0, c.CachedCanotoSize()),
+	}
+	w = c.MarshalCanotoInto(w)
+	return w.B
+}
+
+// MarshalCanotoInto writes the struct into a [canoto.Writer] and returns the
+// resulting [canoto.Writer]. Most users should just use MarshalCanoto.
+//
+// It is assumed that CalculateCanotoCache has been called since the last
+// modification to this struct.
+//
+// It is assumed that this struct is ValidCanoto.
+//
+// It is not safe to copy this struct concurrently.
+func (c *blockValidationDescriptor) MarshalCanotoInto(w canoto.Writer) canoto.Writer {
+	if c == nil {
+		return w
+	}
+	if fieldSize := (&c.AggregatedMembership).CachedCanotoSize(); fieldSize != 0 {
+		canoto.Append(&w, canoto__blockValidationDescriptor__AggregatedMembership__tag)
+		canoto.AppendUint(&w, fieldSize)
+		w = (&c.AggregatedMembership).MarshalCanotoInto(w)
+	}
+	return w
+}
+
+const (
+	canoto__aggregatedMembership__Members = 1
+
+	canoto__aggregatedMembership__Members__tag = "\x0a" // canoto.Tag(canoto__aggregatedMembership__Members, canoto.Len)
+)
+
+type canotoData_aggregatedMembership struct {
+	size uint64
+}
+
+// CanotoSpec returns the specification of this canoto message.
+func (*aggregatedMembership) CanotoSpec(types ...reflect.Type) *canoto.Spec {
+	types = append(types, reflect.TypeOf(aggregatedMembership{}))
+	var zero aggregatedMembership
+	s := &canoto.Spec{
+		Name: "aggregatedMembership",
+		Fields: []canoto.FieldType{
+			canoto.FieldTypeFromField(
+				/*type inference:*/ (canoto.MakeEntryNilPointer(zero.Members)),
+				/*FieldNumber:   */ canoto__aggregatedMembership__Members,
+				/*Name:          */ "Members",
+				/*FixedLength:   */ 0,
+				/*Repeated:      */ true,
+				/*OneOf:         */ "",
+				/*types:         */ types,
+			),
+		},
+	}
+	s.CalculateCanotoCache()
+	return s
+}
+
+// MakeCanoto creates a new empty value.
+func (*aggregatedMembership) MakeCanoto() *aggregatedMembership {
+	return new(aggregatedMembership)
+}
+
+// UnmarshalCanoto unmarshals a Canoto-encoded byte slice into the struct.
+//
+// During parsing, the canoto cache is saved.
+func (c *aggregatedMembership) UnmarshalCanoto(bytes []byte) error {
+	r := canoto.Reader{
+		B: bytes,
+	}
+	return c.UnmarshalCanotoFrom(r)
+}
+
+// UnmarshalCanotoFrom populates the struct from a [canoto.Reader]. Most users
+// should just use UnmarshalCanoto.
+//
+// During parsing, the canoto cache is saved.
+//
+// This function enables configuration of reader options.
+func (c *aggregatedMembership) UnmarshalCanotoFrom(r canoto.Reader) error {
+	// Zero the struct before unmarshaling.
+	*c = aggregatedMembership{}
+	atomic.StoreUint64(&c.canotoData.size, uint64(len(r.B)))
+
+	var minField uint32
+	for canoto.HasNext(&r) {
+		field, wireType, err := canoto.ReadTag(&r)
+		if err != nil {
+			return err
+		}
+		if field < minField {
+			return canoto.ErrInvalidFieldOrder
+		}
+
+		switch field {
+		case canoto__aggregatedMembership__Members:
+			if wireType != canoto.Len {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			// Read the first entry manually because the tag is already
+			// stripped.
+			originalUnsafe := r.Unsafe
+			r.Unsafe = true
+			var msgBytes []byte
+			if err := canoto.ReadBytes(&r, &msgBytes); err != nil {
+				return err
+			}
+			r.Unsafe = originalUnsafe
+
+			// Count the number of additional entries after the first entry.
+			countMinus1, err := canoto.CountBytes(r.B, canoto__aggregatedMembership__Members__tag)
+			if err != nil {
+				return err
+			}
+
+			c.Members = canoto.MakeSlice(c.Members, countMinus1+1)
+			field := c.Members
+			additionalField := field[1:]
+			if len(msgBytes) != 0 {
+				remainingBytes := r.B
+				r.B = msgBytes
+				if err := (&field[0]).UnmarshalCanotoFrom(r); err != nil {
+					return err
+				}
+				r.B = remainingBytes
+			}
+
+			// Read the rest of the entries, stripping the tag each time.
+			for i := range additionalField {
+				r.B = r.B[len(canoto__aggregatedMembership__Members__tag):]
+				r.Unsafe = true
+				if err := canoto.ReadBytes(&r, &msgBytes); err != nil {
+					return err
+				}
+				if len(msgBytes) == 0 {
+					continue
+				}
+				r.Unsafe = originalUnsafe
+
+				remainingBytes := r.B
+				r.B = msgBytes
+				if err := (&additionalField[i]).UnmarshalCanotoFrom(r); err != nil {
+					return err
+				}
+				r.B = remainingBytes
+			}
+		default:
+			return canoto.ErrUnknownField
+		}
+
+		minField = field + 1
+	}
+	return nil
+}
+
+// ValidCanoto validates that the struct can be correctly marshaled into the
+// Canoto format.
+//
+// Specifically, ValidCanoto ensures:
+// 1. All OneOfs are specified at most once.
+// 2. All strings are valid utf-8.
+// 3. All custom fields are ValidCanoto.
+func (c *aggregatedMembership) ValidCanoto() bool {
+	if c == nil {
+		return true
+	}
+	{
+		field := c.Members
+		for i := range field {
+			if !(&field[i]).ValidCanoto() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// CalculateCanotoCache populates size and OneOf caches based on the current
+// values in the struct.
+//
+// It is not safe to copy this struct concurrently.
+func (c *aggregatedMembership) CalculateCanotoCache() {
+	if c == nil {
+		return
+	}
+	var size uint64
+	{
+		field := c.Members
+		for i := range field {
+			(&field[i]).CalculateCanotoCache()
+			fieldSize := (&field[i]).CachedCanotoSize()
+			size += uint64(len(canoto__aggregatedMembership__Members__tag)) + canoto.SizeUint(fieldSize) + fieldSize
+		}
+	}
+	atomic.StoreUint64(&c.canotoData.size, size)
+}
+
+// CachedCanotoSize returns the previously calculated size of the Canoto
+// representation from CalculateCanotoCache.
+//
+// If CalculateCanotoCache has not yet been called, it will return 0.
+//
+// If the struct has been modified since the last call to CalculateCanotoCache,
+// the returned size may be incorrect.
+func (c *aggregatedMembership) CachedCanotoSize() uint64 {
+	if c == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&c.canotoData.size)
+}
+
+// MarshalCanoto returns the Canoto representation of this struct.
+//
+// It is assumed that this struct is ValidCanoto.
+//
+// It is not safe to copy this struct concurrently.
+func (c *aggregatedMembership) MarshalCanoto() []byte {
+	c.CalculateCanotoCache()
+	w := canoto.Writer{
+		B: make([]byte, 0, c.CachedCanotoSize()),
+	}
+	w = c.MarshalCanotoInto(w)
+	return w.B
+}
+
+// MarshalCanotoInto writes the struct into a [canoto.Writer] and returns the
+// resulting [canoto.Writer]. Most users should just use MarshalCanoto.
+//
+// It is assumed that CalculateCanotoCache has been called since the last
+// modification to this struct.
+//
+// It is assumed that this struct is ValidCanoto.
+//
+// It is not safe to copy this struct concurrently.
+func (c *aggregatedMembership) MarshalCanotoInto(w canoto.Writer) canoto.Writer {
+	if c == nil {
+		return w
+	}
+	{
+		field := c.Members
+		for i := range field {
+			canoto.Append(&w, canoto__aggregatedMembership__Members__tag)
+			canoto.AppendUint(&w, (&field[i]).CachedCanotoSize())
+			w = (&field[i]).MarshalCanotoInto(w)
+		}
+	}
+	return w
+}
+
+const (
+	canoto__nodeBLSMapping__NodeID = 1
+	canoto__nodeBLSMapping__BLSKey = 2
+
+	canoto__nodeBLSMapping__NodeID__tag = "\x0a" // canoto.Tag(canoto__nodeBLSMapping__NodeID, canoto.Len)
+	canoto__nodeBLSMapping__BLSKey__tag = "\x12" // canoto.Tag(canoto__nodeBLSMapping__BLSKey, canoto.Len)
+)
+
+type canotoData_nodeBLSMapping struct {
+	size uint64
+}
+
+// CanotoSpec returns the specification of this canoto message.
+func (*nodeBLSMapping) CanotoSpec(...reflect.Type) *canoto.Spec {
+	var zero nodeBLSMapping
+	s := &canoto.Spec{
+		Name: "nodeBLSMapping",
+		Fields: []canoto.FieldType{
+			{
+				FieldNumber:    canoto__nodeBLSMapping__NodeID,
+				Name:           "NodeID",
+				OneOf:          "",
+				TypeFixedBytes: uint64(len(zero.NodeID)),
+			},
+			{
+				FieldNumber:    canoto__nodeBLSMapping__BLSKey,
+				Name:           "BLSKey",
+				OneOf:          "",
+				TypeFixedBytes: uint64(len(zero.BLSKey)),
+			},
+		},
+	}
+	s.CalculateCanotoCache()
+	return s
+}
+
+// MakeCanoto creates a new empty value.
+func (*nodeBLSMapping) MakeCanoto() *nodeBLSMapping {
+	return new(nodeBLSMapping)
+}
+
+// UnmarshalCanoto unmarshals a Canoto-encoded byte slice into the struct.
+//
+// During parsing, the canoto cache is saved.
+func (c *nodeBLSMapping) UnmarshalCanoto(bytes []byte) error {
+	r := canoto.Reader{
+		B: bytes,
+	}
+	return c.UnmarshalCanotoFrom(r)
+}
+
+// UnmarshalCanotoFrom populates the struct from a [canoto.Reader]. Most users
+// should just use UnmarshalCanoto.
+//
+// During parsing, the canoto cache is saved.
+//
+// This function enables configuration of reader options.
+func (c *nodeBLSMapping) UnmarshalCanotoFrom(r canoto.Reader) error {
+	// Zero the struct before unmarshaling.
+	*c = nodeBLSMapping{}
+	atomic.StoreUint64(&c.canotoData.size, uint64(len(r.B)))
+
+	var minField uint32
+	for canoto.HasNext(&r) {
+		field, wireType, err := canoto.ReadTag(&r)
+		if err != nil {
+			return err
+		}
+		if field < minField {
+			return canoto.ErrInvalidFieldOrder
+		}
+
+		switch field {
+		case canoto__nodeBLSMapping__NodeID:
+			if wireType != canoto.Len {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			const (
+				expectedLength       = len(c.NodeID)
+				expectedLengthUint64 = uint64(expectedLength)
+			)
+			var length uint64
+			if err := canoto.ReadUint(&r, &length); err != nil {
+				return err
+			}
+			if length != expectedLengthUint64 {
+				return canoto.ErrInvalidLength
+			}
+			if expectedLength > len(r.B) {
+				return io.ErrUnexpectedEOF
+			}
+
+			copy((&c.NodeID)[:], r.B)
+			if canoto.IsZero(c.NodeID) {
+				return canoto.ErrZeroValue
+			}
+			r.B = r.B[expectedLength:]
+		case canoto__nodeBLSMapping__BLSKey:
+			if wireType != canoto.Len {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			const (
+				expectedLength       = len(c.BLSKey)
+				expectedLengthUint64 = uint64(expectedLength)
+			)
+			var length uint64
+			if err := canoto.ReadUint(&r, &length); err != nil {
+				return err
+			}
+			if length != expectedLengthUint64 {
+				return canoto.ErrInvalidLength
+			}
+			if expectedLength > len(r.B) {
+				return io.ErrUnexpectedEOF
+			}
+
+			copy((&c.BLSKey)[:], r.B)
+			if canoto.IsZero(c.BLSKey) {
+				return canoto.ErrZeroValue
+			}
+			r.B = r.B[expectedLength:]
+		default:
+			return canoto.ErrUnknownField
+		}
+
+		minField = field + 1
+	}
+	return nil
+}
+
+// ValidCanoto validates that the struct can be correctly marshaled into the
+// Canoto format.
+//
+// Specifically, ValidCanoto ensures:
+// 1. All OneOfs are specified at most once.
+// 2. All strings are valid utf-8.
+// 3. All custom fields are ValidCanoto.
+func (c *nodeBLSMapping) ValidCanoto() bool {
+	if c == nil {
+		return true
+	}
+	return true
+}
+
+// CalculateCanotoCache populates size and OneOf caches based on the current
+// values in the struct.
+//
+// It is not safe to copy this struct concurrently.
+func (c *nodeBLSMapping) CalculateCanotoCache() {
+	if c == nil {
+		return
+	}
+	var size uint64
+	if !canoto.IsZero(c.NodeID) {
+		size += uint64(len(canoto__nodeBLSMapping__NodeID__tag)) + canoto.SizeBytes((&c.NodeID)[:])
+	}
+	if !canoto.IsZero(c.BLSKey) {
+		size += uint64(len(canoto__nodeBLSMapping__BLSKey__tag)) + canoto.SizeBytes((&c.BLSKey)[:])
+	}
+	atomic.StoreUint64(&c.canotoData.size, size)
+}
+
+// CachedCanotoSize returns the previously calculated size of the Canoto
+// representation from CalculateCanotoCache.
+//
+// If CalculateCanotoCache has not yet been called, it will return 0.
+//
+// If the struct has been modified since the last call to CalculateCanotoCache,
+// the returned size may be incorrect.
+func (c *nodeBLSMapping) CachedCanotoSize() uint64 {
+	if c == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&c.canotoData.size)
+}
+
+// MarshalCanoto returns the Canoto representation of this struct.
+//
+// It is assumed that this struct is ValidCanoto.
+//
+// It is not safe to copy this struct concurrently.
+func (c *nodeBLSMapping) MarshalCanoto() []byte {
+	c.CalculateCanotoCache()
+	w := canoto.Writer{
+		B: make([]byte, 0, c.CachedCanotoSize()),
+	}
+	w = c.MarshalCanotoInto(w)
+	return w.B
+}
+
+// MarshalCanotoInto writes the struct into a [canoto.Writer] and returns the
+// resulting [canoto.Writer]. Most users should just use MarshalCanoto.
+//
+// It is assumed that CalculateCanotoCache has been called since the last
+// modification to this struct.
+//
+// It is assumed that this struct is ValidCanoto.
+//
+// It is not safe to copy this struct concurrently.
+func (c *nodeBLSMapping) MarshalCanotoInto(w canoto.Writer) canoto.Writer {
+	if c == nil {
+		return w
+	}
+	if !canoto.IsZero(c.NodeID) {
+		canoto.Append(&w, canoto__nodeBLSMapping__NodeID__tag)
+		canoto.AppendBytes(&w, (&c.NodeID)[:])
+	}
+	if !canoto.IsZero(c.BLSKey) {
+		canoto.Append(&w, canoto__nodeBLSMapping__BLSKey__tag)
+		canoto.AppendBytes(&w, (&c.BLSKey)[:])
+	}
+	return w
+}
+
+const (
+	canoto__nextEpochApprovals__NodeIDs   = 1
+	canoto__nextEpochApprovals__Signature = 2
+
+	canoto__nextEpochApprovals__NodeIDs__tag   = "\x0a" // canoto.Tag(canoto__nextEpochApprovals__NodeIDs, canoto.Len)
+	canoto__nextEpochApprovals__Signature__tag = "\x12" // canoto.Tag(canoto__nextEpochApprovals__Signature, canoto.Len)
+)
+
+type canotoData_nextEpochApprovals struct {
+	size uint64
+}
+
+// CanotoSpec returns the specification of this canoto message.
+func (*nextEpochApprovals) CanotoSpec(...reflect.Type) *canoto.Spec {
+	s := &canoto.Spec{
+		Name: "nextEpochApprovals",
+		Fields: []canoto.FieldType{
+			{
+				FieldNumber: canoto__nextEpochApprovals__NodeIDs,
+				Name:        "NodeIDs",
+				OneOf:       "",
+				TypeBytes:   true,
+			},
+			{
+				FieldNumber: canoto__nextEpochApprovals__Signature,
+				Name:        "Signature",
+				OneOf:       "",
+				TypeBytes:   true,
+			},
+		},
+	}
+	s.CalculateCanotoCache()
+	return s
+}
+
+// MakeCanoto creates a new empty value.
+func (*nextEpochApprovals) MakeCanoto() *nextEpochApprovals {
+	return new(nextEpochApprovals)
+}
+
+// UnmarshalCanoto unmarshals a Canoto-encoded byte slice into the struct.
+//
+// During parsing, the canoto cache is saved.
+func (c *nextEpochApprovals) UnmarshalCanoto(bytes []byte) error {
+	r := canoto.Reader{
+		B: bytes,
+	}
+	return c.UnmarshalCanotoFrom(r)
+}
+
+// UnmarshalCanotoFrom populates the struct from a [canoto.Reader]. Most users
+// should just use UnmarshalCanoto.
+//
+// During parsing, the canoto cache is saved.
+//
+// This function enables configuration of reader options.
+func (c *nextEpochApprovals) UnmarshalCanotoFrom(r canoto.Reader) error {
+	// Zero the struct before unmarshaling.
+	*c = nextEpochApprovals{}
+	atomic.StoreUint64(&c.canotoData.size, uint64(len(r.B)))
+
+	var minField uint32
+	for canoto.HasNext(&r) {
+		field, wireType, err := canoto.ReadTag(&r)
+		if err != nil {
+			return err
+		}
+		if field < minField {
+			return canoto.ErrInvalidFieldOrder
+		}
+
+		switch field {
+		case canoto__nextEpochApprovals__NodeIDs:
+			if wireType != canoto.Len {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			if err := canoto.ReadBytes(&r, &c.NodeIDs); err != nil {
+				return err
+			}
+			if len(c.NodeIDs) == 0 {
+				return canoto.ErrZeroValue
+			}
+		case canoto__nextEpochApprovals__Signature:
+			if wireType != canoto.Len {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			if err := canoto.ReadBytes(&r, &c.Signature); err != nil {
+				return err
+			}
+			if len(c.Signature) == 0 {
+				return canoto.ErrZeroValue
+			}
+		default:
+			return canoto.ErrUnknownField
+		}
+
+		minField = field + 1
+	}
+	return nil
+}
+
+// ValidCanoto validates that the struct can be correctly marshaled into the
+// Canoto format.
+//
+// Specifically, ValidCanoto ensures:
+// 1. All OneOfs are specified at most once.
+// 2. All strings are valid utf-8.
+// 3. All custom fields are ValidCanoto.
+func (c *nextEpochApprovals) ValidCanoto() bool {
+	if c == nil {
+		return true
+	}
+	return true
+}
+
+// CalculateCanotoCache populates size and OneOf caches based on the current
+// values in the struct.
+//
+// It is not safe to copy this struct concurrently.
+func (c *nextEpochApprovals) CalculateCanotoCache() {
+	if c == nil {
+		return
+	}
+	var size uint64
+	if len(c.NodeIDs) != 0 {
+		size += uint64(len(canoto__nextEpochApprovals__NodeIDs__tag)) + canoto.SizeBytes(c.NodeIDs)
+	}
+	if len(c.Signature) != 0 {
+		size += uint64(len(canoto__nextEpochApprovals__Signature__tag)) + canoto.SizeBytes(c.Signature)
+	}
+	atomic.StoreUint64(&c.canotoData.size, size)
+}
+
+// CachedCanotoSize returns the previously calculated size of the Canoto
+// representation from CalculateCanotoCache.
+//
+// If CalculateCanotoCache has not yet been called, it will return 0.
+//
+// If the struct has been modified since the last call to CalculateCanotoCache,
+// the returned size may be incorrect.
+func (c *nextEpochApprovals) CachedCanotoSize() uint64 {
+	if c == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&c.canotoData.size)
+}
+
+// MarshalCanoto returns the Canoto representation of this struct.
+//
+// It is assumed that this struct is ValidCanoto.
+//
+// It is not safe to copy this struct concurrently.
+func (c *nextEpochApprovals) MarshalCanoto() []byte {
+	c.CalculateCanotoCache()
+	w := canoto.Writer{
+		B: make([]byte, 0, c.CachedCanotoSize()),
+	}
+	w = c.MarshalCanotoInto(w)
+	return w.B
+}
+
+// MarshalCanotoInto writes the struct into a [canoto.Writer] and returns the
+// resulting [canoto.Writer]. Most users should just use MarshalCanoto.
+//
+// It is assumed that CalculateCanotoCache has been called since the last
+// modification to this struct.
+//
+// It is assumed that this struct is ValidCanoto.
+//
+// It is not safe to copy this struct concurrently.
+func (c *nextEpochApprovals) MarshalCanotoInto(w canoto.Writer) canoto.Writer {
+	if c == nil {
+		return w
+	}
+	if len(c.NodeIDs) != 0 {
+		canoto.Append(&w, canoto__nextEpochApprovals__NodeIDs__tag)
+		canoto.AppendBytes(&w, c.NodeIDs)
+	}
+	if len(c.Signature) != 0 {
+		canoto.Append(&w, canoto__nextEpochApprovals__Signature__tag)
+		canoto.AppendBytes(&w, c.Signature)
+	}
+	return w
+}
@@ -0,0 +1,171 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simplex
+
+//go:generate go tool canoto $GOFILE
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+)
+
+var (
+	errMissingValidationDescriptor = errors.New("missing block validation descriptor")
+	errDuplicateMember             = errors.New("duplicate member in block validation descriptor")
+)
+
+// simplexEpochInfo describes the Simplex epoch that a block belongs to and the
+// progress towards sealing it. See docs/reconfiguration.md for details.
+//
+// The genesis block implicitly has the zero value.
+type simplexEpochInfo struct {
+	// PChainReferenceHeight is the P-chain height that the validator set of
+	// the epoch is derived from. The first epoch uses the validator set
+	// provided in the Config and has a reference height of 0.
+	PChainReferenceHeight uint64 `canoto:"uint,1"`
+	// EpochNumber is the sequence number of the block that sealed the
+	// previous epoch, or 0 for the first epoch.
+	EpochNumber uint64 `canoto:"uint,2"`
+	// PrevSealingBlockHash is the digest of the block that sealed the previous
+	// epoch.
+	PrevSealingBlockHash [32]byte `canoto:"fixed bytes,3"`
+	// NextPChainReferenceHeight is the P-chain height that the validator set of
+	// the next epoch will be derived from, or 0 if no change has been observed.
+	NextPChainReferenceHeight uint64 `canoto:"uint,4"`
+	// PrevVMBlockSeq is the sequence number of the previous block that wraps a
+	// VM block.
+	PrevVMBlockSeq uint64 `canoto:"uint,5"`
+	// BlockValidationDescriptor describes how to validate the blocks of the
+	// next epoch. It is only set in sealing blocks.
+	BlockValidationDescriptor *blockValidationDescriptor `canoto:"pointer,6"`
+	// NextEpochApprovals are the approvals of the next epoch by its
+	// validators. They are collected once the next P-chain reference height is
+	// set, and the epoch is sealed once a quorum of approvals is collected.
+	NextEpochApprovals *nextEpochApprovals `canoto:"pointer,7"`
+	// SealingBlockSeq is the sequence number of the sealing block of the
+	// epoch. It is only set in telocks, which are the blocks built on top of
+	// the sealing block until it is finalized.
+	SealingBlockSeq uint64 `canoto:"uint,8"`
+
+	canotoData canotoData_simplexEpochInfo
+}
+
+// isSealingBlock returns true if the block is the last block of its epoch.
+func (e *simplexEpochInfo) isSealingBlock() bool {
+	return e.BlockValidationDescriptor != nil && e.SealingBlockSeq == 0
+}
+
+// isTelock returns true if the block was built on top of the sealing block of
+// its epoch. Telocks don't wrap a VM block and are never indexed.
+func (e *simplexEpochInfo) isTelock() bool {
+	return e.SealingBlockSeq > 0
+}
+
+func (e *simplexEpochInfo) equal(other *simplexEpochInfo) bool {
+	return bytes.Equal(e.MarshalCanoto(), other.MarshalCanoto())
+}
+
+type blockValidationDescriptor struct {
+	AggregatedMembership aggregatedMembership `canoto:"value,1"`
+
+	canotoData canotoData_blockValidationDescriptor
+}
+
+// aggregatedMembership is the set of nodes whose BLS signatures are aggregated
+// into the quorum certificates of an epoch.
+type aggregatedMembership struct {
+	Members []nodeBLSMapping `canoto:"repeated value,1"`
+
+	canotoData canotoData_aggregatedMembership
+}
+
+type nodeBLSMapping struct {
+	NodeID ids.NodeID             `canoto:"fixed bytes,1"`
+	BLSKey [bls.PublicKeyLen]byte `canoto:"fixed bytes,2"`
+
+	canotoData canotoData_nodeBLSMapping
+}
+
+type nextEpochApprovals struct {
+	// NodeIDs is a bitset of the members of the next epoch that approved the
+	// epoch change, indexed by their position in the block validation
+	// descriptor of the next epoch.
+	NodeIDs []byte `canoto:"bytes,1"`
+	// Signature is the aggregated signature of the approvers over the next
+	// P-chain reference height.
+	Signature []byte `canoto:"bytes,2"`
+
+	canotoData canotoData_nextEpochApprovals
+}
+
+func (a *nextEpochApprovals) equal(other *nextEpochApprovals) bool {
+	return bytes.Equal(a.NodeIDs, other.NodeIDs) && bytes.Equal(a.Signature, other.Signature)
+}
+
+// newBlockValidationDescriptor returns the descriptor of [vdrs], with the
+// members sorted by node ID. Validators without a BLS key are unable to sign
+// votes, so they are excluded.
+func newBlockValidationDescriptor(vdrs map[ids.NodeID]*validators.GetValidatorOutput) *blockValidationDescriptor {
+	nodeIDs := make([]ids.NodeID, 0, len(vdrs))
+	for nodeID, vdr := range vdrs {
+		if vdr.PublicKey == nil {
+			continue
+		}
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	utils.Sort(nodeIDs)
+
+	members := make([]nodeBLSMapping, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		members[i] = nodeBLSMapping{
+			NodeID: nodeID,
+			BLSKey: [bls.PublicKeyLen]byte(bls.PublicKeyToCompressedBytes(vdrs[nodeID].PublicKey)),
+		}
+	}
+	return &blockValidationDescriptor{
+		AggregatedMembership: aggregatedMembership{
+			Members: members,
+		},
+	}
+}
+
+// validators returns the validator set described by [d].
+func (d *blockValidationDescriptor) validators() (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+	if d == nil {
+		return nil, errMissingValidationDescriptor
+	}
+
+	members := d.AggregatedMembership.Members
+	vdrs := make(map[ids.NodeID]*validators.GetValidatorOutput, len(members))
+	for _, member := range members {
+		if _, ok := vdrs[member.NodeID]; ok {
+			return nil, fmt.Errorf("%w: %s", errDuplicateMember, member.NodeID)
+		}
+
+		pk, err := bls.PublicKeyFromCompressedBytes(member.BLSKey[:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid BLS key of %s: %w", member.NodeID, err)
+		}
+		vdrs[member.NodeID] = &validators.GetValidatorOutput{
+			NodeID:    member.NodeID,
+			PublicKey: pk,
+		}
+	}
+	return vdrs, nil
+}
+
+// sameMembership returns true if [a] and [b] contain the same nodes with the
+// same BLS keys.
+func sameMembership(a, b map[ids.NodeID]*validators.GetValidatorOutput) bool {
+	return bytes.Equal(
+		newBlockValidationDescriptor(a).MarshalCanoto(),
+		newBlockValidationDescriptor(b).MarshalCanoto(),
+	)
+}
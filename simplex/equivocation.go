@@ -0,0 +1,228 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simplex
+
+import (
+	"errors"
+
+	"github.com/ava-labs/simplex"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/cache/lru"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+// maxTrackedVotes is the number of votes that are remembered to detect
+// validators voting for conflicting blocks in the same round.
+const maxTrackedVotes = 4096
+
+var errEquivocationWrongVersion = errors.New("wrong version")
+
+// Equivocation is evidence that a validator signed votes for two different
+// blocks in the same round.
+type Equivocation struct {
+	Signer ids.NodeID `serialize:"true"`
+	Epoch  uint64     `serialize:"true"`
+	Round  uint64     `serialize:"true"`
+	// Finalize is true if the conflicting votes are finalize votes, rather
+	// than notarization votes.
+	Finalize bool `serialize:"true"`
+	// Headers are the conflicting signed block headers.
+	Headers []SignedHeader `serialize:"true"`
+}
+
+// SignedHeader is a block header and the signature of a vote for it.
+type SignedHeader struct {
+	Header    []byte `serialize:"true"`
+	Signature []byte `serialize:"true"`
+}
+
+type voteKey struct {
+	signer   ids.NodeID
+	epoch    uint64
+	round    uint64
+	finalize bool
+}
+
+type signedVote struct {
+	header    simplex.BlockHeader
+	signature simplex.Signature
+	// verified is true once the signature has been verified.
+	verified bool
+}
+
+// equivocationDetector records evidence of validators that sign votes for
+// conflicting blocks in the same round.
+//
+// Signatures are only verified once a conflict is found, so that honest votes
+// aren't verified a second time.
+type equivocationDetector struct {
+	log    logging.Logger
+	db     database.Database
+	metric prometheus.Counter
+
+	// verifier verifies the signatures of the current epoch's validators.
+	verifier simplex.SignatureVerifier
+	votes    *lru.Cache[voteKey, *signedVote]
+}
+
+func newEquivocationDetector(
+	log logging.Logger,
+	db database.Database,
+	reg prometheus.Registerer,
+) (*equivocationDetector, error) {
+	d := &equivocationDetector{
+		log: log,
+		db:  db,
+		metric: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "equivocations",
+			Help: "number of times a validator was detected voting for conflicting blocks",
+		}),
+		votes: lru.NewCache[voteKey, *signedVote](maxTrackedVotes),
+	}
+	return d, reg.Register(d.metric)
+}
+
+// observe records the votes in [msg].
+func (d *equivocationDetector) observe(msg *simplex.Message) error {
+	switch {
+	case msg.VoteMessage != nil:
+		return d.observeVote(false, msg.VoteMessage.Vote.BlockHeader, msg.VoteMessage.Signature)
+	case msg.BlockMessage != nil:
+		return d.observeVote(false, msg.BlockMessage.Vote.Vote.BlockHeader, msg.BlockMessage.Vote.Signature)
+	case msg.FinalizeVote != nil:
+		return d.observeVote(true, msg.FinalizeVote.Finalization.BlockHeader, msg.FinalizeVote.Signature)
+	default:
+		return nil
+	}
+}
+
+func (d *equivocationDetector) observeVote(
+	finalize bool,
+	header simplex.BlockHeader,
+	signature simplex.Signature,
+) error {
+	signer, err := ids.ToNodeID(signature.Signer)
+	if err != nil {
+		// The epoch will drop the vote.
+		return nil
+	}
+
+	key := voteKey{
+		signer:   signer,
+		epoch:    header.Epoch,
+		round:    header.Round,
+		finalize: finalize,
+	}
+	vote := &signedVote{
+		header:    header,
+		signature: signature,
+	}
+	previous, ok := d.votes.Get(key)
+	if !ok {
+		d.votes.Put(key, vote)
+		return nil
+	}
+	if previous.header.Digest == header.Digest {
+		return nil
+	}
+
+	// Only votes with valid signatures are evidence.
+	if !d.verify(finalize, vote) {
+		return nil
+	}
+	if !d.verify(finalize, previous) {
+		d.votes.Put(key, vote)
+		return nil
+	}
+
+	d.log.Warn("detected simplex equivocation",
+		zap.Stringer("signer", signer),
+		zap.Uint64("epoch", header.Epoch),
+		zap.Uint64("round", header.Round),
+		zap.Bool("finalize", finalize),
+		zap.Stringer("digest", header.Digest),
+		zap.Stringer("conflictingDigest", previous.header.Digest),
+	)
+	d.metric.Inc()
+
+	return d.put(header.Digest, &Equivocation{
+		Signer:   signer,
+		Epoch:    header.Epoch,
+		Round:    header.Round,
+		Finalize: finalize,
+		Headers: []SignedHeader{
+			{
+				Header:    previous.header.Bytes(),
+				Signature: previous.signature.Value,
+			},
+			{
+				Header:    header.Bytes(),
+				Signature: signature.Value,
+			},
+		},
+	})
+}
+
+func (d *equivocationDetector) verify(finalize bool, vote *signedVote) bool {
+	if vote.verified {
+		return true
+	}
+
+	var err error
+	if finalize {
+		toBeSigned := simplex.ToBeSignedFinalization{BlockHeader: vote.header}
+		err = toBeSigned.Verify(vote.signature.Value, d.verifier, vote.signature.Signer)
+	} else {
+		toBeSigned := simplex.ToBeSignedVote{BlockHeader: vote.header}
+		err = toBeSigned.Verify(vote.signature.Value, d.verifier, vote.signature.Signer)
+	}
+	vote.verified = err == nil
+	return vote.verified
+}
+
+// Evidence is keyed by signer, then epoch, then round, then the digest of the
+// block that was detected to conflict.
+func (d *equivocationDetector) put(digest simplex.Digest, equivocation *Equivocation) error {
+	bytes, err := Codec.Marshal(CodecVersion, equivocation)
+	if err != nil {
+		return err
+	}
+
+	key := make([]byte, 0, ids.NodeIDLen+2*database.Uint64Size+len(digest))
+	key = append(key, equivocation.Signer.Bytes()...)
+	key = append(key, database.PackUInt64(equivocation.Epoch)...)
+	key = append(key, database.PackUInt64(equivocation.Round)...)
+	key = append(key, digest[:]...)
+	return d.db.Put(key, bytes)
+}
+
+// equivocations returns the stored evidence of [signer]. If [signer] is empty,
+// the evidence of every validator is returned.
+func (d *equivocationDetector) equivocations(signer ids.NodeID) ([]*Equivocation, error) {
+	var prefix []byte
+	if signer != ids.EmptyNodeID {
+		prefix = signer.Bytes()
+	}
+
+	it := d.db.NewIteratorWithPrefix(prefix)
+	defer it.Release()
+
+	var equivocations []*Equivocation
+	for it.Next() {
+		equivocation := &Equivocation{}
+		parsedVersion, err := Codec.Unmarshal(it.Value(), equivocation)
+		if err != nil {
+			return nil, err
+		}
+		if parsedVersion != CodecVersion {
+			return nil, errEquivocationWrongVersion
+		}
+		equivocations = append(equivocations, equivocation)
+	}
+	return equivocations, it.Error()
+}
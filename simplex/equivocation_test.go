@@ -0,0 +1,135 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simplex
+
+import (
+	"testing"
+
+	"github.com/ava-labs/simplex"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+func newTestVote(t *testing.T, config *Config, header simplex.BlockHeader) *simplex.Message {
+	signer, _ := NewBLSAuth(config)
+	vote := simplex.ToBeSignedVote{BlockHeader: header}
+	sig, err := vote.Sign(&signer)
+	require.NoError(t, err)
+	return &simplex.Message{
+		VoteMessage: &simplex.Vote{
+			Vote: vote,
+			Signature: simplex.Signature{
+				Signer: config.Ctx.NodeID[:],
+				Value:  sig,
+			},
+		},
+	}
+}
+
+func newTestFinalizeVote(t *testing.T, config *Config, header simplex.BlockHeader) *simplex.Message {
+	signer, _ := NewBLSAuth(config)
+	vote := simplex.ToBeSignedFinalization{BlockHeader: header}
+	sig, err := vote.Sign(&signer)
+	require.NoError(t, err)
+	return &simplex.Message{
+		FinalizeVote: &simplex.FinalizeVote{
+			Finalization: vote,
+			Signature: simplex.Signature{
+				Signer: config.Ctx.NodeID[:],
+				Value:  sig,
+			},
+		},
+	}
+}
+
+func TestEquivocationDetector(t *testing.T) {
+	require := require.New(t)
+
+	configs := newNetworkConfigs(t, 2)
+	d, err := newEquivocationDetector(logging.NoLog{}, memdb.New(), prometheus.NewRegistry())
+	require.NoError(err)
+	_, d.verifier = NewBLSAuth(configs[0])
+
+	var (
+		metadata = simplex.ProtocolMetadata{
+			Epoch: 1,
+			Round: 2,
+			Seq:   3,
+		}
+		header1 = simplex.BlockHeader{
+			ProtocolMetadata: metadata,
+			Digest:           simplex.Digest{1},
+		}
+		header2 = simplex.BlockHeader{
+			ProtocolMetadata: metadata,
+			Digest:           simplex.Digest{2},
+		}
+		signer = configs[1].Ctx.NodeID
+	)
+
+	// Voting for the same block twice isn't an equivocation.
+	require.NoError(d.observe(newTestVote(t, configs[1], header1)))
+	require.NoError(d.observe(newTestVote(t, configs[1], header1)))
+
+	// Neither are the votes of different validators.
+	require.NoError(d.observe(newTestVote(t, configs[0], header2)))
+
+	// Nor a vote with an invalid signature.
+	forged := newTestVote(t, configs[0], header2)
+	forged.VoteMessage.Signature.Signer = signer[:]
+	require.NoError(d.observe(forged))
+
+	// Nor a notarization vote and a finalize vote for different blocks.
+	require.NoError(d.observe(newTestFinalizeVote(t, configs[1], header2)))
+	require.Zero(testutil.ToFloat64(d.metric))
+
+	equivocations, err := d.equivocations(ids.EmptyNodeID)
+	require.NoError(err)
+	require.Empty(equivocations)
+
+	// Voting for different blocks in the same round is an equivocation.
+	vote := newTestVote(t, configs[1], header2)
+	require.NoError(d.observe(vote))
+	require.Equal(1.0, testutil.ToFloat64(d.metric))
+
+	equivocations, err = d.equivocations(signer)
+	require.NoError(err)
+	require.Len(equivocations, 1)
+
+	equivocation := equivocations[0]
+	require.Equal(signer, equivocation.Signer)
+	require.Equal(metadata.Epoch, equivocation.Epoch)
+	require.Equal(metadata.Round, equivocation.Round)
+	require.False(equivocation.Finalize)
+	require.Len(equivocation.Headers, 2)
+	require.Equal(header1.Bytes(), equivocation.Headers[0].Header)
+	require.Equal(header2.Bytes(), equivocation.Headers[1].Header)
+	require.Equal(vote.VoteMessage.Signature.Value, equivocation.Headers[1].Signature)
+
+	// The evidence can be verified.
+	for _, signedHeader := range equivocation.Headers {
+		var header simplex.BlockHeader
+		require.NoError(header.FromBytes(signedHeader.Header))
+
+		toBeSigned := simplex.ToBeSignedVote{BlockHeader: header}
+		require.NoError(toBeSigned.Verify(signedHeader.Signature, d.verifier, signer[:]))
+	}
+
+	// Finalizing different blocks in the same round is an equivocation.
+	require.NoError(d.observe(newTestFinalizeVote(t, configs[1], header1)))
+	require.Equal(2.0, testutil.ToFloat64(d.metric))
+
+	equivocations, err = d.equivocations(ids.EmptyNodeID)
+	require.NoError(err)
+	require.Len(equivocations, 2)
+
+	equivocations, err = d.equivocations(configs[0].Ctx.NodeID)
+	require.NoError(err)
+	require.Empty(equivocations)
+}
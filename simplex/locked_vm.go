@@ -0,0 +1,107 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simplex
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+)
+
+var (
+	_ block.ChainVM = (*lockedVM)(nil)
+	_ snowman.Block = (*lockedBlock)(nil)
+)
+
+// lockedVM holds [lock] while calling into the VM. The Simplex epoch calls the
+// VM from its own goroutines, whereas the VM expects the chain's lock to be
+// held.
+//
+// WaitForEvent is not wrapped, as it blocks until the VM has an event.
+type lockedVM struct {
+	block.ChainVM
+	lock sync.Locker
+}
+
+func (vm *lockedVM) BuildBlock(ctx context.Context) (snowman.Block, error) {
+	vm.lock.Lock()
+	defer vm.lock.Unlock()
+
+	return vm.wrap(vm.ChainVM.BuildBlock(ctx))
+}
+
+func (vm *lockedVM) ParseBlock(ctx context.Context, blockBytes []byte) (snowman.Block, error) {
+	vm.lock.Lock()
+	defer vm.lock.Unlock()
+
+	return vm.wrap(vm.ChainVM.ParseBlock(ctx, blockBytes))
+}
+
+func (vm *lockedVM) GetBlock(ctx context.Context, blkID ids.ID) (snowman.Block, error) {
+	vm.lock.Lock()
+	defer vm.lock.Unlock()
+
+	return vm.wrap(vm.ChainVM.GetBlock(ctx, blkID))
+}
+
+func (vm *lockedVM) SetPreference(ctx context.Context, blkID ids.ID) error {
+	vm.lock.Lock()
+	defer vm.lock.Unlock()
+
+	return vm.ChainVM.SetPreference(ctx, blkID)
+}
+
+func (vm *lockedVM) LastAccepted(ctx context.Context) (ids.ID, error) {
+	vm.lock.Lock()
+	defer vm.lock.Unlock()
+
+	return vm.ChainVM.LastAccepted(ctx)
+}
+
+func (vm *lockedVM) GetBlockIDAtHeight(ctx context.Context, height uint64) (ids.ID, error) {
+	vm.lock.Lock()
+	defer vm.lock.Unlock()
+
+	return vm.ChainVM.GetBlockIDAtHeight(ctx, height)
+}
+
+func (vm *lockedVM) wrap(blk snowman.Block, err error) (snowman.Block, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &lockedBlock{
+		Block: blk,
+		lock:  vm.lock,
+	}, nil
+}
+
+// lockedBlock holds [lock] while verifying, accepting, or rejecting the block.
+type lockedBlock struct {
+	snowman.Block
+	lock sync.Locker
+}
+
+func (b *lockedBlock) Verify(ctx context.Context) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.Block.Verify(ctx)
+}
+
+func (b *lockedBlock) Accept(ctx context.Context) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.Block.Accept(ctx)
+}
+
+func (b *lockedBlock) Reject(ctx context.Context) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.Block.Reject(ctx)
+}
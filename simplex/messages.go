@@ -4,7 +4,10 @@
 package simplex
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"math"
 
 	"github.com/ava-labs/simplex"
 
@@ -12,6 +15,13 @@ import (
 	"github.com/ava-labs/avalanchego/proto/pb/p2p"
 )
 
+var (
+	errMissingField       = errors.New("missing field")
+	errInvalidDigest      = errors.New("invalid digest")
+	errInvalidVersion     = errors.New("invalid version")
+	errUnknownMessageType = errors.New("unknown message type")
+)
+
 func newBlockProposal(
 	chainID ids.ID,
 	msg *simplex.VerifiedBlockMessage,
@@ -189,6 +199,22 @@ func newReplicationResponse(
 	}, nil
 }
 
+func newNextEpochApproval(
+	chainID ids.ID,
+	height uint64,
+	signature []byte,
+) *p2p.Simplex {
+	return &p2p.Simplex{
+		ChainId: chainID[:],
+		Message: &p2p.Simplex_NextEpochApproval{
+			NextEpochApproval: &p2p.NextEpochApproval{
+				NextPChainReferenceHeight: height,
+				Signature:                 signature,
+			},
+		},
+	}
+}
+
 func blockHeaderToP2P(bh simplex.BlockHeader) *p2p.BlockHeader {
 	return &p2p.BlockHeader{
 		Metadata: protocolMetadataToP2P(bh.ProtocolMetadata),
@@ -244,3 +270,309 @@ func emptyVoteMetadataToP2P(ev simplex.EmptyVoteMetadata) *p2p.EmptyVoteMetadata
 		Round: ev.Round,
 	}
 }
+
+// messageParser converts p2p messages into their simplex representation.
+type messageParser struct {
+	blockDeserializer simplex.BlockDeserializer
+	qcDeserializer    simplex.QCDeserializer
+}
+
+func (p *messageParser) parse(ctx context.Context, msg *p2p.Simplex) (*simplex.Message, error) {
+	switch m := msg.GetMessage().(type) {
+	case *p2p.Simplex_BlockProposal:
+		if m.BlockProposal == nil {
+			return nil, fmt.Errorf("%w: block proposal", errMissingField)
+		}
+		block, err := p.blockDeserializer.DeserializeBlock(ctx, m.BlockProposal.Block)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse block: %w", err)
+		}
+		vote, err := p2pToVote(m.BlockProposal.Vote)
+		if err != nil {
+			return nil, err
+		}
+		return &simplex.Message{
+			BlockMessage: &simplex.BlockMessage{
+				Block: block,
+				Vote:  *vote,
+			},
+		}, nil
+	case *p2p.Simplex_Vote:
+		vote, err := p2pToVote(m.Vote)
+		if err != nil {
+			return nil, err
+		}
+		return &simplex.Message{VoteMessage: vote}, nil
+	case *p2p.Simplex_EmptyVote:
+		if m.EmptyVote == nil {
+			return nil, fmt.Errorf("%w: empty vote", errMissingField)
+		}
+		metadata, err := p2pToEmptyVoteMetadata(m.EmptyVote.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		signature, err := p2pToSignature(m.EmptyVote.Signature)
+		if err != nil {
+			return nil, err
+		}
+		return &simplex.Message{
+			EmptyVoteMessage: &simplex.EmptyVote{
+				Vote: simplex.ToBeSignedEmptyVote{
+					EmptyVoteMetadata: metadata,
+				},
+				Signature: signature,
+			},
+		}, nil
+	case *p2p.Simplex_FinalizeVote:
+		vote, err := p2pToVote(m.FinalizeVote)
+		if err != nil {
+			return nil, err
+		}
+		return &simplex.Message{
+			FinalizeVote: &simplex.FinalizeVote{
+				Finalization: simplex.ToBeSignedFinalization{
+					BlockHeader: vote.Vote.BlockHeader,
+				},
+				Signature: vote.Signature,
+			},
+		}, nil
+	case *p2p.Simplex_Notarization:
+		notarization, err := p.parseNotarization(m.Notarization)
+		if err != nil {
+			return nil, err
+		}
+		return &simplex.Message{Notarization: notarization}, nil
+	case *p2p.Simplex_EmptyNotarization:
+		emptyNotarization, err := p.parseEmptyNotarization(m.EmptyNotarization)
+		if err != nil {
+			return nil, err
+		}
+		return &simplex.Message{EmptyNotarization: emptyNotarization}, nil
+	case *p2p.Simplex_Finalization:
+		finalization, err := p.parseFinalization(m.Finalization)
+		if err != nil {
+			return nil, err
+		}
+		return &simplex.Message{Finalization: finalization}, nil
+	case *p2p.Simplex_ReplicationRequest:
+		if m.ReplicationRequest == nil {
+			return nil, fmt.Errorf("%w: replication request", errMissingField)
+		}
+		return &simplex.Message{
+			ReplicationRequest: &simplex.ReplicationRequest{
+				Seqs:        m.ReplicationRequest.Seqs,
+				LatestRound: m.ReplicationRequest.LatestRound,
+			},
+		}, nil
+	case *p2p.Simplex_ReplicationResponse:
+		if m.ReplicationResponse == nil {
+			return nil, fmt.Errorf("%w: replication response", errMissingField)
+		}
+		data := make([]simplex.QuorumRound, 0, len(m.ReplicationResponse.Data))
+		for _, p2pQR := range m.ReplicationResponse.Data {
+			qr, err := p.parseQuorumRound(ctx, p2pQR)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, *qr)
+		}
+
+		var latestRound *simplex.QuorumRound
+		if m.ReplicationResponse.LatestRound != nil {
+			qr, err := p.parseQuorumRound(ctx, m.ReplicationResponse.LatestRound)
+			if err != nil {
+				return nil, err
+			}
+			latestRound = qr
+		}
+		return &simplex.Message{
+			ReplicationResponse: &simplex.ReplicationResponse{
+				Data:        data,
+				LatestRound: latestRound,
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %T", errUnknownMessageType, m)
+	}
+}
+
+func (p *messageParser) parseQuorumRound(ctx context.Context, p2pQR *p2p.QuorumRound) (*simplex.QuorumRound, error) {
+	qr := &simplex.QuorumRound{}
+	if len(p2pQR.Block) > 0 {
+		block, err := p.blockDeserializer.DeserializeBlock(ctx, p2pQR.Block)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse block: %w", err)
+		}
+		qr.Block = block
+	}
+	if p2pQR.Notarization != nil {
+		notarization, err := p.parseNotarization(p2pQR.Notarization)
+		if err != nil {
+			return nil, err
+		}
+		qr.Notarization = notarization
+	}
+	if p2pQR.Finalization != nil {
+		finalization, err := p.parseFinalization(p2pQR.Finalization)
+		if err != nil {
+			return nil, err
+		}
+		qr.Finalization = finalization
+	}
+	if p2pQR.EmptyNotarization != nil {
+		emptyNotarization, err := p.parseEmptyNotarization(p2pQR.EmptyNotarization)
+		if err != nil {
+			return nil, err
+		}
+		qr.EmptyNotarization = emptyNotarization
+	}
+	return qr, nil
+}
+
+func (p *messageParser) parseNotarization(qc *p2p.QuorumCertificate) (*simplex.Notarization, error) {
+	blockHeader, quorumCertificate, err := p.parseQuorumCertificate(qc)
+	if err != nil {
+		return nil, err
+	}
+	return &simplex.Notarization{
+		Vote: simplex.ToBeSignedVote{
+			BlockHeader: blockHeader,
+		},
+		QC: quorumCertificate,
+	}, nil
+}
+
+func (p *messageParser) parseFinalization(qc *p2p.QuorumCertificate) (*simplex.Finalization, error) {
+	blockHeader, quorumCertificate, err := p.parseQuorumCertificate(qc)
+	if err != nil {
+		return nil, err
+	}
+	return &simplex.Finalization{
+		Finalization: simplex.ToBeSignedFinalization{
+			BlockHeader: blockHeader,
+		},
+		QC: quorumCertificate,
+	}, nil
+}
+
+func (p *messageParser) parseQuorumCertificate(qc *p2p.QuorumCertificate) (simplex.BlockHeader, simplex.QuorumCertificate, error) {
+	if qc == nil {
+		return simplex.BlockHeader{}, nil, fmt.Errorf("%w: quorum certificate", errMissingField)
+	}
+	blockHeader, err := p2pToBlockHeader(qc.BlockHeader)
+	if err != nil {
+		return simplex.BlockHeader{}, nil, err
+	}
+	quorumCertificate, err := p.qcDeserializer.DeserializeQuorumCertificate(qc.QuorumCertificate)
+	if err != nil {
+		return simplex.BlockHeader{}, nil, err
+	}
+	return blockHeader, quorumCertificate, nil
+}
+
+func (p *messageParser) parseEmptyNotarization(emptyNotarization *p2p.EmptyNotarization) (*simplex.EmptyNotarization, error) {
+	if emptyNotarization == nil {
+		return nil, fmt.Errorf("%w: empty notarization", errMissingField)
+	}
+	metadata, err := p2pToEmptyVoteMetadata(emptyNotarization.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	quorumCertificate, err := p.qcDeserializer.DeserializeQuorumCertificate(emptyNotarization.QuorumCertificate)
+	if err != nil {
+		return nil, err
+	}
+	return &simplex.EmptyNotarization{
+		Vote: simplex.ToBeSignedEmptyVote{
+			EmptyVoteMetadata: metadata,
+		},
+		QC: quorumCertificate,
+	}, nil
+}
+
+func p2pToVote(vote *p2p.Vote) (*simplex.Vote, error) {
+	if vote == nil {
+		return nil, fmt.Errorf("%w: vote", errMissingField)
+	}
+	blockHeader, err := p2pToBlockHeader(vote.BlockHeader)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := p2pToSignature(vote.Signature)
+	if err != nil {
+		return nil, err
+	}
+	return &simplex.Vote{
+		Vote: simplex.ToBeSignedVote{
+			BlockHeader: blockHeader,
+		},
+		Signature: signature,
+	}, nil
+}
+
+func p2pToBlockHeader(bh *p2p.BlockHeader) (simplex.BlockHeader, error) {
+	if bh == nil {
+		return simplex.BlockHeader{}, fmt.Errorf("%w: block header", errMissingField)
+	}
+	metadata, err := p2pToProtocolMetadata(bh.Metadata)
+	if err != nil {
+		return simplex.BlockHeader{}, err
+	}
+	digest, err := p2pToDigest(bh.Digest)
+	if err != nil {
+		return simplex.BlockHeader{}, err
+	}
+	return simplex.BlockHeader{
+		ProtocolMetadata: metadata,
+		Digest:           digest,
+	}, nil
+}
+
+func p2pToProtocolMetadata(md *p2p.ProtocolMetadata) (simplex.ProtocolMetadata, error) {
+	if md == nil {
+		return simplex.ProtocolMetadata{}, fmt.Errorf("%w: protocol metadata", errMissingField)
+	}
+	if md.Version > math.MaxUint8 {
+		return simplex.ProtocolMetadata{}, fmt.Errorf("%w: %d", errInvalidVersion, md.Version)
+	}
+	prev, err := p2pToDigest(md.Prev)
+	if err != nil {
+		return simplex.ProtocolMetadata{}, err
+	}
+	return simplex.ProtocolMetadata{
+		Version: uint8(md.Version),
+		Epoch:   md.Epoch,
+		Round:   md.Round,
+		Seq:     md.Seq,
+		Prev:    prev,
+	}, nil
+}
+
+func p2pToEmptyVoteMetadata(md *p2p.EmptyVoteMetadata) (simplex.EmptyVoteMetadata, error) {
+	if md == nil {
+		return simplex.EmptyVoteMetadata{}, fmt.Errorf("%w: empty vote metadata", errMissingField)
+	}
+	return simplex.EmptyVoteMetadata{
+		Epoch: md.Epoch,
+		Round: md.Round,
+	}, nil
+}
+
+func p2pToSignature(signature *p2p.Signature) (simplex.Signature, error) {
+	if signature == nil {
+		return simplex.Signature{}, fmt.Errorf("%w: signature", errMissingField)
+	}
+	return simplex.Signature{
+		Signer: signature.Signer,
+		Value:  signature.Value,
+	}, nil
+}
+
+func p2pToDigest(bytes []byte) (simplex.Digest, error) {
+	var digest simplex.Digest
+	if len(bytes) != len(digest) {
+		return digest, fmt.Errorf("%w: length %d", errInvalidDigest, len(bytes))
+	}
+	copy(digest[:], bytes)
+	return digest, nil
+}
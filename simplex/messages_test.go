@@ -0,0 +1,198 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simplex
+
+import (
+	"testing"
+
+	"github.com/ava-labs/simplex"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/proto/pb/p2p"
+)
+
+func TestMessageParserRoundTrip(t *testing.T) {
+	configs := newNetworkConfigs(t, 4)
+	config := configs[0]
+	chainID := config.Ctx.ChainID
+
+	_, verifier := NewBLSAuth(config)
+	parser := &messageParser{
+		qcDeserializer: &QCDeserializer{verifier: &verifier},
+	}
+
+	genesis := newTestBlock(t, newBlockConfig{})
+	child := newTestBlock(t, newBlockConfig{prev: genesis})
+	blockHeader := child.BlockHeader()
+	signature := simplex.Signature{
+		Signer: config.Ctx.NodeID[:],
+		Value:  []byte("signature"),
+	}
+	finalization := newTestFinalization(t, configs, blockHeader)
+	emptyVote := simplex.ToBeSignedEmptyVote{
+		EmptyVoteMetadata: simplex.EmptyVoteMetadata{
+			Epoch: 1,
+			Round: 2,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		msg      *p2p.Simplex
+		expected *simplex.Message
+	}{
+		{
+			name: "vote",
+			msg: newVote(chainID, &simplex.Vote{
+				Vote:      simplex.ToBeSignedVote{BlockHeader: blockHeader},
+				Signature: signature,
+			}),
+			expected: &simplex.Message{
+				VoteMessage: &simplex.Vote{
+					Vote:      simplex.ToBeSignedVote{BlockHeader: blockHeader},
+					Signature: signature,
+				},
+			},
+		},
+		{
+			name: "empty vote",
+			msg: newEmptyVote(chainID, &simplex.EmptyVote{
+				Vote:      emptyVote,
+				Signature: signature,
+			}),
+			expected: &simplex.Message{
+				EmptyVoteMessage: &simplex.EmptyVote{
+					Vote:      emptyVote,
+					Signature: signature,
+				},
+			},
+		},
+		{
+			name: "finalize vote",
+			msg: newFinalizeVote(chainID, &simplex.FinalizeVote{
+				Finalization: simplex.ToBeSignedFinalization{BlockHeader: blockHeader},
+				Signature:    signature,
+			}),
+			expected: &simplex.Message{
+				FinalizeVote: &simplex.FinalizeVote{
+					Finalization: simplex.ToBeSignedFinalization{BlockHeader: blockHeader},
+					Signature:    signature,
+				},
+			},
+		},
+		{
+			name: "replication request",
+			msg: newReplicationRequest(chainID, &simplex.ReplicationRequest{
+				Seqs:        []uint64{1, 2, 3},
+				LatestRound: 4,
+			}),
+			expected: &simplex.Message{
+				ReplicationRequest: &simplex.ReplicationRequest{
+					Seqs:        []uint64{1, 2, 3},
+					LatestRound: 4,
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg, err := parser.parse(t.Context(), test.msg)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, msg)
+		})
+	}
+
+	t.Run("finalization", func(t *testing.T) {
+		require := require.New(t)
+
+		msg, err := parser.parse(t.Context(), newFinalization(chainID, &finalization))
+		require.NoError(err)
+		require.NotNil(msg.Finalization)
+		require.Equal(finalization.Finalization, msg.Finalization.Finalization)
+		require.Equal(finalization.QC.Bytes(), msg.Finalization.QC.Bytes())
+	})
+}
+
+func TestMessageParserErrors(t *testing.T) {
+	parser := &messageParser{}
+
+	tests := []struct {
+		name        string
+		msg         *p2p.Simplex
+		expectedErr error
+	}{
+		{
+			name:        "no message",
+			msg:         &p2p.Simplex{},
+			expectedErr: errUnknownMessageType,
+		},
+		{
+			name: "missing vote",
+			msg: &p2p.Simplex{
+				Message: &p2p.Simplex_Vote{},
+			},
+			expectedErr: errMissingField,
+		},
+		{
+			name: "missing signature",
+			msg: &p2p.Simplex{
+				Message: &p2p.Simplex_Vote{
+					Vote: &p2p.Vote{
+						BlockHeader: &p2p.BlockHeader{
+							Metadata: &p2p.ProtocolMetadata{
+								Prev: make([]byte, len(simplex.Digest{})),
+							},
+							Digest: make([]byte, len(simplex.Digest{})),
+						},
+					},
+				},
+			},
+			expectedErr: errMissingField,
+		},
+		{
+			name: "invalid digest",
+			msg: &p2p.Simplex{
+				Message: &p2p.Simplex_FinalizeVote{
+					FinalizeVote: &p2p.Vote{
+						BlockHeader: &p2p.BlockHeader{
+							Metadata: &p2p.ProtocolMetadata{
+								Prev: make([]byte, len(simplex.Digest{})),
+							},
+							Digest: []byte{1},
+						},
+					},
+				},
+			},
+			expectedErr: errInvalidDigest,
+		},
+		{
+			name: "invalid version",
+			msg: &p2p.Simplex{
+				Message: &p2p.Simplex_Vote{
+					Vote: &p2p.Vote{
+						BlockHeader: &p2p.BlockHeader{
+							Metadata: &p2p.ProtocolMetadata{
+								Version: 256,
+							},
+						},
+					},
+				},
+			},
+			expectedErr: errInvalidVersion,
+		},
+		{
+			name: "missing empty notarization",
+			msg: &p2p.Simplex{
+				Message: &p2p.Simplex_EmptyNotarization{},
+			},
+			expectedErr: errMissingField,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parser.parse(t.Context(), test.msg)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simplex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/simplex"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+var (
+	errUnexpectedEpoch       = errors.New("block is not in the current epoch")
+	errMismatchedEpochInfo   = errors.New("unexpected epoch info")
+	errInvalidPChainHeight   = errors.New("next P-chain reference height must exceed the current reference height")
+	errUnknownPChainHeight   = errors.New("next P-chain reference height is unknown")
+	errValidatorSetUnchanged = errors.New("validator set at the next P-chain reference height is unchanged")
+)
+
+// epochState is the state of a Simplex epoch.
+type epochState struct {
+	// number of the epoch, which is the sequence of the block that sealed the
+	// previous epoch, or 0 for the first epoch.
+	number uint64
+	// pChainReferenceHeight is the P-chain height that [validators] was derived
+	// from.
+	pChainReferenceHeight uint64
+	// prevSealingBlockHash is the digest of the block that sealed the previous
+	// epoch.
+	prevSealingBlockHash simplex.Digest
+	// validators is the set of nodes whose signatures are aggregated into the
+	// quorum certificates of the epoch.
+	validators map[ids.NodeID]*validators.GetValidatorOutput
+}
+
+// newEpochState returns the state of the epoch that [sealingBlock] sealed the
+// predecessor of.
+func newEpochState(sealingBlock *Block) (epochState, error) {
+	vdrs, err := sealingBlock.epochInfo.BlockValidationDescriptor.validators()
+	if err != nil {
+		return epochState{}, err
+	}
+	return epochState{
+		number:                sealingBlock.metadata.Seq,
+		pChainReferenceHeight: sealingBlock.epochInfo.NextPChainReferenceHeight,
+		prevSealingBlockHash:  sealingBlock.digest,
+		validators:            vdrs,
+	}, nil
+}
+
+// metadataStateMachine determines the epoch info of the blocks of an epoch.
+// It is deterministic given the parent block, except for the P-chain height
+// that the block builder samples to detect validator set changes and the next
+// epoch approvals that the block builder received.
+//
+// Metablocks aren't built, so the epoch is sealed by the first VM block that
+// carries the approvals of a quorum of the validators of the next epoch.
+type metadataStateMachine struct {
+	log            logging.Logger
+	networkID      uint32
+	chainID        ids.ID
+	subnetID       ids.ID
+	validatorState validators.State
+
+	epoch epochState
+	// approvals are the approvals of the next epoch received from its
+	// validators.
+	approvals approvalPool
+}
+
+// buildEpochInfo returns the epoch info of a block built on top of [parent].
+//
+// If the validator set of the subnet differs from the current epoch, the
+// P-chain height is recorded in the block so that the next block seals the
+// epoch.
+func (m *metadataStateMachine) buildEpochInfo(ctx context.Context, parent *Block) (simplexEpochInfo, error) {
+	return m.epochInfo(
+		ctx,
+		parent,
+		func() (uint64, error) {
+			return m.sampleNextPChainReferenceHeight(ctx), nil
+		},
+		m.buildNextEpochApprovals,
+	)
+}
+
+// verifyEpochInfo verifies that [info] is the epoch info of a block built on
+// top of [parent].
+func (m *metadataStateMachine) verifyEpochInfo(ctx context.Context, parent *Block, info *simplexEpochInfo) error {
+	expected, err := m.epochInfo(
+		ctx,
+		parent,
+		func() (uint64, error) {
+			height := info.NextPChainReferenceHeight
+			if height == 0 {
+				return 0, nil
+			}
+			return height, m.verifyNextPChainReferenceHeight(ctx, height)
+		},
+		func(descriptor *blockValidationDescriptor, height uint64, parentApprovals *nextEpochApprovals) (*nextEpochApprovals, error) {
+			return m.verifyNextEpochApprovals(descriptor, height, parentApprovals, info.NextEpochApprovals)
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if !expected.equal(info) {
+		return errMismatchedEpochInfo
+	}
+	return nil
+}
+
+// epochInfo returns the epoch info of a block built on top of [parent]. If the
+// epoch hasn't observed a validator set change yet, [nextPChainReferenceHeight]
+// provides the height to record for the next epoch. Otherwise,
+// [nextEpochApprovals] provides the approvals of the next epoch, which extend
+// the approvals of [parent].
+func (m *metadataStateMachine) epochInfo(
+	ctx context.Context,
+	parent *Block,
+	nextPChainReferenceHeight func() (uint64, error),
+	nextEpochApprovals func(descriptor *blockValidationDescriptor, height uint64, parentApprovals *nextEpochApprovals) (*nextEpochApprovals, error),
+) (simplexEpochInfo, error) {
+	parentInfo := &parent.epochInfo
+	parentSeq := parent.metadata.Seq
+
+	// The first block of an epoch is built on top of the sealing block of the
+	// previous epoch.
+	if m.epoch.number != 0 && parentSeq == m.epoch.number {
+		return simplexEpochInfo{
+			PChainReferenceHeight: m.epoch.pChainReferenceHeight,
+			EpochNumber:           m.epoch.number,
+			PrevSealingBlockHash:  m.epoch.prevSealingBlockHash,
+			PrevVMBlockSeq:        parentSeq,
+		}, nil
+	}
+
+	if parentInfo.EpochNumber != m.epoch.number {
+		return simplexEpochInfo{}, fmt.Errorf("%w: parent epoch %d, current epoch %d", errUnexpectedEpoch, parentInfo.EpochNumber, m.epoch.number)
+	}
+
+	info := simplexEpochInfo{
+		PChainReferenceHeight: parentInfo.PChainReferenceHeight,
+		EpochNumber:           parentInfo.EpochNumber,
+		PrevSealingBlockHash:  parentInfo.PrevSealingBlockHash,
+		PrevVMBlockSeq:        parentSeq,
+	}
+	switch {
+	case parentInfo.isTelock():
+		// Telocks are built until the sealing block is finalized.
+		info.PrevVMBlockSeq = parentInfo.PrevVMBlockSeq
+		info.SealingBlockSeq = parentInfo.SealingBlockSeq
+	case parentInfo.isSealingBlock():
+		info.SealingBlockSeq = parentSeq
+	case parentInfo.NextPChainReferenceHeight != 0:
+		// The validator set change was observed by an ancestor, so the
+		// approvals of the next epoch are collected. Once a quorum of the
+		// validators of the next epoch approved it, this block seals the
+		// epoch.
+		height := parentInfo.NextPChainReferenceHeight
+		vdrs, err := m.validatorState.GetValidatorSet(ctx, height, m.subnetID)
+		if err != nil {
+			return simplexEpochInfo{}, fmt.Errorf("failed to get validator set at height %d: %w", height, err)
+		}
+		descriptor := newBlockValidationDescriptor(vdrs)
+		approvals, err := nextEpochApprovals(descriptor, height, parentInfo.NextEpochApprovals)
+		if err != nil {
+			return simplexEpochInfo{}, fmt.Errorf("invalid next epoch approvals: %w", err)
+		}
+		info.NextPChainReferenceHeight = height
+		info.NextEpochApprovals = approvals
+		if numApprovers(approvals) >= approvalQuorum(len(descriptor.AggregatedMembership.Members)) {
+			info.BlockValidationDescriptor = descriptor
+		}
+	default:
+		height, err := nextPChainReferenceHeight()
+		if err != nil {
+			return simplexEpochInfo{}, err
+		}
+		info.NextPChainReferenceHeight = height
+	}
+	if info.NextPChainReferenceHeight != 0 && !info.isSealingBlock() {
+		m.approvals.observe(info.NextPChainReferenceHeight)
+	}
+	return info, nil
+}
+
+// sampleNextPChainReferenceHeight returns the current P-chain height if the
+// validator set at that height differs from the validator set of the epoch.
+// Otherwise, 0 is returned.
+func (m *metadataStateMachine) sampleNextPChainReferenceHeight(ctx context.Context) uint64 {
+	height, err := m.validatorState.GetCurrentHeight(ctx)
+	if err != nil {
+		m.log.Warn("failed to get current P-chain height",
+			zap.Error(err),
+		)
+		return 0
+	}
+	if height <= m.epoch.pChainReferenceHeight {
+		return 0
+	}
+
+	vdrs, err := m.validatorState.GetValidatorSet(ctx, height, m.subnetID)
+	if err != nil {
+		m.log.Warn("failed to get validator set",
+			zap.Uint64("height", height),
+			zap.Error(err),
+		)
+		return 0
+	}
+	if sameMembership(vdrs, m.epoch.validators) {
+		return 0
+	}
+	return height
+}
+
+// verifyNextPChainReferenceHeight verifies that the validator set at [height]
+// is known and differs from the validator set of the epoch.
+func (m *metadataStateMachine) verifyNextPChainReferenceHeight(ctx context.Context, height uint64) error {
+	if height <= m.epoch.pChainReferenceHeight {
+		return fmt.Errorf("%w: %d <= %d", errInvalidPChainHeight, height, m.epoch.pChainReferenceHeight)
+	}
+
+	currentHeight, err := m.validatorState.GetCurrentHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current P-chain height: %w", err)
+	}
+	if height > currentHeight {
+		return fmt.Errorf("%w: %d > %d", errUnknownPChainHeight, height, currentHeight)
+	}
+
+	vdrs, err := m.validatorState.GetValidatorSet(ctx, height, m.subnetID)
+	if err != nil {
+		return fmt.Errorf("failed to get validator set at height %d: %w", height, err)
+	}
+	if sameMembership(vdrs, m.epoch.validators) {
+		return fmt.Errorf("%w: %d", errValidatorSetUnchanged, height)
+	}
+	return nil
+}
@@ -0,0 +1,412 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simplex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/snow/validators/validatorstest"
+)
+
+// newTestValidatorState returns a validator state whose current height is
+// [height] and whose validator set at each height is given by [sets].
+func newTestValidatorState(height uint64, sets map[uint64][]*testNode) *validatorstest.State {
+	return &validatorstest.State{
+		GetCurrentHeightF: func(context.Context) (uint64, error) {
+			return height, nil
+		},
+		GetValidatorSetF: func(_ context.Context, height uint64, _ ids.ID) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+			return newTestValidatorInfo(sets[height]), nil
+		},
+	}
+}
+
+func TestMetadataStateMachineSampleNextPChainReferenceHeight(t *testing.T) {
+	nodes := generateTestNodes(t, 4)
+
+	tests := []struct {
+		name     string
+		current  []*testNode
+		next     []*testNode
+		expected uint64
+	}{
+		{
+			name:     "unchanged",
+			current:  nodes[:3],
+			next:     nodes[:3],
+			expected: 0,
+		},
+		{
+			name:     "validator joins",
+			current:  nodes[:3],
+			next:     nodes,
+			expected: 10,
+		},
+		{
+			name:     "validator leaves",
+			current:  nodes,
+			next:     nodes[1:],
+			expected: 10,
+		},
+		{
+			name:     "validator replaced",
+			current:  nodes[:3],
+			next:     nodes[1:],
+			expected: 10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msm := newTestMetadataStateMachine(newTestValidatorInfo(tt.current))
+			msm.validatorState = newTestValidatorState(10, map[uint64][]*testNode{
+				10: tt.next,
+			})
+
+			require.Equal(t, tt.expected, msm.sampleNextPChainReferenceHeight(t.Context()))
+		})
+	}
+}
+
+func TestMetadataStateMachineEpochTransition(t *testing.T) {
+	require := require.New(t)
+	ctx := t.Context()
+
+	nodes := generateTestNodes(t, 4)
+	genesis := newTestBlock(t, newBlockConfig{})
+	msm := genesis.blockTracker.msm
+	msm.epoch.validators = newTestValidatorInfo(nodes[:3])
+	msm.validatorState = newTestValidatorState(10, map[uint64][]*testNode{
+		10: nodes,
+	})
+
+	// The validator set change is observed.
+	info, err := msm.buildEpochInfo(ctx, genesis)
+	require.NoError(err)
+	require.Equal(uint64(10), info.NextPChainReferenceHeight)
+	require.False(info.isSealingBlock())
+	observed := newTestBlock(t, newBlockConfig{
+		prev:      genesis,
+		epochInfo: &info,
+	})
+	_, err = observed.Verify(ctx)
+	require.NoError(err)
+
+	require.Equal([]uint64{10}, msm.approvals.heights())
+
+	// The epoch isn't sealed until a quorum of the next epoch approved it.
+	info, err = msm.buildEpochInfo(ctx, observed)
+	require.NoError(err)
+	require.False(info.isSealingBlock())
+	require.Equal(uint64(10), info.NextPChainReferenceHeight)
+	require.Nil(info.NextEpochApprovals)
+
+	approveNextEpoch(t, msm, 10, nodes[:2])
+	info, err = msm.buildEpochInfo(ctx, observed)
+	require.NoError(err)
+	require.False(info.isSealingBlock())
+	require.Equal(2, numApprovers(info.NextEpochApprovals))
+	approving := newTestBlock(t, newBlockConfig{
+		prev:      observed,
+		epochInfo: &info,
+	})
+	_, err = approving.Verify(ctx)
+	require.NoError(err)
+
+	// The block that includes a quorum of approvals seals the epoch,
+	// regardless of the sampled height.
+	approveNextEpoch(t, msm, 10, nodes[3:])
+	info, err = msm.buildEpochInfo(ctx, approving)
+	require.NoError(err)
+	require.True(info.isSealingBlock())
+	require.Equal(uint64(10), info.NextPChainReferenceHeight)
+	require.Equal(approvalQuorum(len(nodes)), numApprovers(info.NextEpochApprovals))
+	vdrs, err := info.BlockValidationDescriptor.validators()
+	require.NoError(err)
+	require.True(sameMembership(newTestValidatorInfo(nodes), vdrs))
+	sealing := newTestBlock(t, newBlockConfig{
+		prev:      approving,
+		epochInfo: &info,
+	})
+	_, err = sealing.Verify(ctx)
+	require.NoError(err)
+
+	// Telocks are built until the sealing block is finalized.
+	info, err = msm.buildEpochInfo(ctx, sealing)
+	require.NoError(err)
+	require.True(info.isTelock())
+	require.Equal(sealing.metadata.Seq, info.SealingBlockSeq)
+	require.Equal(sealing.metadata.Seq, info.PrevVMBlockSeq)
+	telock := newTestBlock(t, newBlockConfig{
+		prev:      sealing,
+		epochInfo: &info,
+	})
+	require.Nil(telock.vmBlock)
+	_, err = telock.Verify(ctx)
+	require.NoError(err)
+
+	nextInfo, err := msm.buildEpochInfo(ctx, telock)
+	require.NoError(err)
+	require.True(nextInfo.equal(&info))
+
+	// Once the sealing block is finalized, the next epoch starts on top of it.
+	msm.epoch, err = newEpochState(sealing)
+	require.NoError(err)
+	info, err = msm.buildEpochInfo(ctx, sealing)
+	require.NoError(err)
+	require.True(info.equal(&simplexEpochInfo{
+		PChainReferenceHeight: 10,
+		EpochNumber:           sealing.metadata.Seq,
+		PrevSealingBlockHash:  sealing.digest,
+		PrevVMBlockSeq:        sealing.metadata.Seq,
+	}))
+
+	// Telocks of the previous epoch are no longer valid.
+	_, err = msm.buildEpochInfo(ctx, telock)
+	require.ErrorIs(err, errUnexpectedEpoch)
+}
+
+func TestMetadataStateMachineVerifyEpochInfo(t *testing.T) {
+	nodes := generateTestNodes(t, 4)
+
+	tests := []struct {
+		name          string
+		epoch         epochState
+		parentInfo    simplexEpochInfo
+		info          func(parent *Block) simplexEpochInfo
+		expectedError error
+	}{
+		{
+			name: "no validator set change",
+			epoch: epochState{
+				validators: newTestValidatorInfo(nodes[:3]),
+			},
+			info: func(parent *Block) simplexEpochInfo {
+				return simplexEpochInfo{
+					PrevVMBlockSeq: parent.metadata.Seq,
+				}
+			},
+		},
+		{
+			name: "validator set change",
+			epoch: epochState{
+				validators: newTestValidatorInfo(nodes[:3]),
+			},
+			info: func(parent *Block) simplexEpochInfo {
+				return simplexEpochInfo{
+					NextPChainReferenceHeight: 10,
+					PrevVMBlockSeq:            parent.metadata.Seq,
+				}
+			},
+		},
+		{
+			name: "next height not above reference height",
+			epoch: epochState{
+				number:                5,
+				pChainReferenceHeight: 10,
+				validators:            newTestValidatorInfo(nodes[:3]),
+			},
+			parentInfo: simplexEpochInfo{
+				PChainReferenceHeight: 10,
+				EpochNumber:           5,
+			},
+			info: func(parent *Block) simplexEpochInfo {
+				return simplexEpochInfo{
+					PChainReferenceHeight:     10,
+					EpochNumber:               5,
+					NextPChainReferenceHeight: 10,
+					PrevVMBlockSeq:            parent.metadata.Seq,
+				}
+			},
+			expectedError: errInvalidPChainHeight,
+		},
+		{
+			name: "next height unknown",
+			epoch: epochState{
+				validators: newTestValidatorInfo(nodes[:3]),
+			},
+			info: func(parent *Block) simplexEpochInfo {
+				return simplexEpochInfo{
+					NextPChainReferenceHeight: 11,
+					PrevVMBlockSeq:            parent.metadata.Seq,
+				}
+			},
+			expectedError: errUnknownPChainHeight,
+		},
+		{
+			name: "validator set unchanged",
+			epoch: epochState{
+				validators: newTestValidatorInfo(nodes),
+			},
+			info: func(parent *Block) simplexEpochInfo {
+				return simplexEpochInfo{
+					NextPChainReferenceHeight: 10,
+					PrevVMBlockSeq:            parent.metadata.Seq,
+				}
+			},
+			expectedError: errValidatorSetUnchanged,
+		},
+		{
+			name: "approvals collected",
+			epoch: epochState{
+				validators: newTestValidatorInfo(nodes[:3]),
+			},
+			parentInfo: simplexEpochInfo{
+				NextPChainReferenceHeight: 10,
+				NextEpochApprovals:        newTestNextEpochApprovals(t, 10, nodes, nodes[:1]),
+			},
+			info: func(parent *Block) simplexEpochInfo {
+				return simplexEpochInfo{
+					NextPChainReferenceHeight: 10,
+					PrevVMBlockSeq:            parent.metadata.Seq,
+					NextEpochApprovals:        newTestNextEpochApprovals(t, 10, nodes, nodes[:2]),
+				}
+			},
+		},
+		{
+			name: "sealing block",
+			epoch: epochState{
+				validators: newTestValidatorInfo(nodes[:3]),
+			},
+			parentInfo: simplexEpochInfo{
+				NextPChainReferenceHeight: 10,
+			},
+			info: func(parent *Block) simplexEpochInfo {
+				return simplexEpochInfo{
+					NextPChainReferenceHeight: 10,
+					PrevVMBlockSeq:            parent.metadata.Seq,
+					BlockValidationDescriptor: newBlockValidationDescriptor(newTestValidatorInfo(nodes)),
+					NextEpochApprovals:        newTestNextEpochApprovals(t, 10, nodes, nodes[1:]),
+				}
+			},
+		},
+		{
+			name: "sealing block with wrong validator set",
+			epoch: epochState{
+				validators: newTestValidatorInfo(nodes[:3]),
+			},
+			parentInfo: simplexEpochInfo{
+				NextPChainReferenceHeight: 10,
+			},
+			info: func(parent *Block) simplexEpochInfo {
+				return simplexEpochInfo{
+					NextPChainReferenceHeight: 10,
+					PrevVMBlockSeq:            parent.metadata.Seq,
+					BlockValidationDescriptor: newBlockValidationDescriptor(newTestValidatorInfo(nodes[1:])),
+					NextEpochApprovals:        newTestNextEpochApprovals(t, 10, nodes, nodes[1:]),
+				}
+			},
+			expectedError: errMismatchedEpochInfo,
+		},
+		{
+			name: "sealing block without a quorum of approvals",
+			epoch: epochState{
+				validators: newTestValidatorInfo(nodes[:3]),
+			},
+			parentInfo: simplexEpochInfo{
+				NextPChainReferenceHeight: 10,
+			},
+			info: func(parent *Block) simplexEpochInfo {
+				return simplexEpochInfo{
+					NextPChainReferenceHeight: 10,
+					PrevVMBlockSeq:            parent.metadata.Seq,
+					BlockValidationDescriptor: newBlockValidationDescriptor(newTestValidatorInfo(nodes)),
+					NextEpochApprovals:        newTestNextEpochApprovals(t, 10, nodes, nodes[2:]),
+				}
+			},
+			expectedError: errMismatchedEpochInfo,
+		},
+		{
+			name: "missing sealing block",
+			epoch: epochState{
+				validators: newTestValidatorInfo(nodes[:3]),
+			},
+			parentInfo: simplexEpochInfo{
+				NextPChainReferenceHeight: 10,
+			},
+			info: func(parent *Block) simplexEpochInfo {
+				return simplexEpochInfo{
+					NextPChainReferenceHeight: 10,
+					PrevVMBlockSeq:            parent.metadata.Seq,
+					NextEpochApprovals:        newTestNextEpochApprovals(t, 10, nodes, nodes[1:]),
+				}
+			},
+			expectedError: errMismatchedEpochInfo,
+		},
+		{
+			name: "approvals removed",
+			epoch: epochState{
+				validators: newTestValidatorInfo(nodes[:3]),
+			},
+			parentInfo: simplexEpochInfo{
+				NextPChainReferenceHeight: 10,
+				NextEpochApprovals:        newTestNextEpochApprovals(t, 10, nodes, nodes[:1]),
+			},
+			info: func(parent *Block) simplexEpochInfo {
+				return simplexEpochInfo{
+					NextPChainReferenceHeight: 10,
+					PrevVMBlockSeq:            parent.metadata.Seq,
+					NextEpochApprovals:        newTestNextEpochApprovals(t, 10, nodes, nodes[1:2]),
+				}
+			},
+			expectedError: errApprovalsRemoved,
+		},
+		{
+			name: "approvals of another height",
+			epoch: epochState{
+				validators: newTestValidatorInfo(nodes[:3]),
+			},
+			parentInfo: simplexEpochInfo{
+				NextPChainReferenceHeight: 10,
+			},
+			info: func(parent *Block) simplexEpochInfo {
+				return simplexEpochInfo{
+					NextPChainReferenceHeight: 10,
+					PrevVMBlockSeq:            parent.metadata.Seq,
+					NextEpochApprovals:        newTestNextEpochApprovals(t, 9, nodes, nodes[:1]),
+				}
+			},
+			expectedError: errSignatureVerificationFailed,
+		},
+		{
+			name: "parent in a different epoch",
+			epoch: epochState{
+				validators: newTestValidatorInfo(nodes[:3]),
+			},
+			parentInfo: simplexEpochInfo{
+				EpochNumber: 5,
+			},
+			info: func(parent *Block) simplexEpochInfo {
+				return simplexEpochInfo{
+					EpochNumber:    5,
+					PrevVMBlockSeq: parent.metadata.Seq,
+				}
+			},
+			expectedError: errUnexpectedEpoch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			genesis := newTestBlock(t, newBlockConfig{})
+			parent := newTestBlock(t, newBlockConfig{
+				prev:      genesis,
+				epochInfo: &tt.parentInfo,
+			})
+
+			msm := newTestMetadataStateMachine(nil)
+			msm.epoch = tt.epoch
+			msm.validatorState = newTestValidatorState(10, map[uint64][]*testNode{
+				10: nodes,
+			})
+
+			info := tt.info(parent)
+			err := msm.verifyEpochInfo(t.Context(), parent, &info)
+			require.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
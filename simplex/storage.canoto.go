@@ -29,9 +29,11 @@ var (
 const (
 	canoto__canotoFinalization__Finalization = 1
 	canoto__canotoFinalization__QC           = 2
+	canoto__canotoFinalization__EpochInfo    = 3
 
 	canoto__canotoFinalization__Finalization__tag = "\x0a" // canoto.Tag(canoto__canotoFinalization__Finalization, canoto.Len)
 	canoto__canotoFinalization__QC__tag           = "\x12" // canoto.Tag(canoto__canotoFinalization__QC, canoto.Len)
+	canoto__canotoFinalization__EpochInfo__tag    = "\x1a" // canoto.Tag(canoto__canotoFinalization__EpochInfo, canoto.Len)
 )
 
 type canotoData_canotoFinalization struct {
@@ -39,7 +41,9 @@ type canotoData_canotoFinalization struct {
 }
 
 // CanotoSpec returns the specification of this canoto message.
-func (*canotoFinalization) CanotoSpec(...reflect.Type) *canoto.Spec {
+func (*canotoFinalization) CanotoSpec(types ...reflect.Type) *canoto.Spec {
+	types = append(types, reflect.TypeOf(canotoFinalization{}))
+	var zero canotoFinalization
 	s := &canoto.Spec{
 		Name: "canotoFinalization",
 		Fields: []canoto.FieldType{
@@ -55,6 +59,15 @@ func (*canotoFinalization) CanotoSpec(...reflect.Type) *canoto.Spec {
 				OneOf:       "",
 				TypeBytes:   true,
 			},
+			canoto.FieldTypeFromField(
+				/*type inference:*/ (&zero.EpochInfo),
+				/*FieldNumber:   */ canoto__canotoFinalization__EpochInfo,
+				/*Name:          */ "EpochInfo",
+				/*FixedLength:   */ 0,
+				/*Repeated:      */ false,
+				/*OneOf:         */ "",
+				/*types:         */ types,
+			),
 		},
 	}
 	s.CalculateCanotoCache()
@@ -120,6 +133,30 @@ func (c *canotoFinalization) UnmarshalCanotoFrom(r canoto.Reader) error {
 			if len(c.QC) == 0 {
 				return canoto.ErrZeroValue
 			}
+		case canoto__canotoFinalization__EpochInfo:
+			if wireType != canoto.Len {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			// Read the bytes for the field.
+			originalUnsafe := r.Unsafe
+			r.Unsafe = true
+			var msgBytes []byte
+			if err := canoto.ReadBytes(&r, &msgBytes); err != nil {
+				return err
+			}
+			if len(msgBytes) == 0 {
+				return canoto.ErrZeroValue
+			}
+			r.Unsafe = originalUnsafe
+
+			// Unmarshal the field from the bytes.
+			remainingBytes := r.B
+			r.B = msgBytes
+			if err := (&c.EpochInfo).UnmarshalCanotoFrom(r); err != nil {
+				return err
+			}
+			r.B = remainingBytes
 		default:
 			return canoto.ErrUnknownField
 		}
@@ -140,6 +177,9 @@ func (c *canotoFinalization) ValidCanoto() bool {
 	if c == nil {
 		return true
 	}
+	if !(&c.EpochInfo).ValidCanoto() {
+		return false
+	}
 	return true
 }
 
@@ -158,6 +198,10 @@ func (c *canotoFinalization) CalculateCanotoCache() {
 	if len(c.QC) != 0 {
 		size += uint64(len(canoto__canotoFinalization__QC__tag)) + canoto.SizeBytes(c.QC)
 	}
+	(&c.EpochInfo).CalculateCanotoCache()
+	if fieldSize := (&c.EpochInfo).CachedCanotoSize(); fieldSize != 0 {
+		size += uint64(len(canoto__canotoFinalization__EpochInfo__tag)) + canoto.SizeUint(fieldSize) + fieldSize
+	}
 	atomic.StoreUint64(&c.canotoData.size, size)
 }
 
@@ -210,5 +254,10 @@ func (c *canotoFinalization) MarshalCanotoInto(w canoto.Writer) canoto.Writer {
 		canoto.Append(&w, canoto__canotoFinalization__QC__tag)
 		canoto.AppendBytes(&w, c.QC)
 	}
+	if fieldSize := (&c.EpochInfo).CachedCanotoSize(); fieldSize != 0 {
+		canoto.Append(&w, canoto__canotoFinalization__EpochInfo__tag)
+		canoto.AppendUint(&w, fieldSize)
+		w = (&c.EpochInfo).MarshalCanotoInto(w)
+	}
 	return w
 }
@@ -10,12 +10,14 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 
 	"github.com/ava-labs/simplex"
 	"go.uber.org/zap"
 
 	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/utils/logging"
@@ -33,6 +35,8 @@ var (
 	errUnexpectedSeq    = errors.New("unexpected sequence number")
 	errInvalidQC        = errors.New("invalid quorum certificate")
 	errMismatchedDigest = errors.New("mismatched digest in finalization")
+	errUnexpectedBlock  = errors.New("unexpected block type")
+	errNotSealingBlock  = errors.New("block is not a sealing block")
 )
 
 type Storage struct {
@@ -48,8 +52,22 @@ type Storage struct {
 	// lastIndexed is the last indexed block digest.
 	lastIndexedDigest simplex.Digest
 
-	// deserializer is used to deserialize quorum certificates from bytes.
-	deserializer *QCDeserializer
+	// networkID and chainID are used to verify the quorum certificates of
+	// stored finalizations.
+	networkID uint32
+	chainID   ids.ID
+
+	// genesisEpoch is the first epoch.
+	genesisEpoch epochState
+
+	// verifiers caches the verifiers of the epochs that indexed blocks belong
+	// to, so that the quorum certificates of historical blocks can be
+	// deserialized.
+	verifiersLock sync.Mutex
+	verifiers     map[uint64]*BLSVerifier
+
+	// sealed is signaled once the sealing block of an epoch is indexed.
+	sealed chan struct{}
 
 	// blockTracker is used to manage blocks that have been indexed.
 	blockTracker *blockTracker
@@ -62,7 +80,7 @@ type Storage struct {
 // newStorage creates a new prefixed database to store
 // finalizations according to their sequence numbers.
 // The VM is assumed to be initialized before calling this function.
-func newStorage(ctx context.Context, config *Config, qcDeserializer *QCDeserializer, blockTracker *blockTracker) (*Storage, error) {
+func newStorage(ctx context.Context, config *Config, blockTracker *blockTracker) (*Storage, error) {
 	genesisBlock, err := getGenesisBlock(ctx, config, blockTracker)
 	if err != nil {
 		return nil, err
@@ -71,8 +89,15 @@ func newStorage(ctx context.Context, config *Config, qcDeserializer *QCDeseriali
 	s := &Storage{
 		db:           config.DB,
 		genesisBlock: genesisBlock,
+		networkID:    config.Ctx.NetworkID,
+		chainID:      config.Ctx.ChainID,
+		genesisEpoch: epochState{
+			pChainReferenceHeight: config.GenesisPChainHeight,
+			validators:            config.Validators,
+		},
+		verifiers:    make(map[uint64]*BLSVerifier),
+		sealed:       make(chan struct{}, 1),
 		vm:           config.VM,
-		deserializer: qcDeserializer,
 		blockTracker: blockTracker,
 		log:          config.Log,
 	}
@@ -118,12 +143,23 @@ func (s *Storage) Retrieve(seq uint64) (simplex.VerifiedBlock, simplex.Finalizat
 		return nil, simplex.Finalization{}, err
 	}
 
-	finalization, err := s.retrieveFinalization(seq)
+	record, err := s.retrieveRecord(seq)
+	if err != nil {
+		return nil, simplex.Finalization{}, err
+	}
+
+	// The quorum certificate must be deserialized with the validator set of
+	// the epoch that the block belongs to.
+	verifier, err := s.verifier(record.EpochInfo.EpochNumber)
+	if err != nil {
+		return nil, simplex.Finalization{}, err
+	}
+	finalization, err := record.toFinalization(&QCDeserializer{verifier: verifier})
 	if err != nil {
 		return nil, simplex.Finalization{}, err
 	}
 
-	vb, err := newBlock(finalization.Finalization.ProtocolMetadata, block, s.blockTracker)
+	vb, err := newBlock(finalization.Finalization.ProtocolMetadata, record.EpochInfo, block, s.blockTracker)
 	if err != nil {
 		s.log.Error("failed to create simplex block", zap.Uint64("seq", seq), zap.Error(err))
 		return nil, simplex.Finalization{}, err
@@ -134,7 +170,22 @@ func (s *Storage) Retrieve(seq uint64) (simplex.VerifiedBlock, simplex.Finalizat
 
 // Index indexes the finalization in the storage.
 // It stores the finalization bytes and increments numBlocks.
+//
+// Telocks are not indexed, as their sequence numbers are reused by the next
+// epoch. If the block seals its epoch, [Storage.sealed] is signaled.
 func (s *Storage) Index(ctx context.Context, block simplex.VerifiedBlock, finalization simplex.Finalization) error {
+	b, ok := block.(*Block)
+	if !ok {
+		return fmt.Errorf("%w: %T", errUnexpectedBlock, block)
+	}
+	if b.epochInfo.isTelock() {
+		s.log.Debug("Skipping indexing of telock",
+			zap.Uint64("seq", b.metadata.Seq),
+			zap.Uint64("sealingBlockSeq", b.epochInfo.SealingBlockSeq),
+		)
+		return nil
+	}
+
 	bh := block.BlockHeader()
 	numBlocks := s.numBlocks.Load()
 	if numBlocks != bh.Seq {
@@ -164,7 +215,7 @@ func (s *Storage) Index(ctx context.Context, block simplex.VerifiedBlock, finali
 		return errInvalidQC
 	}
 
-	finalizationBytes := finalizationToBytes(finalization)
+	finalizationBytes := finalizationToBytes(finalization, b.epochInfo)
 	if err := s.db.Put(finalizationKey(bh.Seq), finalizationBytes); err != nil {
 		return fmt.Errorf("failed to store finalization: %w", err)
 	}
@@ -176,9 +227,92 @@ func (s *Storage) Index(ctx context.Context, block simplex.VerifiedBlock, finali
 
 	s.numBlocks.Add(1) // only increment numBlocks after successful indexing
 	s.lastIndexedDigest = bh.Digest
+
+	if b.epochInfo.isSealingBlock() {
+		s.log.Info("Indexed sealing block",
+			zap.Uint64("seq", bh.Seq),
+			zap.Uint64("epoch", b.epochInfo.EpochNumber),
+			zap.Uint64("nextPChainReferenceHeight", b.epochInfo.NextPChainReferenceHeight),
+		)
+		select {
+		case s.sealed <- struct{}{}:
+		default:
+		}
+	}
 	return nil
 }
 
+// currentEpoch returns the epoch that the next block to be indexed belongs to.
+func (s *Storage) currentEpoch() (epochState, error) {
+	lastSeq := s.numBlocks.Load() - 1
+	if lastSeq == 0 {
+		return s.epoch(0)
+	}
+
+	record, err := s.retrieveRecord(lastSeq)
+	if err != nil {
+		return epochState{}, err
+	}
+	if record.EpochInfo.isSealingBlock() {
+		return s.epoch(lastSeq)
+	}
+	return s.epoch(record.EpochInfo.EpochNumber)
+}
+
+// epoch returns the state of the epoch numbered [number].
+//
+// The validator set of every epoch after the first is described by the sealing
+// block of the previous epoch, so the blocks of all epochs can be validated
+// without access to the P-chain.
+func (s *Storage) epoch(number uint64) (epochState, error) {
+	if number == 0 {
+		return s.genesisEpoch, nil
+	}
+
+	record, err := s.retrieveRecord(number)
+	if err != nil {
+		return epochState{}, fmt.Errorf("failed to retrieve sealing block %d: %w", number, err)
+	}
+	if !record.EpochInfo.isSealingBlock() {
+		return epochState{}, fmt.Errorf("%w: %d", errNotSealingBlock, number)
+	}
+
+	var finalization simplex.ToBeSignedFinalization
+	if err := finalization.FromBytes(record.Finalization); err != nil {
+		return epochState{}, err
+	}
+
+	vdrs, err := record.EpochInfo.BlockValidationDescriptor.validators()
+	if err != nil {
+		return epochState{}, err
+	}
+	return epochState{
+		number:                number,
+		pChainReferenceHeight: record.EpochInfo.NextPChainReferenceHeight,
+		prevSealingBlockHash:  finalization.Digest,
+		validators:            vdrs,
+	}, nil
+}
+
+// verifier returns the verifier of the quorum certificates of the epoch
+// numbered [number].
+func (s *Storage) verifier(number uint64) (*BLSVerifier, error) {
+	s.verifiersLock.Lock()
+	defer s.verifiersLock.Unlock()
+
+	if verifier, ok := s.verifiers[number]; ok {
+		return verifier, nil
+	}
+
+	epoch, err := s.epoch(number)
+	if err != nil {
+		return nil, err
+	}
+	verifier := newBLSVerifier(s.networkID, s.chainID, epoch.validators)
+	s.verifiers[number] = &verifier
+	return &verifier, nil
+}
+
 func finalizationKey(seq uint64) []byte {
 	seqBuff := make([]byte, 8)
 	binary.BigEndian.PutUint64(seqBuff, seq)
@@ -208,24 +342,23 @@ func getGenesisBlock(ctx context.Context, config *Config, blockTracker *blockTra
 	return genesis, nil
 }
 
-// retrieveFinalization retrieves the finalization at [seq].
-// If the finalization is not found, it returns false.
-func (s *Storage) retrieveFinalization(seq uint64) (simplex.Finalization, error) {
+// retrieveRecord retrieves the finalization record at [seq].
+// If the record is not found, it returns simplex.ErrBlockNotFound.
+func (s *Storage) retrieveRecord(seq uint64) (*canotoFinalization, error) {
 	finalizationBytes, err := s.db.Get(finalizationKey(seq))
 	if err != nil {
 		if err == database.ErrNotFound {
-			return simplex.Finalization{}, simplex.ErrBlockNotFound
+			return nil, simplex.ErrBlockNotFound
 		}
 		s.log.Debug("Failed to retrieve finalization", zap.Uint64("seq", seq), zap.Error(err))
-		return simplex.Finalization{}, err
+		return nil, err
 	}
 
-	var canotoFinalization canotoFinalization
-	if err := canotoFinalization.UnmarshalCanoto(finalizationBytes); err != nil {
-		return simplex.Finalization{}, err
+	var record canotoFinalization
+	if err := record.UnmarshalCanoto(finalizationBytes); err != nil {
+		return nil, err
 	}
-
-	return canotoFinalization.toFinalization(s.deserializer)
+	return &record, nil
 }
 
 func getBlock(ctx context.Context, vm block.ChainVM, height uint64) (snowman.Block, error) {
@@ -237,18 +370,21 @@ func getBlock(ctx context.Context, vm block.ChainVM, height uint64) (snowman.Blo
 	return vm.GetBlock(ctx, id)
 }
 
-// finalizationToBytes serializes the simplex.Finalization into bytes.
-func finalizationToBytes(finalization simplex.Finalization) []byte {
+// finalizationToBytes serializes the simplex.Finalization and the epoch info
+// of the finalized block into bytes.
+func finalizationToBytes(finalization simplex.Finalization, epochInfo simplexEpochInfo) []byte {
 	cFinalization := canotoFinalization{
 		Finalization: finalization.Finalization.Bytes(),
 		QC:           finalization.QC.Bytes(),
+		EpochInfo:    epochInfo,
 	}
 	return cFinalization.MarshalCanoto()
 }
 
 type canotoFinalization struct {
-	Finalization []byte `canoto:"bytes,1"`
-	QC           []byte `canoto:"bytes,2"`
+	Finalization []byte           `canoto:"bytes,1"`
+	QC           []byte           `canoto:"bytes,2"`
+	EpochInfo    simplexEpochInfo `canoto:"value,3"`
 
 	canotoData canotoData_canotoFinalization
 }
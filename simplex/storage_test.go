@@ -4,6 +4,7 @@
 package simplex
 
 import (
+	"context"
 	"testing"
 
 	"github.com/ava-labs/simplex"
@@ -11,9 +12,12 @@ import (
 
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmantest"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/snow/validators/validatorstest"
 )
 
 func TestStorageNew(t *testing.T) {
@@ -46,7 +50,7 @@ func TestStorageNew(t *testing.T) {
 						Seq:   1,
 					},
 				})
-				require.NoError(t, db.Put(finalizationKey(1), finalizationToBytes(finalization)))
+				require.NoError(t, db.Put(finalizationKey(1), finalizationToBytes(finalization, simplexEpochInfo{})))
 				return db
 			}(),
 			expectedBlocks: 2,
@@ -56,14 +60,9 @@ func TestStorageNew(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			config := newEngineConfig(t, 1)
-			_, verifier := NewBLSAuth(config)
-			qc := QCDeserializer{
-				verifier: &verifier,
-			}
-
 			config.VM = tt.vm
 			config.DB = tt.db
-			s, err := newStorage(ctx, config, &qc, nil)
+			s, err := newStorage(ctx, config, nil)
 			require.NoError(t, err)
 			require.Equal(t, tt.expectedBlocks, s.NumBlocks())
 		})
@@ -79,11 +78,6 @@ func TestStorageRetrieve(t *testing.T) {
 	ctx := t.Context()
 	config := newEngineConfig(t, 4)
 	config.VM = vm
-	_, verifier := NewBLSAuth(config)
-	qc := QCDeserializer{
-		verifier: &verifier,
-	}
-
 	tests := []struct {
 		name                 string
 		seq                  uint64
@@ -111,7 +105,7 @@ func TestStorageRetrieve(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s, err := newStorage(ctx, config, &qc, genesis.blockTracker)
+			s, err := newStorage(ctx, config, genesis.blockTracker)
 			require.NoError(t, err)
 
 			block, finalization, err := s.Retrieve(tt.seq)
@@ -137,11 +131,6 @@ func TestStorageIndexFails(t *testing.T) {
 	configs := newNetworkConfigs(t, 4)
 	configs[0].VM = genesis.vmBlock.(*wrappedBlock).vm
 
-	_, verifier := NewBLSAuth(configs[0])
-	qc := QCDeserializer{
-		verifier: &verifier,
-	}
-
 	tests := []struct {
 		name          string
 		expectedError error
@@ -191,7 +180,7 @@ func TestStorageIndexFails(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s, err := newStorage(ctx, configs[0], &qc, genesis.blockTracker)
+			s, err := newStorage(ctx, configs[0], genesis.blockTracker)
 			require.NoError(t, err)
 
 			err = s.Index(ctx, tt.block, tt.finalization)
@@ -223,12 +212,7 @@ func TestIndexMismatchedChild(t *testing.T) {
 	configs := newNetworkConfigs(t, 4)
 	configs[0].VM = genesis.vmBlock.(*wrappedBlock).vm
 
-	_, verifier := NewBLSAuth(configs[0])
-	qc := QCDeserializer{
-		verifier: &verifier,
-	}
-
-	s, err := newStorage(ctx, configs[0], &qc, genesis.blockTracker)
+	s, err := newStorage(ctx, configs[0], genesis.blockTracker)
 	require.NoError(t, err)
 
 	_, err = child1.Verify(ctx)
@@ -253,11 +237,9 @@ func TestStorageIndexSuccess(t *testing.T) {
 	genesis := newTestBlock(t, newBlockConfig{})
 	configs := newNetworkConfigs(t, 4)
 
-	_, verifier := NewBLSAuth(configs[0])
-	qc := QCDeserializer{verifier: &verifier}
 	configs[0].VM = genesis.vmBlock.(*wrappedBlock).vm
 
-	s, err := newStorage(ctx, configs[0], &qc, genesis.blockTracker)
+	s, err := newStorage(ctx, configs[0], genesis.blockTracker)
 	require.NoError(t, err)
 
 	numBlocks := 10
@@ -301,3 +283,156 @@ func TestStorageIndexSuccess(t *testing.T) {
 
 	require.Equal(t, uint64(numBlocks+1), s.NumBlocks())
 }
+
+// TestStorageAcrossEpochs indexes blocks across epochs where a validator joins
+// and then another validator leaves, and verifies that the historical blocks
+// can be retrieved and their quorum certificates verified after a restart.
+func TestStorageAcrossEpochs(t *testing.T) {
+	require := require.New(t)
+	ctx := t.Context()
+
+	var (
+		chainID = ids.GenerateTestID()
+		nodes   = generateTestNodes(t, 5)
+
+		genesisNodes = nodes[:4]
+		joinedNodes  = nodes     // nodes[4] joins
+		leftNodes    = nodes[1:] // nodes[0] leaves
+
+		genesisConfigs = newTestConfigs(chainID, genesisNodes)
+		joinedConfigs  = newTestConfigs(chainID, joinedNodes)
+		leftConfigs    = newTestConfigs(chainID, leftNodes)
+
+		joinHeight   uint64 = 10
+		leaveHeight  uint64 = 20
+		pChainHeight        = joinHeight
+	)
+	validatorState := &validatorstest.State{
+		GetCurrentHeightF: func(context.Context) (uint64, error) {
+			return pChainHeight, nil
+		},
+		GetValidatorSetF: func(_ context.Context, height uint64, _ ids.ID) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+			if height < leaveHeight {
+				return newTestValidatorInfo(joinedNodes), nil
+			}
+			return newTestValidatorInfo(leftNodes), nil
+		},
+	}
+
+	genesis := newTestBlock(t, newBlockConfig{})
+	msm := genesis.blockTracker.msm
+	msm.validatorState = validatorState
+	msm.epoch.validators = newTestValidatorInfo(genesisNodes)
+
+	config := genesisConfigs[0]
+	config.VM = genesis.vmBlock.(*wrappedBlock).vm
+	s, err := newStorage(ctx, config, genesis.blockTracker)
+	require.NoError(err)
+
+	var (
+		blocks        = []*Block{genesis}
+		finalizations = []simplex.Finalization{{}}
+	)
+	// buildAndIndex builds a block on top of the last block, using the epoch
+	// info that the block builder would, and indexes it.
+	buildAndIndex := func(configs []*Config) *Block {
+		prev := blocks[len(blocks)-1]
+		epochInfo, err := msm.buildEpochInfo(ctx, prev)
+		require.NoError(err)
+
+		block := newTestBlock(t, newBlockConfig{
+			prev:      prev,
+			epochInfo: &epochInfo,
+		})
+		_, err = block.Verify(ctx)
+		require.NoError(err)
+
+		finalization := newTestFinalization(t, configs, block.BlockHeader())
+		require.NoError(s.Index(ctx, block, finalization))
+
+		blocks = append(blocks, block)
+		finalizations = append(finalizations, finalization)
+		return block
+	}
+	// startNextEpoch switches to the epoch that was just sealed, as the engine
+	// would.
+	startNextEpoch := func(expectedNumber uint64) {
+		select {
+		case <-s.sealed:
+		default:
+			require.FailNow("epoch should have been sealed")
+		}
+
+		epoch, err := s.currentEpoch()
+		require.NoError(err)
+		require.Equal(expectedNumber, epoch.number)
+		msm.epoch = epoch
+	}
+
+	// A validator joins.
+	observed := buildAndIndex(genesisConfigs)
+	require.Equal(joinHeight, observed.epochInfo.NextPChainReferenceHeight)
+
+	approveNextEpoch(t, msm, joinHeight, joinedNodes)
+	sealing := buildAndIndex(genesisConfigs)
+	require.True(sealing.epochInfo.isSealingBlock())
+
+	// Telocks are built on top of the sealing block until it is finalized, but
+	// are never indexed.
+	telock := newTestBlock(t, newBlockConfig{prev: sealing})
+	require.True(telock.epochInfo.isTelock())
+	_, err = telock.Verify(ctx)
+	require.NoError(err)
+	require.NoError(s.Index(ctx, telock, newTestFinalization(t, genesisConfigs, telock.BlockHeader())))
+	require.Equal(uint64(3), s.NumBlocks())
+
+	startNextEpoch(sealing.metadata.Seq)
+	first := buildAndIndex(joinedConfigs)
+	require.Equal(sealing.metadata.Seq, first.epochInfo.EpochNumber)
+	require.Equal(joinHeight, first.epochInfo.PChainReferenceHeight)
+	require.Equal(sealing.digest, simplex.Digest(first.epochInfo.PrevSealingBlockHash))
+
+	// A validator leaves.
+	pChainHeight = leaveHeight
+	observed = buildAndIndex(joinedConfigs)
+	require.Equal(leaveHeight, observed.epochInfo.NextPChainReferenceHeight)
+
+	// The epoch isn't sealed until a quorum of the next epoch approved it.
+	approveNextEpoch(t, msm, leaveHeight, leftNodes[:1])
+	approving := buildAndIndex(joinedConfigs)
+	require.False(approving.epochInfo.isSealingBlock())
+
+	approveNextEpoch(t, msm, leaveHeight, leftNodes[1:])
+	sealing = buildAndIndex(joinedConfigs)
+	require.True(sealing.epochInfo.isSealingBlock())
+
+	startNextEpoch(sealing.metadata.Seq)
+	buildAndIndex(leftConfigs)
+
+	// After a restart, the historical blocks must be retrievable with the
+	// quorum certificates of their epochs.
+	s, err = newStorage(ctx, config, genesis.blockTracker)
+	require.NoError(err)
+	require.Equal(uint64(len(blocks)), s.NumBlocks())
+
+	epoch, err := s.currentEpoch()
+	require.NoError(err)
+	require.Equal(sealing.metadata.Seq, epoch.number)
+	require.Equal(leaveHeight, epoch.pChainReferenceHeight)
+	require.True(sameMembership(newTestValidatorInfo(leftNodes), epoch.validators))
+
+	for i := 1; i < len(blocks); i++ {
+		gotBlock, gotFin, err := s.Retrieve(uint64(i))
+		require.NoError(err)
+
+		expectedBytes, err := blocks[i].Bytes()
+		require.NoError(err)
+		gotBytes, err := gotBlock.Bytes()
+		require.NoError(err)
+		require.Equal(expectedBytes, gotBytes)
+
+		require.Equal(finalizations[i].Finalization, gotFin.Finalization)
+		require.Equal(finalizations[i].QC.Signers(), gotFin.QC.Signers())
+		require.NoError(gotFin.Verify())
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/ava-labs/simplex"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ava-labs/avalanchego/database"
@@ -17,6 +18,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmantest"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block/blocktest"
 	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/snow/validators/validatorstest"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
 	"github.com/ava-labs/avalanchego/utils/logging"
@@ -27,6 +29,9 @@ type newBlockConfig struct {
 	prev *Block
 	// If round is 0, it will be set to one higher than the prev's round
 	round uint64
+	// If epochInfo is nil, it will be set to the epoch info of a block built on
+	// top of prev that doesn't observe a validator set change
+	epochInfo *simplexEpochInfo
 }
 
 func newTestBlock(t *testing.T, config newBlockConfig) *Block {
@@ -44,25 +49,46 @@ func newTestBlock(t *testing.T, config newBlockConfig) *Block {
 		digest := computeDigest(bytes)
 		block.digest = digest
 
-		block.blockTracker = newBlockTracker(block)
+		block.blockTracker = newBlockTracker(block, newTestMetadataStateMachine(nil))
 		return block
 	}
 	if config.round == 0 {
 		config.round = config.prev.metadata.Round + 1
 	}
 
-	vmBlock := snowmantest.BuildChild(config.prev.vmBlock.(*wrappedBlock).Block)
+	if config.epochInfo == nil {
+		epochInfo, err := config.prev.blockTracker.msm.epochInfo(
+			t.Context(),
+			config.prev,
+			func() (uint64, error) {
+				return 0, nil
+			},
+			func(_ *blockValidationDescriptor, _ uint64, parentApprovals *nextEpochApprovals) (*nextEpochApprovals, error) {
+				return parentApprovals, nil
+			},
+		)
+		require.NoError(t, err)
+		config.epochInfo = &epochInfo
+	}
+
+	// Telocks don't wrap a VM block
+	var vmBlock snowman.Block
+	if !config.epochInfo.isTelock() {
+		prevVMBlock := config.prev.vmBlock.(*wrappedBlock)
+		vmBlock = &wrappedBlock{
+			Block: snowmantest.BuildChild(prevVMBlock.Block),
+			vm:    prevVMBlock.vm,
+		}
+	}
 	block := &Block{
-		vmBlock: &wrappedBlock{
-			Block: vmBlock,
-			vm:    config.prev.vmBlock.(*wrappedBlock).vm,
-		},
+		vmBlock:      vmBlock,
+		epochInfo:    *config.epochInfo,
 		blockTracker: config.prev.blockTracker,
 		metadata: simplex.ProtocolMetadata{
 			Version: 1,
 			Epoch:   1,
 			Round:   config.round,
-			Seq:     vmBlock.Height(),
+			Seq:     config.prev.metadata.Seq + 1,
 			Prev:    config.prev.digest,
 		},
 	}
@@ -75,6 +101,50 @@ func newTestBlock(t *testing.T, config newBlockConfig) *Block {
 	return block
 }
 
+// newTestMetadataStateMachine returns a metadataStateMachine for the first
+// epoch, whose validator set is [vdrs]. Queries of the P-chain fail unless the
+// validatorState is replaced.
+func newTestMetadataStateMachine(vdrs map[ids.NodeID]*validators.GetValidatorOutput) *metadataStateMachine {
+	return &metadataStateMachine{
+		log:            logging.NoLog{},
+		validatorState: &validatorstest.State{},
+		epoch: epochState{
+			validators: vdrs,
+		},
+	}
+}
+
+// approveNextEpoch adds the approvals by [nodes] of the next epoch at [height]
+// to [msm].
+func approveNextEpoch(t *testing.T, msm *metadataStateMachine, height uint64, nodes []*testNode) {
+	msm.approvals.observe(height)
+	for _, node := range nodes {
+		signer := BLSSigner{
+			chainID:   msm.chainID,
+			networkID: msm.networkID,
+			signBLS:   node.signFunc,
+		}
+		sig, err := signer.Sign(approvalMessage(height))
+		require.NoError(t, err)
+		require.NoError(t, msm.addApproval(t.Context(), node.validator.NodeID, height, sig))
+	}
+}
+
+// newTestNextEpochApprovals returns the approvals by [approvers] of the next
+// epoch at [height], whose validator set is [members].
+func newTestNextEpochApprovals(t *testing.T, height uint64, members []*testNode, approvers []*testNode) *nextEpochApprovals {
+	msm := newTestMetadataStateMachine(nil)
+	msm.validatorState = newTestValidatorState(height, map[uint64][]*testNode{
+		height: members,
+	})
+	approveNextEpoch(t, msm, height, approvers)
+
+	descriptor := newBlockValidationDescriptor(newTestValidatorInfo(members))
+	approvals, err := msm.buildNextEpochApprovals(descriptor, height, nil)
+	require.NoError(t, err)
+	return approvals
+}
+
 func newTestValidatorInfo(allNodes []*testNode) map[ids.NodeID]*validators.GetValidatorOutput {
 	vds := make(map[ids.NodeID]*validators.GetValidatorOutput, len(allNodes))
 	for _, node := range allNodes {
@@ -98,11 +168,13 @@ type testNode struct {
 func newNetworkConfigs(t *testing.T, numNodes uint64) []*Config {
 	require.Positive(t, numNodes)
 
-	chainID := ids.GenerateTestID()
-
-	testNodes := generateTestNodes(t, numNodes)
+	return newTestConfigs(ids.GenerateTestID(), generateTestNodes(t, numNodes))
+}
 
-	configs := make([]*Config, 0, numNodes)
+// newTestConfigs creates a Config for each of the provided nodes, with
+// validator set [testNodes].
+func newTestConfigs(chainID ids.ID, testNodes []*testNode) []*Config {
+	configs := make([]*Config, 0, len(testNodes))
 	for _, node := range testNodes {
 		config := &Config{
 			Ctx: SimplexChainContext{
@@ -114,6 +186,8 @@ func newNetworkConfigs(t *testing.T, numNodes uint64) []*Config {
 			Validators: newTestValidatorInfo(testNodes),
 			SignBLS:    node.signFunc,
 			DB:         memdb.New(),
+			EvidenceDB: memdb.New(),
+			Registerer: prometheus.NewRegistry(),
 		}
 		configs = append(configs, config)
 	}
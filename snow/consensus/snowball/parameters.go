@@ -46,6 +46,13 @@ var (
 		MaxItemProcessingTime: 30 * time.Second,
 	}
 
+	DefaultAdaptiveParameters = AdaptiveParameters{
+		MinConcurrentRepolls: 1,
+		MaxConcurrentRepolls: 20,
+		TargetPollLatency:    500 * time.Millisecond,
+		MaxPollFailureRate:   .5,
+	}
+
 	ErrParametersInvalid = errors.New("parameters invalid")
 )
 
@@ -76,6 +83,31 @@ type Parameters struct {
 	// Reports unhealthy if there is an item processing for longer than this
 	// duration.
 	MaxItemProcessingTime time.Duration `json:"maxItemProcessingTime" yaml:"maxItemProcessingTime"`
+
+	// Adaptive, if provided, allows the engine to tune the number of
+	// concurrent repolls and the network timeout from the observed poll
+	// latencies and failure rates.
+	Adaptive *AdaptiveParameters `json:"adaptive,omitempty" yaml:"adaptive,omitempty"`
+}
+
+// AdaptiveParameters bound the values the engine is allowed to tune.
+type AdaptiveParameters struct {
+	// MinConcurrentRepolls is the fewest outstanding polls the engine will
+	// target while polls are failing.
+	MinConcurrentRepolls int `json:"minConcurrentRepolls" yaml:"minConcurrentRepolls"`
+	// MaxConcurrentRepolls is the most outstanding polls the engine will
+	// target while polls are slow.
+	MaxConcurrentRepolls int `json:"maxConcurrentRepolls" yaml:"maxConcurrentRepolls"`
+	// TargetPollLatency is the poll latency above which the engine issues
+	// additional concurrent polls.
+	TargetPollLatency time.Duration `json:"targetPollLatency" yaml:"targetPollLatency"`
+	// MaxPollFailureRate is the fraction of failed query responses above
+	// which the engine issues fewer concurrent polls.
+	MaxPollFailureRate float64 `json:"maxPollFailureRate" yaml:"maxPollFailureRate"`
+	// MaxNetworkTimeout is the most the engine may raise the maximum network
+	// timeout to while polls are failing. If 0, the network timeout isn't
+	// tuned.
+	MaxNetworkTimeout time.Duration `json:"maxNetworkTimeout" yaml:"maxNetworkTimeout"`
 }
 
 // Verify returns nil if the parameters describe a valid initialization.
@@ -88,6 +120,13 @@ type Parameters struct {
 // - 0 < MaxOutstandingItems
 // - 0 < MaxItemProcessingTime
 //
+// If Adaptive is provided, the following conditions must also be met:
+//
+// - 0 < MinConcurrentRepolls <= ConcurrentRepolls <= MaxConcurrentRepolls <= Beta
+// - 0 < TargetPollLatency
+// - 0 < MaxPollFailureRate <= 1
+// - 0 <= MaxNetworkTimeout
+//
 // Note: K/2 < K implies that 0 <= K/2, so we don't need an explicit check that
 // AlphaPreference is positive.
 func (p Parameters) Verify() error {
@@ -110,6 +149,29 @@ func (p Parameters) Verify() error {
 		return fmt.Errorf("%w: maxOutstandingItems = %d: fails the condition that: 0 < maxOutstandingItems", ErrParametersInvalid, p.MaxOutstandingItems)
 	case p.MaxItemProcessingTime <= 0:
 		return fmt.Errorf("%w: maxItemProcessingTime = %d: fails the condition that: 0 < maxItemProcessingTime", ErrParametersInvalid, p.MaxItemProcessingTime)
+	case p.Adaptive != nil:
+		return p.Adaptive.verify(p)
+	default:
+		return nil
+	}
+}
+
+func (a *AdaptiveParameters) verify(p Parameters) error {
+	switch {
+	case a.MinConcurrentRepolls <= 0:
+		return fmt.Errorf("%w: minConcurrentRepolls = %d: fails the condition that: 0 < minConcurrentRepolls", ErrParametersInvalid, a.MinConcurrentRepolls)
+	case p.ConcurrentRepolls < a.MinConcurrentRepolls:
+		return fmt.Errorf("%w: minConcurrentRepolls = %d, concurrentRepolls = %d: fails the condition that: minConcurrentRepolls <= concurrentRepolls", ErrParametersInvalid, a.MinConcurrentRepolls, p.ConcurrentRepolls)
+	case a.MaxConcurrentRepolls < p.ConcurrentRepolls:
+		return fmt.Errorf("%w: concurrentRepolls = %d, maxConcurrentRepolls = %d: fails the condition that: concurrentRepolls <= maxConcurrentRepolls", ErrParametersInvalid, p.ConcurrentRepolls, a.MaxConcurrentRepolls)
+	case p.Beta < a.MaxConcurrentRepolls:
+		return fmt.Errorf("%w: maxConcurrentRepolls = %d, beta = %d: fails the condition that: maxConcurrentRepolls <= beta", ErrParametersInvalid, a.MaxConcurrentRepolls, p.Beta)
+	case a.TargetPollLatency <= 0:
+		return fmt.Errorf("%w: targetPollLatency = %d: fails the condition that: 0 < targetPollLatency", ErrParametersInvalid, a.TargetPollLatency)
+	case a.MaxPollFailureRate <= 0 || a.MaxPollFailureRate > 1:
+		return fmt.Errorf("%w: maxPollFailureRate = %f: fails the condition that: 0 < maxPollFailureRate <= 1", ErrParametersInvalid, a.MaxPollFailureRate)
+	case a.MaxNetworkTimeout < 0:
+		return fmt.Errorf("%w: maxNetworkTimeout = %d: fails the condition that: 0 <= maxNetworkTimeout", ErrParametersInvalid, a.MaxNetworkTimeout)
 	default:
 		return nil
 	}
@@ -5,6 +5,7 @@ package snowball
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -211,6 +212,195 @@ func TestParametersVerify(t *testing.T) {
 			},
 			expectedError: ErrParametersInvalid,
 		},
+		{
+			name: "valid adaptive",
+			params: Parameters{
+				K:                     1,
+				AlphaPreference:       1,
+				AlphaConfidence:       1,
+				Beta:                  4,
+				ConcurrentRepolls:     2,
+				OptimalProcessing:     1,
+				MaxOutstandingItems:   1,
+				MaxItemProcessingTime: 1,
+				Adaptive: &AdaptiveParameters{
+					MinConcurrentRepolls: 1,
+					MaxConcurrentRepolls: 4,
+					TargetPollLatency:    time.Second,
+					MaxPollFailureRate:   .5,
+					MaxNetworkTimeout:    time.Minute,
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "invalid adaptive MinConcurrentRepolls 1",
+			params: Parameters{
+				K:                     1,
+				AlphaPreference:       1,
+				AlphaConfidence:       1,
+				Beta:                  4,
+				ConcurrentRepolls:     2,
+				OptimalProcessing:     1,
+				MaxOutstandingItems:   1,
+				MaxItemProcessingTime: 1,
+				Adaptive: &AdaptiveParameters{
+					MinConcurrentRepolls: 0,
+					MaxConcurrentRepolls: 4,
+					TargetPollLatency:    time.Second,
+					MaxPollFailureRate:   .5,
+					MaxNetworkTimeout:    0,
+				},
+			},
+			expectedError: ErrParametersInvalid,
+		},
+		{
+			name: "invalid adaptive MinConcurrentRepolls 2",
+			params: Parameters{
+				K:                     1,
+				AlphaPreference:       1,
+				AlphaConfidence:       1,
+				Beta:                  4,
+				ConcurrentRepolls:     2,
+				OptimalProcessing:     1,
+				MaxOutstandingItems:   1,
+				MaxItemProcessingTime: 1,
+				Adaptive: &AdaptiveParameters{
+					MinConcurrentRepolls: 3,
+					MaxConcurrentRepolls: 4,
+					TargetPollLatency:    time.Second,
+					MaxPollFailureRate:   .5,
+					MaxNetworkTimeout:    0,
+				},
+			},
+			expectedError: ErrParametersInvalid,
+		},
+		{
+			name: "invalid adaptive MaxConcurrentRepolls 1",
+			params: Parameters{
+				K:                     1,
+				AlphaPreference:       1,
+				AlphaConfidence:       1,
+				Beta:                  4,
+				ConcurrentRepolls:     2,
+				OptimalProcessing:     1,
+				MaxOutstandingItems:   1,
+				MaxItemProcessingTime: 1,
+				Adaptive: &AdaptiveParameters{
+					MinConcurrentRepolls: 1,
+					MaxConcurrentRepolls: 1,
+					TargetPollLatency:    time.Second,
+					MaxPollFailureRate:   .5,
+					MaxNetworkTimeout:    0,
+				},
+			},
+			expectedError: ErrParametersInvalid,
+		},
+		{
+			name: "invalid adaptive MaxConcurrentRepolls 2",
+			params: Parameters{
+				K:                     1,
+				AlphaPreference:       1,
+				AlphaConfidence:       1,
+				Beta:                  4,
+				ConcurrentRepolls:     2,
+				OptimalProcessing:     1,
+				MaxOutstandingItems:   1,
+				MaxItemProcessingTime: 1,
+				Adaptive: &AdaptiveParameters{
+					MinConcurrentRepolls: 1,
+					MaxConcurrentRepolls: 5,
+					TargetPollLatency:    time.Second,
+					MaxPollFailureRate:   .5,
+					MaxNetworkTimeout:    0,
+				},
+			},
+			expectedError: ErrParametersInvalid,
+		},
+		{
+			name: "invalid adaptive TargetPollLatency",
+			params: Parameters{
+				K:                     1,
+				AlphaPreference:       1,
+				AlphaConfidence:       1,
+				Beta:                  4,
+				ConcurrentRepolls:     2,
+				OptimalProcessing:     1,
+				MaxOutstandingItems:   1,
+				MaxItemProcessingTime: 1,
+				Adaptive: &AdaptiveParameters{
+					MinConcurrentRepolls: 1,
+					MaxConcurrentRepolls: 4,
+					TargetPollLatency:    0,
+					MaxPollFailureRate:   .5,
+					MaxNetworkTimeout:    0,
+				},
+			},
+			expectedError: ErrParametersInvalid,
+		},
+		{
+			name: "invalid adaptive MaxPollFailureRate 1",
+			params: Parameters{
+				K:                     1,
+				AlphaPreference:       1,
+				AlphaConfidence:       1,
+				Beta:                  4,
+				ConcurrentRepolls:     2,
+				OptimalProcessing:     1,
+				MaxOutstandingItems:   1,
+				MaxItemProcessingTime: 1,
+				Adaptive: &AdaptiveParameters{
+					MinConcurrentRepolls: 1,
+					MaxConcurrentRepolls: 4,
+					TargetPollLatency:    time.Second,
+					MaxPollFailureRate:   0,
+					MaxNetworkTimeout:    0,
+				},
+			},
+			expectedError: ErrParametersInvalid,
+		},
+		{
+			name: "invalid adaptive MaxPollFailureRate 2",
+			params: Parameters{
+				K:                     1,
+				AlphaPreference:       1,
+				AlphaConfidence:       1,
+				Beta:                  4,
+				ConcurrentRepolls:     2,
+				OptimalProcessing:     1,
+				MaxOutstandingItems:   1,
+				MaxItemProcessingTime: 1,
+				Adaptive: &AdaptiveParameters{
+					MinConcurrentRepolls: 1,
+					MaxConcurrentRepolls: 4,
+					TargetPollLatency:    time.Second,
+					MaxPollFailureRate:   1.5,
+					MaxNetworkTimeout:    0,
+				},
+			},
+			expectedError: ErrParametersInvalid,
+		},
+		{
+			name: "invalid adaptive MaxNetworkTimeout",
+			params: Parameters{
+				K:                     1,
+				AlphaPreference:       1,
+				AlphaConfidence:       1,
+				Beta:                  4,
+				ConcurrentRepolls:     2,
+				OptimalProcessing:     1,
+				MaxOutstandingItems:   1,
+				MaxItemProcessingTime: 1,
+				Adaptive: &AdaptiveParameters{
+					MinConcurrentRepolls: 1,
+					MaxConcurrentRepolls: 4,
+					TargetPollLatency:    time.Second,
+					MaxPollFailureRate:   .5,
+					MaxNetworkTimeout:    -1,
+				},
+			},
+			expectedError: ErrParametersInvalid,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
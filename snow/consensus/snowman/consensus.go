@@ -4,6 +4,7 @@
 package snowman
 
 import (
+	"cmp"
 	"context"
 	"time"
 
@@ -65,4 +66,29 @@ type Consensus interface {
 	// GetParent returns the ID of the parent block with the given ID, if it is known.
 	// Returns (Empty, false) if no such parent block is known.
 	GetParent(id ids.ID) (ids.ID, bool)
+
+	// ProcessingBlocks returns the blocks that are currently processing,
+	// sorted by height.
+	ProcessingBlocks() []ProcessingBlock
+}
+
+// ProcessingBlock describes a block that is currently processing.
+type ProcessingBlock struct {
+	ID       ids.ID
+	ParentID ids.ID
+	Height   uint64
+	// Preferred is true if the block is on the preferred chain.
+	Preferred bool
+	// Snowball is the state of the snowball instance that decides between
+	// this block and its siblings, including the confidence in the preferred
+	// sibling.
+	Snowball string
+}
+
+// Compare orders blocks by height, and then by ID.
+func (b ProcessingBlock) Compare(o ProcessingBlock) int {
+	if c := cmp.Compare(b.Height, o.Height); c != 0 {
+		return c
+	}
+	return b.ID.Compare(o.ID)
 }
@@ -56,6 +56,7 @@ var (
 		ErrorOnAddDecidedBlockTest,
 		RecordPollWithDefaultParameters,
 		RecordPollRegressionCalculateInDegreeIndegreeCalculation,
+		ProcessingBlocksTest,
 	}
 
 	errTest = errors.New("non-nil error")
@@ -1468,3 +1469,53 @@ func RecordPollRegressionCalculateInDegreeIndegreeCalculation(t *testing.T, fact
 	require.Equal(snowtest.Accepted, blk2.Status)
 	require.Equal(snowtest.Accepted, blk3.Status)
 }
+
+func ProcessingBlocksTest(t *testing.T, factory Factory) {
+	require := require.New(t)
+	sm := factory.New()
+
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+	params := snowball.Parameters{
+		K:                     1,
+		AlphaPreference:       1,
+		AlphaConfidence:       1,
+		Beta:                  3,
+		ConcurrentRepolls:     1,
+		OptimalProcessing:     1,
+		MaxOutstandingItems:   1,
+		MaxItemProcessingTime: 1,
+	}
+	require.NoError(sm.Initialize(
+		ctx,
+		params,
+		snowmantest.GenesisID,
+		snowmantest.GenesisHeight,
+		snowmantest.GenesisTimestamp,
+	))
+	require.Empty(sm.ProcessingBlocks())
+
+	block0 := snowmantest.BuildChild(snowmantest.Genesis)
+	block1 := snowmantest.BuildChild(snowmantest.Genesis)
+	block2 := snowmantest.BuildChild(block0)
+	require.NoError(sm.Add(block0))
+	require.NoError(sm.Add(block1))
+	require.NoError(sm.Add(block2))
+
+	// Vote for the chain that isn't initially preferred.
+	require.NoError(sm.RecordPoll(t.Context(), bag.Of(block1.ID())))
+
+	processing := sm.ProcessingBlocks()
+	require.Len(processing, 3)
+	require.Equal(block2.ID(), processing[2].ID)
+	require.Equal(block0.ID(), processing[2].ParentID)
+	require.Equal(block2.Height(), processing[2].Height)
+	require.False(processing[2].Preferred)
+	require.Contains(processing[2].Snowball, "Confidence = [0]")
+
+	for _, blk := range processing[:2] {
+		require.Equal(snowmantest.GenesisID, blk.ParentID)
+		require.Equal(blk.ID == block1.ID(), blk.Preferred)
+		require.Contains(blk.Snowball, "Confidence = [1]")
+	}
+}
@@ -155,6 +155,14 @@ func (p *earlyTermPoll) Drop(vdr ids.NodeID) {
 	p.polled.Remove(vdr)
 }
 
+func (p *earlyTermPoll) Waiting() []ids.NodeID {
+	return p.polled.List()
+}
+
+func (p *earlyTermPoll) Votes() bag.Bag[ids.ID] {
+	return p.votes.Clone()
+}
+
 // Finished returns true when one of the following conditions is met.
 //
 //  1. There are no outstanding votes.
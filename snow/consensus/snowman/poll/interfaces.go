@@ -5,6 +5,7 @@ package poll
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/bag"
@@ -19,6 +20,20 @@ type Set interface {
 	Vote(requestID uint32, vdr ids.NodeID, vote ids.ID) []bag.Bag[ids.ID]
 	Drop(requestID uint32, vdr ids.NodeID) []bag.Bag[ids.ID]
 	Len() int
+	// Outstanding returns the outstanding polls, from oldest to newest.
+	Outstanding() []Info
+}
+
+// Info describes an outstanding poll.
+type Info struct {
+	RequestID uint32
+	Start     time.Time
+	// Responded are the validators that have responded to the poll.
+	Responded []ids.NodeID
+	// Waiting are the validators that haven't responded to the poll yet.
+	Waiting []ids.NodeID
+	// Votes are the votes that have been received so far.
+	Votes bag.Bag[ids.ID]
 }
 
 // Poll is an outstanding poll
@@ -29,6 +44,10 @@ type Poll interface {
 	Drop(vdr ids.NodeID)
 	Finished() bool
 	Result() bag.Bag[ids.ID]
+	// Waiting returns the validators that haven't responded yet.
+	Waiting() []ids.NodeID
+	// Votes returns the votes that have been received so far.
+	Votes() bag.Bag[ids.ID]
 }
 
 // Factory creates a new Poll
@@ -6,6 +6,7 @@ package poll
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/bag"
 	"github.com/ava-labs/avalanchego/utils/linked"
 	"github.com/ava-labs/avalanchego/utils/logging"
@@ -27,11 +29,13 @@ var (
 type pollHolder interface {
 	GetPoll() Poll
 	StartTime() time.Time
+	Polled() []ids.NodeID
 }
 
 type poll struct {
 	Poll
-	start time.Time
+	start  time.Time
+	polled []ids.NodeID
 }
 
 func (p poll) GetPoll() Poll {
@@ -42,6 +46,10 @@ func (p poll) StartTime() time.Time {
 	return p.start
 }
 
+func (p poll) Polled() []ids.NodeID {
+	return p.polled
+}
+
 type set struct {
 	log      logging.Logger
 	numPolls prometheus.Gauge
@@ -101,8 +109,9 @@ func (s *set) Add(requestID uint32, vdrs bag.Bag[ids.NodeID]) bool {
 	)
 
 	s.polls.Put(requestID, poll{
-		Poll:  s.factory.New(vdrs), // create the new poll
-		start: time.Now(),
+		Poll:   s.factory.New(vdrs), // create the new poll
+		start:  time.Now(),
+		polled: vdrs.List(),
 	})
 	s.numPolls.Inc() // increase the metrics
 	return true
@@ -201,6 +210,31 @@ func (s *set) Len() int {
 	return s.polls.Len()
 }
 
+func (s *set) Outstanding() []Info {
+	polls := make([]Info, 0, s.polls.Len())
+	iter := s.polls.NewIterator()
+	for iter.Next() {
+		holder := iter.Value()
+		p := holder.GetPoll()
+
+		info := Info{
+			RequestID: iter.Key(),
+			Start:     holder.StartTime(),
+			Waiting:   p.Waiting(),
+			Votes:     p.Votes(),
+		}
+		for _, vdr := range holder.Polled() {
+			if !slices.Contains(info.Waiting, vdr) {
+				info.Responded = append(info.Responded, vdr)
+			}
+		}
+		utils.Sort(info.Responded)
+		utils.Sort(info.Waiting)
+		polls = append(polls, info)
+	}
+	return polls
+}
+
 func (s *set) String() string {
 	sb := strings.Builder{}
 	sb.WriteString(fmt.Sprintf("current polls: (Size = %d)", s.polls.Len()))
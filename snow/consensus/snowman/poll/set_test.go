@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/bag"
 	"github.com/ava-labs/avalanchego/utils/logging"
 )
@@ -236,6 +237,44 @@ func TestCreateAndFinishFailedPoll(t *testing.T) {
 	require.Empty(results[0].List())
 }
 
+func TestSetOutstanding(t *testing.T) {
+	require := require.New(t)
+
+	vdrs := []ids.NodeID{vdr1, vdr2, vdr3} // k = 3
+	alpha := 3
+
+	factory := newEarlyTermNoTraversalTestFactory(require, alpha)
+	log := logging.NoLog{}
+	registerer := prometheus.NewRegistry()
+	s, err := NewSet(factory, log, registerer)
+	require.NoError(err)
+	require.Empty(s.Outstanding())
+
+	require.True(s.Add(1, bag.Of(vdrs...)))
+	require.True(s.Add(2, bag.Of(vdrs...)))
+
+	require.Empty(s.Vote(1, vdr1, blkID1))
+	require.Empty(s.Vote(1, vdr2, blkID1))
+	require.Empty(s.Vote(2, vdr3, blkID2))
+
+	polls := s.Outstanding()
+	require.Len(polls, 2)
+
+	require.Equal(uint32(1), polls[0].RequestID)
+	expectedResponded := []ids.NodeID{vdr1, vdr2}
+	utils.Sort(expectedResponded)
+	require.Equal(expectedResponded, polls[0].Responded)
+	require.Equal([]ids.NodeID{vdr3}, polls[0].Waiting)
+	require.Equal(bag.Of(blkID1, blkID1), polls[0].Votes)
+
+	require.Equal(uint32(2), polls[1].RequestID)
+	require.Equal([]ids.NodeID{vdr3}, polls[1].Responded)
+	expectedWaiting := []ids.NodeID{vdr1, vdr2}
+	utils.Sort(expectedWaiting)
+	require.Equal(expectedWaiting, polls[1].Waiting)
+	require.Equal(bag.Of(blkID2), polls[1].Votes)
+}
+
 func TestSetString(t *testing.T) {
 	require := require.New(t)
 
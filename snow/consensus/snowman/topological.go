@@ -14,6 +14,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/bag"
 	"github.com/ava-labs/avalanchego/utils/set"
 )
@@ -197,6 +198,29 @@ func (ts *Topological) Processing(blkID ids.ID) bool {
 	return ok
 }
 
+func (ts *Topological) ProcessingBlocks() []ProcessingBlock {
+	blocks := make([]ProcessingBlock, 0, ts.NumProcessing())
+	for blkID, n := range ts.blocks {
+		if blkID == ts.lastAcceptedID {
+			continue
+		}
+
+		parentID := n.blk.Parent()
+		blocks = append(blocks, ProcessingBlock{
+			ID:        blkID,
+			ParentID:  parentID,
+			Height:    n.blk.Height(),
+			Preferred: ts.preferredIDs.Contains(blkID),
+			// The parent of a processing block is either processing or the
+			// last accepted block, and has a snowball instance because it has
+			// at least one child.
+			Snowball: ts.blocks[parentID].sb.String(),
+		})
+	}
+	utils.Sort(blocks)
+	return blocks
+}
+
 func (ts *Topological) IsPreferred(blkID ids.ID) bool {
 	return blkID == ts.lastAcceptedID || ts.preferredIDs.Contains(blkID)
 }
@@ -154,6 +154,16 @@ func (gh *getter) GetAncestors(ctx context.Context, nodeID ids.NodeID, requestID
 	return nil
 }
 
+func (gh *getter) GetAncestorsAtHeight(_ context.Context, nodeID ids.NodeID, requestID uint32, _ uint64) error {
+	gh.log.Debug("dropping request",
+		zap.String("reason", "unhandled by this gear"),
+		zap.Stringer("messageOp", message.GetAncestorsOp),
+		zap.Stringer("nodeID", nodeID),
+		zap.Uint32("requestID", requestID),
+	)
+	return nil
+}
+
 func (gh *getter) Get(_ context.Context, nodeID ids.NodeID, requestID uint32, _ ids.ID) error {
 	gh.log.Debug("dropping request",
 		zap.String("reason", "unhandled by this gear"),
@@ -219,6 +219,18 @@ func (mr *SenderMockRecorder) SendGetAncestors(ctx, nodeID, requestID, container
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendGetAncestors", reflect.TypeOf((*Sender)(nil).SendGetAncestors), ctx, nodeID, requestID, containerID)
 }
 
+// SendGetAncestorsAtHeight mocks base method.
+func (m *Sender) SendGetAncestorsAtHeight(ctx context.Context, nodeID ids.NodeID, requestID uint32, height uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SendGetAncestorsAtHeight", ctx, nodeID, requestID, height)
+}
+
+// SendGetAncestorsAtHeight indicates an expected call of SendGetAncestorsAtHeight.
+func (mr *SenderMockRecorder) SendGetAncestorsAtHeight(ctx, nodeID, requestID, height any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendGetAncestorsAtHeight", reflect.TypeOf((*Sender)(nil).SendGetAncestorsAtHeight), ctx, nodeID, requestID, height)
+}
+
 // SendGetStateSummaryFrontier mocks base method.
 func (m *Sender) SendGetStateSummaryFrontier(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32) {
 	m.ctrl.T.Helper()
@@ -268,13 +280,13 @@ func (mr *SenderMockRecorder) SendPut(ctx, nodeID, requestID, container any) *go
 }
 
 // SendStateSummaryFrontier mocks base method.
-func (m *Sender) SendStateSummaryFrontier(ctx context.Context, nodeID ids.NodeID, requestID uint32, summary []byte) {
+func (m *Sender) SendStateSummaryFrontier(ctx context.Context, nodeID ids.NodeID, requestID uint32, summary, checkpoint []byte) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SendStateSummaryFrontier", ctx, nodeID, requestID, summary)
+	m.ctrl.Call(m, "SendStateSummaryFrontier", ctx, nodeID, requestID, summary, checkpoint)
 }
 
 // SendStateSummaryFrontier indicates an expected call of SendStateSummaryFrontier.
-func (mr *SenderMockRecorder) SendStateSummaryFrontier(ctx, nodeID, requestID, summary any) *gomock.Call {
+func (mr *SenderMockRecorder) SendStateSummaryFrontier(ctx, nodeID, requestID, summary, checkpoint any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendStateSummaryFrontier", reflect.TypeOf((*Sender)(nil).SendStateSummaryFrontier), ctx, nodeID, requestID, summary)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendStateSummaryFrontier", reflect.TypeOf((*Sender)(nil).SendStateSummaryFrontier), ctx, nodeID, requestID, summary, checkpoint)
 }
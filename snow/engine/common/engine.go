@@ -70,12 +70,14 @@ type StateSummaryFrontierHandler interface {
 	// GetStateSummaryFrontier message with the same requestID.
 	//
 	// It is not guaranteed that the summary bytes are from a valid state
-	// summary.
+	// summary, nor that the (possibly empty) checkpoint bytes are from a valid
+	// checkpoint.
 	StateSummaryFrontier(
 		ctx context.Context,
 		nodeID ids.NodeID,
 		requestID uint32,
 		summary []byte,
+		checkpoint []byte,
 	) error
 
 	// Notify this engine that a GetStateSummaryFrontier request it issued has
@@ -216,6 +218,18 @@ type GetAncestorsHandler interface {
 		requestID uint32,
 		containerID ids.ID,
 	) error
+
+	// Notify this engine of a request for an Ancestors message with the same
+	// requestID, the container accepted at height, and some of its ancestors
+	// on a best effort basis.
+	//
+	// This function can be called by any node at any time.
+	GetAncestorsAtHeight(
+		ctx context.Context,
+		nodeID ids.NodeID,
+		requestID uint32,
+		height uint64,
+	) error
 }
 
 type AncestorsHandler interface {
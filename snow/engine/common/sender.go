@@ -64,8 +64,9 @@ type StateSummarySender interface {
 	SendGetStateSummaryFrontier(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32)
 
 	// SendStateSummaryFrontier responds to a StateSummaryFrontier message with this
-	// engine's current state summary frontier.
-	SendStateSummaryFrontier(ctx context.Context, nodeID ids.NodeID, requestID uint32, summary []byte)
+	// engine's current state summary frontier, along with the (possibly empty)
+	// checkpoint that this node trusts.
+	SendStateSummaryFrontier(ctx context.Context, nodeID ids.NodeID, requestID uint32, summary, checkpoint []byte)
 }
 
 type AcceptedStateSummarySender interface {
@@ -125,6 +126,10 @@ type FetchSender interface {
 	// and its ancestors.
 	SendGetAncestors(ctx context.Context, nodeID ids.NodeID, requestID uint32, containerID ids.ID)
 
+	// SendGetAncestorsAtHeight requests that node [nodeID] send the container
+	// it accepted at [height] and its ancestors.
+	SendGetAncestorsAtHeight(ctx context.Context, nodeID ids.NodeID, requestID uint32, height uint64)
+
 	// Tell the specified node about [container].
 	SendPut(ctx context.Context, nodeID ids.NodeID, requestID uint32, container []byte)
 
@@ -41,15 +41,16 @@ func (e *tracedEngine) GetStateSummaryFrontier(ctx context.Context, nodeID ids.N
 	return e.engine.GetStateSummaryFrontier(ctx, nodeID, requestID)
 }
 
-func (e *tracedEngine) StateSummaryFrontier(ctx context.Context, nodeID ids.NodeID, requestID uint32, summary []byte) error {
+func (e *tracedEngine) StateSummaryFrontier(ctx context.Context, nodeID ids.NodeID, requestID uint32, summary, checkpoint []byte) error {
 	ctx, span := e.tracer.Start(ctx, "tracedEngine.StateSummaryFrontier", oteltrace.WithAttributes(
 		attribute.Stringer("nodeID", nodeID),
 		attribute.Int64("requestID", int64(requestID)),
 		attribute.Int("summaryLen", len(summary)),
+		attribute.Int("checkpointLen", len(checkpoint)),
 	))
 	defer span.End()
 
-	return e.engine.StateSummaryFrontier(ctx, nodeID, requestID, summary)
+	return e.engine.StateSummaryFrontier(ctx, nodeID, requestID, summary, checkpoint)
 }
 
 func (e *tracedEngine) GetStateSummaryFrontierFailed(ctx context.Context, nodeID ids.NodeID, requestID uint32) error {
@@ -179,6 +180,17 @@ func (e *tracedEngine) Ancestors(ctx context.Context, nodeID ids.NodeID, request
 	return e.engine.Ancestors(ctx, nodeID, requestID, containers)
 }
 
+func (e *tracedEngine) GetAncestorsAtHeight(ctx context.Context, nodeID ids.NodeID, requestID uint32, height uint64) error {
+	ctx, span := e.tracer.Start(ctx, "tracedEngine.GetAncestorsAtHeight", oteltrace.WithAttributes(
+		attribute.Stringer("nodeID", nodeID),
+		attribute.Int64("requestID", int64(requestID)),
+		attribute.Int64("height", int64(height)),
+	))
+	defer span.End()
+
+	return e.engine.GetAncestorsAtHeight(ctx, nodeID, requestID, height)
+}
+
 func (e *tracedEngine) GetAncestorsFailed(ctx context.Context, nodeID ids.NodeID, requestID uint32) error {
 	ctx, span := e.tracer.Start(ctx, "tracedEngine.GetAncestorsFailed", oteltrace.WithAttributes(
 		attribute.Stringer("nodeID", nodeID),
@@ -35,6 +35,7 @@ var (
 	errAccepted                      = errors.New("unexpectedly called Accepted")
 	errGet                           = errors.New("unexpectedly called Get")
 	errGetAncestors                  = errors.New("unexpectedly called GetAncestors")
+	errGetAncestorsAtHeight          = errors.New("unexpectedly called GetAncestorsAtHeight")
 	errGetFailed                     = errors.New("unexpectedly called GetFailed")
 	errGetAncestorsFailed            = errors.New("unexpectedly called GetAncestorsFailed")
 	errPut                           = errors.New("unexpectedly called Put")
@@ -82,6 +83,7 @@ type Engine struct {
 
 	CantGet,
 	CantGetAncestors,
+	CantGetAncestorsAtHeight,
 	CantGetFailed,
 	CantGetAncestorsFailed,
 	CantPut,
@@ -111,6 +113,7 @@ type Engine struct {
 	TimeoutF, GossipF, ShutdownF func(context.Context) error
 	NotifyF                      func(context.Context, common.Message) error
 	GetF, GetAncestorsF          func(ctx context.Context, nodeID ids.NodeID, requestID uint32, containerID ids.ID) error
+	GetAncestorsAtHeightF        func(ctx context.Context, nodeID ids.NodeID, requestID uint32, height uint64) error
 	PullQueryF                   func(ctx context.Context, nodeID ids.NodeID, requestID uint32, containerID ids.ID, requestedHeight uint64) error
 	PutF                         func(ctx context.Context, nodeID ids.NodeID, requestID uint32, container []byte) error
 	PushQueryF                   func(ctx context.Context, nodeID ids.NodeID, requestID uint32, container []byte, requestedHeight uint64) error
@@ -122,7 +125,7 @@ type Engine struct {
 	GetAcceptedFrontierF, GetFailedF, GetAncestorsFailedF,
 	QueryFailedF, GetAcceptedFrontierFailedF, GetAcceptedFailedF func(ctx context.Context, nodeID ids.NodeID, requestID uint32) error
 	AppRequestFailedF        func(ctx context.Context, nodeID ids.NodeID, requestID uint32, appErr *common.AppError) error
-	StateSummaryFrontierF    func(ctx context.Context, nodeID ids.NodeID, requestID uint32, summary []byte) error
+	StateSummaryFrontierF    func(ctx context.Context, nodeID ids.NodeID, requestID uint32, summary, checkpoint []byte) error
 	GetAcceptedStateSummaryF func(ctx context.Context, nodeID ids.NodeID, requestID uint32, keys set.Set[uint64]) error
 	AcceptedStateSummaryF    func(ctx context.Context, nodeID ids.NodeID, requestID uint32, summaryIDs set.Set[ids.ID]) error
 	ConnectedF               func(ctx context.Context, nodeID ids.NodeID, nodeVersion *version.Application) error
@@ -157,6 +160,7 @@ func (e *Engine) Default(cant bool) {
 	e.CantAccepted = cant
 	e.CantGet = cant
 	e.CantGetAncestors = cant
+	e.CantGetAncestorsAtHeight = cant
 	e.CantGetAncestorsFailed = cant
 	e.CantGetFailed = cant
 	e.CantPut = cant
@@ -240,9 +244,9 @@ func (e *Engine) GetStateSummaryFrontier(ctx context.Context, validatorID ids.No
 	return errGetStateSummaryFrontier
 }
 
-func (e *Engine) StateSummaryFrontier(ctx context.Context, validatorID ids.NodeID, requestID uint32, summary []byte) error {
+func (e *Engine) StateSummaryFrontier(ctx context.Context, validatorID ids.NodeID, requestID uint32, summary, checkpoint []byte) error {
 	if e.StateSummaryFrontierF != nil {
-		return e.StateSummaryFrontierF(ctx, validatorID, requestID, summary)
+		return e.StateSummaryFrontierF(ctx, validatorID, requestID, summary, checkpoint)
 	}
 	if !e.CantStateSummaryFrontier {
 		return nil
@@ -409,6 +413,19 @@ func (e *Engine) GetAncestors(ctx context.Context, nodeID ids.NodeID, requestID
 	return errGetAncestors
 }
 
+func (e *Engine) GetAncestorsAtHeight(ctx context.Context, nodeID ids.NodeID, requestID uint32, height uint64) error {
+	if e.GetAncestorsAtHeightF != nil {
+		return e.GetAncestorsAtHeightF(ctx, nodeID, requestID, height)
+	}
+	if !e.CantGetAncestorsAtHeight {
+		return nil
+	}
+	if e.T != nil {
+		require.FailNow(e.T, errGetAncestorsAtHeight.Error())
+	}
+	return errGetAncestorsAtHeight
+}
+
 func (e *Engine) GetFailed(ctx context.Context, nodeID ids.NodeID, requestID uint32) error {
 	if e.GetFailedF != nil {
 		return e.GetFailedF(ctx, nodeID, requestID)
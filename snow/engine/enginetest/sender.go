@@ -33,13 +33,14 @@ type Sender struct {
 	CantSendGetAcceptedStateSummary, CantSendAcceptedStateSummary,
 	CantSendGetAcceptedFrontier, CantSendAcceptedFrontier,
 	CantSendGetAccepted, CantSendAccepted,
-	CantSendGet, CantSendGetAncestors, CantSendPut, CantSendAncestors,
+	CantSendGet, CantSendGetAncestors, CantSendGetAncestorsAtHeight,
+	CantSendPut, CantSendAncestors,
 	CantSendPullQuery, CantSendPushQuery, CantSendChits,
 	CantSendAppRequest, CantSendAppResponse, CantSendAppError,
 	CantSendAppGossip bool
 
 	SendGetStateSummaryFrontierF func(context.Context, set.Set[ids.NodeID], uint32)
-	SendStateSummaryFrontierF    func(context.Context, ids.NodeID, uint32, []byte)
+	SendStateSummaryFrontierF    func(context.Context, ids.NodeID, uint32, []byte, []byte)
 	SendGetAcceptedStateSummaryF func(context.Context, set.Set[ids.NodeID], uint32, []uint64)
 	SendAcceptedStateSummaryF    func(context.Context, ids.NodeID, uint32, []ids.ID)
 	SendGetAcceptedFrontierF     func(context.Context, set.Set[ids.NodeID], uint32)
@@ -48,6 +49,7 @@ type Sender struct {
 	SendAcceptedF                func(context.Context, ids.NodeID, uint32, []ids.ID)
 	SendGetF                     func(context.Context, ids.NodeID, uint32, ids.ID)
 	SendGetAncestorsF            func(context.Context, ids.NodeID, uint32, ids.ID)
+	SendGetAncestorsAtHeightF    func(context.Context, ids.NodeID, uint32, uint64)
 	SendPutF                     func(context.Context, ids.NodeID, uint32, []byte)
 	SendAncestorsF               func(context.Context, ids.NodeID, uint32, [][]byte)
 	SendPushQueryF               func(context.Context, set.Set[ids.NodeID], uint32, []byte, uint64)
@@ -95,9 +97,9 @@ func (s *Sender) SendGetStateSummaryFrontier(ctx context.Context, validatorIDs s
 // SendStateSummaryFrontier calls SendStateSummaryFrontierF if it was
 // initialized. If it wasn't initialized and this function shouldn't be called
 // and testing was initialized, then testing will fail.
-func (s *Sender) SendStateSummaryFrontier(ctx context.Context, validatorID ids.NodeID, requestID uint32, summary []byte) {
+func (s *Sender) SendStateSummaryFrontier(ctx context.Context, validatorID ids.NodeID, requestID uint32, summary, checkpoint []byte) {
 	if s.SendStateSummaryFrontierF != nil {
-		s.SendStateSummaryFrontierF(ctx, validatorID, requestID, summary)
+		s.SendStateSummaryFrontierF(ctx, validatorID, requestID, summary, checkpoint)
 	} else if s.CantSendStateSummaryFrontier && s.T != nil {
 		require.FailNow(s.T, "Unexpectedly called SendStateSummaryFrontier")
 	}
@@ -191,6 +193,17 @@ func (s *Sender) SendGetAncestors(ctx context.Context, validatorID ids.NodeID, r
 	}
 }
 
+// SendGetAncestorsAtHeight calls SendGetAncestorsAtHeightF if it was
+// initialized. If it wasn't initialized and this function shouldn't be called
+// and testing was initialized, then testing will fail.
+func (s *Sender) SendGetAncestorsAtHeight(ctx context.Context, validatorID ids.NodeID, requestID uint32, height uint64) {
+	if s.SendGetAncestorsAtHeightF != nil {
+		s.SendGetAncestorsAtHeightF(ctx, validatorID, requestID, height)
+	} else if s.CantSendGetAncestorsAtHeight && s.T != nil {
+		require.FailNow(s.T, "Unexpectedly called SendGetAncestorsAtHeight")
+	}
+}
+
 // SendPut calls SendPutF if it was initialized. If it wasn't initialized and
 // this function shouldn't be called and testing was initialized, then testing
 // will fail.
@@ -0,0 +1,184 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snowman
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+	"github.com/ava-labs/avalanchego/utils/buffer"
+)
+
+// adaptiveSmoothing is the weight of a new observation in the moving averages
+// of the poll latency and the query failure rate.
+const adaptiveSmoothing = .2
+
+// NetworkTimeouts allows the engine to raise the maximum network timeout.
+type NetworkTimeouts interface {
+	// TimeoutDuration returns the current network timeout duration of
+	// [chainID].
+	TimeoutDuration(chainID ids.ID) time.Duration
+	// SetMaximumTimeout raises the maximum network timeout duration of
+	// [chainID]. A [maximumTimeout] of 0 removes the chain's maximum.
+	SetMaximumTimeout(chainID ids.ID, maximumTimeout time.Duration)
+}
+
+// adaptiveTuner tunes the number of concurrent repolls and the maximum network
+// timeout, within the configured bounds, from the observed poll latencies and
+// query failure rates.
+//
+// While polls take longer than the target latency, more polls are issued
+// concurrently so that the number of polls that finish per unit of time, and
+// therefore the time to finalize a block, is maintained. While queries are
+// failing, fewer polls are issued and, if allowed, the maximum network timeout
+// is raised so that slow responses aren't dropped.
+type adaptiveTuner struct {
+	params                snowball.AdaptiveParameters
+	baseConcurrentRepolls int
+	chainID               ids.ID
+	timeouts              NetworkTimeouts
+
+	// pollStarts are the times the outstanding polls were issued, from oldest
+	// to newest. Polls finish in the order they were issued.
+	pollStarts buffer.Deque[time.Time]
+
+	avgPollLatency    time.Duration
+	failureRate       float64
+	concurrentRepolls int
+	// maxNetworkTimeout is the maximum network timeout requested by the
+	// engine, or 0 if the engine hasn't requested one.
+	maxNetworkTimeout time.Duration
+
+	concurrentRepollsMetric prometheus.Gauge
+	pollLatencyMetric       prometheus.Gauge
+	failureRateMetric       prometheus.Gauge
+	maxNetworkTimeoutMetric prometheus.Gauge
+}
+
+func newAdaptiveTuner(
+	params snowball.Parameters,
+	chainID ids.ID,
+	timeouts NetworkTimeouts,
+	reg prometheus.Registerer,
+) (*adaptiveTuner, error) {
+	a := &adaptiveTuner{
+		params:                *params.Adaptive,
+		baseConcurrentRepolls: params.ConcurrentRepolls,
+		chainID:               chainID,
+		timeouts:              timeouts,
+		pollStarts:            buffer.NewUnboundedDeque[time.Time](params.Adaptive.MaxConcurrentRepolls),
+		concurrentRepolls:     params.ConcurrentRepolls,
+		concurrentRepollsMetric: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "adaptive_concurrent_repolls",
+			Help: "Number of outstanding polls the engine targets while there is something processing",
+		}),
+		pollLatencyMetric: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "adaptive_poll_latency",
+			Help: "Moving average of the time (in ns) polls took to finish",
+		}),
+		failureRateMetric: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "adaptive_query_failure_rate",
+			Help: "Moving average of the fraction of queries that failed",
+		}),
+		maxNetworkTimeoutMetric: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "adaptive_max_network_timeout",
+			Help: "Maximum network timeout (in ns) requested by the engine. 0 if none is requested",
+		}),
+	}
+	a.concurrentRepollsMetric.Set(float64(a.concurrentRepolls))
+
+	err := errors.Join(
+		reg.Register(a.concurrentRepollsMetric),
+		reg.Register(a.pollLatencyMetric),
+		reg.Register(a.failureRateMetric),
+		reg.Register(a.maxNetworkTimeoutMetric),
+	)
+	return a, err
+}
+
+// pollIssued records that a poll was issued at [now].
+func (a *adaptiveTuner) pollIssued(now time.Time) {
+	a.pollStarts.PushRight(now)
+}
+
+// responseReceived records whether a query was answered or failed.
+func (a *adaptiveTuner) responseReceived(failed bool) {
+	var sample float64
+	if failed {
+		sample = 1
+	}
+	a.failureRate = movingAverage(a.failureRate, sample)
+	a.failureRateMetric.Set(a.failureRate)
+}
+
+// pollsFinished records that the [numPolls] oldest polls finished at [now]
+// and re-tunes the parameters.
+func (a *adaptiveTuner) pollsFinished(numPolls int, now time.Time) {
+	for range numPolls {
+		start, ok := a.pollStarts.PopLeft()
+		if !ok {
+			break
+		}
+
+		latency := float64(now.Sub(start))
+		if a.avgPollLatency == 0 {
+			a.avgPollLatency = time.Duration(latency)
+		} else {
+			a.avgPollLatency = time.Duration(movingAverage(float64(a.avgPollLatency), latency))
+		}
+		a.tune()
+	}
+	a.pollLatencyMetric.Set(float64(a.avgPollLatency))
+	a.concurrentRepollsMetric.Set(float64(a.concurrentRepolls))
+}
+
+func (a *adaptiveTuner) tune() {
+	failing := a.failureRate > a.params.MaxPollFailureRate
+	switch {
+	case failing:
+		// Issuing more polls wouldn't help while validators aren't
+		// responding.
+		a.concurrentRepolls = max(a.concurrentRepolls-1, a.params.MinConcurrentRepolls)
+	case a.avgPollLatency > a.params.TargetPollLatency:
+		a.concurrentRepolls = min(a.concurrentRepolls+1, a.params.MaxConcurrentRepolls)
+	case a.concurrentRepolls > a.baseConcurrentRepolls:
+		a.concurrentRepolls--
+	case a.concurrentRepolls < a.baseConcurrentRepolls:
+		a.concurrentRepolls++
+	}
+
+	if a.timeouts == nil || a.params.MaxNetworkTimeout == 0 {
+		return
+	}
+	switch {
+	case failing:
+		maxNetworkTimeout := min(2*a.timeouts.TimeoutDuration(a.chainID), a.params.MaxNetworkTimeout)
+		if maxNetworkTimeout > a.maxNetworkTimeout {
+			a.setMaxNetworkTimeout(maxNetworkTimeout)
+		}
+	case a.maxNetworkTimeout != 0 && a.failureRate < a.params.MaxPollFailureRate/2:
+		a.setMaxNetworkTimeout(0)
+	}
+}
+
+func (a *adaptiveTuner) setMaxNetworkTimeout(maxNetworkTimeout time.Duration) {
+	a.maxNetworkTimeout = maxNetworkTimeout
+	a.timeouts.SetMaximumTimeout(a.chainID, maxNetworkTimeout)
+	a.maxNetworkTimeoutMetric.Set(float64(maxNetworkTimeout))
+}
+
+// release removes the maximum network timeout requested by the engine.
+func (a *adaptiveTuner) release() {
+	if a.maxNetworkTimeout != 0 {
+		a.setMaxNetworkTimeout(0)
+	}
+}
+
+func movingAverage(avg, sample float64) float64 {
+	return (1-adaptiveSmoothing)*avg + adaptiveSmoothing*sample
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snowman
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+)
+
+type testNetworkTimeouts struct {
+	timeout         time.Duration
+	maximumTimeouts map[ids.ID]time.Duration
+}
+
+func (t *testNetworkTimeouts) TimeoutDuration(ids.ID) time.Duration {
+	return t.timeout
+}
+
+func (t *testNetworkTimeouts) SetMaximumTimeout(chainID ids.ID, maximumTimeout time.Duration) {
+	if maximumTimeout == 0 {
+		delete(t.maximumTimeouts, chainID)
+		return
+	}
+	t.maximumTimeouts[chainID] = maximumTimeout
+}
+
+func newTestAdaptiveTuner(t *testing.T, timeouts NetworkTimeouts) *adaptiveTuner {
+	params := snowball.DefaultParameters
+	params.Adaptive = &snowball.AdaptiveParameters{
+		MinConcurrentRepolls: 1,
+		MaxConcurrentRepolls: 8,
+		TargetPollLatency:    time.Second,
+		MaxPollFailureRate:   .5,
+		MaxNetworkTimeout:    time.Minute,
+	}
+	require.NoError(t, params.Verify())
+
+	a, err := newAdaptiveTuner(params, ids.Empty, timeouts, prometheus.NewRegistry())
+	require.NoError(t, err)
+	return a
+}
+
+// finishPolls issues and finishes [numPolls] polls that each took [latency].
+func finishPolls(a *adaptiveTuner, numPolls int, latency time.Duration) {
+	start := time.Unix(0, 0)
+	for range numPolls {
+		a.pollIssued(start)
+		a.pollsFinished(1, start.Add(latency))
+	}
+}
+
+func TestAdaptiveTunerConcurrentRepolls(t *testing.T) {
+	require := require.New(t)
+
+	a := newTestAdaptiveTuner(t, nil)
+	require.Equal(snowball.DefaultParameters.ConcurrentRepolls, a.concurrentRepolls)
+
+	// Fast polls don't change the number of concurrent repolls.
+	finishPolls(a, 10, 100*time.Millisecond)
+	require.Equal(snowball.DefaultParameters.ConcurrentRepolls, a.concurrentRepolls)
+
+	// Slow polls increase the number of concurrent repolls up to the maximum.
+	finishPolls(a, 20, 5*time.Second)
+	require.Equal(8, a.concurrentRepolls)
+
+	// Once polls are fast again, the number of concurrent repolls returns to
+	// the configured value.
+	finishPolls(a, 20, 100*time.Millisecond)
+	require.Equal(snowball.DefaultParameters.ConcurrentRepolls, a.concurrentRepolls)
+
+	// Failing queries decrease the number of concurrent repolls down to the
+	// minimum.
+	for range 10 {
+		a.responseReceived(true)
+	}
+	finishPolls(a, 10, 5*time.Second)
+	require.Equal(1, a.concurrentRepolls)
+}
+
+func TestAdaptiveTunerNetworkTimeout(t *testing.T) {
+	require := require.New(t)
+
+	timeouts := &testNetworkTimeouts{
+		timeout:         10 * time.Second,
+		maximumTimeouts: make(map[ids.ID]time.Duration),
+	}
+	a := newTestAdaptiveTuner(t, timeouts)
+
+	finishPolls(a, 1, time.Second)
+	require.Empty(timeouts.maximumTimeouts)
+
+	// While queries are failing, the maximum network timeout is raised up to
+	// the configured bound.
+	for range 10 {
+		a.responseReceived(true)
+	}
+	finishPolls(a, 1, time.Second)
+	require.Equal(map[ids.ID]time.Duration{ids.Empty: 20 * time.Second}, timeouts.maximumTimeouts)
+
+	timeouts.timeout = 40 * time.Second
+	finishPolls(a, 1, time.Second)
+	require.Equal(map[ids.ID]time.Duration{ids.Empty: time.Minute}, timeouts.maximumTimeouts)
+
+	// Once queries succeed again, the maximum network timeout is removed.
+	for range 10 {
+		a.responseReceived(false)
+	}
+	finishPolls(a, 1, time.Second)
+	require.Empty(timeouts.maximumTimeouts)
+
+	// Releasing the tuner removes the maximum network timeout.
+	for range 10 {
+		a.responseReceived(true)
+	}
+	finishPolls(a, 1, time.Second)
+	require.NotEmpty(timeouts.maximumTimeouts)
+	a.release()
+	require.Empty(timeouts.maximumTimeouts)
+}
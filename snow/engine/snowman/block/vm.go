@@ -84,6 +84,16 @@ type Parser interface {
 	ParseBlock(ctx context.Context, blockBytes []byte) (snowman.Block, error)
 }
 
+// ConcurrentParser defines the interface a ChainVM can optionally implement to
+// advertise that its blocks can be parsed concurrently with the execution of
+// other blocks.
+type ConcurrentParser interface {
+	// SupportsConcurrentParsing returns true if ParseBlock may be called from
+	// multiple goroutines concurrently with the verification and acceptance
+	// of previously parsed blocks.
+	SupportsConcurrentParsing() bool
+}
+
 // ParseFunc defines a function that parses raw bytes into a block.
 type ParseFunc func(context.Context, []byte) (snowman.Block, error)
 
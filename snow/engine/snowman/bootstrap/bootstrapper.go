@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"sync"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/bootstrap/interval"
 	"github.com/ava-labs/avalanchego/utils/bimap"
+	"github.com/ava-labs/avalanchego/utils/heap"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer"
 	"github.com/ava-labs/avalanchego/version"
@@ -44,6 +46,10 @@ const (
 	// minimumLogInterval is the minimum time between log entries to avoid noise
 	minimumLogInterval = 5 * time.Second
 
+	// maxPeerSelectionAttempts is the maximum number of times a peer is
+	// sampled when looking for a peer without an outstanding request.
+	maxPeerSelectionAttempts = 8
+
 	epsilon = 1e-6 // small amount to add to time to avoid division by 0
 )
 
@@ -51,6 +57,15 @@ var (
 	_ common.BootstrapableEngine = (*Bootstrapper)(nil)
 
 	errUnexpectedTimeout = errors.New("unexpected timeout fired")
+
+	// minHeightRequestVersion is the first version that serves GetAncestors
+	// requests by height. Older versions drop them.
+	minHeightRequestVersion = &version.Application{
+		Name:  version.Client,
+		Major: 1,
+		Minor: 14,
+		Patch: 1,
+	}
 )
 
 // bootstrapper repeatedly performs the bootstrapping protocol.
@@ -107,6 +122,18 @@ type Bootstrapper struct {
 	// tracks which validators were asked for which containers in which requests
 	outstandingRequests     *bimap.BiMap[common.Request, ids.ID]
 	outstandingRequestTimes map[common.Request]time.Time
+	// number of outstanding requests to each peer
+	outstandingRequestsPerPeer map[ids.NodeID]int
+	// missing blocks that are waiting for a request to be sent, prioritized
+	// by their height
+	queuedRequests heap.Map[ids.ID, uint64]
+	// height of the missing blocks, if known
+	missingBlockHeights map[ids.ID]uint64
+	// tracks which peers were asked for the blocks below which heights
+	outstandingHeightRequests map[common.Request]uint64
+	// intervals of blocks requested by height, indexed by the height of their
+	// highest block
+	heightIntervals map[uint64]*heightInterval
 
 	// number of state transitions executed
 	executedStateTransitions uint64
@@ -141,8 +168,13 @@ func New(config Config, onFinished func(ctx context.Context, lastReqID uint32) e
 		minority: bootstrapper.Noop,
 		majority: bootstrapper.Noop,
 
-		outstandingRequests:     bimap.New[common.Request, ids.ID](),
-		outstandingRequestTimes: make(map[common.Request]time.Time),
+		outstandingRequests:        bimap.New[common.Request, ids.ID](),
+		outstandingRequestTimes:    make(map[common.Request]time.Time),
+		outstandingRequestsPerPeer: make(map[ids.NodeID]int),
+		queuedRequests:             newRequestQueue(),
+		missingBlockHeights:        make(map[ids.ID]uint64),
+		outstandingHeightRequests:  make(map[common.Request]uint64),
+		heightIntervals:            make(map[uint64]*heightInterval),
 
 		executedStateTransitions: math.MaxInt,
 		onFinished:               onFinished,
@@ -406,7 +438,7 @@ func (b *Bootstrapper) startSyncing(ctx context.Context, acceptedBlockIDs []ids.
 		// `database.ErrNotFound`, then the error should be propagated.
 		blk, err := b.VM.GetBlock(ctx, blkID)
 		if err != nil {
-			if err := b.fetch(ctx, blkID); err != nil {
+			if err := b.fetch(ctx, blkID, b.missingBlockHeights[blkID]); err != nil {
 				return err
 			}
 			continue
@@ -428,17 +460,61 @@ func (b *Bootstrapper) startSyncing(ctx context.Context, acceptedBlockIDs []ids.
 		}
 	}
 
+	if err := b.requestHeightIntervals(ctx); err != nil {
+		return err
+	}
 	return b.tryStartExecuting(ctx)
 }
 
-// Get block [blkID] and its ancestors from a validator
-func (b *Bootstrapper) fetch(ctx context.Context, blkID ids.ID) error {
+// newRequestQueue returns a queue of missing blocks that pops the lowest height
+// first. Blocks of unknown height are assigned height 0 as they seed new
+// intervals.
+func newRequestQueue() heap.Map[ids.ID, uint64] {
+	return heap.NewMap[ids.ID, uint64](func(a, b uint64) bool {
+		return a < b
+	})
+}
+
+// Get block [blkID], which is expected to be at [height], and its ancestors
+// from a validator.
+func (b *Bootstrapper) fetch(ctx context.Context, blkID ids.ID, height uint64) error {
 	// Make sure we haven't already requested this block
-	if b.outstandingRequests.HasValue(blkID) {
+	if b.outstandingRequests.HasValue(blkID) || b.queuedRequests.Contains(blkID) {
 		return nil
 	}
 
-	nodeID, ok := b.PeerTracker.SelectPeer()
+	b.queuedRequests.Push(blkID, height)
+	b.sendRequests(ctx)
+	return nil
+}
+
+// sendRequests sends requests for the queued missing blocks until the maximum
+// number of outstanding requests is reached.
+func (b *Bootstrapper) sendRequests(ctx context.Context) {
+	for b.canSendRequest() {
+		blkID, _, ok := b.queuedRequests.Pop()
+		if !ok {
+			break
+		}
+		b.sendRequest(ctx, blkID)
+	}
+
+	b.numOutstandingRequests.Set(float64(b.numRequests()))
+	b.numQueuedRequests.Set(float64(b.queuedRequests.Len()))
+}
+
+// numRequests returns the number of outstanding requests, including the
+// requests for intervals of blocks by height.
+func (b *Bootstrapper) numRequests() int {
+	return b.outstandingRequests.Len() + len(b.outstandingHeightRequests)
+}
+
+func (b *Bootstrapper) canSendRequest() bool {
+	return b.Config.MaxOutstandingRequests <= 0 || b.numRequests() < b.Config.MaxOutstandingRequests
+}
+
+func (b *Bootstrapper) sendRequest(ctx context.Context, blkID ids.ID) {
+	nodeID, ok := b.selectPeer(nil)
 	if !ok {
 		// If we aren't connected to any peers, we send a request to ourself
 		// which is guaranteed to fail. We send this message to use the message
@@ -447,6 +523,13 @@ func (b *Bootstrapper) fetch(ctx context.Context, blkID ids.ID) error {
 		nodeID = b.Ctx.NodeID
 	}
 
+	request := b.newRequest(nodeID)
+	b.outstandingRequests.Put(request, blkID)
+	b.Config.Sender.SendGetAncestors(ctx, request.NodeID, request.RequestID, blkID) // request block and ancestors
+}
+
+// newRequest tracks a new request to [nodeID].
+func (b *Bootstrapper) newRequest(nodeID ids.NodeID) common.Request {
 	b.PeerTracker.RegisterRequest(nodeID)
 
 	b.requestID++
@@ -454,12 +537,182 @@ func (b *Bootstrapper) fetch(ctx context.Context, blkID ids.ID) error {
 		NodeID:    nodeID,
 		RequestID: b.requestID,
 	}
-	b.outstandingRequests.Put(request, blkID)
 	b.outstandingRequestTimes[request] = time.Now()
-	b.Config.Sender.SendGetAncestors(ctx, nodeID, b.requestID, blkID) // request block and ancestors
+	b.outstandingRequestsPerPeer[nodeID]++
+	return request
+}
+
+// requestHeightIntervals requests, by height, the intervals of blocks below
+// the missing blocks of known height. This allows disjoint intervals of the
+// chain to be fetched from different peers concurrently, rather than only
+// walking the chain backwards one GetAncestors response at a time.
+//
+// The fetched blocks are only stored once they are reached by following the
+// parent IDs of the accepted frontier, so a peer can't make this node store
+// blocks that weren't accepted.
+//
+// At most MaxOutstandingRequests-1 intervals are tracked at once, so that a
+// request slot is always available to fetch missing blocks by ID.
+func (b *Bootstrapper) requestHeightIntervals(ctx context.Context) error {
+	maxIntervals := b.Config.MaxOutstandingRequests - 1
+	intervalSize := uint64(b.Config.AncestorsMaxContainersReceived)
+	if maxIntervals <= 0 || intervalSize == 0 {
+		return nil
+	}
+
+	// Drop the intervals that were fetched by ID in the meantime.
+	for top, interval := range b.heightIntervals {
+		if interval.received() && b.tree.Contains(top) {
+			delete(b.heightIntervals, top)
+		}
+	}
+	if len(b.heightIntervals) >= maxIntervals || !b.canSendRequest() {
+		return nil
+	}
+
+	lastAccepted, err := b.getLastAccepted(ctx)
+	if err != nil {
+		return err
+	}
+	lastAcceptedHeight := lastAccepted.Height()
+
+	// Fill the gaps closest to the last accepted block first.
+	frontierHeights := make([]uint64, 0, len(b.missingBlockHeights))
+	for blkID, height := range b.missingBlockHeights {
+		if b.missingBlockIDs.Contains(blkID) {
+			frontierHeights = append(frontierHeights, height)
+		}
+	}
+	slices.Sort(frontierHeights)
+
+	for _, frontierHeight := range frontierHeights {
+		// The GetAncestors request for the missing block is expected to
+		// fetch the blocks down to [frontierHeight-intervalSize+1].
+		for top := frontierHeight; top > lastAcceptedHeight+intervalSize; {
+			top -= intervalSize
+			if b.tree.Contains(top) {
+				break
+			}
+			if covering, ok := b.coveringHeightInterval(top); ok {
+				top = covering
+				continue
+			}
+			if len(b.heightIntervals) >= maxIntervals || !b.canSendRequest() {
+				return nil
+			}
+			if !b.sendHeightRequest(ctx, top, intervalSize) {
+				return nil
+			}
+		}
+	}
 	return nil
 }
 
+// sendHeightRequest requests the interval of blocks below [top] from a peer
+// that serves requests by height. Returns false if no such peer was found.
+func (b *Bootstrapper) sendHeightRequest(ctx context.Context, top uint64, intervalSize uint64) bool {
+	nodeID, ok := b.selectPeer(b.supportsHeightRequests)
+	if !ok {
+		return false
+	}
+
+	// Intervals must not overlap so that each missing block is expected to be
+	// included in at most one interval.
+	bottom := top - intervalSize + 1
+	for otherTop := range b.heightIntervals {
+		if bottom <= otherTop && otherTop < top {
+			bottom = otherTop + 1
+		}
+	}
+
+	request := b.newRequest(nodeID)
+	b.outstandingHeightRequests[request] = top
+	b.heightIntervals[top] = &heightInterval{
+		nodeID: request.NodeID,
+		bottom: bottom,
+	}
+	b.numOutstandingRequests.Set(float64(b.numRequests()))
+	b.Config.Sender.SendGetAncestorsAtHeight(ctx, request.NodeID, request.RequestID, top)
+	return true
+}
+
+// supportsHeightRequests returns true if [nodeID] serves GetAncestors requests
+// by height.
+func (b *Bootstrapper) supportsHeightRequests(nodeID ids.NodeID) bool {
+	nodeVersion, ok := b.PeerTracker.Version(nodeID)
+	return ok && nodeVersion.Compare(minHeightRequestVersion) >= 0
+}
+
+// coveringHeightInterval returns the top of the interval that includes
+// [height], if any.
+func (b *Bootstrapper) coveringHeightInterval(height uint64) (uint64, bool) {
+	for top, interval := range b.heightIntervals {
+		if interval.bottom <= height && height <= top {
+			return top, true
+		}
+	}
+	return 0, false
+}
+
+// selectPeer returns a peer to fetch blocks from. Peers without an
+// outstanding request are preferred so that disjoint intervals are fetched
+// from different peers concurrently. If [eligible] is non-nil, only the peers
+// it returns true for are selected.
+func (b *Bootstrapper) selectPeer(eligible func(ids.NodeID) bool) (ids.NodeID, bool) {
+	var (
+		selected ids.NodeID
+		found    bool
+	)
+	for range maxPeerSelectionAttempts {
+		nodeID, ok := b.PeerTracker.SelectPeer()
+		if !ok {
+			break
+		}
+		if eligible != nil && !eligible(nodeID) {
+			continue
+		}
+
+		selected, found = nodeID, true
+		if b.outstandingRequestsPerPeer[nodeID] == 0 {
+			break
+		}
+	}
+	return selected, found
+}
+
+// removeRequest marks [request] as no longer outstanding and returns the ID of
+// the requested block and the time the request was sent.
+func (b *Bootstrapper) removeRequest(request common.Request) (ids.ID, time.Time, bool) {
+	blkID, ok := b.outstandingRequests.DeleteKey(request)
+	if !ok {
+		return ids.Empty, time.Time{}, false
+	}
+	return blkID, b.untrackRequest(request), true
+}
+
+// removeHeightRequest marks [request] as no longer outstanding and returns the
+// requested height and the time the request was sent.
+func (b *Bootstrapper) removeHeightRequest(request common.Request) (uint64, time.Time, bool) {
+	height, ok := b.outstandingHeightRequests[request]
+	if !ok {
+		return 0, time.Time{}, false
+	}
+	delete(b.outstandingHeightRequests, request)
+	return height, b.untrackRequest(request), true
+}
+
+func (b *Bootstrapper) untrackRequest(request common.Request) time.Time {
+	requestTime := b.outstandingRequestTimes[request]
+	delete(b.outstandingRequestTimes, request)
+
+	b.outstandingRequestsPerPeer[request.NodeID]--
+	if b.outstandingRequestsPerPeer[request.NodeID] <= 0 {
+		delete(b.outstandingRequestsPerPeer, request.NodeID)
+	}
+	b.numOutstandingRequests.Set(float64(b.numRequests()))
+	return requestTime
+}
+
 // Ancestors handles the receipt of multiple containers. Should be received in
 // response to a GetAncestors message to [nodeID] with request ID [requestID]
 func (b *Bootstrapper) Ancestors(ctx context.Context, nodeID ids.NodeID, requestID uint32, blks [][]byte) error {
@@ -468,7 +721,11 @@ func (b *Bootstrapper) Ancestors(ctx context.Context, nodeID ids.NodeID, request
 		NodeID:    nodeID,
 		RequestID: requestID,
 	}
-	wantedBlkID, ok := b.outstandingRequests.DeleteKey(request)
+	if height, requestTime, ok := b.removeHeightRequest(request); ok {
+		return b.heightAncestors(ctx, nodeID, requestID, height, requestTime, blks)
+	}
+
+	wantedBlkID, requestTime, ok := b.removeRequest(request)
 	if !ok { // this message isn't in response to a request we made
 		b.Ctx.Log.Debug("received unexpected Ancestors",
 			zap.Stringer("nodeID", nodeID),
@@ -476,8 +733,7 @@ func (b *Bootstrapper) Ancestors(ctx context.Context, nodeID ids.NodeID, request
 		)
 		return nil
 	}
-	requestTime := b.outstandingRequestTimes[request]
-	delete(b.outstandingRequestTimes, request)
+	wantedHeight := b.missingBlockHeights[wantedBlkID]
 
 	lenBlks := len(blks)
 	if lenBlks == 0 {
@@ -489,7 +745,7 @@ func (b *Bootstrapper) Ancestors(ctx context.Context, nodeID ids.NodeID, request
 		b.PeerTracker.RegisterFailure(nodeID)
 
 		// Send another request for this
-		return b.fetch(ctx, wantedBlkID)
+		return b.fetch(ctx, wantedBlkID, wantedHeight)
 	}
 
 	if lenBlks > b.Config.AncestorsMaxContainersReceived {
@@ -509,7 +765,7 @@ func (b *Bootstrapper) Ancestors(ctx context.Context, nodeID ids.NodeID, request
 			zap.Error(err),
 		)
 		b.PeerTracker.RegisterFailure(nodeID)
-		return b.fetch(ctx, wantedBlkID)
+		return b.fetch(ctx, wantedBlkID, wantedHeight)
 	}
 
 	if len(blocks) == 0 {
@@ -518,7 +774,7 @@ func (b *Bootstrapper) Ancestors(ctx context.Context, nodeID ids.NodeID, request
 			zap.Uint32("requestID", requestID),
 		)
 		b.PeerTracker.RegisterFailure(nodeID)
-		return b.fetch(ctx, wantedBlkID)
+		return b.fetch(ctx, wantedBlkID, wantedHeight)
 	}
 
 	requestedBlock := blocks[0]
@@ -528,7 +784,7 @@ func (b *Bootstrapper) Ancestors(ctx context.Context, nodeID ids.NodeID, request
 			zap.Stringer("blkID", actualID),
 		)
 		b.PeerTracker.RegisterFailure(nodeID)
-		return b.fetch(ctx, wantedBlkID)
+		return b.fetch(ctx, wantedBlkID, wantedHeight)
 	}
 
 	var (
@@ -547,20 +803,161 @@ func (b *Bootstrapper) Ancestors(ctx context.Context, nodeID ids.NodeID, request
 		bandwidth      = float64(numBytes) / requestLatency
 	)
 	b.PeerTracker.RegisterResponse(nodeID, bandwidth)
+	delete(b.missingBlockHeights, wantedBlkID)
 
 	if err := b.process(ctx, requestedBlock, ancestors); err != nil {
 		return err
 	}
 
+	// The response freed a request slot.
+	b.sendRequests(ctx)
+	if err := b.requestHeightIntervals(ctx); err != nil {
+		return err
+	}
 	return b.tryStartExecuting(ctx)
 }
 
+// heightAncestors handles the response to a request for the interval of
+// blocks below [height].
+func (b *Bootstrapper) heightAncestors(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	requestID uint32,
+	height uint64,
+	requestTime time.Time,
+	blks [][]byte,
+) error {
+	if len(blks) > b.Config.AncestorsMaxContainersReceived {
+		blks = blks[:b.Config.AncestorsMaxContainersReceived]
+	}
+
+	blocks, err := block.BatchedParseBlock(ctx, b.VM, blks)
+	if err != nil || len(blocks) == 0 || blocks[0].Height() != height {
+		b.Ctx.Log.Debug("dropping invalid interval in Ancestors",
+			zap.Stringer("nodeID", nodeID),
+			zap.Uint32("requestID", requestID),
+			zap.Uint64("height", height),
+			zap.Error(err),
+		)
+		b.PeerTracker.RegisterFailure(nodeID)
+		return b.heightIntervalFailed(ctx, height)
+	}
+
+	interval, ok := b.heightIntervals[height]
+	if !ok {
+		return nil
+	}
+
+	numBytes := 0
+	interval.lowest = height
+	interval.blocks = make(map[ids.ID]snowman.Block, len(blocks))
+	for _, blk := range blocks {
+		numBytes += len(blk.Bytes())
+		interval.blocks[blk.ID()] = blk
+		interval.lowest = min(interval.lowest, blk.Height())
+	}
+
+	var (
+		requestLatency = time.Since(requestTime).Seconds() + epsilon
+		bandwidth      = float64(numBytes) / requestLatency
+	)
+	b.PeerTracker.RegisterResponse(nodeID, bandwidth)
+
+	if b.tree.Contains(height) {
+		// The interval was fetched by ID in the meantime.
+		delete(b.heightIntervals, height)
+	} else if blkID, blkHeight, ok := b.waitingMissingBlock(height); ok {
+		if err := b.fetchMissing(ctx, blkID, blkHeight); err != nil {
+			return err
+		}
+	}
+
+	b.sendRequests(ctx)
+	if err := b.requestHeightIntervals(ctx); err != nil {
+		return err
+	}
+	return b.tryStartExecuting(ctx)
+}
+
+// heightIntervalFailed drops the interval below [height] and fetches by ID the
+// missing block that was waiting for it, if any.
+func (b *Bootstrapper) heightIntervalFailed(ctx context.Context, height uint64) error {
+	blkID, blkHeight, waiting := b.waitingMissingBlock(height)
+	delete(b.heightIntervals, height)
+	if waiting {
+		if err := b.fetch(ctx, blkID, blkHeight); err != nil {
+			return err
+		}
+	}
+
+	b.sendRequests(ctx)
+	return b.requestHeightIntervals(ctx)
+}
+
+// waitingMissingBlock returns the missing block, if any, that isn't being
+// fetched because it is expected to be included in the interval below [top].
+func (b *Bootstrapper) waitingMissingBlock(top uint64) (ids.ID, uint64, bool) {
+	interval, ok := b.heightIntervals[top]
+	if !ok {
+		return ids.Empty, 0, false
+	}
+	for blkID, height := range b.missingBlockHeights {
+		if height < interval.bottom || top < height || !b.missingBlockIDs.Contains(blkID) {
+			continue
+		}
+		if !b.outstandingRequests.HasValue(blkID) && !b.queuedRequests.Contains(blkID) {
+			return blkID, height, true
+		}
+	}
+	return ids.Empty, 0, false
+}
+
+// fetchMissing fetches the missing block [blkID], which is expected to be at
+// [height]. If the block is included in an interval that was requested by
+// height, the block is taken from the interval instead.
+func (b *Bootstrapper) fetchMissing(ctx context.Context, blkID ids.ID, height uint64) error {
+	top, ok := b.coveringHeightInterval(height)
+	if !ok {
+		return b.fetch(ctx, blkID, height)
+	}
+
+	interval := b.heightIntervals[top]
+	if !interval.received() {
+		// The block will be processed once the interval is received.
+		return nil
+	}
+
+	delete(b.heightIntervals, top)
+	blk, ok := interval.blocks[blkID]
+	if !ok {
+		// The peer may have sent fewer blocks than requested.
+		if height >= interval.lowest {
+			b.Ctx.Log.Debug("dropping interval that doesn't include the missing block",
+				zap.Stringer("nodeID", interval.nodeID),
+				zap.Uint64("height", top),
+				zap.Stringer("blkID", blkID),
+			)
+			b.PeerTracker.RegisterFailure(interval.nodeID)
+		}
+		return b.fetch(ctx, blkID, height)
+	}
+
+	delete(b.missingBlockHeights, blkID)
+	return b.process(ctx, blk, interval.blocks)
+}
+
 func (b *Bootstrapper) GetAncestorsFailed(ctx context.Context, nodeID ids.NodeID, requestID uint32) error {
 	request := common.Request{
 		NodeID:    nodeID,
 		RequestID: requestID,
 	}
-	blkID, ok := b.outstandingRequests.DeleteKey(request)
+	if height, _, ok := b.removeHeightRequest(request); ok {
+		// Peers running an older version don't serve requests by height.
+		b.PeerTracker.RegisterFailure(nodeID)
+		return b.heightIntervalFailed(ctx, height)
+	}
+
+	blkID, _, ok := b.removeRequest(request)
 	if !ok {
 		b.Ctx.Log.Debug("unexpectedly called GetAncestorsFailed",
 			zap.Stringer("nodeID", nodeID),
@@ -568,13 +965,12 @@ func (b *Bootstrapper) GetAncestorsFailed(ctx context.Context, nodeID ids.NodeID
 		)
 		return nil
 	}
-	delete(b.outstandingRequestTimes, request)
 
 	// This node timed out their request.
 	b.PeerTracker.RegisterFailure(nodeID)
 
 	// Send another request for this
-	return b.fetch(ctx, blkID)
+	return b.fetch(ctx, blkID, b.missingBlockHeights[blkID])
 }
 
 // process a series of consecutive blocks starting at [blk].
@@ -597,7 +993,7 @@ func (b *Bootstrapper) process(
 	numPreviouslyFetched := b.tree.Len()
 
 	batch := b.DB.NewBatch()
-	missingBlockID, foundNewMissingID, err := process(
+	missingBlockID, missingBlockHeight, foundNewMissingID, err := process(
 		batch,
 		b.tree,
 		b.missingBlockIDs,
@@ -656,8 +1052,9 @@ func (b *Bootstrapper) process(
 	}
 
 	b.missingBlockIDs.Add(missingBlockID)
+	b.missingBlockHeights[missingBlockID] = missingBlockHeight
 	// Attempt to fetch the newly discovered block
-	return b.fetch(ctx, missingBlockID)
+	return b.fetchMissing(ctx, missingBlockID, missingBlockHeight)
 }
 
 // tryStartExecuting executes all pending blocks if there are no more blocks
@@ -695,6 +1092,8 @@ func (b *Bootstrapper) tryStartExecuting(ctx context.Context) error {
 		},
 		b.tree,
 		lastAccepted.Height(),
+		b.Config.ParseWorkers,
+		b.metrics,
 	)
 	if err != nil {
 		// If a fatal error has occurred, include the last accepted block
@@ -745,6 +1144,23 @@ func (b *Bootstrapper) tryStartExecuting(ctx context.Context) error {
 	return b.onFinished(ctx, b.requestID)
 }
 
+// heightInterval is a sequence of blocks requested by the height of its highest
+// block. Its blocks aren't trusted until they are reached by following the
+// parent IDs of the accepted frontier.
+type heightInterval struct {
+	nodeID ids.NodeID
+	// bottom is the lowest height of the missing blocks that are expected to
+	// be included in this interval.
+	bottom uint64
+	// lowest is the height of the lowest block that was received.
+	lowest uint64
+	blocks map[ids.ID]snowman.Block
+}
+
+func (i *heightInterval) received() bool {
+	return i.blocks != nil
+}
+
 func (b *Bootstrapper) getLastAccepted(ctx context.Context) (snowman.Block, error) {
 	lastAcceptedID, err := b.VM.LastAccepted(ctx)
 	if err != nil {
@@ -774,6 +1190,11 @@ func (b *Bootstrapper) restartBootstrapping(ctx context.Context) error {
 	b.restarted = true
 	b.outstandingRequests = bimap.New[common.Request, ids.ID]()
 	b.outstandingRequestTimes = make(map[common.Request]time.Time)
+	b.outstandingRequestsPerPeer = make(map[ids.NodeID]int)
+	b.queuedRequests = newRequestQueue()
+	b.missingBlockHeights = make(map[ids.ID]uint64)
+	b.outstandingHeightRequests = make(map[common.Request]uint64)
+	b.heightIntervals = make(map[uint64]*heightInterval)
 	return b.startBootstrapping(ctx)
 }
 
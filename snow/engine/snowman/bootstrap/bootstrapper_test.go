@@ -76,7 +76,7 @@ func newConfig(t *testing.T) (Config, ids.NodeID, *enginetest.Sender, *blocktest
 
 	require.NoError(startupTracker.Connected(t.Context(), peer, version.Current))
 
-	snowGetHandler, err := getter.New(vm, sender, ctx.Log, time.Second, 2000, ctx.Registerer)
+	snowGetHandler, err := getter.New(vm, sender, ctx.Log, time.Second, 2000, nil, ctx.Registerer)
 	require.NoError(err)
 
 	peerTracker, err := p2p.NewPeerTracker(
@@ -130,7 +130,7 @@ func TestBootstrapperStartsOnlyIfEnoughStakeIsConnected(t *testing.T) {
 	startupTracker := tracker.NewStartup(tracker.NewPeers(), startupAlpha)
 	peers.RegisterSetCallbackListener(ctx.SubnetID, startupTracker)
 
-	snowGetHandler, err := getter.New(vm, sender, ctx.Log, time.Second, 2000, ctx.Registerer)
+	snowGetHandler, err := getter.New(vm, sender, ctx.Log, time.Second, 2000, nil, ctx.Registerer)
 	require.NoError(err)
 
 	peerTracker, err := p2p.NewPeerTracker(
@@ -338,6 +338,243 @@ func TestBootstrapperPartialFetch(t *testing.T) {
 	require.Equal(snow.NormalOp, config.Ctx.State.Get().State)
 }
 
+// Requests are queued once the maximum number of outstanding requests is
+// reached, and the lowest missing blocks are requested first.
+func TestBootstrapperMaxOutstandingRequests(t *testing.T) {
+	require := require.New(t)
+
+	config, peerID, sender, vm, _ := newConfig(t)
+	config.MaxOutstandingRequests = 1
+
+	blks := snowmantest.BuildChain(6)
+	initializeVMWithBlockchain(vm, blks)
+
+	bs, err := New(
+		config,
+		func(context.Context, uint32) error {
+			config.Ctx.State.Set(snow.EngineState{
+				Type:  p2ppb.EngineType_ENGINE_TYPE_CHAIN,
+				State: snow.NormalOp,
+			})
+			return nil
+		},
+	)
+	require.NoError(err)
+	bs.TimeoutRegistrar = &enginetest.Timer{}
+
+	require.NoError(bs.Start(t.Context(), 0))
+
+	var (
+		requestID uint32
+		requested []ids.ID
+	)
+	sender.SendGetAncestorsF = func(_ context.Context, nodeID ids.NodeID, reqID uint32, blkID ids.ID) {
+		require.Equal(peerID, nodeID)
+		requestID = reqID
+		requested = append(requested, blkID)
+	}
+
+	// Only one of the two unknown blocks is requested.
+	require.NoError(bs.startSyncing(t.Context(), blocksToIDs([]*snowmantest.Block{blks[2], blks[5]})))
+	require.Len(requested, 1)
+	require.Equal(1, bs.outstandingRequests.Len())
+	require.Equal(1, bs.queuedRequests.Len())
+
+	// Respond with only the requested block until everything is fetched. The
+	// response frees the request slot for the lowest missing block.
+	blksByID := make(map[ids.ID]*snowmantest.Block, len(blks))
+	for _, blk := range blks {
+		blksByID[blk.ID()] = blk
+	}
+	for i := 0; bs.outstandingRequests.Len() > 0; i++ {
+		blk := blksByID[requested[i]]
+		require.NoError(bs.Ancestors(t.Context(), peerID, requestID, [][]byte{blk.Bytes()}))
+		require.LessOrEqual(bs.outstandingRequests.Len(), 1)
+	}
+	require.Len(requested, 5)
+	// Blocks with an unknown height are requested first.
+	require.ElementsMatch(blocksToIDs([]*snowmantest.Block{blks[2], blks[5]}), requested[:2])
+	require.Equal(blocksToIDs([]*snowmantest.Block{blks[1], blks[4], blks[3]}), requested[2:])
+	require.Zero(bs.queuedRequests.Len())
+	snowmantest.RequireStatusIs(require, snowtest.Accepted, blks...)
+}
+
+// Intervals of blocks below the missing blocks are requested by height, and
+// are only processed once they are reached by following the parent IDs of the
+// accepted frontier.
+func TestBootstrapperHeightIntervals(t *testing.T) {
+	require := require.New(t)
+
+	config, peerID, sender, vm, _ := newConfig(t)
+	config.MaxOutstandingRequests = 3
+	config.AncestorsMaxContainersReceived = 2
+	config.PeerTracker.Connected(peerID, minHeightRequestVersion)
+
+	blks := snowmantest.BuildChain(8)
+	initializeVMWithBlockchain(vm, blks)
+
+	bs, err := New(
+		config,
+		func(context.Context, uint32) error {
+			config.Ctx.State.Set(snow.EngineState{
+				Type:  p2ppb.EngineType_ENGINE_TYPE_CHAIN,
+				State: snow.NormalOp,
+			})
+			return nil
+		},
+	)
+	require.NoError(err)
+	bs.TimeoutRegistrar = &enginetest.Timer{}
+
+	require.NoError(bs.Start(t.Context(), 0))
+
+	var (
+		requestedIDs     = make(map[ids.ID]uint32)
+		requestedHeights = make(map[uint64]uint32)
+	)
+	sender.SendGetAncestorsF = func(_ context.Context, nodeID ids.NodeID, reqID uint32, blkID ids.ID) {
+		require.Equal(peerID, nodeID)
+		requestedIDs[blkID] = reqID
+	}
+	sender.SendGetAncestorsAtHeightF = func(_ context.Context, nodeID ids.NodeID, reqID uint32, height uint64) {
+		require.Equal(peerID, nodeID)
+		requestedHeights[height] = reqID
+	}
+
+	require.NoError(bs.startSyncing(t.Context(), blocksToIDs(blks[7:8])))
+	require.Contains(requestedIDs, blks[7].ID())
+	require.Empty(requestedHeights)
+
+	// Once the height of the missing block is known, the intervals below it
+	// are requested by height.
+	require.NoError(bs.Ancestors(t.Context(), peerID, requestedIDs[blks[7].ID()], blocksToBytes(blks[6:8])))
+	require.Contains(requestedIDs, blks[5].ID())
+	require.Equal(map[uint64]uint32{3: requestedHeights[3], 1: requestedHeights[1]}, requestedHeights)
+
+	// The intervals aren't stored until they are reached from the accepted
+	// frontier.
+	require.NoError(bs.Ancestors(t.Context(), peerID, requestedHeights[1], blocksToBytes(blks[1:2])))
+	require.NoError(bs.Ancestors(t.Context(), peerID, requestedHeights[3], blocksToBytes(blks[2:4])))
+	require.Equal(uint64(2), bs.tree.Len())
+
+	require.NoError(bs.Ancestors(t.Context(), peerID, requestedIDs[blks[5].ID()], blocksToBytes(blks[4:6])))
+	require.Len(requestedIDs, 2)
+	require.Empty(bs.heightIntervals)
+	snowmantest.RequireStatusIs(require, snowtest.Accepted, blks...)
+}
+
+// Intervals aren't requested by height from peers that don't serve requests by
+// height.
+func TestBootstrapperHeightIntervalsUnsupportedPeer(t *testing.T) {
+	require := require.New(t)
+
+	config, peerID, sender, vm, _ := newConfig(t)
+	config.MaxOutstandingRequests = 3
+	config.AncestorsMaxContainersReceived = 2
+	config.PeerTracker.Connected(peerID, &version.Application{
+		Name:  version.Client,
+		Major: minHeightRequestVersion.Major,
+		Minor: minHeightRequestVersion.Minor,
+		Patch: minHeightRequestVersion.Patch - 1,
+	})
+
+	blks := snowmantest.BuildChain(8)
+	initializeVMWithBlockchain(vm, blks)
+
+	bs, err := New(
+		config,
+		func(context.Context, uint32) error {
+			config.Ctx.State.Set(snow.EngineState{
+				Type:  p2ppb.EngineType_ENGINE_TYPE_CHAIN,
+				State: snow.NormalOp,
+			})
+			return nil
+		},
+	)
+	require.NoError(err)
+	bs.TimeoutRegistrar = &enginetest.Timer{}
+
+	require.NoError(bs.Start(t.Context(), 0))
+
+	requestedIDs := make(map[ids.ID]uint32)
+	sender.SendGetAncestorsF = func(_ context.Context, nodeID ids.NodeID, reqID uint32, blkID ids.ID) {
+		require.Equal(peerID, nodeID)
+		requestedIDs[blkID] = reqID
+	}
+	sender.SendGetAncestorsAtHeightF = func(context.Context, ids.NodeID, uint32, uint64) {
+		require.FailNow("requested an interval by height")
+	}
+
+	require.NoError(bs.startSyncing(t.Context(), blocksToIDs(blks[7:8])))
+	require.NoError(bs.Ancestors(t.Context(), peerID, requestedIDs[blks[7].ID()], blocksToBytes(blks[6:8])))
+	require.Empty(bs.heightIntervals)
+
+	// The missing blocks are only fetched by ID.
+	require.NoError(bs.Ancestors(t.Context(), peerID, requestedIDs[blks[5].ID()], blocksToBytes(blks[4:6])))
+	require.NoError(bs.Ancestors(t.Context(), peerID, requestedIDs[blks[3].ID()], blocksToBytes(blks[2:4])))
+	require.NoError(bs.Ancestors(t.Context(), peerID, requestedIDs[blks[1].ID()], blocksToBytes(blks[1:2])))
+	snowmantest.RequireStatusIs(require, snowtest.Accepted, blks...)
+}
+
+// An interval that doesn't include the missing block at its height is dropped
+// and the missing block is fetched by ID.
+func TestBootstrapperHeightIntervalNotAncestor(t *testing.T) {
+	require := require.New(t)
+
+	config, peerID, sender, vm, _ := newConfig(t)
+	config.MaxOutstandingRequests = 3
+	config.AncestorsMaxContainersReceived = 2
+	config.PeerTracker.Connected(peerID, minHeightRequestVersion)
+
+	blks := snowmantest.BuildChain(8)
+	conflict := snowmantest.BuildChild(blks[2])
+	initializeVMWithBlockchain(vm, append(blks, conflict))
+
+	bs, err := New(
+		config,
+		func(context.Context, uint32) error {
+			config.Ctx.State.Set(snow.EngineState{
+				Type:  p2ppb.EngineType_ENGINE_TYPE_CHAIN,
+				State: snow.NormalOp,
+			})
+			return nil
+		},
+	)
+	require.NoError(err)
+	bs.TimeoutRegistrar = &enginetest.Timer{}
+
+	require.NoError(bs.Start(t.Context(), 0))
+
+	var (
+		requestedIDs     = make(map[ids.ID]uint32)
+		requestedHeights = make(map[uint64]uint32)
+	)
+	sender.SendGetAncestorsF = func(_ context.Context, _ ids.NodeID, reqID uint32, blkID ids.ID) {
+		requestedIDs[blkID] = reqID
+	}
+	sender.SendGetAncestorsAtHeightF = func(_ context.Context, _ ids.NodeID, reqID uint32, height uint64) {
+		requestedHeights[height] = reqID
+	}
+
+	require.NoError(bs.startSyncing(t.Context(), blocksToIDs(blks[7:8])))
+	require.NoError(bs.Ancestors(t.Context(), peerID, requestedIDs[blks[7].ID()], blocksToBytes(blks[6:8])))
+	require.Contains(requestedHeights, uint64(3))
+
+	// Respond to the request for height 3 with a block that isn't an ancestor
+	// of the accepted frontier.
+	require.NoError(bs.Ancestors(t.Context(), peerID, requestedHeights[3], [][]byte{conflict.Bytes()}))
+	require.NoError(bs.Ancestors(t.Context(), peerID, requestedHeights[1], blocksToBytes(blks[1:2])))
+
+	// Once the missing block at height 3 is known, it is fetched by ID.
+	require.NoError(bs.Ancestors(t.Context(), peerID, requestedIDs[blks[5].ID()], blocksToBytes(blks[4:6])))
+	require.Contains(requestedIDs, blks[3].ID())
+
+	require.NoError(bs.Ancestors(t.Context(), peerID, requestedIDs[blks[3].ID()], blocksToBytes(blks[2:4])))
+	require.Empty(bs.heightIntervals)
+	snowmantest.RequireStatusIs(require, snowtest.Accepted, blks...)
+	require.Equal(snowtest.Undecided, conflict.Status)
+}
+
 // There are multiple needed blocks and some validators do not have all the
 // blocks.
 func TestBootstrapperEmptyResponse(t *testing.T) {
@@ -655,7 +892,7 @@ func TestBootstrapNoParseOnNew(t *testing.T) {
 	peers.RegisterSetCallbackListener(ctx.SubnetID, startupTracker)
 	require.NoError(startupTracker.Connected(t.Context(), peer, version.Current))
 
-	snowGetHandler, err := getter.New(vm, sender, ctx.Log, time.Second, 2000, ctx.Registerer)
+	snowGetHandler, err := getter.New(vm, sender, ctx.Log, time.Second, 2000, nil, ctx.Registerer)
 	require.NoError(err)
 
 	blk1 := snowmantest.BuildChild(snowmantest.Genesis)
@@ -31,6 +31,24 @@ type Config struct {
 	// containers in an ancestors message it receives.
 	AncestorsMaxContainersReceived int
 
+	// MaxOutstandingRequests is the maximum number of GetAncestors requests
+	// that are outstanding at once. Missing blocks are fetched concurrently
+	// from different peers, and the blocks closest to the last accepted block
+	// are requested first. If 0, the number of requests isn't limited.
+	//
+	// If greater than 1, up to MaxOutstandingRequests-1 intervals of blocks
+	// below the missing blocks are additionally requested by height from
+	// different peers, so that disjoint intervals of the chain are fetched
+	// concurrently.
+	MaxOutstandingRequests int
+
+	// ParseWorkers is the number of goroutines that parse the fetched blocks
+	// ahead of their execution. If 0, blocks are parsed when they are
+	// executed. Otherwise, NonVerifyingParse must be safe to call
+	// concurrently with the execution of blocks, which VMs advertise by
+	// implementing block.ConcurrentParser.
+	ParseWorkers int
+
 	// Database used to track the fetched, but not yet executed, blocks during
 	// bootstrapping.
 	DB database.Database
@@ -88,6 +88,15 @@ func GetBlockIteratorWithStart(db database.Iteratee, height uint64) database.Ite
 	)
 }
 
+// ParseBlockKey returns the height of the block that a block iterator produced
+// the value of at [key].
+func ParseBlockKey(key []byte) (uint64, error) {
+	if len(key) < prefixLen {
+		return 0, errInvalidKeyLength
+	}
+	return database.ParseUInt64(key[prefixLen:])
+}
+
 func GetBlock(db database.KeyValueReader, height uint64) ([]byte, error) {
 	return db.Get(makeBlockKey(height))
 }
@@ -11,6 +11,13 @@ import (
 
 type metrics struct {
 	numFetched, numAccepted prometheus.Counter
+
+	// fetching stage
+	numOutstandingRequests, numQueuedRequests prometheus.Gauge
+
+	// execution stage
+	numBuffered         prometheus.Gauge
+	parseTime, execTime prometheus.Counter
 }
 
 func newMetrics(registerer prometheus.Registerer) (*metrics, error) {
@@ -23,11 +30,36 @@ func newMetrics(registerer prometheus.Registerer) (*metrics, error) {
 			Name: "bs_accepted",
 			Help: "Number of blocks accepted during bootstrapping",
 		}),
+		numOutstandingRequests: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "bs_outstanding_requests",
+			Help: "Number of outstanding GetAncestors requests during bootstrapping",
+		}),
+		numQueuedRequests: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "bs_queued_requests",
+			Help: "Number of missing blocks waiting for a GetAncestors request to be sent during bootstrapping",
+		}),
+		numBuffered: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "bs_buffered",
+			Help: "Number of blocks read ahead of execution during bootstrapping",
+		}),
+		parseTime: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "bs_parse_time",
+			Help: "Time spent parsing blocks before execution during bootstrapping (in nanoseconds)",
+		}),
+		execTime: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "bs_execute_time",
+			Help: "Time spent verifying and accepting blocks during bootstrapping (in nanoseconds)",
+		}),
 	}
 
 	err := errors.Join(
 		registerer.Register(m.numFetched),
 		registerer.Register(m.numAccepted),
+		registerer.Register(m.numOutstandingRequests),
+		registerer.Register(m.numQueuedRequests),
+		registerer.Register(m.numBuffered),
+		registerer.Register(m.parseTime),
+		registerer.Register(m.execTime),
 	)
 	return m, err
 }
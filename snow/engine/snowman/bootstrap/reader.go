@@ -0,0 +1,240 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bootstrap
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/bootstrap/interval"
+)
+
+// maxBufferedBlocks is the maximum number of blocks that are read and parsed
+// ahead of execution. It bounds the memory used by the pipelined reader.
+const maxBufferedBlocks = 256
+
+var (
+	_ blockReader = (*sequentialReader)(nil)
+	_ blockReader = (*pipelinedReader)(nil)
+)
+
+// blockReader reads the fetched blocks in order of increasing height.
+type blockReader interface {
+	// Next returns the next block, or false if all the blocks have been read.
+	Next() (snowman.Block, bool, error)
+	// Release releases the resources held by the reader.
+	Release()
+}
+
+// sequentialReader parses each block when it is read.
+type sequentialReader struct {
+	ctx     context.Context
+	db      database.Iteratee
+	parser  block.Parser
+	metrics *metrics
+	// flush is called before the iterator is re-grabbed so that the executed
+	// blocks are deleted from the database.
+	flush func() error
+
+	iterator                      database.Iterator
+	processedSinceIteratorRelease uint
+	lastHeight                    uint64
+}
+
+func newSequentialReader(
+	ctx context.Context,
+	db database.Iteratee,
+	parser block.Parser,
+	metrics *metrics,
+	flush func() error,
+) *sequentialReader {
+	return &sequentialReader{
+		ctx:      ctx,
+		db:       db,
+		parser:   parser,
+		metrics:  metrics,
+		flush:    flush,
+		iterator: interval.GetBlockIterator(db),
+	}
+}
+
+func (r *sequentialReader) Next() (snowman.Block, bool, error) {
+	// Periodically release and re-grab the database iterator to avoid keeping
+	// a reference to an old database revision.
+	if r.processedSinceIteratorRelease >= iteratorReleasePeriod {
+		if err := r.iterator.Error(); err != nil {
+			return nil, false, err
+		}
+
+		// The batch must be written here to avoid re-processing a block.
+		if err := r.flush(); err != nil {
+			return nil, false, err
+		}
+
+		r.processedSinceIteratorRelease = 0
+		r.iterator.Release()
+		// We specify the starting key of the iterator so that the underlying
+		// database doesn't need to scan over the, potentially not yet
+		// compacted, blocks we just deleted.
+		r.iterator = interval.GetBlockIteratorWithStart(r.db, r.lastHeight+1)
+	}
+
+	if !r.iterator.Next() {
+		return nil, false, r.iterator.Error()
+	}
+
+	start := time.Now()
+	blk, err := r.parser.ParseBlock(r.ctx, r.iterator.Value())
+	r.metrics.parseTime.Add(float64(time.Since(start)))
+	if err != nil {
+		return nil, false, err
+	}
+
+	r.lastHeight = blk.Height()
+	r.processedSinceIteratorRelease++
+	return blk, true, nil
+}
+
+func (r *sequentialReader) Release() {
+	r.iterator.Release()
+}
+
+type parseJob struct {
+	blkBytes []byte
+	// done is closed once blk or err is set.
+	done chan struct{}
+	blk  snowman.Block
+	err  error
+}
+
+// pipelinedReader reads blocks ahead of execution and parses them
+// concurrently, so that parsing, which typically includes signature
+// verification, overlaps with the execution of the previous blocks.
+//
+// The parser must be safe to call concurrently with the execution of blocks.
+type pipelinedReader struct {
+	cancel  context.CancelFunc
+	metrics *metrics
+
+	// jobs are the blocks that have been read, in order of increasing height.
+	jobs chan *parseJob
+	// readErr is set before jobs is closed.
+	readErr error
+
+	wg sync.WaitGroup
+}
+
+func newPipelinedReader(
+	ctx context.Context,
+	db database.Iteratee,
+	parser block.Parser,
+	metrics *metrics,
+	numWorkers int,
+) *pipelinedReader {
+	ctx, cancel := context.WithCancel(ctx)
+	r := &pipelinedReader{
+		cancel:  cancel,
+		metrics: metrics,
+		jobs:    make(chan *parseJob, maxBufferedBlocks),
+	}
+
+	work := make(chan *parseJob, maxBufferedBlocks)
+	r.wg.Add(1 + numWorkers)
+	go func() {
+		defer r.wg.Done()
+		defer close(r.jobs)
+		defer close(work)
+
+		r.readErr = r.read(ctx, db, work)
+	}()
+	for range numWorkers {
+		go func() {
+			defer r.wg.Done()
+
+			for job := range work {
+				start := time.Now()
+				job.blk, job.err = parser.ParseBlock(ctx, job.blkBytes)
+				r.metrics.parseTime.Add(float64(time.Since(start)))
+				close(job.done)
+			}
+		}()
+	}
+	return r
+}
+
+// read sends the blocks in [db] to the parsing workers and to the executor, in
+// order of increasing height.
+func (r *pipelinedReader) read(ctx context.Context, db database.Iteratee, work chan<- *parseJob) error {
+	var (
+		iterator                      = interval.GetBlockIterator(db)
+		processedSinceIteratorRelease uint
+	)
+	defer func() {
+		iterator.Release()
+	}()
+
+	for iterator.Next() {
+		key := iterator.Key()
+		job := &parseJob{
+			blkBytes: bytes.Clone(iterator.Value()),
+			done:     make(chan struct{}),
+		}
+		select {
+		case work <- job:
+		case <-ctx.Done():
+			return nil
+		}
+		select {
+		case r.jobs <- job:
+			r.metrics.numBuffered.Set(float64(len(r.jobs)))
+		case <-ctx.Done():
+			return nil
+		}
+
+		processedSinceIteratorRelease++
+		if processedSinceIteratorRelease < iteratorReleasePeriod {
+			continue
+		}
+
+		if err := iterator.Error(); err != nil {
+			return err
+		}
+		height, err := interval.ParseBlockKey(key)
+		if err != nil {
+			return err
+		}
+
+		// Blocks that were already read are skipped, so executed blocks don't
+		// need to be deleted before the iterator is re-grabbed.
+		processedSinceIteratorRelease = 0
+		iterator.Release()
+		iterator = interval.GetBlockIteratorWithStart(db, height+1)
+	}
+	return iterator.Error()
+}
+
+func (r *pipelinedReader) Next() (snowman.Block, bool, error) {
+	job, ok := <-r.jobs
+	if !ok {
+		return nil, false, r.readErr
+	}
+	r.metrics.numBuffered.Set(float64(len(r.jobs)))
+
+	<-job.done
+	if job.err != nil {
+		return nil, false, job.err
+	}
+	return job.blk, true, nil
+}
+
+func (r *pipelinedReader) Release() {
+	r.cancel()
+	r.wg.Wait()
+	r.metrics.numBuffered.Set(0)
+}
@@ -76,7 +76,8 @@ func getMissingBlockIDs(
 // If [blk]'s height is <= the last accepted height, then it will be removed
 // from the missingIDs set.
 //
-// Returns a newly discovered blockID that should be fetched.
+// Returns a newly discovered blockID that should be fetched, along with its
+// height.
 func process(
 	db database.KeyValueWriterDeleter,
 	tree *interval.Tree,
@@ -84,7 +85,7 @@ func process(
 	lastAcceptedHeight uint64,
 	blk snowman.Block,
 	ancestors map[ids.ID]snowman.Block,
-) (ids.ID, bool, error) {
+) (ids.ID, uint64, bool, error) {
 	for {
 		// It's possible that missingBlockIDs contain values contained inside of
 		// ancestors. So, it's important to remove IDs from the set for each
@@ -102,7 +103,7 @@ func process(
 			blkBytes,
 		)
 		if err != nil || !wantsParent {
-			return ids.Empty, false, err
+			return ids.Empty, 0, false, err
 		}
 
 		// If the parent was provided in the ancestors set, we can immediately
@@ -110,7 +111,7 @@ func process(
 		parentID := blk.Parent()
 		parent, ok := ancestors[parentID]
 		if !ok {
-			return parentID, true, nil
+			return parentID, height - 1, true, nil
 		}
 
 		blk = parent
@@ -121,6 +122,10 @@ func process(
 // already accepted based on the lastAcceptedHeight, it will be removed from the
 // tree but not executed.
 //
+// If [parseWorkers] is non-zero, blocks are parsed by [parseWorkers]
+// goroutines ahead of execution. Otherwise, blocks are parsed when they are
+// executed.
+//
 // execute assumes that getMissingBlockIDs would return an empty set.
 //
 // TODO: Replace usage of haltable with context cancellation.
@@ -132,6 +137,8 @@ func execute(
 	nonVerifyingParser block.Parser,
 	tree *interval.Tree,
 	lastAcceptedHeight uint64,
+	parseWorkers int,
+	metrics *metrics,
 ) error {
 	totalNumberToProcess := tree.Len()
 	if totalNumberToProcess >= minBlocksToCompact {
@@ -160,15 +167,19 @@ func execute(
 			return nil
 		}
 
-		iterator                      = interval.GetBlockIterator(db)
-		processedSinceIteratorRelease uint
+		reader blockReader
 
 		startTime     = time.Now()
 		timeOfNextLog = startTime.Add(logPeriod)
 		etaTracker    = timer.NewEtaTracker(10, 1.2)
 	)
+	if parseWorkers > 0 {
+		reader = newPipelinedReader(ctx, db, nonVerifyingParser, metrics, parseWorkers)
+	} else {
+		reader = newSequentialReader(ctx, db, nonVerifyingParser, metrics, writeBatch)
+	}
 	defer func() {
-		iterator.Release()
+		reader.Release()
 
 		var (
 			numProcessed = totalNumberToProcess - tree.Len()
@@ -194,17 +205,20 @@ func execute(
 
 	log("executing blocks",
 		zap.Uint64("numToExecute", totalNumberToProcess),
+		zap.Int("numParseWorkers", parseWorkers),
 	)
 
 	// Add the first sample to the EtaTracker to establish an accurate baseline
 	etaTracker.AddSample(0, totalNumberToProcess, startTime)
 
-	for !shouldHalt() && iterator.Next() {
-		blkBytes := iterator.Value()
-		blk, err := nonVerifyingParser.ParseBlock(ctx, blkBytes)
+	for !shouldHalt() {
+		blk, ok, err := reader.Next()
 		if err != nil {
 			return err
 		}
+		if !ok {
+			break
+		}
 
 		height := blk.Height()
 		if err := interval.Remove(batch, tree, height); err != nil {
@@ -219,27 +233,6 @@ func execute(
 			}
 		}
 
-		// Periodically release and re-grab the database iterator to avoid
-		// keeping a reference to an old database revision.
-		processedSinceIteratorRelease++
-		if processedSinceIteratorRelease >= iteratorReleasePeriod {
-			if err := iterator.Error(); err != nil {
-				return err
-			}
-
-			// The batch must be written here to avoid re-processing a block.
-			if err := writeBatch(); err != nil {
-				return err
-			}
-
-			processedSinceIteratorRelease = 0
-			iterator.Release()
-			// We specify the starting key of the iterator so that the
-			// underlying database doesn't need to scan over the, potentially
-			// not yet compacted, blocks we just deleted.
-			iterator = interval.GetBlockIteratorWithStart(db, height+1)
-		}
-
 		if now := time.Now(); now.After(timeOfNextLog) {
 			numProcessed := totalNumberToProcess - tree.Len()
 
@@ -264,6 +257,7 @@ func execute(
 			continue
 		}
 
+		start := time.Now()
 		if err := blk.Verify(ctx); err != nil {
 			return fmt.Errorf("failed to verify block %s (height=%d, parentID=%s) in bootstrapping: %w",
 				blk.ID(),
@@ -280,9 +274,7 @@ func execute(
 				err,
 			)
 		}
+		metrics.execTime.Add(float64(time.Since(start)))
 	}
-	if err := writeBatch(); err != nil {
-		return err
-	}
-	return iterator.Error()
+	return writeBatch()
 }
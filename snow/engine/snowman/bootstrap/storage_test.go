@@ -6,8 +6,10 @@ package bootstrap
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ava-labs/avalanchego/database"
@@ -114,6 +116,7 @@ func TestProcess(t *testing.T) {
 		blk                         snowman.Block
 		ancestors                   map[ids.ID]snowman.Block
 		expectedParentID            ids.ID
+		expectedParentHeight        uint64
 		expectedShouldFetchParentID bool
 		expectedMissingBlockIDs     set.Set[ids.ID]
 		expectedTrackedHeights      []uint64
@@ -126,6 +129,7 @@ func TestProcess(t *testing.T) {
 			blk:                         blocks[5],
 			ancestors:                   nil,
 			expectedParentID:            blocks[4].ID(),
+			expectedParentHeight:        4,
 			expectedShouldFetchParentID: true,
 			expectedMissingBlockIDs:     set.Set[ids.ID]{},
 			expectedTrackedHeights:      []uint64{5},
@@ -140,6 +144,7 @@ func TestProcess(t *testing.T) {
 				blocks[4].ID(): blocks[4],
 			},
 			expectedParentID:            blocks[3].ID(),
+			expectedParentHeight:        3,
 			expectedShouldFetchParentID: true,
 			expectedMissingBlockIDs:     set.Set[ids.ID]{},
 			expectedTrackedHeights:      []uint64{4, 5},
@@ -154,6 +159,7 @@ func TestProcess(t *testing.T) {
 				blocks[3].ID(): blocks[3],
 			},
 			expectedParentID:            blocks[4].ID(),
+			expectedParentHeight:        4,
 			expectedShouldFetchParentID: true,
 			expectedMissingBlockIDs:     set.Of(blocks[3].ID()),
 			expectedTrackedHeights:      []uint64{5},
@@ -195,7 +201,7 @@ func TestProcess(t *testing.T) {
 				require.NoError(err)
 			}
 
-			parentID, shouldFetchParentID, err := process(
+			parentID, parentHeight, shouldFetchParentID, err := process(
 				db,
 				tree,
 				test.missingBlockIDs,
@@ -206,6 +212,7 @@ func TestProcess(t *testing.T) {
 			require.NoError(err)
 			require.Equal(test.expectedShouldFetchParentID, shouldFetchParentID)
 			require.Equal(test.expectedParentID, parentID)
+			require.Equal(test.expectedParentHeight, parentHeight)
 			require.Equal(test.expectedMissingBlockIDs, test.missingBlockIDs)
 
 			require.Equal(uint64(len(test.expectedTrackedHeights)), tree.Len())
@@ -253,7 +260,61 @@ func TestExecute(t *testing.T) {
 		},
 	}
 	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
+		for _, parseWorkers := range []int{0, 4} {
+			t.Run(fmt.Sprintf("%s with %d parse workers", test.name, parseWorkers), func(t *testing.T) {
+				require := require.New(t)
+
+				db := memdb.New()
+				tree, err := interval.NewTree(db)
+				require.NoError(err)
+
+				blocks := snowmantest.BuildChain(numBlocks)
+				parser := makeParser(blocks)
+				for _, blk := range blocks {
+					_, err := interval.Add(db, tree, 0, blk.Height(), blk.Bytes())
+					require.NoError(err)
+				}
+
+				metrics, err := newMetrics(prometheus.NewRegistry())
+				require.NoError(err)
+
+				require.NoError(execute(
+					t.Context(),
+					test.haltable.Halted,
+					logging.NoLog{}.Info,
+					db,
+					parser,
+					tree,
+					test.lastAcceptedHeight,
+					parseWorkers,
+					metrics,
+				))
+				for _, height := range test.expectedProcessingHeights {
+					require.Equal(snowtest.Undecided, blocks[height].Status)
+				}
+				for _, height := range test.expectedAcceptedHeights {
+					require.Equal(snowtest.Accepted, blocks[height].Status)
+				}
+
+				if test.haltable.Halted() {
+					return
+				}
+
+				size, err := database.Count(db)
+				require.NoError(err)
+				require.Zero(size)
+			})
+		}
+	}
+}
+
+// TestExecuteReleasesIterator executes enough blocks for the database iterator
+// to be released and re-grabbed.
+func TestExecuteReleasesIterator(t *testing.T) {
+	const numBlocks = 2*iteratorReleasePeriod + 1
+
+	for _, parseWorkers := range []int{0, 4} {
+		t.Run(fmt.Sprintf("%d parse workers", parseWorkers), func(t *testing.T) {
 			require := require.New(t)
 
 			db := memdb.New()
@@ -261,30 +322,36 @@ func TestExecute(t *testing.T) {
 			require.NoError(err)
 
 			blocks := snowmantest.BuildChain(numBlocks)
-			parser := makeParser(blocks)
+			parsed := make(map[string]*snowmantest.Block, len(blocks))
 			for _, blk := range blocks {
+				parsed[string(blk.Bytes())] = blk
 				_, err := interval.Add(db, tree, 0, blk.Height(), blk.Bytes())
 				require.NoError(err)
 			}
+			parser := testParser(func(_ context.Context, b []byte) (snowman.Block, error) {
+				blk, ok := parsed[string(b)]
+				if !ok {
+					return nil, database.ErrNotFound
+				}
+				return blk, nil
+			})
+
+			metrics, err := newMetrics(prometheus.NewRegistry())
+			require.NoError(err)
 
 			require.NoError(execute(
 				t.Context(),
-				test.haltable.Halted,
+				(&common.Halter{}).Halted,
 				logging.NoLog{}.Info,
 				db,
 				parser,
 				tree,
-				test.lastAcceptedHeight,
+				0,
+				parseWorkers,
+				metrics,
 			))
-			for _, height := range test.expectedProcessingHeights {
-				require.Equal(snowtest.Undecided, blocks[height].Status)
-			}
-			for _, height := range test.expectedAcceptedHeights {
-				require.Equal(snowtest.Accepted, blocks[height].Status)
-			}
-
-			if test.haltable.Halted() {
-				return
+			for _, blk := range blocks {
+				require.Equal(snowtest.Accepted, blk.Status)
 			}
 
 			size, err := database.Count(db)
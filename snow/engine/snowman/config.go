@@ -25,4 +25,7 @@ type Config struct {
 	Params              snowball.Parameters
 	Consensus           snowman.Consensus
 	PartialSync         bool
+	// Timeouts, if provided, allows the engine to raise the maximum network
+	// timeout when Params.Adaptive is provided.
+	Timeouts NetworkTimeouts
 }
@@ -26,10 +26,12 @@ import (
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/bag"
 	"github.com/ava-labs/avalanchego/utils/bimap"
+	"github.com/ava-labs/avalanchego/utils/buffer"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/utils/units"
 )
 
@@ -50,6 +52,9 @@ type Engine struct {
 	Config
 	*metrics
 
+	// Tells the time. Can be faked for testing.
+	Clock mockable.Clock
+
 	// list of NoOpsHandler for messages dropped by engine
 	common.StateSummaryFrontierHandler
 	common.AcceptedStateSummaryHandler
@@ -63,6 +68,11 @@ type Engine struct {
 
 	// track outstanding preference requests
 	polls poll.Set
+	// most recently finished polls
+	recentPolls buffer.Queue[PollResult]
+	// tunes the number of concurrent repolls if adaptive parameters are
+	// provided, nil otherwise
+	adaptive *adaptiveTuner
 
 	// blocks that have we have sent get requests for but haven't yet received
 	blkReqs            *bimap.BiMap[common.Request, ids.ID]
@@ -132,6 +142,24 @@ func New(config Config) (*Engine, error) {
 		return nil, err
 	}
 
+	recentPolls, err := buffer.NewBoundedQueue[PollResult](maxRecentPolls, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var adaptive *adaptiveTuner
+	if config.Params.Adaptive != nil {
+		adaptive, err = newAdaptiveTuner(
+			config.Params,
+			config.Ctx.ChainID,
+			config.Timeouts,
+			config.Ctx.Registerer,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &Engine{
 		Config:                      config,
 		metrics:                     metrics,
@@ -148,6 +176,8 @@ func New(config Config) (*Engine, error) {
 		acceptedFrontiers:           acceptedFrontiers,
 		blocked:                     job.NewScheduler[ids.ID](),
 		polls:                       polls,
+		recentPolls:                 recentPolls,
+		adaptive:                    adaptive,
 		blkReqs:                     bimap.New[common.Request, ids.ID](),
 		blkReqSourceMetric:          make(map[common.Request]prometheus.Counter),
 	}, nil
@@ -357,6 +387,13 @@ func (e *Engine) PushQuery(ctx context.Context, nodeID ids.NodeID, requestID uin
 }
 
 func (e *Engine) Chits(ctx context.Context, nodeID ids.NodeID, requestID uint32, preferredID ids.ID, preferredIDAtHeight ids.ID, acceptedID ids.ID, acceptedHeight uint64) error {
+	if e.adaptive != nil {
+		e.adaptive.responseReceived(false)
+	}
+	return e.chits(ctx, nodeID, requestID, preferredID, preferredIDAtHeight, acceptedID, acceptedHeight)
+}
+
+func (e *Engine) chits(ctx context.Context, nodeID ids.NodeID, requestID uint32, preferredID ids.ID, preferredIDAtHeight ids.ID, acceptedID ids.ID, acceptedHeight uint64) error {
 	e.acceptedFrontiers.SetLastAccepted(nodeID, acceptedID, acceptedHeight)
 
 	e.Ctx.Log.Verbo("called Chits for the block",
@@ -415,9 +452,13 @@ func (e *Engine) Chits(ctx context.Context, nodeID ids.NodeID, requestID uint32,
 }
 
 func (e *Engine) QueryFailed(ctx context.Context, nodeID ids.NodeID, requestID uint32) error {
+	if e.adaptive != nil {
+		e.adaptive.responseReceived(true)
+	}
+
 	lastAcceptedID, lastAcceptedHeight, ok := e.acceptedFrontiers.LastAccepted(nodeID)
 	if ok {
-		return e.Chits(ctx, nodeID, requestID, lastAcceptedID, lastAcceptedID, lastAcceptedID, lastAcceptedHeight)
+		return e.chits(ctx, nodeID, requestID, lastAcceptedID, lastAcceptedID, lastAcceptedID, lastAcceptedHeight)
 	}
 
 	v := &voter{
@@ -437,6 +478,9 @@ func (e *Engine) Shutdown(ctx context.Context) error {
 	e.Ctx.Lock.Lock()
 	defer e.Ctx.Lock.Unlock()
 
+	if e.adaptive != nil {
+		e.adaptive.release()
+	}
 	return e.VM.Shutdown(ctx)
 }
 
@@ -696,7 +740,11 @@ func (e *Engine) repoll(ctx context.Context) {
 	// propagate the most likely branch as quickly as possible
 	prefID := e.Consensus.Preference()
 
-	for i := e.polls.Len(); i < e.Params.ConcurrentRepolls; i++ {
+	concurrentRepolls := e.Params.ConcurrentRepolls
+	if e.adaptive != nil {
+		concurrentRepolls = e.adaptive.concurrentRepolls
+	}
+	for i := e.polls.Len(); i < concurrentRepolls; i++ {
 		e.sendQuery(ctx, prefID, nil, false)
 	}
 }
@@ -912,6 +960,9 @@ func (e *Engine) sendQuery(
 		)
 		return
 	}
+	if e.adaptive != nil {
+		e.adaptive.pollIssued(e.Clock.Time())
+	}
 
 	vdrSet := set.Of(vdrIDs...)
 	if push {
@@ -91,6 +91,7 @@ func setup(t *testing.T, config Config) (ids.NodeID, validators.Manager, *engine
 		config.Ctx.Log,
 		time.Second,
 		2000,
+		nil,
 		config.Ctx.Registerer,
 	)
 	require.NoError(err)
@@ -631,6 +632,64 @@ func TestEngineRepoll(t *testing.T) {
 	require.True(*queried)
 }
 
+func TestEngineState(t *testing.T) {
+	require := require.New(t)
+
+	engCfg := DefaultConfig(t)
+	engCfg.Params.Beta = 2
+	vdr, _, sender, vm, te := setup(t, engCfg)
+
+	blk := snowmantest.BuildChild(snowmantest.Genesis)
+
+	var queryRequestID uint32
+	sender.SendPullQueryF = func(_ context.Context, _ set.Set[ids.NodeID], requestID uint32, _ ids.ID, _ uint64) {
+		queryRequestID = requestID
+	}
+	vm.GetBlockF = MakeGetBlockF(
+		[]*snowmantest.Block{snowmantest.Genesis, blk},
+	)
+
+	state := te.State()
+	require.Equal(snowmantest.GenesisID, state.Preference)
+	require.Equal(snowmantest.GenesisID, state.LastAcceptedID)
+	require.Empty(state.Processing)
+	require.Empty(state.Polls)
+	require.Empty(state.RecentPolls)
+
+	require.NoError(te.issue(
+		t.Context(),
+		te.Ctx.NodeID,
+		blk,
+		false,
+		te.metrics.issued.WithLabelValues(unknownSource),
+	))
+
+	state = te.State()
+	require.Equal(blk.ID(), state.Preference)
+	require.Len(state.Processing, 1)
+	require.Equal(blk.ID(), state.Processing[0].ID)
+	require.Equal(snowmantest.GenesisID, state.Processing[0].ParentID)
+	require.True(state.Processing[0].Preferred)
+	require.Len(state.Polls, 1)
+	require.Equal(queryRequestID, state.Polls[0].RequestID)
+	require.Empty(state.Polls[0].Responded)
+	require.Equal([]ids.NodeID{vdr}, state.Polls[0].Waiting)
+
+	firstRequestID := queryRequestID
+	require.NoError(te.Chits(t.Context(), vdr, queryRequestID, blk.ID(), blk.ID(), snowmantest.GenesisID, snowmantest.GenesisHeight))
+	require.Equal(snowtest.Undecided, blk.Status)
+
+	// The finished poll is recorded and the block is repolled.
+	state = te.State()
+	require.Len(state.Processing, 1)
+	require.Len(state.RecentPolls, 1)
+	require.Equal(1, state.RecentPolls[0].Votes.Count(blk.ID()))
+	require.Equal(blk.ID(), state.RecentPolls[0].Preference)
+	require.Equal(snowmantest.GenesisHeight, state.RecentPolls[0].LastAcceptedHeight)
+	require.Len(state.Polls, 1)
+	require.NotEqual(firstRequestID, state.Polls[0].RequestID)
+}
+
 func TestVoteCanceling(t *testing.T) {
 	require := require.New(t)
 
@@ -28,6 +28,7 @@ func New(
 	log logging.Logger,
 	maxTimeGetAncestors time.Duration,
 	maxContainersGetAncestors int,
+	checkpoint []byte,
 	reg prometheus.Registerer,
 ) (common.AllGetsServer, error) {
 	ssVM, _ := vm.(block.StateSyncableVM)
@@ -38,6 +39,7 @@ func New(
 		log:                       log,
 		maxTimeGetAncestors:       maxTimeGetAncestors,
 		maxContainersGetAncestors: maxContainersGetAncestors,
+		checkpoint:                checkpoint,
 	}
 
 	var err error
@@ -60,6 +62,9 @@ type getter struct {
 	maxTimeGetAncestors time.Duration
 	// Max number of containers in an ancestors message sent by this node.
 	maxContainersGetAncestors int
+	// Checkpoint, signed by the chain's validators, that is served alongside
+	// the state summary frontier. Can be empty.
+	checkpoint []byte
 
 	getAncestorsBlks metric.Averager
 }
@@ -77,8 +82,14 @@ func (gh *getter) GetStateSummaryFrontier(ctx context.Context, nodeID ids.NodeID
 		return nil
 	}
 
+	// If the state summary frontier isn't available, the checkpoint is still
+	// served so that the requester can sync to it.
+	var summaryBytes []byte
 	summary, err := gh.ssVM.GetLastStateSummary(ctx)
-	if err != nil {
+	switch {
+	case err == nil:
+		summaryBytes = summary.Bytes()
+	case len(gh.checkpoint) == 0:
 		gh.log.Debug("dropping GetStateSummaryFrontier message",
 			zap.String("reason", "couldn't get state summary frontier"),
 			zap.Stringer("nodeID", nodeID),
@@ -88,7 +99,7 @@ func (gh *getter) GetStateSummaryFrontier(ctx context.Context, nodeID ids.NodeID
 		return nil
 	}
 
-	gh.sender.SendStateSummaryFrontier(ctx, nodeID, requestID, summary.Bytes())
+	gh.sender.SendStateSummaryFrontier(ctx, nodeID, requestID, summaryBytes, gh.checkpoint)
 	return nil
 }
 
@@ -208,6 +219,21 @@ func (gh *getter) GetAncestors(ctx context.Context, nodeID ids.NodeID, requestID
 	return nil
 }
 
+func (gh *getter) GetAncestorsAtHeight(ctx context.Context, nodeID ids.NodeID, requestID uint32, height uint64) error {
+	blkID, err := gh.vm.GetBlockIDAtHeight(ctx, height)
+	if err != nil {
+		gh.log.Verbo("dropping GetAncestors message",
+			zap.String("reason", "couldn't get accepted block"),
+			zap.Stringer("nodeID", nodeID),
+			zap.Uint32("requestID", requestID),
+			zap.Uint64("height", height),
+			zap.Error(err),
+		)
+		return nil
+	}
+	return gh.GetAncestors(ctx, nodeID, requestID, blkID)
+}
+
 func (gh *getter) Get(ctx context.Context, nodeID ids.NodeID, requestID uint32, blkID ids.ID) error {
 	blk, err := gh.vm.GetBlock(ctx, blkID)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmantest"
@@ -50,6 +51,7 @@ func newTest(t *testing.T) (common.AllGetsServer, StateSyncEnabledMock, *enginet
 		logging.NoLog{},
 		time.Second,
 		2000,
+		nil,
 		prometheus.NewRegistry(),
 	)
 	require.NoError(t, err)
@@ -116,3 +118,77 @@ func TestFilterAccepted(t *testing.T) {
 	require.Contains(accepted, acceptedBlk.ID())
 	require.NotContains(accepted, unknownBlkID)
 }
+
+func TestGetAncestorsAtHeight(t *testing.T) {
+	require := require.New(t)
+	bs, vm, sender := newTest(t)
+
+	blks := snowmantest.BuildChain(3)
+	for _, blk := range blks[1:] {
+		require.NoError(blk.Accept(t.Context()))
+	}
+
+	vm.GetBlockIDAtHeightF = snowmantest.MakeGetBlockIDAtHeightF(blks)
+	vm.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		for _, blk := range blks {
+			if blk.ID() == blkID {
+				return blk, nil
+			}
+		}
+		return nil, errUnknownBlock
+	}
+
+	var ancestors [][]byte
+	sender.SendAncestorsF = func(_ context.Context, _ ids.NodeID, _ uint32, containers [][]byte) {
+		ancestors = containers
+	}
+
+	require.NoError(bs.GetAncestorsAtHeight(t.Context(), ids.EmptyNodeID, 0, 1))
+	require.Equal([][]byte{blks[1].Bytes(), blks[0].Bytes()}, ancestors)
+
+	// Requests for heights that weren't accepted are dropped.
+	ancestors = nil
+	require.NoError(bs.GetAncestorsAtHeight(t.Context(), ids.EmptyNodeID, 0, 3))
+	require.Nil(ancestors)
+}
+
+func TestGetStateSummaryFrontierServesCheckpoint(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	vm := StateSyncEnabledMock{
+		VM:              &blocktest.VM{},
+		StateSyncableVM: blockmock.NewStateSyncableVM(ctrl),
+	}
+	sender := &enginetest.Sender{
+		T: t,
+	}
+	sender.Default(true)
+
+	checkpoint := []byte("checkpoint")
+	bs, err := New(
+		vm,
+		sender,
+		logging.NoLog{},
+		time.Second,
+		2000,
+		checkpoint,
+		prometheus.NewRegistry(),
+	)
+	require.NoError(err)
+
+	var (
+		sentSummary    []byte
+		sentCheckpoint []byte
+	)
+	sender.SendStateSummaryFrontierF = func(_ context.Context, _ ids.NodeID, _ uint32, summary, checkpoint []byte) {
+		sentSummary = summary
+		sentCheckpoint = checkpoint
+	}
+
+	// The checkpoint is served even if no state summary is available.
+	vm.StateSyncableVM.EXPECT().GetLastStateSummary(gomock.Any()).Return(nil, database.ErrNotFound)
+	require.NoError(bs.GetStateSummaryFrontier(t.Context(), ids.EmptyNodeID, 0))
+	require.Empty(sentSummary)
+	require.Equal(checkpoint, sentCheckpoint)
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+)
+
+var (
+	errInvalidNumNodes       = errors.New("invalid number of nodes")
+	errInvalidLatency        = errors.New("invalid latency")
+	errInvalidDropRate       = errors.New("invalid drop rate")
+	errInvalidRequestTimeout = errors.New("invalid request timeout")
+	errInvalidBlockInterval  = errors.New("invalid block interval")
+	errInvalidTargetHeight   = errors.New("invalid target height")
+	errInvalidPartition      = errors.New("invalid partition")
+	errInvalidLatencySpike   = errors.New("invalid latency spike")
+	errUnknownNode           = errors.New("unknown node")
+)
+
+// Config describes a simulation. Two simulations with the same Config produce
+// the same event log.
+type Config struct {
+	// Seed of the source of randomness of the simulation. It determines the
+	// block IDs, message latencies, dropped messages, sampled validators and
+	// byzantine votes.
+	Seed uint64
+	// NumNodes is the number of nodes in the network. Every node is a
+	// validator with the same weight.
+	NumNodes int
+	// Params are the consensus parameters of every node.
+	Params snowball.Parameters
+
+	// MinLatency and MaxLatency bound the uniformly distributed delay of a
+	// message between two nodes. Messages a node sends to itself are
+	// delivered without delay.
+	MinLatency time.Duration
+	MaxLatency time.Duration
+	// LatencySpikes are the periods during which the latency of messages is
+	// bounded by the spike rather than by MinLatency and MaxLatency.
+	LatencySpikes []LatencySpike
+	// DropRate is the probability that a message between two nodes is
+	// dropped.
+	DropRate float64
+	// RequestTimeout is the time after which an unanswered request is
+	// reported as failed to the requester.
+	RequestTimeout time.Duration
+	// Partitions are the periods during which the network is partitioned.
+	Partitions []Partition
+	// Byzantine maps the index of a node to the strategy it uses to vote.
+	// Nodes that aren't included vote honestly.
+	Byzantine map[int]VoteStrategy
+
+	// BlockInterval is the time between two notifications of pending
+	// transactions to a randomly selected node.
+	BlockInterval time.Duration
+	// TargetHeight is the height that every honest node must accept for the
+	// simulation to succeed.
+	TargetHeight uint64
+	// MaxTime is the simulated time after which the simulation is considered
+	// to be stalled.
+	MaxTime time.Duration
+}
+
+// LatencySpike replaces the bounds of the latency of messages between Start
+// and End.
+type LatencySpike struct {
+	Start      time.Duration
+	End        time.Duration
+	MinLatency time.Duration
+	MaxLatency time.Duration
+}
+
+// Partition separates the nodes of the network into groups that can't
+// communicate with each other between Start and End.
+type Partition struct {
+	Start time.Duration
+	End   time.Duration
+	// Groups of node indices. Nodes that aren't included in any group form
+	// an additional group.
+	Groups [][]int
+}
+
+// group returns the group of the node with index [i] during the partition.
+func (p *Partition) group(i int) int {
+	for g, nodes := range p.Groups {
+		for _, n := range nodes {
+			if n == i {
+				return g
+			}
+		}
+	}
+	return len(p.Groups)
+}
+
+func (c *Config) Verify() error {
+	if err := c.Params.Verify(); err != nil {
+		return err
+	}
+	switch {
+	case c.NumNodes < c.Params.K:
+		return fmt.Errorf("%w: %d < k = %d", errInvalidNumNodes, c.NumNodes, c.Params.K)
+	case c.MinLatency < 0 || c.MaxLatency < c.MinLatency:
+		return fmt.Errorf("%w: [%s, %s]", errInvalidLatency, c.MinLatency, c.MaxLatency)
+	case c.DropRate < 0 || c.DropRate >= 1:
+		return fmt.Errorf("%w: %f", errInvalidDropRate, c.DropRate)
+	case c.RequestTimeout <= 0:
+		return fmt.Errorf("%w: %s", errInvalidRequestTimeout, c.RequestTimeout)
+	case c.BlockInterval <= 0:
+		return fmt.Errorf("%w: %s", errInvalidBlockInterval, c.BlockInterval)
+	case c.TargetHeight == 0:
+		return errInvalidTargetHeight
+	}
+	for i, p := range c.Partitions {
+		if p.End < p.Start {
+			return fmt.Errorf("%w: partition %d ends before it starts", errInvalidPartition, i)
+		}
+		for _, nodes := range p.Groups {
+			for _, n := range nodes {
+				if n < 0 || n >= c.NumNodes {
+					return fmt.Errorf("%w: partition %d contains node %d", errUnknownNode, i, n)
+				}
+			}
+		}
+	}
+	for i, s := range c.LatencySpikes {
+		switch {
+		case s.End < s.Start:
+			return fmt.Errorf("%w: latency spike %d ends before it starts", errInvalidLatencySpike, i)
+		case s.MinLatency < 0 || s.MaxLatency < s.MinLatency:
+			return fmt.Errorf("%w: latency spike %d: [%s, %s]", errInvalidLatencySpike, i, s.MinLatency, s.MaxLatency)
+		}
+	}
+	for n := range c.Byzantine {
+		if n < 0 || n >= c.NumNodes {
+			return fmt.Errorf("%w: byzantine node %d", errUnknownNode, n)
+		}
+	}
+	return nil
+}
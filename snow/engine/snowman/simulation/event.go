@@ -0,0 +1,138 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+const (
+	// Deliver is recorded when a message is delivered to its recipient.
+	Deliver EventKind = iota + 1
+	// Drop is recorded when a message is dropped by the network.
+	Drop
+	// Timeout is recorded when a request is reported as failed to the
+	// requester.
+	Timeout
+	// Build is recorded when a node is notified of pending transactions.
+	Build
+	// Accept is recorded when a node accepts a block.
+	Accept
+)
+
+const (
+	PushQuery Op = iota + 1
+	PullQuery
+	Chits
+	Get
+	Put
+)
+
+type EventKind uint8
+
+func (k EventKind) String() string {
+	switch k {
+	case Deliver:
+		return "deliver"
+	case Drop:
+		return "drop"
+	case Timeout:
+		return "timeout"
+	case Build:
+		return "build"
+	case Accept:
+		return "accept"
+	default:
+		return "unknown"
+	}
+}
+
+// Op is the type of a message sent between nodes.
+type Op uint8
+
+func (o Op) String() string {
+	switch o {
+	case PushQuery:
+		return "push_query"
+	case PullQuery:
+		return "pull_query"
+	case Chits:
+		return "chits"
+	case Get:
+		return "get"
+	case Put:
+		return "put"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is an entry of the event log of a simulation.
+type Event struct {
+	Time time.Duration
+	Kind EventKind
+	// Op is only set for Deliver, Drop and Timeout events.
+	Op Op
+	// From is the index of the node that sent the message. For Timeout
+	// events, it is the index of the node that didn't respond.
+	From int
+	// To is the index of the node that the event occurred on.
+	To        int
+	RequestID uint32
+	// BlockID is the block that the message references, the block that was
+	// voted for or the block that was accepted.
+	BlockID ids.ID
+	Height  uint64
+}
+
+func (e Event) String() string {
+	switch e.Kind {
+	case Build:
+		return fmt.Sprintf("%s %s node=%d", e.Time, e.Kind, e.To)
+	case Accept:
+		return fmt.Sprintf("%s %s node=%d blkID=%s height=%d", e.Time, e.Kind, e.To, e.BlockID, e.Height)
+	default:
+		return fmt.Sprintf("%s %s %s from=%d to=%d requestID=%d blkID=%s height=%d",
+			e.Time,
+			e.Kind,
+			e.Op,
+			e.From,
+			e.To,
+			e.RequestID,
+			e.BlockID,
+			e.Height,
+		)
+	}
+}
+
+// Log is the event log of a simulation. Replaying the log re-runs the
+// simulation it was recorded from and verifies that the same events occur.
+type Log struct {
+	Config Config
+	Events []Event
+}
+
+func (l *Log) String() string {
+	var sb strings.Builder
+	for i, e := range l.Events {
+		_, _ = fmt.Fprintf(&sb, "%d: %s\n", i, e)
+	}
+	return sb.String()
+}
+
+// Accepted returns the IDs of the blocks that the node with index [node]
+// accepted, in the order they were accepted.
+func (l *Log) Accepted(node int) []ids.ID {
+	var accepted []ids.ID
+	for _, e := range l.Events {
+		if e.Kind == Accept && e.To == node {
+			accepted = append(accepted, e.BlockID)
+		}
+	}
+	return accepted
+}
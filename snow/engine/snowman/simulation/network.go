@@ -0,0 +1,546 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package simulation runs Snowman engines over a simulated network.
+//
+// The simulation is deterministic: the network is driven by a virtual clock
+// and every random decision, including the validators that the engines
+// sample, is derived from the seed of the simulation. This allows liveness
+// and safety issues to be reproduced and debugged by replaying the event log
+// of the simulation that exposed them.
+package simulation
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"gonum.org/v1/gonum/mathext/prng"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/engine/common/tracker"
+	"github.com/ava-labs/avalanchego/snow/engine/enginetest"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/getter"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/heap"
+	"github.com/ava-labs/avalanchego/utils/sampler"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/version"
+
+	smcon "github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	smeng "github.com/ava-labs/avalanchego/snow/engine/snowman"
+)
+
+// epoch is the wall-clock time of the start of every simulation.
+var epoch = time.Unix(0, 0)
+
+var (
+	// ErrSafetyViolation is returned when two nodes accept different blocks
+	// at the same height.
+	ErrSafetyViolation = errors.New("safety violation")
+	// ErrStalled is returned when the honest nodes didn't accept the target
+	// height before the maximum simulated time.
+	ErrStalled = errors.New("stalled")
+	// ErrDivergence is returned when a replayed simulation doesn't produce the
+	// recorded event log.
+	ErrDivergence = errors.New("replay diverged")
+)
+
+type node struct {
+	index     int
+	nodeID    ids.NodeID
+	strategy  VoteStrategy
+	vm        *vm
+	consensus smcon.Consensus
+	engine    *smeng.Engine
+
+	lastAcceptedHeight uint64
+}
+
+// request is a message that the requester expects a response for.
+type request struct {
+	// response is the type of the expected response.
+	response  Op
+	requester int
+	responder int
+	requestID uint32
+}
+
+type action struct {
+	time time.Duration
+	// seq orders the actions that are scheduled at the same time.
+	seq uint64
+	// node is the index of the node whose state is modified by the action.
+	node int
+	run  func(context.Context) error
+}
+
+// Network is a network of Snowman engines that communicate over a simulated
+// network.
+type Network struct {
+	config Config
+	rng    sampler.Source
+
+	nodes       []*node
+	nodeIndices map[ids.NodeID]int
+
+	now         time.Duration
+	nextSeq     uint64
+	actions     heap.Queue[*action]
+	outstanding set.Set[request]
+	// finalized is the block accepted at each height.
+	finalized map[uint64]ids.ID
+
+	log *Log
+}
+
+// New returns a network of [config.NumNodes] engines that share the genesis
+// block of snowmantest.
+func New(tb testing.TB, config Config) (*Network, error) {
+	if err := config.Verify(); err != nil {
+		return nil, err
+	}
+
+	rng := prng.NewMT19937()
+	rng.Seed(config.Seed)
+
+	n := &Network{
+		config:      config,
+		rng:         rng,
+		nodeIndices: make(map[ids.NodeID]int, config.NumNodes),
+		actions: heap.NewQueue(func(a, b *action) bool {
+			if a.time != b.time {
+				return a.time < b.time
+			}
+			return a.seq < b.seq
+		}),
+		finalized: map[uint64]ids.ID{},
+		log: &Log{
+			Config: config,
+		},
+	}
+
+	nodeIDs := make([]ids.NodeID, config.NumNodes)
+	for i := range nodeIDs {
+		nodeIDs[i] = ids.BuildTestNodeID(binary.BigEndian.AppendUint32(nil, uint32(i+1)))
+		n.nodeIndices[nodeIDs[i]] = i
+	}
+	for i, nodeID := range nodeIDs {
+		strategy, ok := config.Byzantine[i]
+		if !ok {
+			strategy = Honest{}
+		}
+		nd, err := n.newNode(tb, i, nodeID, nodeIDs, strategy)
+		if err != nil {
+			return nil, err
+		}
+		n.nodes = append(n.nodes, nd)
+	}
+	return n, nil
+}
+
+func (n *Network) newNode(
+	tb testing.TB,
+	index int,
+	nodeID ids.NodeID,
+	nodeIDs []ids.NodeID,
+	strategy VoteStrategy,
+) (*node, error) {
+	snowCtx := snowtest.Context(tb, snowtest.CChainID)
+	snowCtx.NodeID = nodeID
+	ctx := snowtest.ConsensusContext(snowCtx)
+
+	vdrs := validators.NewManager()
+	peers := tracker.NewPeers()
+	vdrs.RegisterSetCallbackListener(ctx.SubnetID, peers)
+	for _, vdrID := range nodeIDs {
+		if err := vdrs.AddStaker(ctx.SubnetID, vdrID, nil, ids.Empty, 1); err != nil {
+			return nil, err
+		}
+		if err := peers.Connected(context.Background(), vdrID, version.Current); err != nil {
+			return nil, err
+		}
+	}
+
+	nd := &node{
+		index:     index,
+		nodeID:    nodeID,
+		strategy:  strategy,
+		vm:        newVM(n.rng),
+		consensus: &smcon.Topological{Factory: snowball.SnowflakeFactory},
+	}
+
+	sender := &enginetest.Sender{}
+	sender.Default(false)
+	sender.SendPushQueryF = func(_ context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, blkBytes []byte, requestedHeight uint64) {
+		blkID := ids.ID(blkBytes[:ids.IDLen])
+		n.sendRequest(nd, PushQuery, nodeIDs, requestID, blkID, requestedHeight, func(ctx context.Context, to *node) error {
+			return to.engine.PushQuery(ctx, nodeID, requestID, blkBytes, requestedHeight)
+		})
+	}
+	sender.SendPullQueryF = func(_ context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, blkID ids.ID, requestedHeight uint64) {
+		n.sendRequest(nd, PullQuery, nodeIDs, requestID, blkID, requestedHeight, func(ctx context.Context, to *node) error {
+			return to.engine.PullQuery(ctx, nodeID, requestID, blkID, requestedHeight)
+		})
+	}
+	sender.SendGetF = func(_ context.Context, vdrID ids.NodeID, requestID uint32, blkID ids.ID) {
+		n.sendRequest(nd, Get, set.Of(vdrID), requestID, blkID, 0, func(ctx context.Context, to *node) error {
+			return to.engine.Get(ctx, nodeID, requestID, blkID)
+		})
+	}
+	sender.SendChitsF = func(_ context.Context, vdrID ids.NodeID, requestID uint32, preferredID ids.ID, preferredIDAtHeight ids.ID, acceptedID ids.ID, acceptedHeight uint64) {
+		vote, ok := nd.strategy.Vote(n.rng, sortedIDs(nd.vm.blocks), Vote{
+			PreferredID:         preferredID,
+			PreferredIDAtHeight: preferredIDAtHeight,
+			AcceptedID:          acceptedID,
+			AcceptedHeight:      acceptedHeight,
+		})
+		if !ok {
+			return
+		}
+		n.sendResponse(nd, Chits, vdrID, requestID, vote.PreferredID, vote.AcceptedHeight, func(ctx context.Context, to *node) error {
+			return to.engine.Chits(ctx, nodeID, requestID, vote.PreferredID, vote.PreferredIDAtHeight, vote.AcceptedID, vote.AcceptedHeight)
+		})
+	}
+	sender.SendPutF = func(_ context.Context, vdrID ids.NodeID, requestID uint32, blkBytes []byte) {
+		blkID := ids.ID(blkBytes[:ids.IDLen])
+		n.sendResponse(nd, Put, vdrID, requestID, blkID, 0, func(ctx context.Context, to *node) error {
+			return to.engine.Put(ctx, nodeID, requestID, blkBytes)
+		})
+	}
+
+	gets, err := getter.New(
+		nd.vm,
+		sender,
+		ctx.Log,
+		time.Second,
+		2000,
+		nil,
+		ctx.Registerer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	nd.engine, err = smeng.New(smeng.Config{
+		AllGetsServer: gets,
+		Ctx:           ctx,
+		VM:            nd.vm,
+		Sender:        sender,
+		Validators: &seededValidators{
+			Manager: vdrs,
+			sampler: sampler.NewDeterministicWeightedWithoutReplacement(n.rng),
+		},
+		ConnectedValidators: peers,
+		Params:              n.config.Params,
+		Consensus:           nd.consensus,
+	})
+	if err != nil {
+		return nil, err
+	}
+	nd.engine.Clock.Set(epoch)
+	return nd, nil
+}
+
+// Run runs the simulation until every honest node accepted the target height.
+// The event log is returned even if the simulation failed.
+func (n *Network) Run(ctx context.Context) (*Log, error) {
+	for _, nd := range n.nodes {
+		if err := nd.engine.Start(ctx, 0); err != nil {
+			return n.log, fmt.Errorf("failed to start node %d: %w", nd.index, err)
+		}
+	}
+	n.scheduleBuild()
+
+	for !n.done() {
+		next, ok := n.actions.Pop()
+		if !ok || next.time > n.config.MaxTime {
+			return n.log, fmt.Errorf("%w: honest nodes didn't accept height %d within %s",
+				ErrStalled,
+				n.config.TargetHeight,
+				n.config.MaxTime,
+			)
+		}
+
+		n.now = next.time
+		n.nodes[next.node].engine.Clock.Set(epoch.Add(n.now))
+		if err := next.run(ctx); err != nil {
+			return n.log, fmt.Errorf("node %d failed at %s: %w", next.node, n.now, err)
+		}
+		if err := n.recordAccepted(n.nodes[next.node]); err != nil {
+			return n.log, err
+		}
+	}
+	return n.log, nil
+}
+
+// Replay re-runs the simulation that [log] was recorded from and returns an
+// error if a different sequence of events occurs.
+func Replay(ctx context.Context, tb testing.TB, log *Log) error {
+	n, err := New(tb, log.Config)
+	if err != nil {
+		return err
+	}
+
+	// The outcome of the simulation is part of the event log, so only the
+	// events are compared.
+	replayed, _ := n.Run(ctx)
+	for i, expected := range log.Events {
+		if i >= len(replayed.Events) {
+			return fmt.Errorf("%w: missing event %d: %s", ErrDivergence, i, expected)
+		}
+		if got := replayed.Events[i]; got != expected {
+			return fmt.Errorf("%w: event %d: expected %s but got %s", ErrDivergence, i, expected, got)
+		}
+	}
+	if len(replayed.Events) > len(log.Events) {
+		return fmt.Errorf("%w: unexpected event %d: %s", ErrDivergence, len(log.Events), replayed.Events[len(log.Events)])
+	}
+	return nil
+}
+
+// done returns true if every honest node accepted the target height.
+func (n *Network) done() bool {
+	for _, nd := range n.nodes {
+		if _, ok := nd.strategy.(Honest); ok && nd.lastAcceptedHeight < n.config.TargetHeight {
+			return false
+		}
+	}
+	return true
+}
+
+func (n *Network) schedule(delay time.Duration, node int, run func(context.Context) error) {
+	n.actions.Push(&action{
+		time: n.now + delay,
+		seq:  n.nextSeq,
+		node: node,
+		run:  run,
+	})
+	n.nextSeq++
+}
+
+// scheduleBuild notifies a random node of pending transactions after the
+// block interval.
+func (n *Network) scheduleBuild() {
+	s := sampler.NewDeterministicUniform(n.rng)
+	s.Initialize(uint64(len(n.nodes)))
+	index, _ := s.Next()
+	nd := n.nodes[index]
+
+	n.schedule(n.config.BlockInterval, nd.index, func(ctx context.Context) error {
+		n.record(Event{
+			Kind: Build,
+			To:   nd.index,
+		})
+		n.scheduleBuild()
+		return nd.engine.Notify(ctx, common.PendingTxs)
+	})
+}
+
+// sendRequest sends a request from [from] to each of [nodeIDs]. If a
+// recipient doesn't respond before the request timeout, the request is
+// reported as failed to [from].
+func (n *Network) sendRequest(
+	from *node,
+	op Op,
+	nodeIDs set.Set[ids.NodeID],
+	requestID uint32,
+	blkID ids.ID,
+	height uint64,
+	deliver func(context.Context, *node) error,
+) {
+	recipients := nodeIDs.List()
+	utils.Sort(recipients)
+	for _, nodeID := range recipients {
+		to := n.nodes[n.nodeIndices[nodeID]]
+		response := Chits
+		if op == Get {
+			response = Put
+		}
+		req := request{
+			response:  response,
+			requester: from.index,
+			responder: to.index,
+			requestID: requestID,
+		}
+		n.outstanding.Add(req)
+		n.schedule(n.config.RequestTimeout, from.index, func(ctx context.Context) error {
+			if !n.outstanding.Contains(req) {
+				return nil
+			}
+			n.outstanding.Remove(req)
+			n.record(Event{
+				Kind:      Timeout,
+				Op:        op,
+				From:      to.index,
+				To:        from.index,
+				RequestID: requestID,
+				BlockID:   blkID,
+				Height:    height,
+			})
+			if op == Get {
+				return from.engine.GetFailed(ctx, to.nodeID, requestID)
+			}
+			return from.engine.QueryFailed(ctx, to.nodeID, requestID)
+		})
+
+		n.send(from, to, op, requestID, blkID, height, func(ctx context.Context) error {
+			return deliver(ctx, to)
+		})
+	}
+}
+
+// sendResponse sends a response from [from] to [nodeID]. The response is
+// dropped if the request it responds to already failed.
+func (n *Network) sendResponse(
+	from *node,
+	op Op,
+	nodeID ids.NodeID,
+	requestID uint32,
+	blkID ids.ID,
+	height uint64,
+	deliver func(context.Context, *node) error,
+) {
+	to := n.nodes[n.nodeIndices[nodeID]]
+	req := request{
+		response:  op,
+		requester: to.index,
+		responder: from.index,
+		requestID: requestID,
+	}
+	n.send(from, to, op, requestID, blkID, height, func(ctx context.Context) error {
+		if !n.outstanding.Contains(req) {
+			return nil
+		}
+		n.outstanding.Remove(req)
+		return deliver(ctx, to)
+	})
+}
+
+// send delivers a message from [from] to [to] after a random latency, unless
+// the message is dropped or the nodes are partitioned.
+func (n *Network) send(
+	from *node,
+	to *node,
+	op Op,
+	requestID uint32,
+	blkID ids.ID,
+	height uint64,
+	deliver func(context.Context) error,
+) {
+	event := Event{
+		Op:        op,
+		From:      from.index,
+		To:        to.index,
+		RequestID: requestID,
+		BlockID:   blkID,
+		Height:    height,
+	}
+
+	var latency time.Duration
+	if from != to {
+		if n.partitioned(from.index, to.index) || n.float64() < n.config.DropRate {
+			event.Kind = Drop
+			n.record(event)
+			return
+		}
+
+		minLatency, maxLatency := n.latencyBounds()
+		latency = minLatency
+		if jitter := maxLatency - minLatency; jitter > 0 {
+			latency += time.Duration(n.float64() * float64(jitter))
+		}
+	}
+
+	n.schedule(latency, to.index, func(ctx context.Context) error {
+		event.Time = n.now
+		event.Kind = Deliver
+		n.log.Events = append(n.log.Events, event)
+		return deliver(ctx)
+	})
+}
+
+// latencyBounds returns the bounds of the latency of a message sent now.
+func (n *Network) latencyBounds() (time.Duration, time.Duration) {
+	for _, s := range n.config.LatencySpikes {
+		if s.Start <= n.now && n.now < s.End {
+			return s.MinLatency, s.MaxLatency
+		}
+	}
+	return n.config.MinLatency, n.config.MaxLatency
+}
+
+// partitioned returns true if the nodes with indices [a] and [b] are unable
+// to communicate with each other.
+func (n *Network) partitioned(a, b int) bool {
+	for _, p := range n.config.Partitions {
+		if p.Start <= n.now && n.now < p.End && p.group(a) != p.group(b) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordAccepted records the blocks that [nd] accepted since the last call
+// and verifies that no other node accepted a conflicting block.
+func (n *Network) recordAccepted(nd *node) error {
+	lastAcceptedID, lastAcceptedHeight := nd.consensus.LastAccepted()
+	if lastAcceptedHeight <= nd.lastAcceptedHeight {
+		return nil
+	}
+
+	accepted := make([]ids.ID, lastAcceptedHeight-nd.lastAcceptedHeight)
+	blkID := lastAcceptedID
+	for i := len(accepted) - 1; i >= 0; i-- {
+		accepted[i] = blkID
+		blkID = nd.vm.blocks[blkID].Parent()
+	}
+
+	for i, blkID := range accepted {
+		height := nd.lastAcceptedHeight + uint64(i) + 1
+		n.record(Event{
+			Kind:    Accept,
+			To:      nd.index,
+			BlockID: blkID,
+			Height:  height,
+		})
+		if finalizedID, ok := n.finalized[height]; ok && finalizedID != blkID {
+			return fmt.Errorf("%w: node %d accepted %s at height %d but %s was accepted",
+				ErrSafetyViolation,
+				nd.index,
+				blkID,
+				height,
+				finalizedID,
+			)
+		}
+		n.finalized[height] = blkID
+	}
+	nd.lastAcceptedHeight = lastAcceptedHeight
+	return nil
+}
+
+func (n *Network) record(event Event) {
+	event.Time = n.now
+	n.log.Events = append(n.log.Events, event)
+}
+
+// float64 returns a random number in [0, 1).
+func (n *Network) float64() float64 {
+	return float64(n.rng.Uint64()>>11) / (1 << 53)
+}
+
+// Nodes returns the IDs of the nodes of the network, indexed by their index.
+func (n *Network) Nodes() []ids.NodeID {
+	nodeIDs := make([]ids.NodeID, len(n.nodes))
+	for i, nd := range n.nodes {
+		nodeIDs[i] = nd.nodeID
+	}
+	return nodeIDs
+}
@@ -0,0 +1,254 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
+)
+
+func newTestConfig(params snowball.Parameters, numNodes int) Config {
+	return Config{
+		Seed:           1,
+		NumNodes:       numNodes,
+		Params:         params,
+		MinLatency:     10 * time.Millisecond,
+		MaxLatency:     100 * time.Millisecond,
+		RequestTimeout: time.Second,
+		BlockInterval:  500 * time.Millisecond,
+		TargetHeight:   5,
+		MaxTime:        time.Minute,
+	}
+}
+
+func TestSimulation(t *testing.T) {
+	singleNodeParams := snowball.Parameters{
+		K:                     1,
+		AlphaPreference:       1,
+		AlphaConfidence:       1,
+		Beta:                  1,
+		ConcurrentRepolls:     1,
+		OptimalProcessing:     10,
+		MaxOutstandingItems:   256,
+		MaxItemProcessingTime: 30 * time.Second,
+	}
+
+	tests := []struct {
+		name   string
+		config func() Config
+	}{
+		{
+			name: "single node",
+			config: func() Config {
+				return newTestConfig(singleNodeParams, 1)
+			},
+		},
+		{
+			name: "default parameters",
+			config: func() Config {
+				return newTestConfig(snowball.DefaultParameters, 20)
+			},
+		},
+		{
+			name: "default parameters with dropped messages",
+			config: func() Config {
+				config := newTestConfig(snowball.DefaultParameters, 20)
+				config.DropRate = .05
+				return config
+			},
+		},
+		{
+			name: "default parameters with healed partition",
+			config: func() Config {
+				// The majority is able to reach the confidence quorum during
+				// the partition and the minority catches up once it heals.
+				config := newTestConfig(snowball.DefaultParameters, 20)
+				config.Partitions = []Partition{
+					{
+						Start:  0,
+						End:    10 * time.Second,
+						Groups: [][]int{{0, 1, 2, 3}},
+					},
+				}
+				return config
+			},
+		},
+		{
+			name: "default parameters with byzantine nodes",
+			config: func() Config {
+				config := newTestConfig(snowball.DefaultParameters, 20)
+				config.Byzantine = map[int]VoteStrategy{
+					0: Mute{},
+					1: Stale{},
+					2: Random{},
+				}
+				return config
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			config := tt.config()
+			n, err := New(t, config)
+			require.NoError(err)
+
+			log, err := n.Run(t.Context())
+			require.NoError(err)
+
+			// Every honest node accepted the same chain.
+			var expected []ids.ID
+			for i := range config.NumNodes {
+				if _, ok := config.Byzantine[i]; ok {
+					continue
+				}
+				accepted := log.Accepted(i)
+				require.GreaterOrEqual(len(accepted), int(config.TargetHeight))
+				if expected == nil {
+					expected = accepted
+				}
+				require.Equal(expected[:config.TargetHeight], accepted[:config.TargetHeight])
+			}
+			require.NotNil(expected)
+
+			require.NoError(Replay(t.Context(), t, log))
+		})
+	}
+}
+
+func TestSimulationIsDeterministic(t *testing.T) {
+	require := require.New(t)
+
+	config := newTestConfig(snowball.DefaultParameters, 20)
+	config.DropRate = .1
+
+	n, err := New(t, config)
+	require.NoError(err)
+	log0, err := n.Run(t.Context())
+	require.NoError(err)
+
+	n, err = New(t, config)
+	require.NoError(err)
+	log1, err := n.Run(t.Context())
+	require.NoError(err)
+	require.Equal(log0.Events, log1.Events)
+
+	config.Seed++
+	n, err = New(t, config)
+	require.NoError(err)
+	log2, err := n.Run(t.Context())
+	require.NoError(err)
+	require.NotEqual(log0.Events, log2.Events)
+}
+
+func TestSimulationStalls(t *testing.T) {
+	require := require.New(t)
+
+	// Neither half of the network can reach the confidence quorum.
+	config := newTestConfig(snowball.DefaultParameters, 20)
+	config.MaxTime = 10 * time.Second
+	config.Partitions = []Partition{
+		{
+			Start:  0,
+			End:    config.MaxTime,
+			Groups: [][]int{{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		},
+	}
+
+	n, err := New(t, config)
+	require.NoError(err)
+	log, err := n.Run(t.Context())
+	require.ErrorIs(err, ErrStalled)
+	require.Empty(log.Accepted(0))
+
+	// Replaying a failed simulation reproduces the failure.
+	require.NoError(Replay(t.Context(), t, log))
+}
+
+func TestReplayDetectsDivergence(t *testing.T) {
+	require := require.New(t)
+
+	config := newTestConfig(snowball.DefaultParameters, 20)
+	n, err := New(t, config)
+	require.NoError(err)
+	log, err := n.Run(t.Context())
+	require.NoError(err)
+
+	log.Config.Seed++
+	err = Replay(t.Context(), t, log)
+	require.ErrorIs(err, ErrDivergence)
+}
+
+// meanFinality returns the mean time between the issuance of the blocks up to
+// [height] and their acceptance by the node with index [node].
+func meanFinality(log *Log, node int, height uint64) time.Duration {
+	var (
+		issued = make(map[ids.ID]time.Duration)
+		total  time.Duration
+		count  int64
+	)
+	for _, e := range log.Events {
+		switch {
+		case e.Kind == Deliver && e.Op == PushQuery:
+			if _, ok := issued[e.BlockID]; !ok {
+				issued[e.BlockID] = e.Time
+			}
+		case e.Kind == Accept && e.To == node && e.Height <= height:
+			total += e.Time - issued[e.BlockID]
+			count++
+		}
+	}
+	return total / time.Duration(count)
+}
+
+func TestSimulationAdaptiveParametersUnderLatencySpike(t *testing.T) {
+	require := require.New(t)
+
+	newConfig := func(adaptive *snowball.AdaptiveParameters) Config {
+		params := snowball.DefaultParameters
+		params.Adaptive = adaptive
+		config := newTestConfig(params, 20)
+		config.TargetHeight = 20
+		config.LatencySpikes = []LatencySpike{
+			{
+				Start:      2 * time.Second,
+				End:        8 * time.Second,
+				MinLatency: 200 * time.Millisecond,
+				MaxLatency: 400 * time.Millisecond,
+			},
+		}
+		return config
+	}
+
+	staticConfig := newConfig(nil)
+	n, err := New(t, staticConfig)
+	require.NoError(err)
+	staticLog, err := n.Run(t.Context())
+	require.NoError(err)
+
+	adaptiveConfig := newConfig(&snowball.AdaptiveParameters{
+		MinConcurrentRepolls: 1,
+		MaxConcurrentRepolls: snowball.DefaultParameters.Beta,
+		TargetPollLatency:    250 * time.Millisecond,
+		MaxPollFailureRate:   .5,
+	})
+	n, err = New(t, adaptiveConfig)
+	require.NoError(err)
+	adaptiveLog, err := n.Run(t.Context())
+	require.NoError(err)
+
+	// Issuing more concurrent polls while polls are slow finalizes blocks
+	// sooner.
+	staticFinality := meanFinality(staticLog, 0, staticConfig.TargetHeight)
+	adaptiveFinality := meanFinality(adaptiveLog, 0, adaptiveConfig.TargetHeight)
+	require.Less(adaptiveFinality, staticFinality)
+
+	require.NoError(Replay(t.Context(), t, adaptiveLog))
+}
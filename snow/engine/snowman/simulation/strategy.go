@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/sampler"
+)
+
+var (
+	_ VoteStrategy = Honest{}
+	_ VoteStrategy = Mute{}
+	_ VoteStrategy = Stale{}
+	_ VoteStrategy = Random{}
+)
+
+// Vote is the content of a chits message.
+type Vote struct {
+	PreferredID         ids.ID
+	PreferredIDAtHeight ids.ID
+	AcceptedID          ids.ID
+	AcceptedHeight      uint64
+}
+
+// VoteStrategy determines how a node responds to queries.
+type VoteStrategy interface {
+	// Vote returns the vote to send instead of the honest vote [honest] of
+	// the node that knows about [known], or false if no vote should be sent.
+	//
+	// [known] is sorted and [rng] must be the only source of randomness.
+	Vote(rng sampler.Source, known []ids.ID, honest Vote) (Vote, bool)
+}
+
+// Honest votes for the preference of the node.
+type Honest struct{}
+
+func (Honest) Vote(_ sampler.Source, _ []ids.ID, honest Vote) (Vote, bool) {
+	return honest, true
+}
+
+// Mute never responds to queries, causing them to time out.
+type Mute struct{}
+
+func (Mute) Vote(sampler.Source, []ids.ID, Vote) (Vote, bool) {
+	return Vote{}, false
+}
+
+// Stale votes for the last accepted block, as if it had never heard of any
+// processing block.
+type Stale struct{}
+
+func (Stale) Vote(_ sampler.Source, _ []ids.ID, honest Vote) (Vote, bool) {
+	return Vote{
+		PreferredID:         honest.AcceptedID,
+		PreferredIDAtHeight: honest.AcceptedID,
+		AcceptedID:          honest.AcceptedID,
+		AcceptedHeight:      honest.AcceptedHeight,
+	}, true
+}
+
+// Random votes for a uniformly selected block that the node knows about.
+type Random struct{}
+
+func (Random) Vote(rng sampler.Source, known []ids.ID, honest Vote) (Vote, bool) {
+	if len(known) == 0 {
+		return honest, true
+	}
+
+	s := sampler.NewDeterministicUniform(rng)
+	s.Initialize(uint64(len(known)))
+	i, _ := s.Next()
+	blkID := known[i]
+	return Vote{
+		PreferredID:         blkID,
+		PreferredIDAtHeight: blkID,
+		AcceptedID:          honest.AcceptedID,
+		AcceptedHeight:      honest.AcceptedHeight,
+	}, true
+}
+
+func sortedIDs[T any](m map[ids.ID]T) []ids.ID {
+	blkIDs := make([]ids.ID, 0, len(m))
+	for blkID := range m {
+		blkIDs = append(blkIDs, blkID)
+	}
+	utils.Sort(blkIDs)
+	return blkIDs
+}
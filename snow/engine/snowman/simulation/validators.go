@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"errors"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/sampler"
+)
+
+var (
+	_ validators.Manager = (*seededValidators)(nil)
+
+	errInsufficientWeight = errors.New("insufficient weight")
+)
+
+// seededValidators samples validators using the source of randomness of the
+// simulation rather than the global source of randomness.
+type seededValidators struct {
+	validators.Manager
+
+	sampler sampler.WeightedWithoutReplacement
+}
+
+func (v *seededValidators) Sample(subnetID ids.ID, size int) ([]ids.NodeID, error) {
+	vdrs := v.GetMap(subnetID)
+	nodeIDs := make([]ids.NodeID, 0, len(vdrs))
+	for nodeID := range vdrs {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	utils.Sort(nodeIDs)
+
+	weights := make([]uint64, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		weights[i] = vdrs[nodeID].Weight
+	}
+	if err := v.sampler.Initialize(weights); err != nil {
+		return nil, err
+	}
+
+	indices, ok := v.sampler.Sample(size)
+	if !ok {
+		return nil, errInsufficientWeight
+	}
+
+	sampled := make([]ids.NodeID, size)
+	for i, index := range indices {
+		sampled[i] = nodeIDs[index]
+	}
+	return sampled, nil
+}
@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package simulation
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmantest"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block/blocktest"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/utils/sampler"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+const blockLen = ids.IDLen + ids.IDLen + wrappers.LongLen
+
+var (
+	errUnknownBlock = errors.New("unknown block")
+	errInvalidBlock = errors.New("invalid block")
+)
+
+// vm is a ChainVM of snowmantest blocks. Every node has its own copy of each
+// block so that each node decides blocks independently.
+type vm struct {
+	blocktest.VM
+
+	rng       sampler.Source
+	blocks    map[ids.ID]*snowmantest.Block
+	preferred ids.ID
+}
+
+func newVM(rng sampler.Source) *vm {
+	genesis := *snowmantest.Genesis
+	genesis.Status = snowtest.Accepted
+
+	v := &vm{
+		rng: rng,
+		blocks: map[ids.ID]*snowmantest.Block{
+			genesis.ID(): &genesis,
+		},
+		preferred: genesis.ID(),
+	}
+	v.BuildBlockF = v.buildBlock
+	v.ParseBlockF = v.parseBlock
+	v.GetBlockF = v.getBlock
+	v.SetPreferenceF = v.setPreference
+	v.LastAcceptedF = v.lastAccepted
+	v.GetBlockIDAtHeightF = v.getBlockIDAtHeight
+	return v
+}
+
+// buildBlock builds a child of the preferred block whose ID is derived from
+// the source of randomness of the simulation.
+func (v *vm) buildBlock(context.Context) (snowman.Block, error) {
+	parent, ok := v.blocks[v.preferred]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errUnknownBlock, v.preferred)
+	}
+
+	blkID := ids.Empty.Prefix(v.rng.Uint64())
+	blk := &snowmantest.Block{
+		Decidable: snowtest.Decidable{
+			IDV:    blkID,
+			Status: snowtest.Undecided,
+		},
+		ParentV:    parent.ID(),
+		HeightV:    parent.Height() + 1,
+		TimestampV: parent.Timestamp(),
+		BytesV:     marshalBlock(blkID, parent.ID(), parent.Height()+1),
+	}
+	v.blocks[blkID] = blk
+	return blk, nil
+}
+
+func (v *vm) parseBlock(_ context.Context, blkBytes []byte) (snowman.Block, error) {
+	if bytes.Equal(blkBytes, snowmantest.GenesisBytes) {
+		return v.blocks[snowmantest.GenesisID], nil
+	}
+	if len(blkBytes) != blockLen {
+		return nil, fmt.Errorf("%w: expected %d bytes but got %d", errInvalidBlock, blockLen, len(blkBytes))
+	}
+
+	blkID := ids.ID(blkBytes[:ids.IDLen])
+	if blk, ok := v.blocks[blkID]; ok {
+		return blk, nil
+	}
+
+	blk := &snowmantest.Block{
+		Decidable: snowtest.Decidable{
+			IDV:    blkID,
+			Status: snowtest.Undecided,
+		},
+		ParentV:    ids.ID(blkBytes[ids.IDLen : 2*ids.IDLen]),
+		HeightV:    binary.BigEndian.Uint64(blkBytes[2*ids.IDLen:]),
+		TimestampV: snowmantest.GenesisTimestamp,
+		BytesV:     bytes.Clone(blkBytes),
+	}
+	v.blocks[blkID] = blk
+	return blk, nil
+}
+
+func (v *vm) getBlock(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+	blk, ok := v.blocks[blkID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errUnknownBlock, blkID)
+	}
+	return blk, nil
+}
+
+func (v *vm) setPreference(_ context.Context, blkID ids.ID) error {
+	v.preferred = blkID
+	return nil
+}
+
+func (v *vm) lastAccepted(context.Context) (ids.ID, error) {
+	var (
+		highestHeight uint64
+		highestID     ids.ID
+	)
+	for blkID, blk := range v.blocks {
+		if blk.Status == snowtest.Accepted && blk.Height() >= highestHeight {
+			highestHeight = blk.Height()
+			highestID = blkID
+		}
+	}
+	return highestID, nil
+}
+
+func (v *vm) getBlockIDAtHeight(_ context.Context, height uint64) (ids.ID, error) {
+	for blkID, blk := range v.blocks {
+		if blk.Status == snowtest.Accepted && blk.Height() == height {
+			return blkID, nil
+		}
+	}
+	return ids.Empty, database.ErrNotFound
+}
+
+func marshalBlock(blkID ids.ID, parentID ids.ID, height uint64) []byte {
+	blkBytes := make([]byte, 0, blockLen)
+	blkBytes = append(blkBytes, blkID[:]...)
+	blkBytes = append(blkBytes, parentID[:]...)
+	return binary.BigEndian.AppendUint64(blkBytes, height)
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snowman
+
+import (
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/poll"
+	"github.com/ava-labs/avalanchego/utils/bag"
+)
+
+// maxRecentPolls is the number of finished polls that are kept for
+// introspection.
+const maxRecentPolls = 32
+
+// PollResult is the outcome of a finished poll.
+type PollResult struct {
+	// Finished is the time the poll's result was applied to consensus.
+	Finished time.Time
+	// Votes are the votes of the poll after they were applied.
+	Votes bag.Bag[ids.ID]
+	// Preference is the preferred block after the poll was applied.
+	Preference ids.ID
+	// LastAcceptedHeight is the height of the last accepted block after the
+	// poll was applied.
+	LastAcceptedHeight uint64
+}
+
+// State is a snapshot of the engine's consensus instance and outstanding
+// polls, used to diagnose stalled chains.
+type State struct {
+	Preference         ids.ID
+	LastAcceptedID     ids.ID
+	LastAcceptedHeight uint64
+	// Processing are the blocks that have been issued to consensus but not yet
+	// decided, in order of increasing height.
+	Processing []snowman.ProcessingBlock
+	// Polls are the outstanding polls, in the order they were issued.
+	Polls []poll.Info
+	// RecentPolls are the most recently finished polls, oldest first.
+	RecentPolls []PollResult
+}
+
+// State returns a snapshot of the engine.
+//
+// Assumes the context lock is held.
+func (e *Engine) State() State {
+	lastAcceptedID, lastAcceptedHeight := e.Consensus.LastAccepted()
+	return State{
+		Preference:         e.Consensus.Preference(),
+		LastAcceptedID:     lastAcceptedID,
+		LastAcceptedHeight: lastAcceptedHeight,
+		Processing:         e.Consensus.ProcessingBlocks(),
+		Polls:              e.polls.Outstanding(),
+		RecentPolls:        e.recentPolls.List(),
+	}
+}
+
+func (e *Engine) recordPollResult(votes bag.Bag[ids.ID]) {
+	_, lastAcceptedHeight := e.Consensus.LastAccepted()
+	e.recentPolls.Push(PollResult{
+		Finished:           e.Clock.Time(),
+		Votes:              votes,
+		Preference:         e.Consensus.Preference(),
+		LastAcceptedHeight: lastAcceptedHeight,
+	})
+}
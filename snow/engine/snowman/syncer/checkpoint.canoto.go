@@ -0,0 +1,281 @@
+// Code generated by canoto. DO NOT EDIT.
+// versions:
+// 	canoto v0.17.3
+// source: checkpoint.go
+
+package syncer
+
+import (
+	"io"
+	"reflect"
+	"sync/atomic"
+
+	"github.com/StephenButtolph/canoto"
+)
+
+// Ensure that the generated code is compatible with the library version.
+const (
+	_ uint = canoto.VersionCompatibility - 0
+	_ uint = 0 - canoto.VersionCompatibility
+)
+
+// Ensure that unused imports do not error
+var (
+	_ atomic.Uint64
+
+	_ = io.ErrUnexpectedEOF
+)
+
+const (
+	canoto__Checkpoint__PChainHeight = 1
+	canoto__Checkpoint__BlockID      = 2
+	canoto__Checkpoint__Height       = 3
+	canoto__Checkpoint__Summary      = 4
+
+	canoto__Checkpoint__PChainHeight__tag = "\x08" // canoto.Tag(canoto__Checkpoint__PChainHeight, canoto.Varint)
+	canoto__Checkpoint__BlockID__tag      = "\x12" // canoto.Tag(canoto__Checkpoint__BlockID, canoto.Len)
+	canoto__Checkpoint__Height__tag       = "\x18" // canoto.Tag(canoto__Checkpoint__Height, canoto.Varint)
+	canoto__Checkpoint__Summary__tag      = "\x22" // canoto.Tag(canoto__Checkpoint__Summary, canoto.Len)
+)
+
+type canotoData_Checkpoint struct {
+	size uint64
+}
+
+// CanotoSpec returns the specification of this canoto message.
+func (*Checkpoint) CanotoSpec(...reflect.Type) *canoto.Spec {
+	var zero Checkpoint
+	s := &canoto.Spec{
+		Name: "Checkpoint",
+		Fields: []canoto.FieldType{
+			{
+				FieldNumber: canoto__Checkpoint__PChainHeight,
+				Name:        "PChainHeight",
+				OneOf:       "",
+				TypeUint:    canoto.SizeOf(zero.PChainHeight),
+			},
+			{
+				FieldNumber:    canoto__Checkpoint__BlockID,
+				Name:           "BlockID",
+				OneOf:          "",
+				TypeFixedBytes: uint64(len(zero.BlockID)),
+			},
+			{
+				FieldNumber: canoto__Checkpoint__Height,
+				Name:        "Height",
+				OneOf:       "",
+				TypeUint:    canoto.SizeOf(zero.Height),
+			},
+			{
+				FieldNumber: canoto__Checkpoint__Summary,
+				Name:        "Summary",
+				OneOf:       "",
+				TypeBytes:   true,
+			},
+		},
+	}
+	s.CalculateCanotoCache()
+	return s
+}
+
+// MakeCanoto creates a new empty value.
+func (*Checkpoint) MakeCanoto() *Checkpoint {
+	return new(Checkpoint)
+}
+
+// UnmarshalCanoto unmarshals a Canoto-encoded byte slice into the struct.
+//
+// During parsing, the canoto cache is saved.
+func (c *Checkpoint) UnmarshalCanoto(bytes []byte) error {
+	r := canoto.Reader{
+		B: bytes,
+	}
+	return c.UnmarshalCanotoFrom(r)
+}
+
+// UnmarshalCanotoFrom populates the struct from a [canoto.Reader]. Most users
+// should just use UnmarshalCanoto.
+//
+// During parsing, the canoto cache is saved.
+//
+// This function enables configuration of reader options.
+func (c *Checkpoint) UnmarshalCanotoFrom(r canoto.Reader) error {
+	// Zero the struct before unmarshaling.
+	*c = Checkpoint{}
+	atomic.StoreUint64(&c.canotoData.size, uint64(len(r.B)))
+
+	var minField uint32
+	for canoto.HasNext(&r) {
+		field, wireType, err := canoto.ReadTag(&r)
+		if err != nil {
+			return err
+		}
+		if field < minField {
+			return canoto.ErrInvalidFieldOrder
+		}
+
+		switch field {
+		case canoto__Checkpoint__PChainHeight:
+			if wireType != canoto.Varint {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			if err := canoto.ReadUint(&r, &c.PChainHeight); err != nil {
+				return err
+			}
+			if canoto.IsZero(c.PChainHeight) {
+				return canoto.ErrZeroValue
+			}
+		case canoto__Checkpoint__BlockID:
+			if wireType != canoto.Len {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			const (
+				expectedLength       = len(c.BlockID)
+				expectedLengthUint64 = uint64(expectedLength)
+			)
+			var length uint64
+			if err := canoto.ReadUint(&r, &length); err != nil {
+				return err
+			}
+			if length != expectedLengthUint64 {
+				return canoto.ErrInvalidLength
+			}
+			if expectedLength > len(r.B) {
+				return io.ErrUnexpectedEOF
+			}
+
+			copy((&c.BlockID)[:], r.B)
+			if canoto.IsZero(c.BlockID) {
+				return canoto.ErrZeroValue
+			}
+			r.B = r.B[expectedLength:]
+		case canoto__Checkpoint__Height:
+			if wireType != canoto.Varint {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			if err := canoto.ReadUint(&r, &c.Height); err != nil {
+				return err
+			}
+			if canoto.IsZero(c.Height) {
+				return canoto.ErrZeroValue
+			}
+		case canoto__Checkpoint__Summary:
+			if wireType != canoto.Len {
+				return canoto.ErrUnexpectedWireType
+			}
+
+			if err := canoto.ReadBytes(&r, &c.Summary); err != nil {
+				return err
+			}
+			if len(c.Summary) == 0 {
+				return canoto.ErrZeroValue
+			}
+		default:
+			return canoto.ErrUnknownField
+		}
+
+		minField = field + 1
+	}
+	return nil
+}
+
+// ValidCanoto validates that the struct can be correctly marshaled into the
+// Canoto format.
+//
+// Specifically, ValidCanoto ensures:
+// 1. All OneOfs are specified at most once.
+// 2. All strings are valid utf-8.
+// 3. All custom fields are ValidCanoto.
+func (c *Checkpoint) ValidCanoto() bool {
+	if c == nil {
+		return true
+	}
+	return true
+}
+
+// CalculateCanotoCache populates size and OneOf caches based on the current
+// values in the struct.
+//
+// It is not safe to copy this struct concurrently.
+func (c *Checkpoint) CalculateCanotoCache() {
+	if c == nil {
+		return
+	}
+	var size uint64
+	if !canoto.IsZero(c.PChainHeight) {
+		size += uint64(len(canoto__Checkpoint__PChainHeight__tag)) + canoto.SizeUint(c.PChainHeight)
+	}
+	if !canoto.IsZero(c.BlockID) {
+		size += uint64(len(canoto__Checkpoint__BlockID__tag)) + canoto.SizeBytes((&c.BlockID)[:])
+	}
+	if !canoto.IsZero(c.Height) {
+		size += uint64(len(canoto__Checkpoint__Height__tag)) + canoto.SizeUint(c.Height)
+	}
+	if len(c.Summary) != 0 {
+		size += uint64(len(canoto__Checkpoint__Summary__tag)) + canoto.SizeBytes(c.Summary)
+	}
+	atomic.StoreUint64(&c.canotoData.size, size)
+}
+
+// CachedCanotoSize returns the previously calculated size of the Canoto
+// representation from CalculateCanotoCache.
+//
+// If CalculateCanotoCache has not yet been called, it will return 0.
+//
+// If the struct has been modified since the last call to CalculateCanotoCache,
+// the returned size may be incorrect.
+func (c *Checkpoint) CachedCanotoSize() uint64 {
+	if c == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&c.canotoData.size)
+}
+
+// MarshalCanoto returns the Canoto representation of this struct.
+//
+// It is assumed that this struct is ValidCanoto.
+//
+// It is not safe to copy this struct concurrently.
+func (c *Checkpoint) MarshalCanoto() []byte {
+	c.CalculateCanotoCache()
+	w := canoto.Writer{
+		B: make([]byte, 0, c.CachedCanotoSize()),
+	}
+	w = c.MarshalCanotoInto(w)
+	return w.B
+}
+
+// MarshalCanotoInto writes the struct into a [canoto.Writer] and returns the
+// resulting [canoto.Writer]. Most users should just use MarshalCanoto.
+//
+// It is assumed that CalculateCanotoCache has been called since the last
+// modification to this struct.
+//
+// It is assumed that this struct is ValidCanoto.
+//
+// It is not safe to copy this struct concurrently.
+func (c *Checkpoint) MarshalCanotoInto(w canoto.Writer) canoto.Writer {
+	if c == nil {
+		return w
+	}
+	if !canoto.IsZero(c.PChainHeight) {
+		canoto.Append(&w, canoto__Checkpoint__PChainHeight__tag)
+		canoto.AppendUint(&w, c.PChainHeight)
+	}
+	if !canoto.IsZero(c.BlockID) {
+		canoto.Append(&w, canoto__Checkpoint__BlockID__tag)
+		canoto.AppendBytes(&w, (&c.BlockID)[:])
+	}
+	if !canoto.IsZero(c.Height) {
+		canoto.Append(&w, canoto__Checkpoint__Height__tag)
+		canoto.AppendUint(&w, c.Height)
+	}
+	if len(c.Summary) != 0 {
+		canoto.Append(&w, canoto__Checkpoint__Summary__tag)
+		canoto.AppendBytes(&w, c.Summary)
+	}
+	return w
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package syncer
+
+//go:generate go tool canoto $GOFILE
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+)
+
+const (
+	// CheckpointQuorumNumerator and CheckpointQuorumDenominator define the
+	// fraction of the chain's validator weight that must sign a checkpoint for
+	// it to be trusted.
+	CheckpointQuorumNumerator   = 67
+	CheckpointQuorumDenominator = 100
+
+	// CheckpointFromBeacons can be provided instead of a checkpoint to fetch
+	// the checkpoint from the state sync beacons.
+	CheckpointFromBeacons = "beacons"
+)
+
+var (
+	consumedCheckpointKey = []byte("consumedCheckpoint")
+
+	errWrongSourceChain            = errors.New("wrong source chain")
+	errMissingSummary              = errors.New("missing state summary")
+	errWrongSummaryHeight          = errors.New("state summary height doesn't match checkpoint height")
+	errCheckpointMismatch          = errors.New("accepted block doesn't match checkpoint")
+	errCheckpointStateSyncDisabled = errors.New("checkpoint provided but state sync is disabled")
+)
+
+// Checkpoint attests that a block was accepted by the chain, along with the
+// state summary at the height of the block.
+//
+// Checkpoints are the payload of warp messages that are signed by the
+// validators of the chain.
+type Checkpoint struct {
+	// PChainHeight is the P-chain height of the validator set that signed the
+	// checkpoint.
+	PChainHeight uint64 `canoto:"uint,1" json:"pChainHeight"`
+	// BlockID is the ID of the accepted block.
+	BlockID ids.ID `canoto:"fixed bytes,2" json:"blockID"`
+	// Height is the height of the accepted block.
+	Height uint64 `canoto:"uint,3" json:"height"`
+	// Summary is the state summary at [Height].
+	Summary []byte `canoto:"bytes,4" json:"summary"`
+
+	canotoData canotoData_Checkpoint
+}
+
+// NewCheckpointMessage returns the unsigned warp message of [checkpoint] for
+// [chainID].
+func NewCheckpointMessage(
+	networkID uint32,
+	chainID ids.ID,
+	checkpoint *Checkpoint,
+) (*warp.UnsignedMessage, error) {
+	return warp.NewUnsignedMessage(networkID, chainID, checkpoint.MarshalCanoto())
+}
+
+// SignedCheckpoint is a checkpoint along with the warp message that attests
+// to it.
+type SignedCheckpoint struct {
+	Checkpoint
+	Message *warp.Message
+}
+
+// ParseSignedCheckpoint parses a warp message containing a checkpoint. The
+// signature of the message is not verified.
+func ParseSignedCheckpoint(b []byte) (*SignedCheckpoint, error) {
+	msg, err := warp.ParseMessage(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse warp message: %w", err)
+	}
+
+	c := &SignedCheckpoint{
+		Message: msg,
+	}
+	if err := c.Checkpoint.UnmarshalCanoto(msg.Payload); err != nil {
+		return nil, fmt.Errorf("failed to parse checkpoint: %w", err)
+	}
+	if len(c.Summary) == 0 {
+		return nil, errMissingSummary
+	}
+	return c, nil
+}
+
+// Verify that the checkpoint was issued by [chainID] and signed by a quorum
+// of the validators of [subnetID] at the checkpoint's P-chain height.
+func (c *SignedCheckpoint) Verify(
+	ctx context.Context,
+	networkID uint32,
+	chainID ids.ID,
+	subnetID ids.ID,
+	validatorState validators.State,
+) error {
+	if c.Message.SourceChainID != chainID {
+		return fmt.Errorf("%w: expected %s but got %s",
+			errWrongSourceChain,
+			chainID,
+			c.Message.SourceChainID,
+		)
+	}
+
+	vdrs, err := validatorState.GetWarpValidatorSet(ctx, c.PChainHeight, subnetID)
+	if err != nil {
+		return fmt.Errorf("failed to get validator set at P-chain height %d: %w", c.PChainHeight, err)
+	}
+	return c.Message.Signature.Verify(
+		&c.Message.UnsignedMessage,
+		networkID,
+		vdrs,
+		CheckpointQuorumNumerator,
+		CheckpointQuorumDenominator,
+	)
+}
+
+// isCheckpointFromBeacons returns true if [checkpointBytes] requests the
+// checkpoint to be fetched from the state sync beacons.
+func isCheckpointFromBeacons(checkpointBytes []byte) bool {
+	return string(bytes.TrimSpace(checkpointBytes)) == CheckpointFromBeacons
+}
+
+// GetConsumedCheckpoint returns the last checkpoint that was synced to. If no
+// checkpoint was synced to, nil is returned.
+func GetConsumedCheckpoint(db database.KeyValueReader) ([]byte, error) {
+	checkpointBytes, err := db.Get(consumedCheckpointKey)
+	if err == database.ErrNotFound {
+		return nil, nil
+	}
+	return checkpointBytes, err
+}
+
+// CheckpointToServe returns the checkpoint to serve to peers alongside the
+// state summary frontier. This is the provided checkpoint, or, if none was
+// provided, the last checkpoint that was synced to.
+func CheckpointToServe(checkpointBytes []byte, db database.KeyValueReader) ([]byte, error) {
+	if len(checkpointBytes) != 0 && !isCheckpointFromBeacons(checkpointBytes) {
+		return checkpointBytes, nil
+	}
+	return GetConsumedCheckpoint(db)
+}
@@ -0,0 +1,580 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package syncer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmantest"
+	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/snow/engine/common/tracker"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block/blocktest"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/snow/validators/validatorstest"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
+)
+
+const checkpointPChainHeight = 10
+
+type checkpointSigner struct {
+	sk  bls.Signer
+	vdr *validators.Warp
+}
+
+func (s *checkpointSigner) Compare(o *checkpointSigner) int {
+	return s.vdr.Compare(o.vdr)
+}
+
+// newCheckpointSigners returns [n] validators of equal weight in canonical
+// order.
+func newCheckpointSigners(t *testing.T, n int) ([]*checkpointSigner, validators.WarpSet) {
+	require := require.New(t)
+
+	signers := make([]*checkpointSigner, n)
+	for i := range signers {
+		sk, err := localsigner.New()
+		require.NoError(err)
+
+		pk := sk.PublicKey()
+		signers[i] = &checkpointSigner{
+			sk: sk,
+			vdr: &validators.Warp{
+				PublicKey:      pk,
+				PublicKeyBytes: bls.PublicKeyToUncompressedBytes(pk),
+				Weight:         1,
+				NodeIDs:        []ids.NodeID{ids.GenerateTestNodeID()},
+			},
+		}
+	}
+	utils.Sort(signers)
+
+	vdrs := validators.WarpSet{
+		Validators:  make([]*validators.Warp, n),
+		TotalWeight: uint64(n),
+	}
+	for i, s := range signers {
+		vdrs.Validators[i] = s.vdr
+	}
+	return signers, vdrs
+}
+
+// signCheckpoint returns the bytes of a warp message containing [checkpoint]
+// that is signed by the first [numSigners] of [signers].
+func signCheckpoint(
+	t *testing.T,
+	networkID uint32,
+	chainID ids.ID,
+	checkpoint *Checkpoint,
+	signers []*checkpointSigner,
+	numSigners int,
+) []byte {
+	require := require.New(t)
+
+	unsignedMsg, err := NewCheckpointMessage(networkID, chainID, checkpoint)
+	require.NoError(err)
+
+	var (
+		signerIndices = set.NewBits()
+		sigs          = make([]*bls.Signature, numSigners)
+	)
+	for i := range numSigners {
+		signerIndices.Add(i)
+		sigs[i], err = signers[i].sk.Sign(unsignedMsg.Bytes())
+		require.NoError(err)
+	}
+	aggSig, err := bls.AggregateSignatures(sigs)
+	require.NoError(err)
+
+	signature := &warp.BitSetSignature{
+		Signers: signerIndices.Bytes(),
+	}
+	copy(signature.Signature[:], bls.SignatureToBytes(aggSig))
+
+	msg, err := warp.NewMessage(unsignedMsg, signature)
+	require.NoError(err)
+	return msg.Bytes()
+}
+
+func TestSignedCheckpointVerify(t *testing.T) {
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	signers, vdrs := newCheckpointSigners(t, 3)
+	validatorState := &validatorstest.State{
+		GetWarpValidatorSetF: func(_ context.Context, height uint64, subnetID ids.ID) (validators.WarpSet, error) {
+			require.Equal(t, uint64(checkpointPChainHeight), height)
+			require.Equal(t, snowCtx.SubnetID, subnetID)
+			return vdrs, nil
+		},
+	}
+	checkpoint := &Checkpoint{
+		PChainHeight: checkpointPChainHeight,
+		BlockID:      ids.GenerateTestID(),
+		Height:       100,
+		Summary:      summaryBytes,
+	}
+
+	tests := []struct {
+		name        string
+		chainID     ids.ID
+		numSigners  int
+		expectedErr error
+	}{
+		{
+			name:       "signed by every validator",
+			chainID:    snowCtx.ChainID,
+			numSigners: 3,
+		},
+		{
+			name:        "insufficient weight",
+			chainID:     snowCtx.ChainID,
+			numSigners:  2,
+			expectedErr: warp.ErrInsufficientWeight,
+		},
+		{
+			name:        "wrong source chain",
+			chainID:     snowtest.XChainID,
+			numSigners:  3,
+			expectedErr: errWrongSourceChain,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			checkpointBytes := signCheckpoint(t, snowCtx.NetworkID, test.chainID, checkpoint, signers, test.numSigners)
+			signedCheckpoint, err := ParseSignedCheckpoint(checkpointBytes)
+			require.NoError(err)
+			require.Equal(checkpoint.BlockID, signedCheckpoint.BlockID)
+			require.Equal(checkpoint.Height, signedCheckpoint.Height)
+			require.Equal(checkpoint.Summary, signedCheckpoint.Summary)
+
+			err = signedCheckpoint.Verify(
+				t.Context(),
+				snowCtx.NetworkID,
+				snowCtx.ChainID,
+				snowCtx.SubnetID,
+				validatorState,
+			)
+			require.ErrorIs(err, test.expectedErr)
+		})
+	}
+}
+
+func TestParseSignedCheckpointMissingSummary(t *testing.T) {
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	signers, _ := newCheckpointSigners(t, 1)
+	checkpointBytes := signCheckpoint(
+		t,
+		snowCtx.NetworkID,
+		snowCtx.ChainID,
+		&Checkpoint{
+			BlockID: ids.GenerateTestID(),
+			Height:  100,
+		},
+		signers,
+		1,
+	)
+
+	_, err := ParseSignedCheckpoint(checkpointBytes)
+	require.ErrorIs(t, err, errMissingSummary)
+}
+
+func TestStateSyncerSyncsToCheckpoint(t *testing.T) {
+	const checkpointHeight = 100
+
+	tests := []struct {
+		name           string
+		lastAcceptedID func(checkpoint ids.ID) ids.ID
+		expectedErr    error
+	}{
+		{
+			name: "checkpoint reached",
+			lastAcceptedID: func(checkpoint ids.ID) ids.ID {
+				return checkpoint
+			},
+		},
+		{
+			name: "checkpoint not reached",
+			lastAcceptedID: func(ids.ID) ids.ID {
+				return ids.GenerateTestID()
+			},
+			expectedErr: errCheckpointMismatch,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			snowCtx := snowtest.Context(t, snowtest.CChainID)
+			ctx := snowtest.ConsensusContext(snowCtx)
+
+			signers, vdrs := newCheckpointSigners(t, 3)
+			pChainHeight := uint64(0)
+			validatorState := snowCtx.ValidatorState.(*validatorstest.State)
+			validatorState.GetCurrentHeightF = func(context.Context) (uint64, error) {
+				return pChainHeight, nil
+			}
+			validatorState.GetWarpValidatorSetF = func(context.Context, uint64, ids.ID) (validators.WarpSet, error) {
+				return vdrs, nil
+			}
+
+			beacons := buildTestPeers(t, ctx.SubnetID)
+			totalWeight, err := beacons.TotalWeight(ctx.SubnetID)
+			require.NoError(err)
+			startup := tracker.NewStartup(tracker.NewPeers(), 0)
+			syncer, fullVM, sender := buildTestsObjects(t, ctx, startup, beacons, totalWeight)
+
+			checkpoint := &Checkpoint{
+				PChainHeight: checkpointPChainHeight,
+				BlockID:      ids.GenerateTestID(),
+				Height:       checkpointHeight,
+				Summary:      summaryBytes,
+			}
+			syncer.Checkpoint, err = ParseSignedCheckpoint(signCheckpoint(
+				t,
+				snowCtx.NetworkID,
+				snowCtx.ChainID,
+				checkpoint,
+				signers,
+				len(signers),
+			))
+			require.NoError(err)
+
+			// The beacons aren't asked for their state summaries.
+			sender.CantSendGetStateSummaryFrontier = true
+			fullVM.CantSetState = false
+			setLastAccepted(fullVM, 0)
+
+			var accepted bool
+			fullVM.ParseStateSummaryF = func(_ context.Context, b []byte) (block.StateSummary, error) {
+				require.Equal(summaryBytes, b)
+				return &blocktest.StateSummary{
+					IDV:     summaryID,
+					HeightV: checkpointHeight,
+					BytesV:  b,
+					AcceptF: func(context.Context) (block.StateSyncMode, error) {
+						accepted = true
+						return block.StateSyncStatic, nil
+					},
+				}, nil
+			}
+
+			var done bool
+			syncer.onDoneStateSyncing = func(context.Context, uint32) error {
+				done = true
+				return nil
+			}
+
+			// The checkpoint can't be verified before the P-chain reaches the
+			// checkpoint's P-chain height.
+			require.NoError(syncer.Start(t.Context(), 0))
+			require.True(syncer.started)
+			require.True(syncer.awaitingPChain)
+			require.False(accepted)
+
+			pChainHeight = checkpointPChainHeight
+			require.NoError(syncer.Gossip(t.Context()))
+			require.False(syncer.awaitingPChain)
+			require.True(accepted)
+			require.True(ctx.StateSyncing.Get())
+			require.False(done)
+
+			fullVM.LastAcceptedF = func(context.Context) (ids.ID, error) {
+				return test.lastAcceptedID(checkpoint.BlockID), nil
+			}
+			err = syncer.Notify(t.Context(), common.StateSyncDone)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expectedErr == nil, done)
+			require.Equal(snow.StateSyncing, ctx.State.Get().State)
+
+			// The checkpoint is only marked as consumed once it was reached.
+			consumed, err := GetConsumedCheckpoint(syncer.DB)
+			require.NoError(err)
+			if test.expectedErr == nil {
+				require.Equal(syncer.Checkpoint.Message.Bytes(), consumed)
+			} else {
+				require.Nil(consumed)
+			}
+		})
+	}
+}
+
+// setLastAccepted sets the last accepted block of [vm] to be at [height].
+func setLastAccepted(vm *fullVM, height uint64) {
+	blk := &snowmantest.Block{
+		Decidable: snowtest.Decidable{
+			IDV:    ids.GenerateTestID(),
+			Status: snowtest.Accepted,
+		},
+		HeightV: height,
+	}
+	vm.LastAcceptedF = func(context.Context) (ids.ID, error) {
+		return blk.ID(), nil
+	}
+	vm.GetBlockF = func(context.Context, ids.ID) (snowman.Block, error) {
+		return blk, nil
+	}
+}
+
+// newCheckpointSummary returns the state summary of [checkpoint].
+func newCheckpointSummary(checkpoint *SignedCheckpoint, accept func() block.StateSyncMode) *blocktest.StateSummary {
+	return &blocktest.StateSummary{
+		IDV:     hashing.ComputeHash256Array(checkpoint.Summary),
+		HeightV: checkpoint.Height,
+		BytesV:  checkpoint.Summary,
+		AcceptF: func(context.Context) (block.StateSyncMode, error) {
+			return accept(), nil
+		},
+	}
+}
+
+func TestStateSyncerSkipsCheckpoint(t *testing.T) {
+	const checkpointHeight = 100
+
+	tests := []struct {
+		name                   string
+		consumedHeight         uint64 // 0 if no checkpoint was consumed
+		lastAcceptedHeight     uint64
+		ongoingHeight          uint64 // 0 if there is no ongoing state sync
+		expectSyncToCheckpoint bool
+	}{
+		{
+			name:                   "no progress",
+			expectSyncToCheckpoint: true,
+		},
+		{
+			name:                   "resumes ongoing state sync to checkpoint",
+			ongoingHeight:          checkpointHeight,
+			expectSyncToCheckpoint: true,
+		},
+		{
+			name:           "checkpoint consumed",
+			consumedHeight: checkpointHeight,
+		},
+		{
+			name:               "accepted checkpoint height",
+			lastAcceptedHeight: checkpointHeight,
+		},
+		{
+			name:          "ongoing state sync past checkpoint",
+			ongoingHeight: checkpointHeight + 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			snowCtx := snowtest.Context(t, snowtest.CChainID)
+			ctx := snowtest.ConsensusContext(snowCtx)
+
+			signers, vdrs := newCheckpointSigners(t, 1)
+			validatorState := snowCtx.ValidatorState.(*validatorstest.State)
+			validatorState.GetCurrentHeightF = func(context.Context) (uint64, error) {
+				return checkpointPChainHeight, nil
+			}
+			validatorState.GetWarpValidatorSetF = func(context.Context, uint64, ids.ID) (validators.WarpSet, error) {
+				return vdrs, nil
+			}
+
+			beacons := buildTestPeers(t, ctx.SubnetID)
+			totalWeight, err := beacons.TotalWeight(ctx.SubnetID)
+			require.NoError(err)
+			startup := tracker.NewStartup(tracker.NewPeers(), 0)
+			syncer, fullVM, sender := buildTestsObjects(t, ctx, startup, beacons, totalWeight)
+			fullVM.CantSetState = false
+			setLastAccepted(fullVM, test.lastAcceptedHeight)
+
+			newCheckpoint := func(height uint64) []byte {
+				return signCheckpoint(
+					t,
+					snowCtx.NetworkID,
+					snowCtx.ChainID,
+					&Checkpoint{
+						PChainHeight: checkpointPChainHeight,
+						BlockID:      ids.GenerateTestID(),
+						Height:       height,
+						Summary:      summaryBytes,
+					},
+					signers,
+					len(signers),
+				)
+			}
+			syncer.Checkpoint, err = ParseSignedCheckpoint(newCheckpoint(checkpointHeight))
+			require.NoError(err)
+			if test.consumedHeight != 0 {
+				require.NoError(syncer.DB.Put(consumedCheckpointKey, newCheckpoint(test.consumedHeight)))
+			}
+			if test.ongoingHeight != 0 {
+				fullVM.GetOngoingSyncStateSummaryF = func(context.Context) (block.StateSummary, error) {
+					return &blocktest.StateSummary{
+						IDV:     ids.GenerateTestID(),
+						HeightV: test.ongoingHeight,
+					}, nil
+				}
+			}
+
+			var accepted bool
+			fullVM.ParseStateSummaryF = func(context.Context, []byte) (block.StateSummary, error) {
+				return newCheckpointSummary(syncer.Checkpoint, func() block.StateSyncMode {
+					accepted = true
+					return block.StateSyncStatic
+				}), nil
+			}
+			var requestedFrontier bool
+			sender.SendGetStateSummaryFrontierF = func(context.Context, set.Set[ids.NodeID], uint32) {
+				requestedFrontier = true
+			}
+
+			require.NoError(syncer.Start(t.Context(), 0))
+			require.Equal(test.expectSyncToCheckpoint, accepted)
+			require.Equal(!test.expectSyncToCheckpoint, requestedFrontier)
+			require.Equal(test.expectSyncToCheckpoint, syncer.Checkpoint != nil)
+		})
+	}
+}
+
+func TestStateSyncerFetchesCheckpoint(t *testing.T) {
+	tests := []struct {
+		name           string
+		heights        []uint64 // heights of the checkpoints served by each beacon
+		numSigners     []int    // number of signers of each checkpoint
+		expectedHeight uint64   // 0 if the state summaries should be voted on
+	}{
+		{
+			name:           "highest valid checkpoint",
+			heights:        []uint64{100, 300, 200},
+			numSigners:     []int{3, 1, 3},
+			expectedHeight: 200,
+		},
+		{
+			name:       "no valid checkpoint",
+			heights:    []uint64{100, 200},
+			numSigners: []int{1, 2},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			snowCtx := snowtest.Context(t, snowtest.CChainID)
+			ctx := snowtest.ConsensusContext(snowCtx)
+
+			signers, vdrs := newCheckpointSigners(t, 3)
+			validatorState := snowCtx.ValidatorState.(*validatorstest.State)
+			validatorState.GetCurrentHeightF = func(context.Context) (uint64, error) {
+				return checkpointPChainHeight, nil
+			}
+			validatorState.GetWarpValidatorSetF = func(context.Context, uint64, ids.ID) (validators.WarpSet, error) {
+				return vdrs, nil
+			}
+
+			beacons := validators.NewManager()
+			for range test.heights {
+				require.NoError(beacons.AddStaker(ctx.SubnetID, ids.GenerateTestNodeID(), nil, ids.Empty, 1))
+			}
+			totalWeight, err := beacons.TotalWeight(ctx.SubnetID)
+			require.NoError(err)
+			startup := tracker.NewStartup(tracker.NewPeers(), 0)
+			syncer, fullVM, sender := buildTestsObjects(t, ctx, startup, beacons, totalWeight)
+			syncer.FetchCheckpoint = true
+			fullVM.CantSetState = false
+			setLastAccepted(fullVM, 0)
+
+			checkpoints := make(map[string]*SignedCheckpoint)
+			fullVM.ParseStateSummaryF = func(_ context.Context, b []byte) (block.StateSummary, error) {
+				checkpoint, ok := checkpoints[string(b)]
+				if !ok {
+					return nil, errUnknownSummary
+				}
+				return newCheckpointSummary(checkpoint, func() block.StateSyncMode {
+					return block.StateSyncSkipped
+				}), nil
+			}
+
+			var seeders set.Set[ids.NodeID]
+			sender.SendGetStateSummaryFrontierF = func(_ context.Context, nodeIDs set.Set[ids.NodeID], _ uint32) {
+				seeders = nodeIDs
+			}
+			var voted bool
+			sender.SendGetAcceptedStateSummaryF = func(context.Context, set.Set[ids.NodeID], uint32, []uint64) {
+				voted = true
+			}
+			var done bool
+			syncer.onDoneStateSyncing = func(context.Context, uint32) error {
+				done = true
+				return nil
+			}
+
+			require.NoError(syncer.Start(t.Context(), 0))
+			require.Len(seeders, len(test.heights))
+
+			var expectedCheckpoint []byte
+			for i, nodeID := range seeders.List() {
+				summary := []byte{byte(i)}
+				checkpointBytes := signCheckpoint(
+					t,
+					snowCtx.NetworkID,
+					snowCtx.ChainID,
+					&Checkpoint{
+						PChainHeight: checkpointPChainHeight,
+						BlockID:      ids.GenerateTestID(),
+						Height:       test.heights[i],
+						Summary:      summary,
+					},
+					signers,
+					test.numSigners[i],
+				)
+				checkpoint, err := ParseSignedCheckpoint(checkpointBytes)
+				require.NoError(err)
+				checkpoints[string(summary)] = checkpoint
+				if checkpoint.Height == test.expectedHeight {
+					expectedCheckpoint = checkpointBytes
+				}
+
+				require.NoError(syncer.StateSummaryFrontier(
+					t.Context(),
+					nodeID,
+					syncer.requestID,
+					nil,
+					checkpointBytes,
+				))
+			}
+
+			consumed, err := GetConsumedCheckpoint(syncer.DB)
+			require.NoError(err)
+			require.Equal(expectedCheckpoint, consumed)
+			require.Equal(test.expectedHeight != 0, done)
+			require.Equal(test.expectedHeight == 0, voted)
+		})
+	}
+}
+
+func TestStateSyncerCheckpointRequiresStateSync(t *testing.T) {
+	require := require.New(t)
+
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+	beacons := buildTestPeers(t, ctx.SubnetID)
+	startup := tracker.NewStartup(tracker.NewPeers(), 0)
+	syncer, fullVM, _ := buildTestsObjects(t, ctx, startup, beacons, 1)
+	syncer.FetchCheckpoint = true
+
+	fullVM.StateSyncEnabledF = func(context.Context) (bool, error) {
+		return false, nil
+	}
+	_, err := syncer.IsEnabled(t.Context())
+	require.ErrorIs(err, errCheckpointStateSyncDisabled)
+}
@@ -6,6 +6,7 @@ package syncer
 import (
 	"fmt"
 
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
@@ -35,6 +36,19 @@ type Config struct {
 	// state summaries.
 	StateSyncBeacons validators.Manager
 
+	// Checkpoint, if non-nil, is the state summary to sync to. It replaces the
+	// state summaries that would otherwise be voted on by the beacons unless
+	// the chain has already progressed past it.
+	Checkpoint *SignedCheckpoint
+
+	// FetchCheckpoint, if true, syncs to the highest valid checkpoint served
+	// by the state sync beacons. If no valid checkpoint is served, the state
+	// summaries are voted on by the beacons.
+	FetchCheckpoint bool
+
+	// DB persists the checkpoint that was synced to.
+	DB database.Database
+
 	VM block.ChainVM
 }
 
@@ -47,6 +61,8 @@ func NewConfig(
 	sampleK int,
 	alpha uint64,
 	stateSyncerIDs []ids.NodeID,
+	checkpointBytes []byte,
+	db database.Database,
 	vm block.ChainVM,
 ) (Config, error) {
 	// Initialize the beacons that will be used if stateSyncerIDs is empty.
@@ -69,6 +85,20 @@ func NewConfig(
 		sampleK = int(min(uint64(sampleK), stateSyncingWeight))
 		alpha = stateSyncingWeight/2 + 1 // must be > 50%
 	}
+
+	// If the user has provided a trusted checkpoint, sync to it rather than to
+	// the summaries provided by the state sync beacons.
+	var (
+		checkpoint      *SignedCheckpoint
+		fetchCheckpoint = isCheckpointFromBeacons(checkpointBytes)
+	)
+	if len(checkpointBytes) != 0 && !fetchCheckpoint {
+		var err error
+		checkpoint, err = ParseSignedCheckpoint(checkpointBytes)
+		if err != nil {
+			return Config{}, fmt.Errorf("failed to parse checkpoint: %w", err)
+		}
+	}
 	return Config{
 		AllGetsServer:    snowGetHandler,
 		Ctx:              ctx,
@@ -77,6 +107,9 @@ func NewConfig(
 		SampleK:          sampleK,
 		Alpha:            alpha,
 		StateSyncBeacons: stateSyncBeacons,
+		Checkpoint:       checkpoint,
+		FetchCheckpoint:  fetchCheckpoint,
+		DB:               db,
 		VM:               vm,
 	}, nil
 }
@@ -4,9 +4,12 @@
 package syncer
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"maps"
 	"math"
+	"slices"
 
 	"go.uber.org/zap"
 
@@ -88,6 +91,13 @@ type stateSyncer struct {
 	// we keep a list of deduplicated height ready for voting
 	summariesHeights       set.Set[uint64]
 	uniqueSummariesHeights []uint64
+
+	// true if the checkpoint can't be verified until the P-chain reaches the
+	// checkpoint's P-chain height
+	awaitingPChain bool
+
+	// blockID --> checkpoint served by the frontier seeders
+	fetchedCheckpoints map[ids.ID]*SignedCheckpoint
 }
 
 func New(
@@ -156,7 +166,7 @@ func (ss *stateSyncer) tryStartSyncing(ctx context.Context) error {
 	return ss.startup(ctx)
 }
 
-func (ss *stateSyncer) StateSummaryFrontier(ctx context.Context, nodeID ids.NodeID, requestID uint32, summaryBytes []byte) error {
+func (ss *stateSyncer) StateSummaryFrontier(ctx context.Context, nodeID ids.NodeID, requestID uint32, summaryBytes, checkpointBytes []byte) error {
 	// ignores any late responses
 	if requestID != ss.requestID {
 		ss.Ctx.Log.Debug("received out-of-sync StateSummaryFrontier message",
@@ -203,6 +213,19 @@ func (ss *stateSyncer) StateSummaryFrontier(ctx context.Context, nodeID ids.Node
 		}
 	}
 
+	// The checkpoint is only verified once all the frontier seeders have
+	// responded, so that the highest checkpoint is synced to.
+	if ss.FetchCheckpoint && len(checkpointBytes) != 0 {
+		if checkpoint, err := ParseSignedCheckpoint(checkpointBytes); err == nil {
+			ss.fetchedCheckpoints[checkpoint.BlockID] = checkpoint
+		} else {
+			ss.Ctx.Log.Debug("failed to parse checkpoint",
+				zap.Stringer("nodeID", nodeID),
+				zap.Error(err),
+			)
+		}
+	}
+
 	return ss.receivedStateSummaryFrontier(ctx)
 }
 
@@ -232,6 +255,23 @@ func (ss *stateSyncer) receivedStateSummaryFrontier(ctx context.Context) error {
 		return nil
 	}
 
+	// A checkpoint is trusted based on the signatures of the validators, so it
+	// doesn't need to be voted on.
+	if ss.FetchCheckpoint {
+		checkpoint, summary, err := ss.selectFetchedCheckpoint(ctx)
+		if err != nil {
+			return err
+		}
+		if checkpoint != nil {
+			ss.Checkpoint = checkpoint
+			return ss.acceptCheckpoint(ctx, summary)
+		}
+
+		ss.Ctx.Log.Info("no valid checkpoint was fetched, falling back to voting on state summaries",
+			zap.Int("numCheckpoints", len(ss.fetchedCheckpoints)),
+		)
+	}
+
 	// All nodes reached out for the summary frontier have responded or timed out.
 	// If enough of them have indeed responded we'll go ahead and ask
 	// each state syncer (not just a sample) to filter the list of state summaries
@@ -388,21 +428,27 @@ func (ss *stateSyncer) AcceptedStateSummary(ctx context.Context, nodeID ids.Node
 	}
 
 	preferredStateSummary := ss.selectSyncableStateSummary()
-	syncMode, err := preferredStateSummary.Accept(ctx)
+	return ss.acceptStateSummary(ctx, preferredStateSummary, size)
+}
+
+// acceptStateSummary notifies the VM that [summary] was accepted and moves on
+// to bootstrapping unless the VM must finish syncing first.
+func (ss *stateSyncer) acceptStateSummary(ctx context.Context, summary block.StateSummary, numTotalSummaries int) error {
+	syncMode, err := summary.Accept(ctx)
 	if err != nil {
 		return err
 	}
 
 	ss.Ctx.Log.Info("accepted state summary",
-		zap.Stringer("summaryID", preferredStateSummary.ID()),
+		zap.Stringer("summaryID", summary.ID()),
 		zap.Stringer("syncMode", syncMode),
-		zap.Int("numTotalSummaries", size),
+		zap.Int("numTotalSummaries", numTotalSummaries),
 	)
 
 	switch syncMode {
 	case block.StateSyncSkipped:
 		// VM did not accept the summary, move on to bootstrapping.
-		return ss.onDoneStateSyncing(ctx, ss.requestID)
+		return ss.finishStateSyncing(ctx)
 	case block.StateSyncStatic:
 		// Summary was accepted and VM is state syncing.
 		// Engine will wait for notification of state sync done.
@@ -413,13 +459,187 @@ func (ss *stateSyncer) AcceptedStateSummary(ctx context.Context, nodeID ids.Node
 		// Engine will continue into bootstrapping and the VM will sync in the
 		// background.
 		ss.Ctx.StateSyncing.Set(true)
-		return ss.onDoneStateSyncing(ctx, ss.requestID)
+		return ss.finishStateSyncing(ctx)
 	default:
 		ss.Ctx.Log.Warn("unhandled state summary mode, proceeding to bootstrap",
 			zap.Stringer("syncMode", syncMode),
 		)
-		return ss.onDoneStateSyncing(ctx, ss.requestID)
+		return ss.finishStateSyncing(ctx)
+	}
+}
+
+// syncToCheckpoint accepts the state summary of the trusted checkpoint after
+// verifying that it was signed by a quorum of the chain's validators.
+func (ss *stateSyncer) syncToCheckpoint(ctx context.Context) error {
+	pChainHeight, err := ss.Ctx.ValidatorState.GetCurrentHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current P-chain height: %w", err)
+	}
+
+	// The validator set that signed the checkpoint is only known once the
+	// P-chain has reached the checkpoint's P-chain height. Until then, this is
+	// retried periodically.
+	ss.awaitingPChain = pChainHeight < ss.Checkpoint.PChainHeight
+	if ss.awaitingPChain {
+		ss.Ctx.Log.Info("waiting for the P-chain to verify the checkpoint",
+			zap.Uint64("pChainHeight", pChainHeight),
+			zap.Uint64("checkpointPChainHeight", ss.Checkpoint.PChainHeight),
+		)
+		return nil
+	}
+
+	summary, err := ss.verifyCheckpoint(ctx, ss.Checkpoint)
+	if err != nil {
+		return err
+	}
+	return ss.acceptCheckpoint(ctx, summary)
+}
+
+// acceptCheckpoint accepts [summary], the state summary of the trusted
+// checkpoint.
+func (ss *stateSyncer) acceptCheckpoint(ctx context.Context, summary block.StateSummary) error {
+	ss.Ctx.Log.Info("syncing to checkpoint",
+		zap.Stringer("blkID", ss.Checkpoint.BlockID),
+		zap.Uint64("height", ss.Checkpoint.Height),
+		zap.Stringer("summaryID", summary.ID()),
+	)
+	return ss.acceptStateSummary(ctx, summary, 1)
+}
+
+// verifyCheckpoint verifies that [checkpoint] was signed by a quorum of the
+// chain's validators and returns its state summary.
+func (ss *stateSyncer) verifyCheckpoint(ctx context.Context, checkpoint *SignedCheckpoint) (block.StateSummary, error) {
+	err := checkpoint.Verify(
+		ctx,
+		ss.Ctx.NetworkID,
+		ss.Ctx.ChainID,
+		ss.Ctx.SubnetID,
+		ss.Ctx.ValidatorState,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify checkpoint: %w", err)
+	}
+
+	summary, err := ss.stateSyncVM.ParseStateSummary(ctx, checkpoint.Summary)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse checkpoint state summary: %w", err)
+	}
+	if height := summary.Height(); height != checkpoint.Height {
+		return nil, fmt.Errorf("%w: %d != %d", errWrongSummaryHeight, height, checkpoint.Height)
+	}
+	return summary, nil
+}
+
+// canSyncToCheckpoint returns true if the chain hasn't already progressed to
+// the height of [checkpoint].
+func (ss *stateSyncer) canSyncToCheckpoint(ctx context.Context, checkpoint *SignedCheckpoint) (bool, error) {
+	consumedBytes, err := GetConsumedCheckpoint(ss.DB)
+	if err != nil {
+		return false, fmt.Errorf("failed to get consumed checkpoint: %w", err)
+	}
+	if len(consumedBytes) != 0 {
+		consumed, err := ParseSignedCheckpoint(consumedBytes)
+		if err != nil {
+			return false, fmt.Errorf("failed to parse consumed checkpoint: %w", err)
+		}
+		if consumed.Height >= checkpoint.Height {
+			return false, nil
+		}
+	}
+
+	lastAcceptedID, err := ss.VM.LastAccepted(ctx)
+	if err != nil {
+		return false, err
+	}
+	lastAccepted, err := ss.VM.GetBlock(ctx, lastAcceptedID)
+	if err != nil {
+		return false, err
+	}
+	if lastAccepted.Height() >= checkpoint.Height {
+		return false, nil
+	}
+
+	// An ongoing state sync to the checkpoint is resumed by syncing to the
+	// checkpoint, whereas an ongoing state sync past the checkpoint is resumed
+	// by voting on it.
+	return ss.locallyAvailableSummary == nil || ss.locallyAvailableSummary.Height() <= checkpoint.Height, nil
+}
+
+// selectFetchedCheckpoint returns the highest checkpoint served by the
+// frontier seeders that can be synced to, along with its state summary. If no
+// such checkpoint was served, nil is returned.
+func (ss *stateSyncer) selectFetchedCheckpoint(ctx context.Context) (*SignedCheckpoint, block.StateSummary, error) {
+	pChainHeight, err := ss.Ctx.ValidatorState.GetCurrentHeight(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get current P-chain height: %w", err)
+	}
+
+	checkpoints := slices.SortedFunc(
+		maps.Values(ss.fetchedCheckpoints),
+		func(a, b *SignedCheckpoint) int {
+			return cmp.Compare(b.Height, a.Height)
+		},
+	)
+	for _, checkpoint := range checkpoints {
+		if checkpoint.PChainHeight > pChainHeight {
+			ss.Ctx.Log.Debug("skipping checkpoint",
+				zap.String("reason", "P-chain height is unknown"),
+				zap.Stringer("blkID", checkpoint.BlockID),
+				zap.Uint64("pChainHeight", pChainHeight),
+				zap.Uint64("checkpointPChainHeight", checkpoint.PChainHeight),
+			)
+			continue
+		}
+
+		canSync, err := ss.canSyncToCheckpoint(ctx, checkpoint)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !canSync {
+			// The remaining checkpoints are at or below this height.
+			break
+		}
+
+		summary, err := ss.verifyCheckpoint(ctx, checkpoint)
+		if err != nil {
+			ss.Ctx.Log.Debug("skipping checkpoint",
+				zap.String("reason", "invalid checkpoint"),
+				zap.Stringer("blkID", checkpoint.BlockID),
+				zap.Error(err),
+			)
+			continue
+		}
+		return checkpoint, summary, nil
+	}
+	return nil, nil, nil
+}
+
+// finishStateSyncing persists the checkpoint that was synced to, if any, and
+// moves on to bootstrapping.
+func (ss *stateSyncer) finishStateSyncing(ctx context.Context) error {
+	if ss.Checkpoint != nil {
+		if err := ss.DB.Put(consumedCheckpointKey, ss.Checkpoint.Message.Bytes()); err != nil {
+			return fmt.Errorf("failed to persist consumed checkpoint: %w", err)
+		}
+	}
+	return ss.onDoneStateSyncing(ctx, ss.requestID)
+}
+
+// verifyCheckpointReached verifies that the VM synced to the block of the
+// trusted checkpoint.
+func (ss *stateSyncer) verifyCheckpointReached(ctx context.Context) error {
+	lastAcceptedID, err := ss.VM.LastAccepted(ctx)
+	if err != nil {
+		return err
 	}
+	if lastAcceptedID != ss.Checkpoint.BlockID {
+		return fmt.Errorf("%w: expected %s but got %s",
+			errCheckpointMismatch,
+			ss.Checkpoint.BlockID,
+			lastAcceptedID,
+		)
+	}
+	return nil
 }
 
 // selectSyncableStateSummary chooses a state summary from all
@@ -477,6 +697,7 @@ func (ss *stateSyncer) startup(ctx context.Context) error {
 	ss.weightedSummaries = make(map[ids.ID]*weightedSummary)
 	ss.summariesHeights.Clear()
 	ss.uniqueSummariesHeights = nil
+	ss.fetchedCheckpoints = make(map[ids.ID]*SignedCheckpoint)
 
 	ss.targetSeeders.Clear()
 	ss.pendingSeeders.Clear()
@@ -485,6 +706,46 @@ func (ss *stateSyncer) startup(ctx context.Context) error {
 	ss.pendingVoters.Clear()
 	ss.failedVoters.Clear()
 
+	// check if there is an ongoing state sync; if so add its state summary
+	// to the frontier to request votes on
+	// Note: database.ErrNotFound means there is no ongoing summary
+	ss.locallyAvailableSummary = nil
+	localSummary, err := ss.stateSyncVM.GetOngoingSyncStateSummary(ctx)
+	switch err {
+	case database.ErrNotFound:
+		// no action needed
+	case nil:
+		ss.locallyAvailableSummary = localSummary
+		ss.weightedSummaries[localSummary.ID()] = &weightedSummary{
+			summary: localSummary,
+		}
+
+		height := localSummary.Height()
+		ss.summariesHeights.Add(height)
+		ss.uniqueSummariesHeights = append(ss.uniqueSummariesHeights, height)
+	default:
+		return err
+	}
+
+	// The checkpoint is only synced to if the chain hasn't already progressed
+	// to it. Otherwise, a restart would roll the chain back to the checkpoint.
+	if ss.Checkpoint != nil {
+		canSync, err := ss.canSyncToCheckpoint(ctx, ss.Checkpoint)
+		if err != nil {
+			return err
+		}
+		if canSync {
+			return ss.syncToCheckpoint(ctx)
+		}
+
+		ss.Ctx.Log.Info("skipping checkpoint",
+			zap.String("reason", "already progressed to the checkpoint"),
+			zap.Stringer("blkID", ss.Checkpoint.BlockID),
+			zap.Uint64("height", ss.Checkpoint.Height),
+		)
+		ss.Checkpoint = nil
+	}
+
 	// sample K beacons to retrieve frontier from
 	beaconIDs, err := ss.StateSyncBeacons.Sample(ss.Ctx.SubnetID, ss.Config.SampleK)
 	if err != nil {
@@ -508,26 +769,6 @@ func (ss *stateSyncer) startup(ctx context.Context) error {
 	// list all beacons, to reach them for voting on frontier
 	ss.targetVoters.Add(ss.StateSyncBeacons.GetValidatorIDs(ss.Ctx.SubnetID)...)
 
-	// check if there is an ongoing state sync; if so add its state summary
-	// to the frontier to request votes on
-	// Note: database.ErrNotFound means there is no ongoing summary
-	localSummary, err := ss.stateSyncVM.GetOngoingSyncStateSummary(ctx)
-	switch err {
-	case database.ErrNotFound:
-		// no action needed
-	case nil:
-		ss.locallyAvailableSummary = localSummary
-		ss.weightedSummaries[localSummary.ID()] = &weightedSummary{
-			summary: localSummary,
-		}
-
-		height := localSummary.Height()
-		ss.summariesHeights.Add(height)
-		ss.uniqueSummariesHeights = append(ss.uniqueSummariesHeights, height)
-	default:
-		return err
-	}
-
 	// initiate messages exchange
 	if ss.targetSeeders.Len() == 0 {
 		ss.Ctx.Log.Info("State syncing skipped due to no provided syncers")
@@ -584,11 +825,19 @@ func (ss *stateSyncer) Notify(ctx context.Context, msg common.Message) error {
 	}
 
 	ss.Ctx.StateSyncing.Set(false)
-	return ss.onDoneStateSyncing(ctx, ss.requestID)
+	if ss.Checkpoint != nil {
+		if err := ss.verifyCheckpointReached(ctx); err != nil {
+			return err
+		}
+	}
+	return ss.finishStateSyncing(ctx)
 }
 
-func (*stateSyncer) Gossip(context.Context) error {
-	return nil
+func (ss *stateSyncer) Gossip(ctx context.Context) error {
+	if !ss.awaitingPChain {
+		return nil
+	}
+	return ss.syncToCheckpoint(ctx)
 }
 
 func (ss *stateSyncer) Shutdown(ctx context.Context) error {
@@ -617,13 +866,26 @@ func (ss *stateSyncer) HealthCheck(ctx context.Context) (interface{}, error) {
 }
 
 func (ss *stateSyncer) IsEnabled(ctx context.Context) (bool, error) {
+	// A checkpoint can only be synced to by state syncing, so it is an error to
+	// provide one if state sync is disabled.
+	checkpointProvided := ss.Checkpoint != nil || ss.FetchCheckpoint
 	if ss.stateSyncVM == nil {
 		// state sync is not implemented
+		if checkpointProvided {
+			return false, fmt.Errorf("%w: state sync is not implemented", errCheckpointStateSyncDisabled)
+		}
 		return false, nil
 	}
 
 	ss.Ctx.Lock.Lock()
 	defer ss.Ctx.Lock.Unlock()
 
-	return ss.stateSyncVM.StateSyncEnabled(ctx)
+	enabled, err := ss.stateSyncVM.StateSyncEnabled(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !enabled && checkpointProvided {
+		return false, errCheckpointStateSyncDisabled
+	}
+	return enabled, nil
 }
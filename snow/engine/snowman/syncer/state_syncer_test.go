@@ -52,11 +52,12 @@ func TestStateSyncerIsEnabledIfVMSupportsStateSyncing(t *testing.T) {
 		logging.NoLog{},
 		time.Second,
 		2000,
+		nil,
 		prometheus.NewRegistry(),
 	)
 	require.NoError(err)
 
-	cfg, err := NewConfig(dummyGetter, ctx, nil, sender, nil, 0, 0, nil, nonStateSyncableVM)
+	cfg, err := NewConfig(dummyGetter, ctx, nil, sender, nil, 0, 0, nil, nil, nil, nonStateSyncableVM)
 	require.NoError(err)
 	syncer := New(cfg, func(context.Context, uint32) error {
 		return nil
@@ -81,10 +82,11 @@ func TestStateSyncerIsEnabledIfVMSupportsStateSyncing(t *testing.T) {
 		logging.NoLog{},
 		time.Second,
 		2000,
+		nil,
 		prometheus.NewRegistry())
 	require.NoError(err)
 
-	cfg, err = NewConfig(dummyGetter, ctx, nil, sender, nil, 0, 0, nil, fullVM)
+	cfg, err = NewConfig(dummyGetter, ctx, nil, sender, nil, 0, 0, nil, nil, nil, fullVM)
 	require.NoError(err)
 	syncer = New(cfg, func(context.Context, uint32) error {
 		return nil
@@ -311,6 +313,7 @@ func TestUnRequestedStateSummaryFrontiersAreDropped(t *testing.T) {
 		responsiveBeaconID,
 		math.MaxInt32,
 		summaryBytes,
+		nil,
 	))
 	require.Contains(syncer.pendingSeeders, responsiveBeaconID) // responsiveBeacon still pending
 	require.Empty(syncer.weightedSummaries)
@@ -322,6 +325,7 @@ func TestUnRequestedStateSummaryFrontiersAreDropped(t *testing.T) {
 		unsolicitedNodeID,
 		responsiveBeaconReqID,
 		summaryBytes,
+		nil,
 	))
 	require.Empty(syncer.weightedSummaries)
 
@@ -331,6 +335,7 @@ func TestUnRequestedStateSummaryFrontiersAreDropped(t *testing.T) {
 		responsiveBeaconID,
 		responsiveBeaconReqID,
 		summaryBytes,
+		nil,
 	))
 
 	// responsiveBeacon not pending anymore
@@ -400,6 +405,7 @@ func TestMalformedStateSummaryFrontiersAreDropped(t *testing.T) {
 		responsiveBeaconID,
 		responsiveBeaconReqID,
 		summary,
+		nil,
 	))
 
 	// responsiveBeacon not pending anymore
@@ -493,6 +499,7 @@ func TestLateResponsesFromUnresponsiveFrontiersAreNotRecorded(t *testing.T) {
 		unresponsiveBeaconID,
 		unresponsiveBeaconReqID,
 		summaryBytes,
+		nil,
 	))
 
 	// late summary is not recorded
@@ -569,6 +576,7 @@ func TestStateSyncIsRestartedIfTooManyFrontierSeedersTimeout(t *testing.T) {
 				beaconID,
 				reqID,
 				summaryBytes,
+				nil,
 			))
 		} else {
 			require.NoError(syncer.GetStateSummaryFrontierFailed(
@@ -649,6 +657,7 @@ func TestVoteRequestsAreSentAsAllFrontierBeaconsResponded(t *testing.T) {
 			beaconID,
 			reqID,
 			summaryBytes,
+			nil,
 		))
 	}
 	require.Empty(syncer.pendingSeeders)
@@ -719,6 +728,7 @@ func TestUnRequestedVotesAreDropped(t *testing.T) {
 			beaconID,
 			reqID,
 			summaryBytes,
+			nil,
 		))
 	}
 	require.Empty(syncer.pendingSeeders)
@@ -836,6 +846,7 @@ func TestVotesForUnknownSummariesAreDropped(t *testing.T) {
 			beaconID,
 			reqID,
 			summaryBytes,
+			nil,
 		))
 	}
 	require.Empty(syncer.pendingSeeders)
@@ -961,6 +972,7 @@ func TestStateSummaryIsPassedToVMAsMajorityOfVotesIsCastedForIt(t *testing.T) {
 				beaconID,
 				reqID,
 				summaryBytes,
+				nil,
 			))
 		} else {
 			require.NoError(syncer.StateSummaryFrontier(
@@ -968,6 +980,7 @@ func TestStateSummaryIsPassedToVMAsMajorityOfVotesIsCastedForIt(t *testing.T) {
 				beaconID,
 				reqID,
 				minoritySummaryBytes,
+				nil,
 			))
 		}
 	}
@@ -1087,6 +1100,7 @@ func TestVotingIsRestartedIfMajorityIsNotReachedDueToTimeouts(t *testing.T) {
 			beaconID,
 			reqID,
 			summaryBytes,
+			nil,
 		))
 	}
 	require.Empty(syncer.pendingSeeders)
@@ -1212,6 +1226,7 @@ func TestStateSyncIsStoppedIfEnoughVotesAreCastedWithNoClearMajority(t *testing.
 				beaconID,
 				reqID,
 				summaryBytes,
+				nil,
 			))
 		} else {
 			require.NoError(syncer.StateSummaryFrontier(
@@ -1219,6 +1234,7 @@ func TestStateSyncIsStoppedIfEnoughVotesAreCastedWithNoClearMajority(t *testing.
 				beaconID,
 				reqID,
 				minoritySummaryBytes,
+				nil,
 			))
 		}
 	}
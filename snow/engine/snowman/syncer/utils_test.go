@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/engine/common/tracker"
@@ -97,6 +98,7 @@ func buildTestsObjects(
 		ctx.Log,
 		time.Second,
 		2000,
+		nil,
 		ctx.Registerer,
 	)
 	require.NoError(err)
@@ -110,6 +112,8 @@ func buildTestsObjects(
 		beacons.NumValidators(ctx.SubnetID),
 		alpha,
 		nil,
+		nil,
+		memdb.New(),
 		fullVM,
 	)
 	require.NoError(err)
@@ -54,6 +54,9 @@ func (v *voter) Execute(ctx context.Context, _ []ids.ID, _ []ids.ID) error {
 	if len(results) == 0 {
 		return nil
 	}
+	if v.e.adaptive != nil {
+		v.e.adaptive.pollsFinished(len(results), v.e.Clock.Time())
+	}
 
 	for _, result := range results {
 		v.e.Ctx.Log.Debug("finishing poll",
@@ -62,6 +65,7 @@ func (v *voter) Execute(ctx context.Context, _ []ids.ID, _ []ids.ID) error {
 		if err := v.e.Consensus.RecordPoll(ctx, result); err != nil {
 			return err
 		}
+		v.e.recordPollResult(result)
 	}
 
 	if err := v.e.VM.SetPreference(ctx, v.e.Consensus.Preference()); err != nil {
@@ -75,6 +75,12 @@ type Handler interface {
 	AwaitStopped(ctx context.Context) (time.Duration, error)
 }
 
+// SimplexHandler is implemented by engines that run the Simplex consensus
+// protocol. Simplex messages sent to other engines are dropped.
+type SimplexHandler interface {
+	SimplexMessage(ctx context.Context, nodeID ids.NodeID, msg *p2ppb.Simplex) error
+}
+
 // handler passes incoming messages from the network to the consensus engine.
 // (Actually, it receives the incoming messages from a ChainRouter, but same difference.)
 type handler struct {
@@ -169,30 +175,41 @@ func New(
 	if err != nil {
 		return nil, fmt.Errorf("initializing handler metrics errored with: %w", err)
 	}
-	cpuTracker := resourceTracker.CPUTracker()
-	h.syncMessageQueue, err = NewMessageQueue(
-		h.ctx.Log,
-		h.ctx.SubnetID,
-		h.validators,
-		cpuTracker,
-		"sync",
-		reg,
-	)
+	h.syncMessageQueue, err = h.newMessageQueue(resourceTracker, "sync", reg)
 	if err != nil {
 		return nil, fmt.Errorf("initializing sync message queue errored with: %w", err)
 	}
-	h.asyncMessageQueue, err = NewMessageQueue(
+	h.asyncMessageQueue, err = h.newMessageQueue(resourceTracker, "async", reg)
+	if err != nil {
+		return nil, fmt.Errorf("initializing async message queue errored with: %w", err)
+	}
+	return h, nil
+}
+
+// newMessageQueue returns the message queue that implements the subnet's
+// message queue policy.
+func (h *handler) newMessageQueue(
+	resourceTracker tracker.ResourceTracker,
+	metricsNamespace string,
+	reg prometheus.Registerer,
+) (MessageQueue, error) {
+	if h.subnet.Config().MessageQueuePolicy == subnets.StakeMessageQueuePolicy {
+		return NewStakeWeightedMessageQueue(
+			h.ctx.Log,
+			h.ctx.SubnetID,
+			h.validators,
+			metricsNamespace,
+			reg,
+		)
+	}
+	return NewMessageQueue(
 		h.ctx.Log,
 		h.ctx.SubnetID,
 		h.validators,
-		cpuTracker,
-		"async",
+		resourceTracker.CPUTracker(),
+		metricsNamespace,
 		reg,
 	)
-	if err != nil {
-		return nil, fmt.Errorf("initializing async message queue errored with: %w", err)
-	}
-	return h, nil
 }
 
 func (h *handler) Context() *snow.ConsensusContext {
@@ -547,7 +564,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg Message) error {
 		return engine.GetStateSummaryFrontier(ctx, nodeID, msg.RequestId)
 
 	case *p2ppb.StateSummaryFrontier:
-		return engine.StateSummaryFrontier(ctx, nodeID, msg.RequestId, msg.Summary)
+		return engine.StateSummaryFrontier(ctx, nodeID, msg.RequestId, msg.Summary, msg.Checkpoint)
 
 	case *message.GetStateSummaryFrontierFailed:
 		return engine.GetStateSummaryFrontierFailed(ctx, nodeID, msg.RequestID)
@@ -635,6 +652,10 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg Message) error {
 		return engine.GetAcceptedFailed(ctx, nodeID, msg.RequestID)
 
 	case *p2ppb.GetAncestors:
+		if len(msg.ContainerId) == 0 {
+			return engine.GetAncestorsAtHeight(ctx, nodeID, msg.RequestId, msg.Height)
+		}
+
 		containerID, err := ids.ToID(msg.ContainerId)
 		if err != nil {
 			h.ctx.Log.Debug("dropping message with invalid field",
@@ -737,12 +758,16 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg Message) error {
 		return engine.QueryFailed(ctx, nodeID, msg.RequestID)
 
 	case *p2ppb.Simplex:
-		h.ctx.Log.Debug("received simplex message",
-			zap.Stringer("nodeID", nodeID),
-			zap.String("messageOp", op),
-			zap.Stringer("message", body),
-		)
-		return nil
+		simplexEngine, ok := engine.(SimplexHandler)
+		if !ok {
+			h.ctx.Log.Debug("dropping simplex message",
+				zap.String("reason", "chain isn't running simplex"),
+				zap.Stringer("nodeID", nodeID),
+				zap.String("messageOp", op),
+			)
+			return nil
+		}
+		return simplexEngine.SimplexMessage(ctx, nodeID, msg)
 	// Connection messages can be sent to the currently executing engine
 	case *message.Connected:
 		err := h.peerTracker.Connected(ctx, nodeID, msg.NodeVersion)
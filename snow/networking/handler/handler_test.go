@@ -353,6 +353,99 @@ func TestHandlerDropsGossipDuringBootstrapping(t *testing.T) {
 }
 
 // Test that messages from the VM are handled
+type testSimplexEngine struct {
+	*enginetest.Engine
+
+	messages chan *p2ppb.Simplex
+}
+
+func (e *testSimplexEngine) SimplexMessage(_ context.Context, _ ids.NodeID, msg *p2ppb.Simplex) error {
+	e.messages <- msg
+	return nil
+}
+
+func TestHandlerDispatchSimplex(t *testing.T) {
+	require := require.New(t)
+
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+	vdrs := validators.NewManager()
+	require.NoError(vdrs.AddStaker(ctx.SubnetID, ids.GenerateTestNodeID(), nil, ids.Empty, 1))
+
+	resourceTracker, err := tracker.NewResourceTracker(
+		prometheus.NewRegistry(),
+		resource.NoUsage,
+		meter.ContinuousFactory{},
+		time.Second,
+	)
+	require.NoError(err)
+
+	peerTracker, err := p2p.NewPeerTracker(
+		logging.NoLog{},
+		"",
+		prometheus.NewRegistry(),
+		nil,
+		version.Current,
+	)
+	require.NoError(err)
+
+	subscription, _ := createSubscriber()
+
+	handler, err := New(
+		ctx,
+		&block.ChangeNotifier{},
+		subscription,
+		vdrs,
+		time.Second,
+		testThreadPoolSize,
+		resourceTracker,
+		subnets.New(ctx.NodeID, subnets.Config{}),
+		commontracker.NewPeers(),
+		peerTracker,
+		prometheus.NewRegistry(),
+		func() {},
+	)
+	require.NoError(err)
+
+	engine := &testSimplexEngine{
+		Engine: &enginetest.Engine{
+			T: t,
+		},
+		messages: make(chan *p2ppb.Simplex, 1),
+	}
+	engine.Default(false)
+	engine.ContextF = func() *snow.ConsensusContext {
+		return ctx
+	}
+	engine.StartF = func(context.Context, uint32) error {
+		return nil
+	}
+
+	handler.SetEngineManager(&EngineManager{
+		Chain: &Engine{
+			Bootstrapper: &enginetest.Bootstrapper{
+				Engine: *engine.Engine,
+			},
+			Consensus: engine,
+		},
+	})
+	ctx.State.Set(snow.EngineState{
+		Type:  p2ppb.EngineType_ENGINE_TYPE_CHAIN,
+		State: snow.NormalOp,
+	})
+
+	handler.Start(t.Context(), false)
+
+	msg := &p2ppb.Simplex{
+		ChainId: ctx.ChainID[:],
+	}
+	handler.Push(t.Context(), Message{
+		InboundMessage: message.InboundSimplexMessage(ids.EmptyNodeID, msg),
+		EngineType:     p2ppb.EngineType_ENGINE_TYPE_UNSPECIFIED,
+	})
+	require.Equal(msg, <-engine.messages)
+}
+
 func TestHandlerDispatchInternal(t *testing.T) {
 	require := require.New(t)
 
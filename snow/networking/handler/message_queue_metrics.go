@@ -5,15 +5,27 @@ package handler
 
 import (
 	"errors"
+	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/metric"
+	"github.com/ava-labs/avalanchego/utils/set"
 )
 
-const opLabel = "op"
+const (
+	opLabel     = "op"
+	nodeIDLabel = "nodeID"
+)
 
-var opLabels = []string{opLabel}
+var (
+	opLabels     = []string{opLabel}
+	nodeIDLabels = []string{nodeIDLabel}
+)
 
 type messageQueueMetrics struct {
 	count             *prometheus.GaugeVec
@@ -51,3 +63,86 @@ func (m *messageQueueMetrics) initialize(
 		metricsRegisterer.Register(m.numExcessiveCPU),
 	)
 }
+
+var _ validators.SetCallbackListener = (*stakeWeightedMessageQueueMetrics)(nil)
+
+// stakeWeightedMessageQueueMetrics reports the queue latency of each validator.
+// The series of a validator are deleted when it leaves the validator set, so
+// that the number of series is bounded by the size of the validator set.
+type stakeWeightedMessageQueueMetrics struct {
+	messageQueueMetrics
+	queueLatencyCount *prometheus.CounterVec
+	queueLatencySum   *prometheus.GaugeVec
+
+	lock sync.Mutex
+	// validators whose latency is reported under their own nodeID label
+	validators set.Set[ids.NodeID]
+}
+
+func (m *stakeWeightedMessageQueueMetrics) initialize(
+	metricsNamespace string,
+	metricsRegisterer prometheus.Registerer,
+) error {
+	namespace := metric.AppendNamespace(metricsNamespace, "queue_latency")
+	m.queueLatencyCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "count",
+			Help:      "messages popped from the queue",
+		},
+		nodeIDLabels,
+	)
+	m.queueLatencySum = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "sum",
+			Help:      "time (in ns) popped messages spent in the queue",
+		},
+		nodeIDLabels,
+	)
+
+	return errors.Join(
+		m.messageQueueMetrics.initialize(metricsNamespace, metricsRegisterer),
+		metricsRegisterer.Register(m.queueLatencyCount),
+		metricsRegisterer.Register(m.queueLatencySum),
+	)
+}
+
+// observeLatency records that a message of [flow] spent [latency] in the
+// queue. The latency of a flow that is no longer a validator is recorded as a
+// non-validator's.
+func (m *stakeWeightedMessageQueueMetrics) observeLatency(flow ids.NodeID, latency time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	label := nonValidatorLabel
+	if m.validators.Contains(flow) {
+		label = flow.String()
+	}
+	labels := prometheus.Labels{
+		nodeIDLabel: label,
+	}
+	m.queueLatencyCount.With(labels).Inc()
+	m.queueLatencySum.With(labels).Add(float64(latency))
+}
+
+func (m *stakeWeightedMessageQueueMetrics) OnValidatorAdded(nodeID ids.NodeID, _ *bls.PublicKey, _ ids.ID, _ uint64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.validators.Add(nodeID)
+}
+
+func (m *stakeWeightedMessageQueueMetrics) OnValidatorRemoved(nodeID ids.NodeID, _ uint64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.validators.Remove(nodeID)
+	labels := prometheus.Labels{
+		nodeIDLabel: nodeID.String(),
+	}
+	m.queueLatencyCount.Delete(labels)
+	m.queueLatencySum.Delete(labels)
+}
+
+func (*stakeWeightedMessageQueueMetrics) OnValidatorWeightChanged(ids.NodeID, uint64, uint64) {}
@@ -0,0 +1,233 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package handler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/heap"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+const (
+	// nonValidatorShare is the share of processing that is guaranteed to the
+	// messages of non-validators. The remaining share is split between the
+	// validators proportionally to their weight.
+	nonValidatorShare = .05
+
+	// nonValidatorLabel is the nodeID label of the latency metrics of the
+	// non-validator flow and of the validators that left the validator set.
+	nonValidatorLabel = "non_validator"
+)
+
+var (
+	_ MessageQueue = (*stakeWeightedMessageQueue)(nil)
+
+	// nonValidatorFlow is the flow that the messages of every non-validator
+	// are queued in.
+	nonValidatorFlow = ids.EmptyNodeID
+)
+
+// stakeWeightedMessageQueue schedules messages with start-time fair queuing.
+// Every validator is a flow whose share of the processed messages is
+// proportional to its weight. The messages of non-validators share a single
+// flow.
+//
+// Each message is tagged, when it is pushed, with the virtual time at which
+// its flow is entitled to have it processed. The message with the earliest
+// tag is popped first, so a flow that sends more than its share of messages
+// only delays its own messages. Processing is work conserving: if only a
+// single flow has messages, its messages are processed in FIFO order.
+type stakeWeightedMessageQueue struct {
+	// Useful for faking time in tests
+	clock   mockable.Clock
+	metrics stakeWeightedMessageQueueMetrics
+
+	log      logging.Logger
+	subnetID ids.ID
+	// Validator set for the chain associated with this
+	vdrs validators.Manager
+
+	cond   *sync.Cond
+	closed bool
+	// Node ID --> Messages this node has in [msgs]
+	nodeToUnprocessedMsgs map[ids.NodeID]int
+	// Flow --> Virtual time at which the flow's last message finishes
+	flowToFinishTag map[ids.NodeID]float64
+	// virtualTime is the start tag of the last popped message
+	virtualTime float64
+	nextSeq     uint64
+	// Unprocessed messages, ordered by their start tags
+	msgs heap.Queue[*taggedMessage]
+}
+
+type taggedMessage struct {
+	msgAndContext
+	flow     ids.NodeID
+	startTag float64
+	// seq orders the messages that have the same start tag by the order they
+	// were pushed in.
+	seq    uint64
+	pushed time.Time
+}
+
+func NewStakeWeightedMessageQueue(
+	log logging.Logger,
+	subnetID ids.ID,
+	vdrs validators.Manager,
+	metricsNamespace string,
+	reg prometheus.Registerer,
+) (MessageQueue, error) {
+	m := &stakeWeightedMessageQueue{
+		log:                   log,
+		subnetID:              subnetID,
+		vdrs:                  vdrs,
+		cond:                  sync.NewCond(&sync.Mutex{}),
+		nodeToUnprocessedMsgs: make(map[ids.NodeID]int),
+		flowToFinishTag:       make(map[ids.NodeID]float64),
+		msgs: heap.NewQueue(func(a, b *taggedMessage) bool {
+			if a.startTag != b.startTag {
+				return a.startTag < b.startTag
+			}
+			return a.seq < b.seq
+		}),
+	}
+	if err := m.metrics.initialize(metricsNamespace, reg); err != nil {
+		return nil, err
+	}
+	vdrs.RegisterSetCallbackListener(subnetID, &m.metrics)
+	return m, nil
+}
+
+func (m *stakeWeightedMessageQueue) Push(ctx context.Context, msg Message) {
+	m.cond.L.Lock()
+	defer m.cond.L.Unlock()
+
+	if m.closed {
+		msg.OnFinishedHandling()
+		return
+	}
+
+	var (
+		nodeID      = msg.NodeID()
+		flow, share = m.flow(nodeID)
+		startTag    = max(m.virtualTime, m.flowToFinishTag[flow])
+	)
+	m.flowToFinishTag[flow] = startTag + 1/share
+	m.msgs.Push(&taggedMessage{
+		msgAndContext: msgAndContext{
+			msg: msg,
+			ctx: ctx,
+		},
+		flow:     flow,
+		startTag: startTag,
+		seq:      m.nextSeq,
+		pushed:   m.clock.Time(),
+	})
+	m.nextSeq++
+	m.nodeToUnprocessedMsgs[nodeID]++
+
+	// Update metrics
+	m.metrics.count.With(prometheus.Labels{
+		opLabel: msg.Op().String(),
+	}).Inc()
+	m.metrics.nodesWithMessages.Set(float64(len(m.nodeToUnprocessedMsgs)))
+
+	// Signal a waiting thread
+	m.cond.Signal()
+}
+
+// Pop the message with the earliest start tag.
+func (m *stakeWeightedMessageQueue) Pop() (context.Context, Message, bool) {
+	m.cond.L.Lock()
+	defer m.cond.L.Unlock()
+
+	for {
+		if m.closed {
+			return nil, Message{}, false
+		}
+		if m.msgs.Len() != 0 {
+			break
+		}
+		m.cond.Wait()
+	}
+
+	tagged, _ := m.msgs.Pop()
+	m.virtualTime = tagged.startTag
+	if m.msgs.Len() == 0 {
+		// Once the queue is empty, no flow is behind any other flow.
+		clear(m.flowToFinishTag)
+	}
+
+	nodeID := tagged.msg.NodeID()
+	m.nodeToUnprocessedMsgs[nodeID]--
+	if m.nodeToUnprocessedMsgs[nodeID] == 0 {
+		delete(m.nodeToUnprocessedMsgs, nodeID)
+	}
+
+	// Update metrics
+	m.metrics.count.With(prometheus.Labels{
+		opLabel: tagged.msg.Op().String(),
+	}).Dec()
+	m.metrics.nodesWithMessages.Set(float64(len(m.nodeToUnprocessedMsgs)))
+	m.metrics.observeLatency(tagged.flow, m.clock.Time().Sub(tagged.pushed))
+	return tagged.ctx, tagged.msg, true
+}
+
+func (m *stakeWeightedMessageQueue) Len() int {
+	m.cond.L.Lock()
+	defer m.cond.L.Unlock()
+
+	return m.msgs.Len()
+}
+
+func (m *stakeWeightedMessageQueue) Shutdown() {
+	m.cond.L.Lock()
+	defer m.cond.L.Unlock()
+
+	// Remove all the current messages from the queue
+	for m.msgs.Len() > 0 {
+		tagged, _ := m.msgs.Pop()
+		tagged.msg.OnFinishedHandling()
+	}
+	m.nodeToUnprocessedMsgs = nil
+	m.flowToFinishTag = nil
+
+	// Update metrics
+	m.metrics.count.Reset()
+	m.metrics.nodesWithMessages.Set(0)
+
+	// Mark the queue as closed
+	m.closed = true
+	m.cond.Broadcast()
+}
+
+// flow returns the flow that the messages of [nodeID] are queued in and the
+// flow's share of processing.
+func (m *stakeWeightedMessageQueue) flow(nodeID ids.NodeID) (ids.NodeID, float64) {
+	weight := m.vdrs.GetWeight(m.subnetID, nodeID)
+	if weight == 0 {
+		return nonValidatorFlow, nonValidatorShare
+	}
+
+	totalWeight, err := m.vdrs.TotalWeight(m.subnetID)
+	if err != nil {
+		// The sum of validator weights should never overflow, but if they do,
+		// we treat the validator as a non-validator.
+		m.log.Error("failed to get total weight of validators",
+			zap.Stringer("subnetID", m.subnetID),
+			zap.Error(err),
+		)
+		return nonValidatorFlow, nonValidatorShare
+	}
+	return nodeID, (1 - nonValidatorShare) * float64(weight) / float64(totalWeight)
+}
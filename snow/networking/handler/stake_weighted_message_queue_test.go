@@ -0,0 +1,266 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package handler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/proto/pb/p2p"
+	"github.com/ava-labs/avalanchego/snow/networking/tracker"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/math/meter"
+	"github.com/ava-labs/avalanchego/utils/resource"
+)
+
+func newTestMessage(nodeID ids.NodeID) Message {
+	return Message{
+		InboundMessage: message.InboundPullQuery(
+			ids.Empty,
+			0,
+			time.Second,
+			ids.GenerateTestID(),
+			0,
+			nodeID,
+		),
+		EngineType: p2p.EngineType_ENGINE_TYPE_UNSPECIFIED,
+	}
+}
+
+func newTestStakeWeightedMessageQueue(t testing.TB, vdrs validators.Manager) *stakeWeightedMessageQueue {
+	mIntf, err := NewStakeWeightedMessageQueue(
+		logging.NoLog{},
+		constants.PrimaryNetworkID,
+		vdrs,
+		"",
+		prometheus.NewRegistry(),
+	)
+	require.NoError(t, err)
+	return mIntf.(*stakeWeightedMessageQueue)
+}
+
+func TestStakeWeightedMessageQueue(t *testing.T) {
+	require := require.New(t)
+
+	vdrs := validators.NewManager()
+	vdr1ID, vdr2ID := ids.GenerateTestNodeID(), ids.GenerateTestNodeID()
+	require.NoError(vdrs.AddStaker(constants.PrimaryNetworkID, vdr1ID, nil, ids.Empty, 3))
+	require.NoError(vdrs.AddStaker(constants.PrimaryNetworkID, vdr2ID, nil, ids.Empty, 1))
+	u := newTestStakeWeightedMessageQueue(t, vdrs)
+
+	// Push then pop should work when there are no other messages
+	msg1 := newTestMessage(vdr1ID)
+	u.Push(t.Context(), msg1)
+	require.Equal(1, u.nodeToUnprocessedMsgs[vdr1ID])
+	require.Equal(1, u.Len())
+	_, gotMsg1, ok := u.Pop()
+	require.True(ok)
+	require.Empty(u.nodeToUnprocessedMsgs)
+	require.Empty(u.flowToFinishTag)
+	require.Zero(u.Len())
+	require.Equal(msg1, gotMsg1)
+
+	// Even though [vdr2ID] pushed all of its messages first, [vdr1ID] has 3
+	// times the weight and should have 3 times as many messages popped.
+	for range 4 {
+		u.Push(t.Context(), newTestMessage(vdr2ID))
+	}
+	for range 4 {
+		u.Push(t.Context(), newTestMessage(vdr1ID))
+	}
+	require.Equal(8, u.Len())
+
+	expectedNodeIDs := []ids.NodeID{vdr2ID, vdr1ID, vdr1ID, vdr1ID}
+	for _, expectedNodeID := range expectedNodeIDs {
+		_, gotMsg, ok := u.Pop()
+		require.True(ok)
+		require.Equal(expectedNodeID, gotMsg.NodeID())
+	}
+	for u.Len() > 0 {
+		_, _, ok := u.Pop()
+		require.True(ok)
+	}
+	require.Empty(u.nodeToUnprocessedMsgs)
+}
+
+func TestStakeWeightedMessageQueueNonValidators(t *testing.T) {
+	require := require.New(t)
+
+	vdrs := validators.NewManager()
+	vdrID := ids.GenerateTestNodeID()
+	require.NoError(vdrs.AddStaker(constants.PrimaryNetworkID, vdrID, nil, ids.Empty, 1))
+	u := newTestStakeWeightedMessageQueue(t, vdrs)
+
+	// Many non-validators flooding the queue shouldn't delay the validator's
+	// message behind more than one of their messages.
+	nonVdrIDs := make([]ids.NodeID, 100)
+	for i := range nonVdrIDs {
+		nonVdrIDs[i] = ids.GenerateTestNodeID()
+		u.Push(t.Context(), newTestMessage(nonVdrIDs[i]))
+	}
+	u.Push(t.Context(), newTestMessage(vdrID))
+	require.Len(u.nodeToUnprocessedMsgs, 101)
+	require.Len(u.flowToFinishTag, 2)
+
+	_, gotMsg, ok := u.Pop()
+	require.True(ok)
+	require.Equal(nonVdrIDs[0], gotMsg.NodeID())
+
+	_, gotMsg, ok = u.Pop()
+	require.True(ok)
+	require.Equal(vdrID, gotMsg.NodeID())
+
+	// The non-validators' messages are popped in the order they were pushed.
+	for _, nonVdrID := range nonVdrIDs[1:] {
+		_, gotMsg, ok := u.Pop()
+		require.True(ok)
+		require.Equal(nonVdrID, gotMsg.NodeID())
+	}
+	require.Zero(u.Len())
+}
+
+func TestStakeWeightedMessageQueueLatencyMetrics(t *testing.T) {
+	require := require.New(t)
+
+	vdrs := validators.NewManager()
+	vdrID, nonVdrID := ids.GenerateTestNodeID(), ids.GenerateTestNodeID()
+	require.NoError(vdrs.AddStaker(constants.PrimaryNetworkID, vdrID, nil, ids.Empty, 1))
+	u := newTestStakeWeightedMessageQueue(t, vdrs)
+
+	now := time.Now()
+	u.clock.Set(now)
+	u.Push(t.Context(), newTestMessage(vdrID))
+	u.Push(t.Context(), newTestMessage(nonVdrID))
+
+	u.clock.Set(now.Add(time.Second))
+	for range 2 {
+		_, _, ok := u.Pop()
+		require.True(ok)
+	}
+
+	for _, label := range []string{vdrID.String(), nonValidatorLabel} {
+		require.InDelta(1, testutil.ToFloat64(u.metrics.queueLatencyCount.WithLabelValues(label)), 0)
+		require.InDelta(float64(time.Second), testutil.ToFloat64(u.metrics.queueLatencySum.WithLabelValues(label)), 0)
+	}
+
+	// The series of a validator are deleted once it leaves the validator set,
+	// and its queued messages are then reported as a non-validator's.
+	u.Push(t.Context(), newTestMessage(vdrID))
+	require.NoError(vdrs.RemoveWeight(constants.PrimaryNetworkID, vdrID, 1))
+	require.Equal(1, testutil.CollectAndCount(u.metrics.queueLatencyCount))
+	require.Equal(1, testutil.CollectAndCount(u.metrics.queueLatencySum))
+
+	_, _, ok := u.Pop()
+	require.True(ok)
+	require.Equal(1, testutil.CollectAndCount(u.metrics.queueLatencyCount))
+	require.InDelta(2, testutil.ToFloat64(u.metrics.queueLatencyCount.WithLabelValues(nonValidatorLabel)), 0)
+}
+
+func TestStakeWeightedMessageQueueShutdown(t *testing.T) {
+	require := require.New(t)
+
+	u := newTestStakeWeightedMessageQueue(t, validators.NewManager())
+	u.Push(t.Context(), newTestMessage(ids.GenerateTestNodeID()))
+	u.Shutdown()
+	require.Zero(u.Len())
+
+	_, _, ok := u.Pop()
+	require.False(ok)
+
+	// Pushing after shutdown drops the message
+	u.Push(t.Context(), newTestMessage(ids.GenerateTestNodeID()))
+	require.Zero(u.Len())
+}
+
+// BenchmarkMessageQueue measures pushing and popping messages from a mix of
+// validators and non-validators with each message queue policy.
+func BenchmarkMessageQueue(b *testing.B) {
+	const (
+		numValidators    = 100
+		numNonValidators = 1_000
+	)
+
+	vdrs := validators.NewManager()
+	nodeIDs := make([]ids.NodeID, 0, numValidators+numNonValidators)
+	for i := range numValidators {
+		nodeID := ids.GenerateTestNodeID()
+		require.NoError(b, vdrs.AddStaker(constants.PrimaryNetworkID, nodeID, nil, ids.Empty, uint64(i+1)))
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	for range numNonValidators {
+		nodeIDs = append(nodeIDs, ids.GenerateTestNodeID())
+	}
+	msgs := make([]Message, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		msgs[i] = newTestMessage(nodeID)
+	}
+
+	resourceTracker, err := tracker.NewResourceTracker(
+		prometheus.NewRegistry(),
+		resource.NoUsage,
+		meter.ContinuousFactory{},
+		time.Second,
+	)
+	require.NoError(b, err)
+
+	queues := []struct {
+		name     string
+		newQueue func() (MessageQueue, error)
+	}{
+		{
+			name: "cpu",
+			newQueue: func() (MessageQueue, error) {
+				return NewMessageQueue(
+					logging.NoLog{},
+					constants.PrimaryNetworkID,
+					vdrs,
+					resourceTracker.CPUTracker(),
+					"",
+					prometheus.NewRegistry(),
+				)
+			},
+		},
+		{
+			name: "stake",
+			newQueue: func() (MessageQueue, error) {
+				return NewStakeWeightedMessageQueue(
+					logging.NoLog{},
+					constants.PrimaryNetworkID,
+					vdrs,
+					"",
+					prometheus.NewRegistry(),
+				)
+			},
+		},
+	}
+	for _, queueSize := range []int{10, 1_000} {
+		for _, queue := range queues {
+			b.Run(fmt.Sprintf("%s_%d", queue.name, queueSize), func(b *testing.B) {
+				q, err := queue.newQueue()
+				require.NoError(b, err)
+
+				// Fill the queue so that the scheduling decisions are made
+				// with [queueSize] messages pending.
+				for i := range queueSize {
+					q.Push(b.Context(), msgs[i%len(msgs)])
+				}
+
+				b.ResetTimer()
+				for i := range b.N {
+					q.Push(b.Context(), msgs[i%len(msgs)])
+					_, _, _ = q.Pop()
+				}
+			})
+		}
+	}
+}
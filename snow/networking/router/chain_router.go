@@ -16,6 +16,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/proto/pb/p2p"
 	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
 	"github.com/ava-labs/avalanchego/snow/networking/handler"
@@ -82,6 +83,9 @@ type ChainRouter struct {
 	metrics                *routerMetrics
 	// Parameters for doing health checks
 	healthConfig HealthConfig
+	// Accounts for the bandwidth used by each chain and enforces the bandwidth
+	// quotas of each subnet. Chains are registered with it when they are added.
+	bandwidthThrottler throttling.SubnetBandwidthThrottler
 	// aggregator of requests based on their time
 	timedRequests *linked.Hashmap[ids.RequestID, requestEntry]
 }
@@ -101,6 +105,7 @@ func (cr *ChainRouter) Initialize(
 	trackedSubnets set.Set[ids.ID],
 	onFatal func(exitCode int),
 	healthConfig HealthConfig,
+	bandwidthThrottler throttling.SubnetBandwidthThrottler,
 	reg prometheus.Registerer,
 ) error {
 	cr.log = log
@@ -114,6 +119,7 @@ func (cr *ChainRouter) Initialize(
 	cr.timedRequests = linked.NewHashmap[ids.RequestID, requestEntry]()
 	cr.peers = make(map[ids.NodeID]*peer)
 	cr.healthConfig = healthConfig
+	cr.bandwidthThrottler = bandwidthThrottler
 
 	// Mark myself as connected
 	cr.myNodeID = nodeID
@@ -424,8 +430,10 @@ func (cr *ChainRouter) AddChain(ctx context.Context, chain handler.Handler) {
 	})
 	cr.chainHandlers[chainID] = chain
 
-	// Notify connected validators
 	subnetID := chain.Context().SubnetID
+	cr.bandwidthThrottler.AddChain(chainID, subnetID)
+
+	// Notify connected validators
 	for validatorID, peer := range cr.peers {
 		// If this validator is benched on any chain, treat them as disconnected
 		// on all chains
@@ -16,6 +16,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/enginetest"
@@ -29,6 +30,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/subnets"
 	"github.com/ava-labs/avalanchego/tests"
+	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/math/meter"
 	"github.com/ava-labs/avalanchego/utils/resource"
@@ -83,6 +85,7 @@ func TestShutdown(t *testing.T) {
 		set.Set[ids.ID]{},
 		nil,
 		HealthConfig{},
+		throttling.NewNoSubnetBandwidthThrottler(),
 		prometheus.NewRegistry(),
 	))
 
@@ -212,6 +215,7 @@ func TestConnectedAfterShutdownErrorLogRegression(t *testing.T) {
 		set.Set[ids.ID]{},
 		nil,
 		HealthConfig{},
+		throttling.NewNoSubnetBandwidthThrottler(),
 		prometheus.NewRegistry(),
 	))
 
@@ -346,6 +350,7 @@ func TestShutdownTimesOut(t *testing.T) {
 		set.Set[ids.ID]{},
 		nil,
 		HealthConfig{},
+		throttling.NewNoSubnetBandwidthThrottler(),
 		prometheus.NewRegistry(),
 	))
 
@@ -496,6 +501,7 @@ func TestRouterTimeout(t *testing.T) {
 		set.Set[ids.ID]{},
 		nil,
 		HealthConfig{},
+		throttling.NewNoSubnetBandwidthThrottler(),
 		prometheus.NewRegistry(),
 	))
 	defer chainRouter.Shutdown(t.Context())
@@ -829,6 +835,7 @@ func TestRouterHonorsRequestedEngine(t *testing.T) {
 		set.Set[ids.ID]{},
 		nil,
 		HealthConfig{},
+		throttling.NewNoSubnetBandwidthThrottler(),
 		prometheus.NewRegistry(),
 	))
 	defer chainRouter.Shutdown(t.Context())
@@ -867,6 +874,7 @@ func TestRouterHonorsRequestedEngine(t *testing.T) {
 			ctx.ChainID,
 			requestID,
 			nil,
+			nil,
 			nodeID,
 		)
 
@@ -938,7 +946,7 @@ func TestRouterClearTimeouts(t *testing.T) {
 		{
 			name:        "StateSummaryFrontier",
 			responseOp:  message.StateSummaryFrontierOp,
-			responseMsg: message.InboundStateSummaryFrontier(ids.Empty, requestID, []byte("summary"), ids.EmptyNodeID),
+			responseMsg: message.InboundStateSummaryFrontier(ids.Empty, requestID, []byte("summary"), nil, ids.EmptyNodeID),
 			timeoutMsg:  message.InternalGetStateSummaryFrontierFailed(ids.EmptyNodeID, ids.Empty, requestID),
 		},
 		{
@@ -983,7 +991,7 @@ func TestRouterClearTimeouts(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			require := require.New(t)
 
-			chainRouter, _ := newChainRouterTest(t)
+			chainRouter, _ := newChainRouterTest(t, throttling.NewNoSubnetBandwidthThrottler())
 
 			chainRouter.RegisterRequest(
 				t.Context(),
@@ -1038,6 +1046,7 @@ func TestValidatorOnlyMessageDrops(t *testing.T) {
 		set.Set[ids.ID]{},
 		nil,
 		HealthConfig{},
+		throttling.NewNoSubnetBandwidthThrottler(),
 		prometheus.NewRegistry(),
 	))
 	defer chainRouter.Shutdown(t.Context())
@@ -1201,6 +1210,7 @@ func TestValidatorOnlyAllowedNodeMessageDrops(t *testing.T) {
 		set.Set[ids.ID]{},
 		nil,
 		HealthConfig{},
+		throttling.NewNoSubnetBandwidthThrottler(),
 		prometheus.NewRegistry(),
 	))
 	defer chainRouter.Shutdown(t.Context())
@@ -1386,7 +1396,7 @@ func TestAppRequest(t *testing.T) {
 			require := require.New(t)
 
 			wg := &sync.WaitGroup{}
-			chainRouter, engine := newChainRouterTest(t)
+			chainRouter, engine := newChainRouterTest(t, throttling.NewNoSubnetBandwidthThrottler())
 
 			wg.Add(1)
 			if tt.inboundMsg == nil || tt.inboundMsg.Op() == message.AppErrorOp {
@@ -1433,7 +1443,25 @@ func TestAppRequest(t *testing.T) {
 	}
 }
 
-func newChainRouterTest(t *testing.T) (*ChainRouter, *enginetest.Engine) {
+type testSubnetBandwidthThrottler struct {
+	throttling.SubnetBandwidthThrottler
+
+	chains map[ids.ID]ids.ID
+}
+
+func (t *testSubnetBandwidthThrottler) AddChain(chainID ids.ID, subnetID ids.ID) {
+	t.chains[chainID] = subnetID
+}
+
+func TestAddChainRegistersBandwidth(t *testing.T) {
+	throttler := &testSubnetBandwidthThrottler{
+		chains: make(map[ids.ID]ids.ID),
+	}
+	newChainRouterTest(t, throttler)
+	require.Equal(t, map[ids.ID]ids.ID{snowtest.PChainID: constants.PrimaryNetworkID}, throttler.chains)
+}
+
+func newChainRouterTest(t *testing.T, bandwidthThrottler throttling.SubnetBandwidthThrottler) (*ChainRouter, *enginetest.Engine) {
 	// Create a timeout manager
 	tm, err := timeout.NewManager(
 		&timer.AdaptiveTimeoutConfig{
@@ -1463,6 +1491,7 @@ func newChainRouterTest(t *testing.T) (*ChainRouter, *enginetest.Engine) {
 		set.Set[ids.ID]{},
 		nil,
 		HealthConfig{},
+		bandwidthThrottler,
 		prometheus.NewRegistry(),
 	))
 
@@ -1569,6 +1598,7 @@ func TestHandleSimplexMessage(t *testing.T) {
 			set.Set[ids.ID]{},
 			nil,
 			HealthConfig{},
+			throttling.NewNoSubnetBandwidthThrottler(),
 			prometheus.NewRegistry(),
 		))
 	defer chainRouter.Shutdown(t.Context())
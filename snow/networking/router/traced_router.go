@@ -12,6 +12,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/proto/pb/p2p"
 	"github.com/ava-labs/avalanchego/snow/networking/handler"
 	"github.com/ava-labs/avalanchego/snow/networking/timeout"
@@ -47,6 +48,7 @@ func (r *tracedRouter) Initialize(
 	trackedSubnets set.Set[ids.ID],
 	onFatal func(exitCode int),
 	healthConfig HealthConfig,
+	bandwidthThrottler throttling.SubnetBandwidthThrottler,
 	reg prometheus.Registerer,
 ) error {
 	return r.router.Initialize(
@@ -59,6 +61,7 @@ func (r *tracedRouter) Initialize(
 		trackedSubnets,
 		onFatal,
 		healthConfig,
+		bandwidthThrottler,
 		reg,
 	)
 }
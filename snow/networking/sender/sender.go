@@ -5,6 +5,7 @@ package sender
 
 import (
 	"context"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
@@ -83,7 +84,7 @@ func (s *sender) SendGetStateSummaryFrontier(ctx context.Context, nodeIDs set.Se
 
 	// Note that this timeout duration won't exactly match the one that gets
 	// registered. That's OK.
-	deadline := s.timeouts.TimeoutDuration()
+	deadline := s.timeouts.TimeoutDuration(s.ctx.ChainID)
 
 	// Tell the router to expect a response message or a message notifying
 	// that we won't get a response from each of these nodes.
@@ -160,7 +161,7 @@ func (s *sender) SendGetStateSummaryFrontier(ctx context.Context, nodeIDs set.Se
 	}
 }
 
-func (s *sender) SendStateSummaryFrontier(ctx context.Context, nodeID ids.NodeID, requestID uint32, summary []byte) {
+func (s *sender) SendStateSummaryFrontier(ctx context.Context, nodeID ids.NodeID, requestID uint32, summary, checkpoint []byte) {
 	ctx = context.WithoutCancel(ctx)
 
 	// Sending this message to myself.
@@ -169,6 +170,7 @@ func (s *sender) SendStateSummaryFrontier(ctx context.Context, nodeID ids.NodeID
 			s.ctx.ChainID,
 			requestID,
 			summary,
+			checkpoint,
 			nodeID,
 		)
 		s.router.HandleInternal(ctx, inMsg)
@@ -180,6 +182,7 @@ func (s *sender) SendStateSummaryFrontier(ctx context.Context, nodeID ids.NodeID
 		s.ctx.ChainID,
 		requestID,
 		summary,
+		checkpoint,
 	)
 	if err != nil {
 		s.ctx.Log.Error("failed to build message",
@@ -227,7 +230,7 @@ func (s *sender) SendGetAcceptedStateSummary(ctx context.Context, nodeIDs set.Se
 
 	// Note that this timeout duration won't exactly match the one that gets
 	// registered. That's OK.
-	deadline := s.timeouts.TimeoutDuration()
+	deadline := s.timeouts.TimeoutDuration(s.ctx.ChainID)
 
 	// Tell the router to expect a response message or a message notifying
 	// that we won't get a response from each of these nodes.
@@ -364,7 +367,7 @@ func (s *sender) SendGetAcceptedFrontier(ctx context.Context, nodeIDs set.Set[id
 
 	// Note that this timeout duration won't exactly match the one that gets
 	// registered. That's OK.
-	deadline := s.timeouts.TimeoutDuration()
+	deadline := s.timeouts.TimeoutDuration(s.ctx.ChainID)
 
 	// Tell the router to expect a response message or a message notifying
 	// that we won't get a response from each of these nodes.
@@ -499,7 +502,7 @@ func (s *sender) SendGetAccepted(ctx context.Context, nodeIDs set.Set[ids.NodeID
 
 	// Note that this timeout duration won't exactly match the one that gets
 	// registered. That's OK.
-	deadline := s.timeouts.TimeoutDuration()
+	deadline := s.timeouts.TimeoutDuration(s.ctx.ChainID)
 
 	// Tell the router to expect a response message or a message notifying
 	// that we won't get a response from each of these nodes.
@@ -628,6 +631,50 @@ func (s *sender) SendAccepted(ctx context.Context, nodeID ids.NodeID, requestID
 }
 
 func (s *sender) SendGetAncestors(ctx context.Context, nodeID ids.NodeID, requestID uint32, containerID ids.ID) {
+	s.sendGetAncestors(
+		ctx,
+		nodeID,
+		requestID,
+		func(deadline time.Duration) (*message.OutboundMessage, error) {
+			return s.msgCreator.GetAncestors(
+				s.ctx.ChainID,
+				requestID,
+				deadline,
+				containerID,
+				s.engineType,
+			)
+		},
+		zap.Stringer("containerID", containerID),
+	)
+}
+
+func (s *sender) SendGetAncestorsAtHeight(ctx context.Context, nodeID ids.NodeID, requestID uint32, height uint64) {
+	s.sendGetAncestors(
+		ctx,
+		nodeID,
+		requestID,
+		func(deadline time.Duration) (*message.OutboundMessage, error) {
+			return s.msgCreator.GetAncestorsAtHeight(
+				s.ctx.ChainID,
+				requestID,
+				deadline,
+				height,
+				s.engineType,
+			)
+		},
+		zap.Uint64("height", height),
+	)
+}
+
+// sendGetAncestors registers a GetAncestors request and sends the message
+// built by [buildMsg]. [requested] describes the requested container in logs.
+func (s *sender) sendGetAncestors(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	requestID uint32,
+	buildMsg func(deadline time.Duration) (*message.OutboundMessage, error),
+	requested zap.Field,
+) {
 	ctx = context.WithoutCancel(ctx)
 
 	// Tell the router to expect a response message or a message notifying
@@ -668,21 +715,15 @@ func (s *sender) SendGetAncestors(ctx context.Context, nodeID ids.NodeID, reques
 
 	// Note that this timeout duration won't exactly match the one that gets
 	// registered. That's OK.
-	deadline := s.timeouts.TimeoutDuration()
+	deadline := s.timeouts.TimeoutDuration(s.ctx.ChainID)
 	// Create the outbound message.
-	outMsg, err := s.msgCreator.GetAncestors(
-		s.ctx.ChainID,
-		requestID,
-		deadline,
-		containerID,
-		s.engineType,
-	)
+	outMsg, err := buildMsg(deadline)
 	if err != nil {
 		s.ctx.Log.Error("failed to build message",
 			zap.Stringer("messageOp", message.GetAncestorsOp),
 			zap.Stringer("chainID", s.ctx.ChainID),
 			zap.Uint32("requestID", requestID),
-			zap.Stringer("containerID", containerID),
+			requested,
 			zap.Error(err),
 		)
 
@@ -706,7 +747,7 @@ func (s *sender) SendGetAncestors(ctx context.Context, nodeID ids.NodeID, reques
 			zap.Stringer("nodeID", nodeID),
 			zap.Stringer("chainID", s.ctx.ChainID),
 			zap.Uint32("requestID", requestID),
-			zap.Stringer("containerID", containerID),
+			requested,
 		)
 
 		s.timeouts.RegisterRequestToUnreachableValidator()
@@ -788,7 +829,7 @@ func (s *sender) SendGet(ctx context.Context, nodeID ids.NodeID, requestID uint3
 
 	// Note that this timeout duration won't exactly match the one that gets
 	// registered. That's OK.
-	deadline := s.timeouts.TimeoutDuration()
+	deadline := s.timeouts.TimeoutDuration(s.ctx.ChainID)
 	// Create the outbound message.
 	outMsg, err := s.msgCreator.Get(
 		s.ctx.ChainID,
@@ -911,7 +952,7 @@ func (s *sender) SendPushQuery(
 
 	// Note that this timeout duration won't exactly match the one that gets
 	// registered. That's OK.
-	deadline := s.timeouts.TimeoutDuration()
+	deadline := s.timeouts.TimeoutDuration(s.ctx.ChainID)
 
 	// Sending a message to myself. No need to send it over the network. Just
 	// put it right into the router. Do so asynchronously to avoid deadlock.
@@ -1048,7 +1089,7 @@ func (s *sender) SendPullQuery(
 
 	// Note that this timeout duration won't exactly match the one that gets
 	// registered. That's OK.
-	deadline := s.timeouts.TimeoutDuration()
+	deadline := s.timeouts.TimeoutDuration(s.ctx.ChainID)
 
 	// Sending a message to myself. No need to send it over the network. Just
 	// put it right into the router. Do so asynchronously to avoid deadlock.
@@ -1234,7 +1275,7 @@ func (s *sender) SendAppRequest(ctx context.Context, nodeIDs set.Set[ids.NodeID]
 
 	// Note that this timeout duration won't exactly match the one that gets
 	// registered. That's OK.
-	deadline := s.timeouts.TimeoutDuration()
+	deadline := s.timeouts.TimeoutDuration(s.ctx.ChainID)
 
 	// Sending a message to myself. No need to send it over the network. Just
 	// put it right into the router. Do so asynchronously to avoid deadlock.
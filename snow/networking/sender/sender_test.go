@@ -19,6 +19,7 @@ import (
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/message/messagemock"
 	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/enginetest"
@@ -94,6 +95,7 @@ func TestTimeout(t *testing.T) {
 		set.Set[ids.ID]{},
 		nil,
 		router.HealthConfig{},
+		throttling.NewNoSubnetBandwidthThrottler(),
 		prometheus.NewRegistry(),
 	))
 
@@ -353,6 +355,7 @@ func TestReliableMessages(t *testing.T) {
 		set.Set[ids.ID]{},
 		nil,
 		router.HealthConfig{},
+		throttling.NewNoSubnetBandwidthThrottler(),
 		prometheus.NewRegistry(),
 	))
 
@@ -512,6 +515,7 @@ func TestReliableMessagesToMyself(t *testing.T) {
 				set.Set[ids.ID]{},
 				nil,
 				router.HealthConfig{},
+				throttling.NewNoSubnetBandwidthThrottler(),
 				prometheus.NewRegistry(),
 			))
 
@@ -850,7 +854,7 @@ func TestSender_Bootstrap_Requests(t *testing.T) {
 			require.NoError(err)
 
 			// Set the timeout (deadline)
-			timeoutManager.EXPECT().TimeoutDuration().Return(deadline).AnyTimes()
+			timeoutManager.EXPECT().TimeoutDuration(ctx.ChainID).Return(deadline).AnyTimes()
 
 			// Make sure we register requests with the router
 			for nodeID := range nodeIDs {
@@ -893,6 +897,7 @@ func TestSender_Bootstrap_Responses(t *testing.T) {
 		requestID         = uint32(1337)
 		summaryIDs        = []ids.ID{ids.GenerateTestID(), ids.GenerateTestID()}
 		summary           = []byte{1, 2, 3}
+		checkpoint        = []byte{4, 5, 6}
 	)
 	snowCtx := snowtest.Context(t, snowtest.PChainID)
 	ctx := snowtest.ConsensusContext(snowCtx)
@@ -913,6 +918,7 @@ func TestSender_Bootstrap_Responses(t *testing.T) {
 					ctx.ChainID,
 					requestID,
 					summary,
+					checkpoint,
 				).Return(nil, nil) // Don't care about the message
 			},
 			assertMsgToMyself: func(require *require.Assertions, msg message.InboundMessage) {
@@ -921,6 +927,7 @@ func TestSender_Bootstrap_Responses(t *testing.T) {
 				require.Equal(ctx.ChainID[:], innerMsg.ChainId)
 				require.Equal(requestID, innerMsg.RequestId)
 				require.Equal(summary, innerMsg.Summary)
+				require.Equal(checkpoint, innerMsg.Checkpoint)
 			},
 			setExternalSenderExpect: func(externalSender *sendermock.ExternalSender) {
 				externalSender.EXPECT().Send(
@@ -933,7 +940,7 @@ func TestSender_Bootstrap_Responses(t *testing.T) {
 				).Return(nil)
 			},
 			sendF: func(_ *require.Assertions, sender common.Sender, nodeID ids.NodeID) {
-				sender.SendStateSummaryFrontier(t.Context(), nodeID, requestID, summary)
+				sender.SendStateSummaryFrontier(t.Context(), nodeID, requestID, summary, checkpoint)
 			},
 		},
 		{
@@ -1057,7 +1064,7 @@ func TestSender_Bootstrap_Responses(t *testing.T) {
 			require.NoError(err)
 
 			// Set the timeout (deadline)
-			timeoutManager.EXPECT().TimeoutDuration().Return(deadline).AnyTimes()
+			timeoutManager.EXPECT().TimeoutDuration(ctx.ChainID).Return(deadline).AnyTimes()
 
 			// Case: sending to ourselves
 			{
@@ -1220,7 +1227,7 @@ func TestSender_Single_Request(t *testing.T) {
 			require.NoError(err)
 
 			// Set the timeout (deadline)
-			timeoutManager.EXPECT().TimeoutDuration().Return(deadline).AnyTimes()
+			timeoutManager.EXPECT().TimeoutDuration(ctx.ChainID).Return(deadline).AnyTimes()
 
 			// Case: sending to myself
 			{
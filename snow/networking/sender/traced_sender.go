@@ -39,15 +39,16 @@ func (s *tracedSender) SendGetStateSummaryFrontier(ctx context.Context, nodeIDs
 	s.sender.SendGetStateSummaryFrontier(ctx, nodeIDs, requestID)
 }
 
-func (s *tracedSender) SendStateSummaryFrontier(ctx context.Context, nodeID ids.NodeID, requestID uint32, summary []byte) {
+func (s *tracedSender) SendStateSummaryFrontier(ctx context.Context, nodeID ids.NodeID, requestID uint32, summary, checkpoint []byte) {
 	ctx, span := s.tracer.Start(ctx, "tracedSender.SendStateSummaryFrontier", oteltrace.WithAttributes(
 		attribute.Stringer("recipients", nodeID),
 		attribute.Int64("requestID", int64(requestID)),
 		attribute.Int("summaryLen", len(summary)),
+		attribute.Int("checkpointLen", len(checkpoint)),
 	))
 	defer span.End()
 
-	s.sender.SendStateSummaryFrontier(ctx, nodeID, requestID, summary)
+	s.sender.SendStateSummaryFrontier(ctx, nodeID, requestID, summary, checkpoint)
 }
 
 func (s *tracedSender) SendGetAcceptedStateSummary(ctx context.Context, nodeIDs set.Set[ids.NodeID], requestID uint32, heights []uint64) {
@@ -123,6 +124,17 @@ func (s *tracedSender) SendGetAncestors(ctx context.Context, nodeID ids.NodeID,
 	s.sender.SendGetAncestors(ctx, nodeID, requestID, containerID)
 }
 
+func (s *tracedSender) SendGetAncestorsAtHeight(ctx context.Context, nodeID ids.NodeID, requestID uint32, height uint64) {
+	ctx, span := s.tracer.Start(ctx, "tracedSender.SendGetAncestorsAtHeight", oteltrace.WithAttributes(
+		attribute.Stringer("recipients", nodeID),
+		attribute.Int64("requestID", int64(requestID)),
+		attribute.Int64("height", int64(height)),
+	))
+	defer span.End()
+
+	s.sender.SendGetAncestorsAtHeight(ctx, nodeID, requestID, height)
+}
+
 func (s *tracedSender) SendAncestors(ctx context.Context, nodeID ids.NodeID, requestID uint32, containers [][]byte) {
 	_, span := s.tracer.Start(ctx, "tracedSender.SendAncestors", oteltrace.WithAttributes(
 		attribute.Stringer("recipients", nodeID),
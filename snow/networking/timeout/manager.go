@@ -24,8 +24,9 @@ type Manager interface {
 	// Start the manager. Must be called before any other method.
 	// Should be called in a goroutine.
 	Dispatch()
-	// TimeoutDuration returns the current timeout duration.
-	TimeoutDuration() time.Duration
+	// TimeoutDuration returns the current timeout duration of requests
+	// regarding chain [chainID].
+	TimeoutDuration(chainID ids.ID) time.Duration
 	// IsBenched returns true if messages to [nodeID] regarding [chainID]
 	// should not be sent over the network and should immediately fail.
 	IsBenched(nodeID ids.NodeID, chainID ids.ID) bool
@@ -63,6 +64,11 @@ type Manager interface {
 	// Mark that we no longer expect a response to this request we sent.
 	// Does not modify the timeout.
 	RemoveRequest(requestID ids.RequestID)
+	// SetMaximumTimeout raises the maximum timeout duration of requests
+	// regarding chain [chainID] to [maximumTimeout]. The timeouts of requests
+	// regarding other chains are unaffected. The configured maximum is used if
+	// it is larger. A [maximumTimeout] of 0 removes the maximum of [chainID].
+	SetMaximumTimeout(chainID ids.ID, maximumTimeout time.Duration)
 
 	// Stops the manager.
 	Stop()
@@ -88,9 +94,11 @@ func NewManager(
 	}
 
 	return &manager{
-		tm:           tm,
-		benchlistMgr: benchlistMgr,
-		metrics:      m,
+		tm:             tm,
+		benchlistMgr:   benchlistMgr,
+		metrics:        m,
+		maximumTimeout: timeoutConfig.MaximumTimeout,
+		chainMaximums:  make(map[ids.ID]time.Duration),
 	}, nil
 }
 
@@ -99,14 +107,25 @@ type manager struct {
 	benchlistMgr benchlist.Manager
 	metrics      *timeoutMetrics
 	stopOnce     sync.Once
+
+	// maximumTimeout is the configured maximum timeout duration.
+	maximumTimeout time.Duration
+
+	maximumsLock sync.RWMutex
+	// chainMaximums are the maximum timeout durations requested by chains.
+	chainMaximums map[ids.ID]time.Duration
 }
 
 func (m *manager) Dispatch() {
 	m.tm.Dispatch()
 }
 
-func (m *manager) TimeoutDuration() time.Duration {
-	return m.tm.TimeoutDuration()
+func (m *manager) TimeoutDuration(chainID ids.ID) time.Duration {
+	maximumTimeout, ok := m.chainMaximum(chainID)
+	if !ok {
+		return m.tm.TimeoutDuration()
+	}
+	return m.tm.TimeoutDurationWithMaximum(maximumTimeout)
 }
 
 // IsBenched returns true if messages to [nodeID] regarding [chainID]
@@ -143,7 +162,12 @@ func (m *manager) RegisterRequest(
 		}
 		timeoutHandler()
 	}
-	m.tm.Put(requestID, measureLatency, newTimeoutHandler)
+	maximumTimeout, ok := m.chainMaximum(chainID)
+	if !ok {
+		m.tm.Put(requestID, measureLatency, newTimeoutHandler)
+		return
+	}
+	m.tm.PutWithMaximum(requestID, measureLatency, maximumTimeout, newTimeoutHandler)
 }
 
 // RegisterResponse registers that we received a response from [nodeID]
@@ -164,8 +188,33 @@ func (m *manager) RemoveRequest(requestID ids.RequestID) {
 	m.tm.Remove(requestID)
 }
 
+func (m *manager) SetMaximumTimeout(chainID ids.ID, maximumTimeout time.Duration) {
+	m.maximumsLock.Lock()
+	defer m.maximumsLock.Unlock()
+
+	if maximumTimeout == 0 {
+		delete(m.chainMaximums, chainID)
+	} else {
+		m.chainMaximums[chainID] = maximumTimeout
+	}
+}
+
+// chainMaximum returns the maximum timeout duration of requests regarding
+// [chainID], if the chain raised it.
+func (m *manager) chainMaximum(chainID ids.ID) (time.Duration, bool) {
+	m.maximumsLock.RLock()
+	defer m.maximumsLock.RUnlock()
+
+	maximumTimeout, ok := m.chainMaximums[chainID]
+	if !ok {
+		return 0, false
+	}
+	// A chain can't lower the configured maximum.
+	return max(maximumTimeout, m.maximumTimeout), true
+}
+
 func (m *manager) RegisterRequestToUnreachableValidator() {
-	m.tm.ObserveLatency(m.TimeoutDuration())
+	m.tm.ObserveLatency(m.tm.TimeoutDuration())
 }
 
 func (m *manager) Stop() {
@@ -47,3 +47,47 @@ func TestManagerFire(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestManagerSetMaximumTimeout(t *testing.T) {
+	require := require.New(t)
+
+	timeoutManager, err := NewManager(
+		&timer.AdaptiveTimeoutConfig{
+			InitialTimeout:     time.Second,
+			MinimumTimeout:     time.Second,
+			MaximumTimeout:     2 * time.Second,
+			TimeoutCoefficient: 4,
+			TimeoutHalflife:    5 * time.Minute,
+		},
+		benchlist.NewNoBenchlist(),
+		prometheus.NewRegistry(),
+		prometheus.NewRegistry(),
+	)
+	require.NoError(err)
+	m := timeoutManager.(*manager)
+
+	// Raise the average latency above every maximum.
+	m.tm.ObserveLatency(time.Hour)
+
+	chainID0 := ids.GenerateTestID()
+	chainID1 := ids.GenerateTestID()
+	require.Equal(2*time.Second, m.TimeoutDuration(chainID0))
+
+	// A chain can't lower the configured maximum.
+	m.SetMaximumTimeout(chainID0, time.Second)
+	require.Equal(2*time.Second, m.TimeoutDuration(chainID0))
+
+	// The maximum of a chain only applies to that chain.
+	m.SetMaximumTimeout(chainID0, 3*time.Second)
+	m.SetMaximumTimeout(chainID1, 4*time.Second)
+	require.Equal(3*time.Second, m.TimeoutDuration(chainID0))
+	require.Equal(4*time.Second, m.TimeoutDuration(chainID1))
+	require.Equal(2*time.Second, m.TimeoutDuration(ids.GenerateTestID()))
+
+	m.SetMaximumTimeout(chainID1, 0)
+	require.Equal(3*time.Second, m.TimeoutDuration(chainID0))
+	require.Equal(2*time.Second, m.TimeoutDuration(chainID1))
+
+	m.SetMaximumTimeout(chainID0, 0)
+	require.Equal(2*time.Second, m.TimeoutDuration(chainID0))
+}
@@ -131,6 +131,18 @@ func (mr *ManagerMockRecorder) RemoveRequest(requestID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRequest", reflect.TypeOf((*Manager)(nil).RemoveRequest), requestID)
 }
 
+// SetMaximumTimeout mocks base method.
+func (m *Manager) SetMaximumTimeout(chainID ids.ID, maximumTimeout time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMaximumTimeout", chainID, maximumTimeout)
+}
+
+// SetMaximumTimeout indicates an expected call of SetMaximumTimeout.
+func (mr *ManagerMockRecorder) SetMaximumTimeout(chainID, maximumTimeout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaximumTimeout", reflect.TypeOf((*Manager)(nil).SetMaximumTimeout), chainID, maximumTimeout)
+}
+
 // Stop mocks base method.
 func (m *Manager) Stop() {
 	m.ctrl.T.Helper()
@@ -144,15 +156,15 @@ func (mr *ManagerMockRecorder) Stop() *gomock.Call {
 }
 
 // TimeoutDuration mocks base method.
-func (m *Manager) TimeoutDuration() time.Duration {
+func (m *Manager) TimeoutDuration(chainID ids.ID) time.Duration {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TimeoutDuration")
+	ret := m.ctrl.Call(m, "TimeoutDuration", chainID)
 	ret0, _ := ret[0].(time.Duration)
 	return ret0
 }
 
 // TimeoutDuration indicates an expected call of TimeoutDuration.
-func (mr *ManagerMockRecorder) TimeoutDuration() *gomock.Call {
+func (mr *ManagerMockRecorder) TimeoutDuration(chainID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeoutDuration", reflect.TypeOf((*Manager)(nil).TimeoutDuration))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TimeoutDuration", reflect.TypeOf((*Manager)(nil).TimeoutDuration), chainID)
 }
@@ -9,11 +9,44 @@ import (
 	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/utils/set"
 )
 
-var errAllowedNodesWhenNotValidatorOnly = errors.New("allowedNodes can only be set when ValidatorOnly is true")
+const (
+	// SnowmanConsensus runs the Snowman consensus engine. It is the default.
+	SnowmanConsensus = "snowman"
+	// SimplexConsensus runs the Simplex consensus engine.
+	SimplexConsensus = "simplex"
+
+	// CPUMessageQueuePolicy defers the messages of nodes that are using more
+	// than their share of the CPU. It is the default.
+	CPUMessageQueuePolicy = "cpu"
+	// StakeMessageQueuePolicy processes the messages of validators in
+	// proportion to their weight.
+	StakeMessageQueuePolicy = "stake"
+
+	// StakeProposerSelection samples the proposer of each slot by stake. It
+	// is the default.
+	StakeProposerSelection = "stake"
+	// RoundRobinProposerSelection rotates the proposer of each slot through
+	// the validators in proportion to their stake.
+	RoundRobinProposerSelection = "roundRobin"
+	// VRFProposerSelection samples the proposer of each slot by stake, using
+	// the VRF output proven with the BLS key of the proposer of the parent
+	// block as randomness.
+	VRFProposerSelection = "vrf"
+)
+
+var (
+	errAllowedNodesWhenNotValidatorOnly = errors.New("allowedNodes can only be set when ValidatorOnly is true")
+	errUnknownConsensus                 = errors.New("unknown consensus")
+	errMissingSimplexGenesisHeight      = errors.New("missing simplex genesis P-chain height")
+	errUnknownMessageQueuePolicy        = errors.New("unknown message queue policy")
+	errUnknownProposerSelection         = errors.New("unknown proposer selection")
+	errMissingProposerSelectionHeight   = errors.New("missing proposer selection P-chain height")
+)
 
 type Config struct {
 	// ValidatorOnly indicates that this Subnet's Chains are available to only subnet validators.
@@ -26,6 +59,30 @@ type Config struct {
 	AllowedNodes        set.Set[ids.NodeID] `json:"allowedNodes"        yaml:"allowedNodes"`
 	ConsensusParameters snowball.Parameters `json:"consensusParameters" yaml:"consensusParameters"`
 
+	// Consensus is the consensus engine that runs this Subnet's linear chains.
+	// If empty, [SnowmanConsensus] is used.
+	Consensus string `json:"consensus" yaml:"consensus"`
+
+	// SimplexGenesisPChainHeight is the P-chain height of the validator set of
+	// the first Simplex epoch of this Subnet's Chains. It must be set if
+	// [Consensus] is [SimplexConsensus]. All validators of the Subnet must use
+	// the same height.
+	SimplexGenesisPChainHeight uint64 `json:"simplexGenesisPChainHeight" yaml:"simplexGenesisPChainHeight"`
+
+	// MessageQueuePolicy is the policy that orders the consensus messages
+	// this Subnet's Chains receive. If empty, [CPUMessageQueuePolicy] is used.
+	MessageQueuePolicy string `json:"messageQueuePolicy" yaml:"messageQueuePolicy"`
+
+	// ProposerSelection is the strategy that selects the snowman++ proposers
+	// of this Subnet's Chains. All validators of the Subnet must use the same
+	// strategy. If empty, [StakeProposerSelection] is used.
+	ProposerSelection string `json:"proposerSelection" yaml:"proposerSelection"`
+	// ProposerSelectionPChainHeight is the P-chain height from which
+	// [ProposerSelection] selects the proposers. The proposers of blocks with a
+	// lower P-chain height are sampled by stake. It must be set if
+	// [ProposerSelection] is not [StakeProposerSelection].
+	ProposerSelectionPChainHeight uint64 `json:"proposerSelectionPChainHeight" yaml:"proposerSelectionPChainHeight"`
+
 	// ProposerMinBlockDelay is the minimum delay this node will enforce when
 	// building a snowman++ block.
 	//
@@ -49,6 +106,11 @@ type Config struct {
 	// TODO: Move this flag once the proposervm is configurable on a per-chain
 	// basis.
 	ProposerNumHistoricalBlocks uint64 `json:"proposerNumHistoricalBlocks" yaml:"proposerNumHistoricalBlocks"`
+
+	// BandwidthQuota limits the inbound and outbound bandwidth that this
+	// Subnet's Chains may consume. Messages that would exceed the quota are
+	// dropped.
+	BandwidthQuota throttling.SubnetBandwidthQuota `json:"bandwidthQuota" yaml:"bandwidthQuota"`
 }
 
 func (c *Config) Valid() error {
@@ -58,5 +120,31 @@ func (c *Config) Valid() error {
 	if !c.ValidatorOnly && c.AllowedNodes.Len() > 0 {
 		return errAllowedNodesWhenNotValidatorOnly
 	}
+	switch c.Consensus {
+	case "", SnowmanConsensus:
+	case SimplexConsensus:
+		if c.SimplexGenesisPChainHeight == 0 {
+			return errMissingSimplexGenesisHeight
+		}
+	default:
+		return fmt.Errorf("%w: %q", errUnknownConsensus, c.Consensus)
+	}
+	switch c.MessageQueuePolicy {
+	case "", CPUMessageQueuePolicy, StakeMessageQueuePolicy:
+	default:
+		return fmt.Errorf("%w: %q", errUnknownMessageQueuePolicy, c.MessageQueuePolicy)
+	}
+	switch c.ProposerSelection {
+	case "", StakeProposerSelection:
+	case RoundRobinProposerSelection, VRFProposerSelection:
+		if c.ProposerSelectionPChainHeight == 0 {
+			return errMissingProposerSelectionHeight
+		}
+	default:
+		return fmt.Errorf("%w: %q", errUnknownProposerSelection, c.ProposerSelection)
+	}
+	if err := c.BandwidthQuota.Verify(); err != nil {
+		return fmt.Errorf("bandwidth quota %w", err)
+	}
 	return nil
 }
@@ -49,6 +49,89 @@ func TestValid(t *testing.T) {
 			},
 			expectedErr: errAllowedNodesWhenNotValidatorOnly,
 		},
+		{
+			name: "unknown consensus",
+			s: Config{
+				ConsensusParameters: validParameters,
+				Consensus:           "avalanche",
+			},
+			expectedErr: errUnknownConsensus,
+		},
+		{
+			name: "simplex consensus",
+			s: Config{
+				ConsensusParameters:        validParameters,
+				Consensus:                  SimplexConsensus,
+				SimplexGenesisPChainHeight: 1,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "simplex consensus without genesis height",
+			s: Config{
+				ConsensusParameters: validParameters,
+				Consensus:           SimplexConsensus,
+			},
+			expectedErr: errMissingSimplexGenesisHeight,
+		},
+		{
+			name: "invalid message queue policy",
+			s: Config{
+				ConsensusParameters: validParameters,
+				MessageQueuePolicy:  "fifo",
+			},
+			expectedErr: errUnknownMessageQueuePolicy,
+		},
+		{
+			name: "stake message queue policy",
+			s: Config{
+				ConsensusParameters: validParameters,
+				MessageQueuePolicy:  StakeMessageQueuePolicy,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "unknown proposer selection",
+			s: Config{
+				ConsensusParameters: validParameters,
+				ProposerSelection:   "random",
+			},
+			expectedErr: errUnknownProposerSelection,
+		},
+		{
+			name: "round robin proposer selection without activation",
+			s: Config{
+				ConsensusParameters: validParameters,
+				ProposerSelection:   RoundRobinProposerSelection,
+			},
+			expectedErr: errMissingProposerSelectionHeight,
+		},
+		{
+			name: "round robin proposer selection",
+			s: Config{
+				ConsensusParameters:           validParameters,
+				ProposerSelection:             RoundRobinProposerSelection,
+				ProposerSelectionPChainHeight: 100,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "vrf proposer selection without activation",
+			s: Config{
+				ConsensusParameters: validParameters,
+				ProposerSelection:   VRFProposerSelection,
+			},
+			expectedErr: errMissingProposerSelectionHeight,
+		},
+		{
+			name: "vrf proposer selection",
+			s: Config{
+				ConsensusParameters:           validParameters,
+				ProposerSelection:             VRFProposerSelection,
+				ProposerSelectionPChainHeight: 100,
+			},
+			expectedErr: nil,
+		},
 		{
 			name: "valid",
 			s: Config{
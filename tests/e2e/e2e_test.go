@@ -49,6 +49,7 @@ var _ = ginkgo.SynchronizedBeforeSuite(func() []byte {
 	require.NoError(tc, err)
 	nodes := tmpnet.NewNodesOrPanic(nodeCount)
 	subnets := vms.XSVMSubnetsOrPanic(nodes...)
+	subnets = append(subnets, vms.SimplexSubnetOrPanic(nodes...))
 
 	upgradeToActivate := upgradetest.Latest
 	if !flagVars.ActivateLatest() {
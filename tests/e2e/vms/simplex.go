@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package vms
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/tests/fixture/e2e"
+	"github.com/ava-labs/avalanchego/tests/fixture/subnet"
+	"github.com/ava-labs/avalanchego/tests/fixture/tmpnet"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/example/xsvm/api"
+	"github.com/ava-labs/avalanchego/vms/example/xsvm/cmd/issue/transfer"
+)
+
+var simplexSubnetName = "xsvm-simplex"
+
+// SimplexSubnetOrPanic returns an XSVM subnet, validated by [nodes], whose
+// chain runs Simplex consensus.
+func SimplexSubnetOrPanic(nodes ...*tmpnet.Node) *tmpnet.Subnet {
+	key, err := secp256k1.NewPrivateKey()
+	if err != nil {
+		panic(err)
+	}
+	s := subnet.NewXSVMOrPanic(simplexSubnetName, key, nodes...)
+	s.Config["consensus"] = subnets.SimplexConsensus
+	return s
+}
+
+var _ = ginkgo.Describe("[Simplex]", ginkgo.Label("simplex"), func() {
+	tc := e2e.NewTestContext()
+	require := require.New(tc)
+
+	ginkgo.It("should accept transactions on a simplex chain", func() {
+		network := e2e.GetEnv(tc).GetNetwork()
+
+		simplexSubnet := network.GetSubnet(simplexSubnetName)
+		require.NotNil(simplexSubnet)
+		chain := simplexSubnet.Chains[0]
+
+		validators := getNodesForIDs(network.Nodes, simplexSubnet.ValidatorIDs)
+		require.NotEmpty(validators)
+		apiNodeURI := validators[0].GetAccessibleURI()
+
+		tc.By("issuing a transfer on the simplex chain")
+		recipientKey := e2e.NewPrivateKey(tc)
+		transferTxStatus, err := transfer.Transfer(
+			tc.DefaultContext(),
+			&transfer.Config{
+				URI:        apiNodeURI,
+				ChainID:    chain.ChainID,
+				AssetID:    chain.ChainID,
+				Amount:     units.Schmeckle,
+				To:         recipientKey.Address(),
+				PrivateKey: chain.PreFundedKey,
+			},
+		)
+		require.NoError(err)
+		tc.Log().Info("issued transfer transaction",
+			zap.Stringer("txID", transferTxStatus.TxID),
+		)
+
+		tc.By("checking that the transfer has been accepted on all validators")
+		for _, node := range validators {
+			require.NoError(api.AwaitTxAccepted(
+				tc.DefaultContext(),
+				api.NewClient(node.GetAccessibleURI(), chain.ChainID.String()),
+				chain.PreFundedKey.Address(),
+				transferTxStatus.Nonce,
+				pollingInterval,
+			))
+		}
+
+		tc.By("checking that the recipient received the transfer")
+		for _, node := range validators {
+			client := api.NewClient(node.GetAccessibleURI(), chain.ChainID.String())
+			balance, err := client.Balance(tc.DefaultContext(), recipientKey.Address(), chain.ChainID)
+			require.NoError(err)
+			require.Equal(units.Schmeckle, balance)
+		}
+	})
+})
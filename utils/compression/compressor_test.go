@@ -4,6 +4,7 @@
 package compression
 
 import (
+	"encoding/binary"
 	"fmt"
 	"math"
 	"runtime"
@@ -25,14 +26,16 @@ var (
 		TypeNone: func(int64) (Compressor, error) { //nolint:unparam // an error is needed to be returned to compile
 			return NewNoCompressor(), nil
 		},
-		TypeZstd: NewZstdCompressor,
+		TypeZstd:     NewZstdCompressor,
+		TypeZstdDict: NewZstdDictCompressor,
 	}
 
 	//go:embed zstd_zip_bomb.bin
 	zstdZipBomb []byte
 
 	zipBombs = map[Type][]byte{
-		TypeZstd: zstdZipBomb,
+		TypeZstd:     zstdZipBomb,
+		TypeZstdDict: zstdZipBomb,
 	}
 )
 
@@ -163,10 +166,99 @@ func TestNewZstdCompressorWithLevel(t *testing.T) {
 	require.Equal(t, data, decompressed)
 }
 
+func TestZstdDictCompressorUsesDict(t *testing.T) {
+	require := require.New(t)
+
+	compressor, err := NewZstdDictCompressor(maxMessageSize)
+	require.NoError(err)
+
+	data := utils.RandomBytes(4096)
+	compressed, err := compressor.Compress(data)
+	require.NoError(err)
+
+	dictID, err := zstdFrameDictID(compressed)
+	require.NoError(err)
+	require.Equal(ZstdDictID, dictID)
+	require.Contains(ZstdDictIDs(), dictID)
+}
+
+func TestZstdDictCompressorDecompressWithoutDict(t *testing.T) {
+	require := require.New(t)
+
+	zstdCompressor, err := NewZstdCompressor(maxMessageSize)
+	require.NoError(err)
+	dictCompressor, err := NewZstdDictCompressor(maxMessageSize)
+	require.NoError(err)
+
+	data := utils.RandomBytes(4096)
+	compressed, err := zstdCompressor.Compress(data)
+	require.NoError(err)
+
+	decompressed, err := dictCompressor.Decompress(compressed)
+	require.NoError(err)
+	require.Equal(data, decompressed)
+}
+
+func TestZstdDictCompressorDecompressUnknownDict(t *testing.T) {
+	require := require.New(t)
+
+	unknownDict := make([]byte, len(zstdDictV1))
+	copy(unknownDict, zstdDictV1)
+	// The dictionary ID immediately follows the 4 byte dictionary magic
+	// number.
+	unknownDictID := ZstdDictID + 1
+	binary.LittleEndian.PutUint32(unknownDict[4:], unknownDictID)
+
+	processor, err := zstd.NewBulkProcessor(unknownDict, zstd.DefaultCompression)
+	require.NoError(err)
+	compressed, err := processor.Compress(nil, utils.RandomBytes(4096))
+	require.NoError(err)
+
+	compressor, err := NewZstdDictCompressor(maxMessageSize)
+	require.NoError(err)
+
+	_, err = compressor.Decompress(compressed)
+	require.ErrorIs(err, ErrUnknownZstdDict)
+}
+
+func TestZstdFrameDictIDInvalidFrame(t *testing.T) {
+	tests := []struct {
+		name        string
+		frame       []byte
+		expectedErr error
+	}{
+		{
+			name:        "empty",
+			frame:       nil,
+			expectedErr: errInvalidZstdFrame,
+		},
+		{
+			name:        "wrong magic",
+			frame:       []byte{0x00, 0x01, 0x02, 0x03, 0x00},
+			expectedErr: errUnexpectedZstdMagic,
+		},
+		{
+			name:        "truncated dictionary ID",
+			frame:       []byte{0x28, 0xb5, 0x2f, 0xfd, 0x23, 0x01},
+			expectedErr: errInvalidZstdFrame,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := zstdFrameDictID(test.frame)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
 func FuzzZstdCompressor(f *testing.F) {
 	fuzzHelper(f, TypeZstd)
 }
 
+func FuzzZstdDictCompressor(f *testing.F) {
+	fuzzHelper(f, TypeZstdDict)
+}
+
 func fuzzHelper(f *testing.F, compressionType Type) {
 	var (
 		compressor Compressor
@@ -176,6 +268,9 @@ func fuzzHelper(f *testing.F, compressionType Type) {
 	case TypeZstd:
 		compressor, err = NewZstdCompressor(maxMessageSize)
 		require.NoError(f, err)
+	case TypeZstdDict:
+		compressor, err = NewZstdDictCompressor(maxMessageSize)
+		require.NoError(f, err)
 	default:
 		require.FailNow(f, "Unknown compression type")
 	}
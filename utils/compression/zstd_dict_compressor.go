@@ -0,0 +1,174 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package compression
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"slices"
+
+	"github.com/DataDog/zstd"
+
+	_ "embed"
+)
+
+// ZstdDictID is the ID of the zstd dictionary that is used to compress
+// messages with [TypeZstdDict].
+//
+// The dictionary ID is written into the header of every compressed frame,
+// which allows the decompressor to select the correct dictionary. When a new
+// dictionary is shipped, it must be assigned a new ID and the previous
+// dictionaries should continue to be supported for decompression until all
+// peers are expected to have upgraded.
+const ZstdDictID uint32 = ZstdDictV1ID
+
+const (
+	// ZstdDictV1ID is the ID of a 16 KiB dictionary trained on a mix of
+	// consensus, bootstrapping, application and network messages.
+	ZstdDictV1ID uint32 = 1<<15 | 1
+
+	zstdMagicNumber    uint32 = 0xFD2FB528
+	zstdMagicNumberLen        = 4
+	zstdMaxDictIDLen          = 4
+)
+
+var (
+	_ Compressor = (*zstdDictCompressor)(nil)
+
+	//go:embed zstd_dict_v1.bin
+	zstdDictV1 []byte
+
+	zstdDicts = map[uint32][]byte{
+		ZstdDictV1ID: zstdDictV1,
+	}
+
+	ErrUnknownZstdDict     = errors.New("unknown zstd dictionary")
+	errInvalidZstdFrame    = errors.New("invalid zstd frame")
+	errUnexpectedZstdMagic = errors.New("unexpected zstd magic number")
+)
+
+// ZstdDictIDs returns the IDs of all the zstd dictionaries that this node is
+// able to decompress.
+func ZstdDictIDs() []uint32 {
+	return slices.Sorted(maps.Keys(zstdDicts))
+}
+
+// NewZstdDictCompressor returns a zstd compressor that compresses messages
+// using the dictionary identified by [ZstdDictID].
+//
+// Messages compressed with any of the dictionaries in [ZstdDictIDs], or
+// without a dictionary, can be decompressed.
+func NewZstdDictCompressor(maxSize int64) (Compressor, error) {
+	if maxSize == math.MaxInt64 {
+		// See [NewZstdCompressorWithLevel] for why this is disallowed.
+		return nil, ErrInvalidMaxSizeCompressor
+	}
+
+	processor, err := zstd.NewBulkProcessor(zstdDicts[ZstdDictID], zstd.DefaultCompression)
+	if err != nil {
+		return nil, err
+	}
+	return &zstdDictCompressor{
+		maxSize:   maxSize,
+		processor: processor,
+	}, nil
+}
+
+type zstdDictCompressor struct {
+	maxSize   int64
+	processor *zstd.BulkProcessor
+}
+
+func (z *zstdDictCompressor) Compress(msg []byte) ([]byte, error) {
+	if int64(len(msg)) > z.maxSize {
+		return nil, fmt.Errorf("%w: (%d) > (%d)", ErrMsgTooLarge, len(msg), z.maxSize)
+	}
+	return z.processor.Compress(nil, msg)
+}
+
+func (z *zstdDictCompressor) Decompress(msg []byte) ([]byte, error) {
+	dictID, err := zstdFrameDictID(msg)
+	if err != nil {
+		return nil, err
+	}
+	// A frame that doesn't specify a dictionary is decompressed with the
+	// current dictionary, which is a no-op if the frame was compressed without
+	// a dictionary.
+	if dictID == 0 {
+		dictID = ZstdDictID
+	}
+	dict, ok := zstdDicts[dictID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownZstdDict, dictID)
+	}
+
+	// The bulk processor isn't used for decompression because it allocates the
+	// full frame content size claimed by the header, which would allow a
+	// malicious peer to force large allocations.
+	reader := zstd.NewReaderDict(bytes.NewReader(msg), dict)
+	defer reader.Close()
+
+	// See [zstdCompressor.Decompress] for why up to [z.maxSize + 1] bytes are
+	// read.
+	limitReader := io.LimitReader(reader, z.maxSize+1)
+	decompressed, err := io.ReadAll(limitReader)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(decompressed)) > z.maxSize {
+		return nil, fmt.Errorf("%w: (%d) > (%d)", ErrDecompressedMsgTooLarge, len(decompressed), z.maxSize)
+	}
+	return decompressed, nil
+}
+
+// zstdFrameDictID returns the dictionary ID specified in the header of the
+// zstd frame. If the frame doesn't specify a dictionary ID, 0 is returned.
+//
+// See https://github.com/facebook/zstd/blob/dev/doc/zstd_compression_format.md#frame_header
+func zstdFrameDictID(frame []byte) (uint32, error) {
+	const (
+		frameHeaderDescriptorLen = 1
+		windowDescriptorLen      = 1
+
+		singleSegmentFlag = 1 << 5
+		dictIDFlagMask    = 0b11
+	)
+
+	if len(frame) < zstdMagicNumberLen+frameHeaderDescriptorLen {
+		return 0, errInvalidZstdFrame
+	}
+	if magic := binary.LittleEndian.Uint32(frame); magic != zstdMagicNumber {
+		return 0, fmt.Errorf("%w: 0x%x", errUnexpectedZstdMagic, magic)
+	}
+
+	descriptor := frame[zstdMagicNumberLen]
+	offset := zstdMagicNumberLen + frameHeaderDescriptorLen
+	if descriptor&singleSegmentFlag == 0 {
+		offset += windowDescriptorLen
+	}
+
+	var dictIDLen int
+	switch descriptor & dictIDFlagMask {
+	case 0:
+		return 0, nil
+	case 1:
+		dictIDLen = 1
+	case 2:
+		dictIDLen = 2
+	default:
+		dictIDLen = zstdMaxDictIDLen
+	}
+	if len(frame) < offset+dictIDLen {
+		return 0, errInvalidZstdFrame
+	}
+
+	var dictIDBytes [zstdMaxDictIDLen]byte
+	copy(dictIDBytes[:], frame[offset:offset+dictIDLen])
+	return binary.LittleEndian.Uint32(dictIDBytes[:]), nil
+}
@@ -88,6 +88,12 @@ const (
 	DefaultOutboundThrottlerVdrAllocSize        = 32 * units.MiB
 	DefaultOutboundThrottlerNodeMaxAtLargeBytes = DefaultMaxMessageSize
 
+	// Outbound Message Queue
+	DefaultNetworkOutboundQueuePrioritizationEnabled = false
+	DefaultNetworkOutboundQueueConsensusWeight       = 4
+	DefaultNetworkOutboundQueueBootstrappingWeight   = 2
+	DefaultNetworkOutboundQueueAppWeight             = 1
+
 	// Network Health
 	DefaultHealthCheckAveragerHalflife = 10 * time.Second
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/netip"
 	"strings"
@@ -19,7 +20,21 @@ var _ Resolver = (*ifConfigResolver)(nil)
 
 // ifConfigResolver resolves our public IP using ifconfig's format.
 type ifConfigResolver struct {
-	url string
+	url    string
+	client *http.Client
+}
+
+// newIPv6HTTPClient returns a client that only connects over IPv6, so that the
+// resolution service reports our IPv6 address.
+func newIPv6HTTPClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	dialer := &net.Dialer{}
+	transport.DialContext = func(ctx context.Context, _, addr string) (net.Conn, error) {
+		return dialer.DialContext(ctx, "tcp6", addr)
+	}
+	return &http.Client{
+		Transport: transport,
+	}
 }
 
 func (r *ifConfigResolver) Resolve(ctx context.Context) (netip.Addr, error) {
@@ -29,7 +44,7 @@ func (r *ifConfigResolver) Resolve(ctx context.Context) (netip.Addr, error) {
 	}
 
 	//nolint:bodyclose // body is closed via rpc.CleanlyCloseBody
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := r.client.Do(req)
 	if err != nil {
 		return netip.Addr{}, err
 	}
@@ -12,7 +12,11 @@ import (
 	"github.com/ava-labs/avalanchego/utils/ips"
 )
 
-const openDNSUrl = "resolver1.opendns.com:53"
+const (
+	openDNSUrl = "resolver1.opendns.com:53"
+	// IPv6 address of resolver1.opendns.com
+	openDNSIPv6Url = "[2620:119:35::35]:53"
+)
 
 var (
 	errOpenDNSNoIP = errors.New("openDNS returned no ip")
@@ -23,6 +27,8 @@ var (
 // openDNSResolver resolves our public IP using openDNS
 type openDNSResolver struct {
 	resolver *net.Resolver
+	// network is the IP network to look up, either "ip" or "ip6".
+	network string
 }
 
 func newOpenDNSResolver() Resolver {
@@ -34,11 +40,27 @@ func newOpenDNSResolver() Resolver {
 				return d.DialContext(ctx, "udp", openDNSUrl)
 			},
 		},
+		network: "ip",
+	}
+}
+
+// newOpenDNSIPv6Resolver queries OpenDNS over IPv6, which reports our IPv6
+// address.
+func newOpenDNSIPv6Resolver() Resolver {
+	return &openDNSResolver{
+		resolver: &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				d := net.Dialer{}
+				return d.DialContext(ctx, "udp6", openDNSIPv6Url)
+			},
+		},
+		network: "ip6",
 	}
 }
 
 func (r *openDNSResolver) Resolve(ctx context.Context) (netip.Addr, error) {
-	resolvedIPs, err := r.resolver.LookupIP(ctx, "ip", "myip.opendns.com")
+	resolvedIPs, err := r.resolver.LookupIP(ctx, r.network, "myip.opendns.com")
 	if err != nil {
 		return netip.Addr{}, err
 	}
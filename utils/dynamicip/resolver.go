@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/netip"
 	"strings"
 )
@@ -24,7 +25,10 @@ const (
 	IFConfigMeName = "ifconfigme"
 )
 
-var errUnknownResolver = errors.New("unknown resolver")
+var (
+	errUnknownResolver = errors.New("unknown resolver")
+	errNotIPv6         = errors.New("resolved IP is not an IPv6 address")
+)
 
 // Resolver resolves our public IP
 type Resolver interface {
@@ -42,10 +46,43 @@ func NewResolver(resolverName string) (Resolver, error) {
 	case OpenDNSName:
 		return newOpenDNSResolver(), nil
 	case IFConfigName, IFConfigCoName:
-		return &ifConfigResolver{url: ifConfigCoURL}, nil
+		return &ifConfigResolver{url: ifConfigCoURL, client: http.DefaultClient}, nil
+	case IFConfigMeName:
+		return &ifConfigResolver{url: ifConfigMeURL, client: http.DefaultClient}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownResolver, resolverName)
+	}
+}
+
+// NewIPv6Resolver returns a resolver of our public IPv6 address. Requests to
+// the resolution service are only made over IPv6.
+func NewIPv6Resolver(resolverName string) (Resolver, error) {
+	var resolver Resolver
+	switch strings.ToLower(resolverName) {
+	case OpenDNSName:
+		resolver = newOpenDNSIPv6Resolver()
+	case IFConfigName, IFConfigCoName:
+		resolver = &ifConfigResolver{url: ifConfigCoURL, client: newIPv6HTTPClient()}
 	case IFConfigMeName:
-		return &ifConfigResolver{url: ifConfigMeURL}, nil
+		resolver = &ifConfigResolver{url: ifConfigMeURL, client: newIPv6HTTPClient()}
 	default:
 		return nil, fmt.Errorf("%w: %s", errUnknownResolver, resolverName)
 	}
+	return &ipv6Resolver{resolver: resolver}, nil
+}
+
+// ipv6Resolver ensures that the resolved IP is an IPv6 address.
+type ipv6Resolver struct {
+	resolver Resolver
+}
+
+func (r *ipv6Resolver) Resolve(ctx context.Context) (netip.Addr, error) {
+	addr, err := r.resolver.Resolve(ctx)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	if !addr.Is6() {
+		return netip.Addr{}, fmt.Errorf("%w: %s", errNotIPv6, addr)
+	}
+	return addr, nil
 }
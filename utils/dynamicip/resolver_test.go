@@ -4,6 +4,8 @@
 package dynamicip
 
 import (
+	"context"
+	"net/netip"
 	"strings"
 	"testing"
 
@@ -49,3 +51,70 @@ func TestNewResolver(t *testing.T) {
 		})
 	}
 }
+
+func TestNewIPv6Resolver(t *testing.T) {
+	tests := []struct {
+		service string
+		err     error
+	}{
+		{
+			service: OpenDNSName,
+			err:     nil,
+		},
+		{
+			service: IFConfigCoName,
+			err:     nil,
+		},
+		{
+			service: strings.ToUpper(IFConfigMeName),
+			err:     nil,
+		},
+		{
+			service: "not a valid resolution service name",
+			err:     errUnknownResolver,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.service, func(t *testing.T) {
+			_, err := NewIPv6Resolver(tt.service)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestIPv6ResolverRejectsIPv4(t *testing.T) {
+	tests := []struct {
+		name string
+		addr netip.Addr
+		err  error
+	}{
+		{
+			name: "ipv6",
+			addr: netip.MustParseAddr("2001:db8::1"),
+			err:  nil,
+		},
+		{
+			name: "ipv4",
+			addr: netip.AddrFrom4([4]byte{1, 2, 3, 4}),
+			err:  errNotIPv6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			r := &ipv6Resolver{
+				resolver: &mockResolver{
+					onResolve: func(context.Context) (netip.Addr, error) {
+						return tt.addr, nil
+					},
+				},
+			}
+			addr, err := r.Resolve(t.Context())
+			require.ErrorIs(err, tt.err)
+			if tt.err == nil {
+				require.Equal(tt.addr, addr)
+			}
+		})
+	}
+}
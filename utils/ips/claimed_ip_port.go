@@ -16,7 +16,9 @@ import (
 const (
 	// Certificate length, signature length, IP, timestamp, tx ID
 	baseIPCertDescLen = 2*wrappers.IntLen + net.IPv6len + wrappers.ShortLen + wrappers.LongLen + ids.IDLen
-	preimageLen       = ids.IDLen + wrappers.LongLen
+	// IPv6 address, signature length
+	ipv6DescLen = net.IPv6len + wrappers.ShortLen + wrappers.IntLen
+	preimageLen = ids.IDLen + wrappers.LongLen
 )
 
 // A self contained proof that a peer is claiming ownership of an IPPort at a
@@ -33,6 +35,11 @@ type ClaimedIPPort struct {
 	// actually claimed by the peer in question, and not by a malicious peer
 	// trying to get us to dial bogus IPPorts.
 	Signature []byte
+	// The IPv6 address and port of a dual-stack peer, if any. It is claimed at
+	// the same [Timestamp] as [AddrPort], which must then be an IPv4 address.
+	IPv6AddrPort netip.AddrPort
+	// [Cert]'s signature over the IPv6AddrPort and timestamp.
+	IPv6Signature []byte
 	// NodeID derived from the peer certificate.
 	NodeID ids.NodeID
 	// GossipID derived from the nodeID and timestamp.
@@ -64,5 +71,9 @@ func NewClaimedIPPort(
 
 // Returns the approximate size of the binary representation of this ClaimedIPPort.
 func (i *ClaimedIPPort) Size() int {
-	return baseIPCertDescLen + len(i.Cert.Raw) + len(i.Signature)
+	size := baseIPCertDescLen + len(i.Cert.Raw) + len(i.Signature)
+	if i.IPv6AddrPort.IsValid() {
+		size += ipv6DescLen + len(i.IPv6Signature)
+	}
+	return size
 }
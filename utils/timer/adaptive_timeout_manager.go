@@ -58,9 +58,17 @@ type AdaptiveTimeoutManager interface {
 	Stop()
 	// Returns the current network timeout duration.
 	TimeoutDuration() time.Duration
+	// TimeoutDurationWithMaximum returns the current network timeout duration
+	// if the maximum network timeout duration were [maximumTimeout].
+	TimeoutDurationWithMaximum(maximumTimeout time.Duration) time.Duration
 	// Registers a timeout for the item with the given [id].
 	// If the timeout occurs before the item is Removed, [timeoutHandler] is called.
 	Put(id ids.RequestID, measureLatency bool, timeoutHandler func())
+	// PutWithMaximum registers a timeout for the item with the given [id]
+	// whose duration is capped by [maximumTimeout] rather than by the
+	// configured maximum.
+	// If the timeout occurs before the item is Removed, [timeoutHandler] is called.
+	PutWithMaximum(id ids.RequestID, measureLatency bool, maximumTimeout time.Duration, timeoutHandler func())
 	// Remove the timeout associated with [id].
 	// Its timeout handler will not be called.
 	Remove(id ids.RequestID)
@@ -84,6 +92,7 @@ type adaptiveTimeoutManager struct {
 	timeoutCoefficient float64
 	minimumTimeout     time.Duration
 	maximumTimeout     time.Duration
+	uncappedTimeout    time.Duration // Timeout before applying the minimum and maximum
 	currentTimeout     time.Duration // Amount of time before a timeout
 	timeoutHeap        heap.Map[ids.RequestID, *adaptiveTimeout]
 	timer              *Timer // Timer that will fire to clear the timeouts
@@ -123,6 +132,7 @@ func NewAdaptiveTimeoutManager(
 		}),
 		minimumTimeout:     config.MinimumTimeout,
 		maximumTimeout:     config.MaximumTimeout,
+		uncappedTimeout:    config.InitialTimeout,
 		currentTimeout:     config.InitialTimeout,
 		timeoutCoefficient: config.TimeoutCoefficient,
 		timeoutHeap: heap.NewMap[ids.RequestID, *adaptiveTimeout](func(a, b *adaptiveTimeout) bool {
@@ -148,6 +158,13 @@ func (tm *adaptiveTimeoutManager) TimeoutDuration() time.Duration {
 	return tm.currentTimeout
 }
 
+func (tm *adaptiveTimeoutManager) TimeoutDurationWithMaximum(maximumTimeout time.Duration) time.Duration {
+	tm.lock.Lock()
+	defer tm.lock.Unlock()
+
+	return tm.timeoutDuration(maximumTimeout)
+}
+
 func (tm *adaptiveTimeoutManager) Dispatch() {
 	tm.timer.Dispatch()
 }
@@ -160,19 +177,26 @@ func (tm *adaptiveTimeoutManager) Put(id ids.RequestID, measureLatency bool, tim
 	tm.lock.Lock()
 	defer tm.lock.Unlock()
 
-	tm.put(id, measureLatency, timeoutHandler)
+	tm.put(id, measureLatency, tm.currentTimeout, timeoutHandler)
+}
+
+func (tm *adaptiveTimeoutManager) PutWithMaximum(id ids.RequestID, measureLatency bool, maximumTimeout time.Duration, timeoutHandler func()) {
+	tm.lock.Lock()
+	defer tm.lock.Unlock()
+
+	tm.put(id, measureLatency, tm.timeoutDuration(maximumTimeout), timeoutHandler)
 }
 
 // Assumes [tm.lock] is held
-func (tm *adaptiveTimeoutManager) put(id ids.RequestID, measureLatency bool, handler func()) {
+func (tm *adaptiveTimeoutManager) put(id ids.RequestID, measureLatency bool, duration time.Duration, handler func()) {
 	now := tm.clock.Time()
 	tm.remove(id, now)
 
 	timeout := &adaptiveTimeout{
 		id:             id,
 		handler:        handler,
-		duration:       tm.currentTimeout,
-		deadline:       now.Add(tm.currentTimeout),
+		duration:       duration,
+		deadline:       now.Add(duration),
 		measureLatency: measureLatency,
 	}
 	tm.timeoutHeap.Push(id, timeout)
@@ -236,18 +260,27 @@ func (tm *adaptiveTimeoutManager) ObserveLatency(latency time.Duration) {
 // Assumes [tm.lock] is held
 func (tm *adaptiveTimeoutManager) observeLatencyAndUpdateTimeout(latency time.Duration, now time.Time) {
 	tm.averager.Observe(float64(latency), now)
+	tm.updateTimeout()
+}
+
+// Assumes [tm.lock] is held
+func (tm *adaptiveTimeoutManager) updateTimeout() {
 	avgLatency := tm.averager.Read()
-	tm.currentTimeout = time.Duration(tm.timeoutCoefficient * avgLatency)
-	if tm.currentTimeout > tm.maximumTimeout {
-		tm.currentTimeout = tm.maximumTimeout
-	} else if tm.currentTimeout < tm.minimumTimeout {
-		tm.currentTimeout = tm.minimumTimeout
-	}
+	tm.uncappedTimeout = time.Duration(tm.timeoutCoefficient * avgLatency)
+	tm.currentTimeout = tm.timeoutDuration(tm.maximumTimeout)
 	// Update the metrics
 	tm.networkTimeoutMetric.Set(float64(tm.currentTimeout))
 	tm.avgLatency.Set(avgLatency)
 }
 
+// timeoutDuration returns the timeout duration capped by [maximumTimeout]. The
+// timeout duration is never below the minimum timeout.
+//
+// Assumes [tm.lock] is held
+func (tm *adaptiveTimeoutManager) timeoutDuration(maximumTimeout time.Duration) time.Duration {
+	return max(min(tm.uncappedTimeout, maximumTimeout), tm.minimumTimeout)
+}
+
 // Returns the handler function associated with the next timeout.
 // If there are no timeouts, or if the next timeout is after [now],
 // returns nil.
@@ -131,3 +131,29 @@ func TestAdaptiveTimeoutManager(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestAdaptiveTimeoutManagerTimeoutDurationWithMaximum(t *testing.T) {
+	require := require.New(t)
+
+	tm, err := NewAdaptiveTimeoutManager(
+		&AdaptiveTimeoutConfig{
+			InitialTimeout:     time.Second,
+			MinimumTimeout:     time.Second,
+			MaximumTimeout:     2 * time.Second,
+			TimeoutHalflife:    5 * time.Minute,
+			TimeoutCoefficient: 1,
+		},
+		prometheus.NewRegistry(),
+	)
+	require.NoError(err)
+
+	tm.ObserveLatency(time.Hour)
+	require.Equal(2*time.Second, tm.TimeoutDuration())
+
+	// A higher maximum timeout doesn't change the configured maximum.
+	require.Equal(10*time.Second, tm.TimeoutDurationWithMaximum(10*time.Second))
+	require.Equal(2*time.Second, tm.TimeoutDuration())
+
+	// The maximum timeout can't be below the minimum timeout.
+	require.Equal(time.Second, tm.TimeoutDurationWithMaximum(0))
+}
@@ -50,6 +50,9 @@ func TestParseConfig(t *testing.T) {
 					PullGossipFrequency:                         network.DefaultConfig.PullGossipFrequency,
 					PullGossipThrottlingPeriod:                  network.DefaultConfig.PullGossipThrottlingPeriod,
 					PullGossipRequestsPerValidator:              network.DefaultConfig.PullGossipRequestsPerValidator,
+					PullGossipMaxRetries:                        network.DefaultConfig.PullGossipMaxRetries,
+					PullGossipHedgingPercentile:                 network.DefaultConfig.PullGossipHedgingPercentile,
+					PullGossipHedgingDelay:                      network.DefaultConfig.PullGossipHedgingDelay,
 					ExpectedBloomFilterElements:                 network.DefaultConfig.ExpectedBloomFilterElements,
 					ExpectedBloomFilterFalsePositiveProbability: network.DefaultConfig.ExpectedBloomFilterFalsePositiveProbability,
 					MaxBloomFilterFalsePositiveProbability:      network.DefaultConfig.MaxBloomFilterFalsePositiveProbability,
@@ -46,6 +46,9 @@ var (
 		PullGossipFrequency:                         time.Second,
 		PullGossipThrottlingPeriod:                  time.Second,
 		PullGossipRequestsPerValidator:              1,
+		PullGossipMaxRetries:                        1,
+		PullGossipHedgingPercentile:                 .9,
+		PullGossipHedgingDelay:                      time.Second,
 		ExpectedBloomFilterElements:                 10,
 		ExpectedBloomFilterFalsePositiveProbability: .1,
 		MaxBloomFilterFalsePositiveProbability:      .5,
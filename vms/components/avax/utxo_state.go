@@ -32,6 +32,11 @@ type UTXOState interface {
 	UTXOReader
 	UTXOWriter
 
+	// UTXOs returns the UTXOs in increasing order of their IDs, starting after
+	// [previous].
+	// Returns at most [limit] UTXOs.
+	UTXOs(previous ids.ID, limit int) ([]*UTXO, error)
+
 	// Checksum returns the current UTXOChecksum.
 	Checksum() ids.ID
 }
@@ -250,6 +255,30 @@ func (s *utxoState) UTXOIDs(addr []byte, start ids.ID, limit int) ([]ids.ID, err
 	return utxoIDs, iter.Error()
 }
 
+func (s *utxoState) UTXOs(start ids.ID, limit int) ([]*UTXO, error) {
+	iter := s.utxoDB.NewIteratorWithStart(start[:])
+	defer iter.Release()
+
+	utxos := []*UTXO(nil)
+	for len(utxos) < limit && iter.Next() {
+		utxoID, err := ids.ToID(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		if utxoID == start {
+			continue
+		}
+
+		start = ids.Empty
+		utxo := &UTXO{}
+		if _, err := s.codec.Unmarshal(iter.Value(), utxo); err != nil {
+			return nil, err
+		}
+		utxos = append(utxos, utxo)
+	}
+	return utxos, iter.Error()
+}
+
 func (s *utxoState) Checksum() ids.ID {
 	return s.checksum
 }
@@ -91,4 +91,14 @@ func TestUTXOState(t *testing.T) {
 	utxoIDs, err = s.UTXOIDs(addr[:], ids.Empty, 5)
 	require.NoError(err)
 	require.Equal([]ids.ID{utxoID}, utxoIDs)
+
+	utxos, err := s.UTXOs(ids.Empty, 5)
+	require.NoError(err)
+	require.Len(utxos, 1)
+	require.Equal(utxoID, utxos[0].InputID())
+	require.Equal(utxo, utxos[0])
+
+	utxos, err = s.UTXOs(utxoID, 5)
+	require.NoError(err)
+	require.Empty(utxos)
 }
@@ -65,3 +65,24 @@ func (s State) ConsumeGas(gas Gas) (State, error) {
 		Excess:   Gas(newExcess),
 	}, nil
 }
+
+// ExcessBounds returns the minimum and maximum excess after the provided
+// duration.
+//
+// The minimum assumes that no gas is consumed. The maximum assumes that all
+// capacity is consumed as soon as it becomes available.
+//
+// The units chosen for time must be consistent with the units chosen for
+// capacityRate and targetRate.
+func (s State) ExcessBounds(
+	capacityRate Gas,
+	targetRate Gas,
+	duration uint64,
+) (Gas, Gas) {
+	minExcess := s.Excess.SubOverTime(targetRate, duration)
+	maxExcess := s.Excess.
+		AddOverTime(s.Capacity, 1).
+		AddOverTime(capacityRate, duration).
+		SubOverTime(targetRate, duration)
+	return minExcess, maxExcess
+}
@@ -157,3 +157,77 @@ func Test_State_ConsumeGas(t *testing.T) {
 		})
 	}
 }
+
+func Test_State_ExcessBounds(t *testing.T) {
+	tests := []struct {
+		name         string
+		initial      State
+		capacityRate Gas
+		targetRate   Gas
+		duration     uint64
+		expectedMin  Gas
+		expectedMax  Gas
+	}{
+		{
+			name: "no time passed",
+			initial: State{
+				Capacity: 10,
+				Excess:   20,
+			},
+			capacityRate: 10,
+			targetRate:   5,
+			duration:     0,
+			expectedMin:  20,
+			expectedMax:  30,
+		},
+		{
+			name: "time passed",
+			initial: State{
+				Capacity: 10,
+				Excess:   20,
+			},
+			capacityRate: 10,
+			targetRate:   5,
+			duration:     2,
+			expectedMin:  10,
+			expectedMax:  40,
+		},
+		{
+			name: "excess fully decayed",
+			initial: State{
+				Capacity: 0,
+				Excess:   20,
+			},
+			capacityRate: 10,
+			targetRate:   5,
+			duration:     10,
+			expectedMin:  0,
+			expectedMax:  70,
+		},
+		{
+			name: "maximum excess",
+			initial: State{
+				Capacity: 10,
+				Excess:   math.MaxUint64 - 5,
+			},
+			capacityRate: 10,
+			targetRate:   5,
+			duration:     1,
+			expectedMin:  math.MaxUint64 - 10,
+			expectedMax:  math.MaxUint64 - 5,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			actualMin, actualMax := test.initial.ExcessBounds(
+				test.capacityRate,
+				test.targetRate,
+				test.duration,
+			)
+			require.Equal(test.expectedMin, actualMin)
+			require.Equal(test.expectedMax, actualMax)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/api"
+)
+
+var (
+	errTxNotInMempool = errors.New("tx not in mempool")
+	errEvictedByAdmin = errors.New("evicted by the admin API")
+)
+
+// AdminService defines the API calls that are only served if the admin API is
+// enabled.
+type AdminService struct {
+	vm *VM
+}
+
+// EvictMempoolTx removes a tx from the mempool and marks it as dropped.
+func (s *AdminService) EvictMempoolTx(_ *http.Request, args *api.JSONTxID, _ *api.EmptyReply) error {
+	s.vm.ctx.Log.Info("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "evictMempoolTx"),
+		zap.Stringer("txID", args.TxID),
+	)
+
+	if _, ok := s.vm.Builder.Get(args.TxID); !ok {
+		return fmt.Errorf("%w: %s", errTxNotInMempool, args.TxID)
+	}
+
+	s.vm.Builder.Remove(args.TxID)
+	s.vm.Builder.MarkDropped(args.TxID, errEvictedByAdmin)
+	return nil
+}
@@ -0,0 +1,32 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"context"
+
+	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+)
+
+// AdminClient for the P-Chain admin API, which is only served if the node's
+// admin API is enabled.
+type AdminClient struct {
+	Requester rpc.EndpointRequester
+}
+
+func NewAdminClient(uri string) *AdminClient {
+	return &AdminClient{Requester: rpc.NewEndpointRequester(
+		uri + "/ext/bc/P" + adminEndpoint,
+	)}
+}
+
+// EvictMempoolTx removes the tx from the node's mempool and marks it as
+// dropped.
+func (c *AdminClient) EvictMempoolTx(ctx context.Context, txID ids.ID, options ...rpc.Option) error {
+	return c.Requester.SendRequest(ctx, "platform.evictMempoolTx", &api.JSONTxID{
+		TxID: txID,
+	}, &api.EmptyReply{}, options...)
+}
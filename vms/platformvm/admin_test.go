@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestEvictMempoolTx(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+	admin := &AdminService{vm: service.vm}
+
+	err := admin.EvictMempoolTx(nil, &api.JSONTxID{TxID: ids.GenerateTestID()}, &api.EmptyReply{})
+	require.ErrorIs(err, errTxNotInMempool)
+
+	service.vm.ctx.Lock.Lock()
+	wallet := newWallet(t, service.vm, walletConfig{})
+	tx, err := wallet.IssueCreateSubnetTx(
+		&secp256k1fx.OutputOwners{},
+	)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+
+	txID := tx.ID()
+	require.NoError(admin.EvictMempoolTx(nil, &api.JSONTxID{TxID: txID}, &api.EmptyReply{}))
+
+	_, ok := service.vm.Builder.Get(txID)
+	require.False(ok)
+	require.ErrorIs(service.vm.Builder.GetDropReason(txID), errEvictedByAdmin)
+}
+
+func TestAdminAPIRequiresNodeAdminAPI(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	handlers, err := service.vm.CreateHandlers(t.Context())
+	require.NoError(err)
+	require.NotContains(handlers, adminEndpoint)
+
+	service.vm.Internal.AdminAPIEnabled = true
+	handlers, err = service.vm.CreateHandlers(t.Context())
+	require.NoError(err)
+	require.Contains(handlers, adminEndpoint)
+}
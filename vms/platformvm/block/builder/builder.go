@@ -57,6 +57,13 @@ type Builder interface {
 	Add(tx *txs.Tx) error
 	// Get returns the tx corresponding to `txID` and if it was present
 	Get(txID ids.ID) (*txs.Tx, bool)
+	// Remove removes `txID` from the mempool
+	Remove(txID ids.ID)
+	// Txs returns the txs in the mempool in the order they are included into
+	// blocks.
+	Txs() []mempool.TxInfo
+	// MarkDropped marks `txID` as dropped
+	MarkDropped(txID ids.ID, reason error)
 	// GetDropReason returns why `txID` was dropped
 	GetDropReason(txID ids.ID) error
 	// WaitForEvent blocks until the mempool has txs that are ready to build into
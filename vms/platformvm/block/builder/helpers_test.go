@@ -148,6 +148,7 @@ func newEnvironment(t *testing.T, f upgradetest.Fork) *environment { //nolint:un
 		res.config.DynamicFeeConfig.Weights,
 		1_000_000,
 		res.ctx.AVAXAssetID,
+		res.clk,
 		registerer,
 	)
 	require.NoError(err)
@@ -158,6 +159,8 @@ func newEnvironment(t *testing.T, f upgradetest.Fork) *environment { //nolint:un
 		res.state,
 		&res.backend,
 		validatorstest.Manager,
+		nil,
+		nil,
 	)
 
 	txVerifier := network.NewLockedTxVerifier(&res.ctx.Lock, res.blkManager)
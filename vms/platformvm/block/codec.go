@@ -36,6 +36,7 @@ func init() {
 			RegisterBanffTypes(c),
 			RegisterDurangoTypes(c),
 			RegisterEtnaTypes(c),
+			RegisterHeliconTypes(c),
 		)
 	}
 
@@ -86,3 +87,9 @@ func RegisterDurangoTypes(targetCodec linearcodec.Codec) error {
 func RegisterEtnaTypes(targetCodec linearcodec.Codec) error {
 	return txs.RegisterEtnaTypes(targetCodec)
 }
+
+// RegisterHeliconTypes registers the type information for blocks that were
+// valid during the Helicon series of upgrades.
+func RegisterHeliconTypes(targetCodec linearcodec.Codec) error {
+	return txs.RegisterHeliconTypes(targetCodec)
+}
@@ -9,10 +9,15 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/events"
 	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakingindex"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/validators"
+
+	pb "github.com/ava-labs/avalanchego/connectproto/pb/platformvm"
 )
 
 var (
@@ -28,6 +33,9 @@ type acceptor struct {
 	*backend
 	metrics    metrics.Metrics
 	validators validators.Manager
+
+	// eventBroker is nil if events aren't published.
+	eventBroker *events.Broker
 }
 
 func (a *acceptor) BanffAbortBlock(b *block.BanffAbortBlock) error {
@@ -78,7 +86,8 @@ func (a *acceptor) ApricotAtomicBlock(b *block.ApricotAtomicBlock) error {
 	}
 
 	// Update the state to reflect the changes made in [onAcceptState].
-	if err := blkState.onAcceptState.Apply(a.state); err != nil {
+	changes, err := a.applyState(blkState.onAcceptState)
+	if err != nil {
 		return err
 	}
 
@@ -102,6 +111,8 @@ func (a *acceptor) ApricotAtomicBlock(b *block.ApricotAtomicBlock) error {
 		)
 	}
 
+	a.publishEvents(blkState, changes)
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", "apricot atomic"),
@@ -134,8 +145,11 @@ func (a *acceptor) optionBlock(b block.Block, blockType string) error {
 		return err
 	}
 
+	var parentChanges []*pb.Event
 	if parentState.onDecisionState != nil {
-		if err := parentState.onDecisionState.Apply(a.state); err != nil {
+		var err error
+		parentChanges, err = a.applyState(parentState.onDecisionState)
+		if err != nil {
 			return err
 		}
 	}
@@ -145,14 +159,33 @@ func (a *acceptor) optionBlock(b block.Block, blockType string) error {
 		return fmt.Errorf("%w %s", errMissingBlockState, blkID)
 	}
 
+	// The removed staker must be read before [blkState] is applied.
+	var period *stakingindex.Period
+	if a.stakingIndex != nil {
+		var err error
+		period, err = stakingindex.Removed(a.state, parentState.statelessBlock)
+		if err != nil {
+			return fmt.Errorf("failed to index removed staker: %w", err)
+		}
+	}
+
 	if err := a.commonAccept(blkState); err != nil {
 		return err
 	}
 
-	if err := blkState.onAcceptState.Apply(a.state); err != nil {
+	changes, err := a.applyState(blkState.onAcceptState)
+	if err != nil {
 		return err
 	}
 
+	// The period is indexed before the state is committed so that it is
+	// indexed again if the node stops before the commit.
+	if period != nil {
+		if err := a.indexStakingPeriod(period, b); err != nil {
+			return err
+		}
+	}
+
 	defer a.state.Abort()
 	batch, err := a.state.CommitBatch()
 	if err != nil {
@@ -168,6 +201,9 @@ func (a *acceptor) optionBlock(b block.Block, blockType string) error {
 		return fmt.Errorf("failed to apply vm's state to shared memory: %w", err)
 	}
 
+	a.publishEvents(parentState, parentChanges)
+	a.publishEvents(blkState, changes)
+
 	if onAcceptFunc := parentState.onAcceptFunc; onAcceptFunc != nil {
 		onAcceptFunc()
 	}
@@ -184,6 +220,65 @@ func (a *acceptor) optionBlock(b block.Block, blockType string) error {
 	return nil
 }
 
+func (a *acceptor) indexStakingPeriod(period *stakingindex.Period, b block.Block) error {
+	rewardUTXOs, err := a.state.GetRewardUTXOs(period.TxID)
+	if err != nil {
+		return fmt.Errorf("failed to get reward UTXOs of %s: %w", period.TxID, err)
+	}
+
+	var rewarded bool
+	switch b.(type) {
+	case *block.BanffCommitBlock, *block.ApricotCommitBlock:
+		rewarded = true
+	}
+	if err := a.stakingIndex.Accept(period, rewarded, rewardUTXOs); err != nil {
+		return fmt.Errorf("failed to index staking period of %s: %w", period.TxID, err)
+	}
+	return nil
+}
+
+// applyState applies [diff] to the accepted state and returns the events of
+// the changes, if events are published.
+//
+// The changes are only recorded while there are subscribers, so blocks
+// accepted without subscribers, such as during bootstrapping, aren't slowed
+// down. Subscriptions are only made while holding the context lock, so the
+// subscribers can't change before the events are published.
+func (a *acceptor) applyState(diff state.Diff) ([]*pb.Event, error) {
+	if a.eventBroker == nil || !a.eventBroker.HasSubscribers() {
+		return nil, diff.Apply(a.state)
+	}
+
+	recorder := events.NewRecorder(a.state)
+	if err := diff.Apply(recorder); err != nil {
+		return nil, err
+	}
+	return recorder.Events(), nil
+}
+
+// publishEvents publishes the acceptance of [b] along with the [changes] it
+// made. It must only be called once the block has been committed.
+func (a *acceptor) publishEvents(b *blockState, changes []*pb.Event) {
+	if a.eventBroker == nil || !a.eventBroker.HasSubscribers() {
+		return
+	}
+
+	blk := b.statelessBlock
+	blkTxs := blk.Txs()
+	txIDs := make([]ids.ID, len(blkTxs))
+	for i, tx := range blkTxs {
+		txIDs[i] = tx.ID()
+	}
+	a.eventBroker.Publish(events.Accepted(
+		blk.ID(),
+		blk.Parent(),
+		blk.Height(),
+		b.timestamp,
+		txIDs,
+		changes,
+	))
+}
+
 func (a *acceptor) proposalBlock(b block.Block, blockType string) {
 	// Note that:
 	//
@@ -228,7 +323,8 @@ func (a *acceptor) standardBlock(b block.Block, blockType string) error {
 	}
 
 	// Update the state to reflect the changes made in [onAcceptState].
-	if err := blkState.onAcceptState.Apply(a.state); err != nil {
+	changes, err := a.applyState(blkState.onAcceptState)
+	if err != nil {
 		return err
 	}
 
@@ -247,6 +343,8 @@ func (a *acceptor) standardBlock(b block.Block, blockType string) error {
 		return fmt.Errorf("failed to apply vm's state to shared memory: %w", err)
 	}
 
+	a.publishEvents(blkState, changes)
+
 	if onAcceptFunc := blkState.onAcceptFunc; onAcceptFunc != nil {
 		onAcceptFunc()
 	}
@@ -273,8 +371,17 @@ func (a *acceptor) commonAccept(b *blockState) error {
 
 	a.backend.lastAccepted = blkID
 	a.state.SetLastAccepted(blkID)
-	a.state.SetHeight(blk.Height())
+	height := blk.Height()
+	a.state.SetHeight(height)
 	a.state.AddStatelessBlock(blk)
 	a.validators.OnAcceptedBlockID(blkID)
+	a.feeHistory.add(FeeRecord{
+		BlockID:     blkID,
+		Height:      height,
+		Timestamp:   b.timestamp,
+		GasConsumed: b.metrics.GasConsumed,
+		GasState:    b.metrics.GasState,
+		GasPrice:    b.metrics.GasPrice,
+	})
 	return nil
 }
@@ -58,7 +58,8 @@ func TestAcceptorVisitProposalBlock(t *testing.T) {
 			blkIDToState: map[ids.ID]*blockState{
 				blkID: {},
 			},
-			state: s,
+			state:      s,
+			feeHistory: newFeeHistory(),
 		},
 		metrics:    metrics.Noop,
 		validators: validatorstest.Manager,
@@ -90,6 +91,7 @@ func TestAcceptorVisitAtomicBlock(t *testing.T) {
 			lastAccepted: parentID,
 			blkIDToState: make(map[ids.ID]*blockState),
 			state:        s,
+			feeHistory:   newFeeHistory(),
 			ctx: &snow.Context{
 				Log:          logging.NoLog{},
 				SharedMemory: sharedMemory,
@@ -168,6 +170,7 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 			lastAccepted: parentID,
 			blkIDToState: make(map[ids.ID]*blockState),
 			state:        s,
+			feeHistory:   newFeeHistory(),
 			ctx: &snow.Context{
 				Log:          logging.NoLog{},
 				SharedMemory: sharedMemory,
@@ -255,6 +258,7 @@ func TestAcceptorVisitCommitBlock(t *testing.T) {
 			lastAccepted: parentID,
 			blkIDToState: make(map[ids.ID]*blockState),
 			state:        s,
+			feeHistory:   newFeeHistory(),
 			ctx: &snow.Context{
 				Log:          logging.NoLog{},
 				SharedMemory: sharedMemory,
@@ -364,6 +368,7 @@ func TestAcceptorVisitAbortBlock(t *testing.T) {
 			lastAccepted: parentID,
 			blkIDToState: make(map[ids.ID]*blockState),
 			state:        s,
+			feeHistory:   newFeeHistory(),
 			ctx: &snow.Context{
 				Log:          logging.NoLog{},
 				SharedMemory: sharedMemory,
@@ -11,6 +11,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakingindex"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/mempool"
 )
@@ -32,6 +33,11 @@ type backend struct {
 	// blkIDToState with it upon backend creation (Genesis is already accepted)
 	blkIDToState map[ids.ID]*blockState
 	state        state.State
+	// feeHistory records the fees of the most recently accepted blocks.
+	feeHistory *feeHistory
+	// stakingIndex, if non-nil, records the staking periods of the stakers
+	// removed by accepted blocks.
+	stakingIndex *stakingindex.Index
 
 	ctx *snow.Context
 }
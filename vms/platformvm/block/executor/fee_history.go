@@ -0,0 +1,59 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/buffer"
+	"github.com/ava-labs/avalanchego/vms/components/gas"
+)
+
+// MaxFeeHistory is the number of accepted blocks whose fees are kept in
+// memory.
+const MaxFeeHistory = 1024
+
+// FeeRecord is the dynamic fee usage of an accepted block.
+type FeeRecord struct {
+	BlockID   ids.ID
+	Height    uint64
+	Timestamp time.Time
+	// GasConsumed is the gas consumed by the transactions in the block.
+	GasConsumed gas.Gas
+	// GasState is the fee state after the block.
+	GasState gas.State
+	// GasPrice is the gas price after the block.
+	GasPrice gas.Price
+}
+
+// feeHistory records the fees of the most recently accepted blocks.
+type feeHistory struct {
+	records buffer.Queue[FeeRecord]
+}
+
+func newFeeHistory() *feeHistory {
+	// MaxFeeHistory is positive, so this can't error.
+	records, _ := buffer.NewBoundedQueue[FeeRecord](MaxFeeHistory, nil)
+	return &feeHistory{
+		records: records,
+	}
+}
+
+func (f *feeHistory) add(record FeeRecord) {
+	f.records.Push(record)
+}
+
+// last returns up to [count] of the most recently accepted blocks, from oldest
+// to newest.
+func (f *feeHistory) last(count int) []FeeRecord {
+	numRecords := f.records.Len()
+	count = min(count, numRecords)
+	records := make([]FeeRecord, 0, count)
+	for i := numRecords - count; i < numRecords; i++ {
+		record, _ := f.records.Index(i)
+		records = append(records, record)
+	}
+	return records
+}
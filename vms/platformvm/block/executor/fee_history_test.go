@@ -0,0 +1,34 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeHistory(t *testing.T) {
+	require := require.New(t)
+
+	h := newFeeHistory()
+	require.Empty(h.last(10))
+
+	for height := uint64(1); height <= MaxFeeHistory+2; height++ {
+		h.add(FeeRecord{Height: height})
+	}
+
+	records := h.last(2)
+	require.Len(records, 2)
+	require.Equal(uint64(MaxFeeHistory+1), records[0].Height)
+	require.Equal(uint64(MaxFeeHistory+2), records[1].Height)
+
+	// Only the most recent blocks are retained.
+	records = h.last(MaxFeeHistory + 2)
+	require.Len(records, MaxFeeHistory)
+	require.Equal(uint64(3), records[0].Height)
+	require.Equal(uint64(MaxFeeHistory+2), records[MaxFeeHistory-1].Height)
+
+	require.Empty(h.last(0))
+}
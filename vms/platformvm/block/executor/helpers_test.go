@@ -155,6 +155,7 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller, f upgradetest.Fork) *
 		res.config.DynamicFeeConfig.Weights,
 		1_000_000,
 		res.ctx.AVAXAssetID,
+		res.clk,
 		registerer,
 	)
 	if err != nil {
@@ -168,6 +169,8 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller, f upgradetest.Fork) *
 			res.state,
 			res.backend,
 			validatorstest.Manager,
+			nil,
+			nil,
 		)
 		addSubnet(t, res)
 	} else {
@@ -177,6 +180,8 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller, f upgradetest.Fork) *
 			res.mockedState,
 			res.backend,
 			validatorstest.Manager,
+			nil,
+			nil,
 		)
 		// we do not add any subnet to state, since we can mock
 		// whatever we need
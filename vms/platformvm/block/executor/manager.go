@@ -12,7 +12,9 @@ import (
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/events"
 	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakingindex"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
@@ -47,6 +49,17 @@ type Manager interface {
 	// preferred state. This should *not* be used to verify transactions in a block.
 	VerifyTx(tx *txs.Tx) error
 
+	// SimulateTx executes the transaction against the currently preferred
+	// state, without committing any changes. If [verifyCredentials] is false,
+	// the credentials of the transaction aren't verified, which allows
+	// unsigned transactions to be simulated.
+	SimulateTx(tx *txs.Tx, verifyCredentials bool) (*Simulation, error)
+
+	// FeeHistory returns the fees of up to [count] of the most recently
+	// accepted blocks, from oldest to newest. At most [MaxFeeHistory] blocks
+	// are retained.
+	FeeHistory(count int) []FeeRecord
+
 	// VerifyUniqueInputs verifies that the inputs are not duplicated in the
 	// provided blk or any of its ancestors pinned in memory.
 	VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error
@@ -58,6 +71,8 @@ func NewManager(
 	s state.State,
 	txExecutorBackend *executor.Backend,
 	validatorManager validators.Manager,
+	stakingIndex *stakingindex.Index,
+	eventBroker *events.Broker,
 ) Manager {
 	lastAccepted := s.GetLastAccepted()
 	backend := &backend{
@@ -66,14 +81,17 @@ func NewManager(
 		state:        s,
 		ctx:          txExecutorBackend.Ctx,
 		blkIDToState: map[ids.ID]*blockState{},
+		feeHistory:   newFeeHistory(),
+		stakingIndex: stakingIndex,
 	}
 
 	return &manager{
 		backend: backend,
 		acceptor: &acceptor{
-			backend:    backend,
-			metrics:    metrics,
-			validators: validatorManager,
+			backend:     backend,
+			metrics:     metrics,
+			validators:  validatorManager,
+			eventBroker: eventBroker,
 		},
 		rejector: &rejector{
 			backend:         backend,
@@ -122,6 +140,10 @@ func (m *manager) Preferred() ids.ID {
 	return m.preferred
 }
 
+func (m *manager) FeeHistory(count int) []FeeRecord {
+	return m.feeHistory.last(count)
+}
+
 func (m *manager) VerifyTx(tx *txs.Tx) error {
 	if !m.txExecutorBackend.Bootstrapped.Get() {
 		return ErrChainNotSynced
@@ -136,6 +158,35 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 		}
 	}
 
+	if err := m.verifyWarpMessages(tx); err != nil {
+		return err
+	}
+
+	stateDiff, err := m.preferredStateDiff()
+	if err != nil {
+		return err
+	}
+
+	if err := m.verifyGasCapacity(stateDiff, tx); err != nil {
+		return err
+	}
+
+	feeCalculator := state.PickFeeCalculator(m.txExecutorBackend.Config, stateDiff)
+	_, _, _, err = executor.StandardTx(
+		m.txExecutorBackend,
+		feeCalculator,
+		tx,
+		stateDiff,
+	)
+	if err != nil {
+		return fmt.Errorf("failed execution: %w", err)
+	}
+	return nil
+}
+
+// verifyWarpMessages verifies the warp messages of [tx] against the currently
+// recommended P-chain height.
+func (m *manager) verifyWarpMessages(tx *txs.Tx) error {
 	var (
 		recommendedPChainHeight uint64
 		err                     error
@@ -158,10 +209,15 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 	if err != nil {
 		return fmt.Errorf("failed verifying warp messages: %w", err)
 	}
+	return nil
+}
 
+// preferredStateDiff returns a diff on the currently preferred state, advanced
+// to the time of the next block.
+func (m *manager) preferredStateDiff() (state.Diff, error) {
 	stateDiff, err := state.NewDiff(m.preferred, m)
 	if err != nil {
-		return fmt.Errorf("failed creating state diff: %w", err)
+		return nil, fmt.Errorf("failed creating state diff: %w", err)
 	}
 
 	nextBlkTime, _, err := state.NextBlockTime(
@@ -170,41 +226,38 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 		m.txExecutorBackend.Clk,
 	)
 	if err != nil {
-		return fmt.Errorf("failed selecting next block time: %w", err)
+		return nil, fmt.Errorf("failed selecting next block time: %w", err)
 	}
 
 	_, err = executor.AdvanceTimeTo(m.txExecutorBackend, stateDiff, nextBlkTime)
 	if err != nil {
-		return fmt.Errorf("failed to advance the chain time: %w", err)
+		return nil, fmt.Errorf("failed to advance the chain time: %w", err)
 	}
+	return stateDiff, nil
+}
 
-	if timestamp := stateDiff.GetTimestamp(); m.txExecutorBackend.Config.UpgradeConfig.IsEtnaActivated(timestamp) {
-		complexity, err := fee.TxComplexity(tx.Unsigned)
-		if err != nil {
-			return fmt.Errorf("failed to calculate tx complexity: %w", err)
-		}
-		gas, err := complexity.ToGas(m.txExecutorBackend.Config.DynamicFeeConfig.Weights)
-		if err != nil {
-			return fmt.Errorf("failed to calculate tx gas: %w", err)
-		}
-
-		// TODO: After the mempool is updated, convert this check to use the
-		// maximum mempool capacity.
-		feeState := stateDiff.GetFeeState()
-		if gas > feeState.Capacity {
-			return fmt.Errorf("tx exceeds current gas capacity: %d > %d", gas, feeState.Capacity)
-		}
+// verifyGasCapacity verifies that [tx] fits in the gas capacity of
+// [stateDiff].
+func (m *manager) verifyGasCapacity(stateDiff state.Diff, tx *txs.Tx) error {
+	timestamp := stateDiff.GetTimestamp()
+	if !m.txExecutorBackend.Config.UpgradeConfig.IsEtnaActivated(timestamp) {
+		return nil
 	}
 
-	feeCalculator := state.PickFeeCalculator(m.txExecutorBackend.Config, stateDiff)
-	_, _, _, err = executor.StandardTx(
-		m.txExecutorBackend,
-		feeCalculator,
-		tx,
-		stateDiff,
-	)
+	complexity, err := fee.TxComplexity(tx.Unsigned)
 	if err != nil {
-		return fmt.Errorf("failed execution: %w", err)
+		return fmt.Errorf("failed to calculate tx complexity: %w", err)
+	}
+	gas, err := complexity.ToGas(m.txExecutorBackend.Config.DynamicFeeConfig.Weights)
+	if err != nil {
+		return fmt.Errorf("failed to calculate tx gas: %w", err)
+	}
+
+	// TODO: After the mempool is updated, convert this check to use the
+	// maximum mempool capacity.
+	feeState := stateDiff.GetFeeState()
+	if gas > feeState.Capacity {
+		return fmt.Errorf("tx exceeds current gas capacity: %d > %d", gas, feeState.Capacity)
 	}
 	return nil
 }
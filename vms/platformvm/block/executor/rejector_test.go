@@ -14,6 +14,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
@@ -126,6 +127,7 @@ func TestRejectBlock(t *testing.T) {
 				gas.Dimensions{},
 				1_000_000,
 				ids.ID{},
+				&mockable.Clock{},
 				prometheus.NewRegistry(),
 			)
 			require.NoError(err)
@@ -0,0 +1,342 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package executor
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
+	"github.com/ava-labs/avalanchego/vms/platformvm/utxo"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var (
+	_ fx.Fx       = (*unsignedFx)(nil)
+	_ state.Chain = (*changeRecorder)(nil)
+)
+
+// Simulation is the result of executing a transaction against the currently
+// preferred state.
+type Simulation struct {
+	// Fee is the fee the transaction must pay.
+	Fee uint64
+	// Consumed are the IDs of the UTXOs the transaction consumes, including
+	// imported UTXOs.
+	Consumed []ids.ID
+	// Produced are the UTXOs the transaction produces.
+	Produced []*avax.UTXO
+	// StakerChanges are the changes the transaction makes to the current and
+	// pending staker sets.
+	StakerChanges []StakerChange
+	// L1Validators are the L1 validators the transaction adds, modifies or
+	// removes.
+	L1Validators []state.L1Validator
+	// Err is the reason the transaction is invalid. If Err is non-nil, the
+	// changes are empty.
+	Err error
+}
+
+// StakerChange is the addition or removal of a staker.
+type StakerChange struct {
+	// Removed is true if the staker is removed, rather than added.
+	Removed bool
+	// Pending is true if the staker is in the pending staker set, rather than
+	// the current staker set.
+	Pending bool
+	Staker  *state.Staker
+}
+
+func (m *manager) SimulateTx(tx *txs.Tx, verifyCredentials bool) (*Simulation, error) {
+	if !m.txExecutorBackend.Bootstrapped.Get() {
+		return nil, ErrChainNotSynced
+	}
+
+	stateDiff, err := m.preferredStateDiff()
+	if err != nil {
+		return nil, err
+	}
+
+	backend := m.txExecutorBackend
+	if !verifyCredentials {
+		backend, tx, err = withoutCredentials(backend, tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	simulation := &Simulation{}
+	feeCalculator := state.PickFeeCalculator(backend.Config, stateDiff)
+	simulation.Fee, err = feeCalculator.CalculateFee(tx.Unsigned)
+	if err != nil {
+		simulation.Err = fmt.Errorf("failed to calculate fee: %w", err)
+		return simulation, nil
+	}
+
+	if err := m.verifyWarpMessages(tx); err != nil {
+		simulation.Err = err
+		return simulation, nil
+	}
+	if err := m.verifyGasCapacity(stateDiff, tx); err != nil {
+		simulation.Err = err
+		return simulation, nil
+	}
+
+	changes, imported, err := m.simulate(backend, feeCalculator, tx, stateDiff)
+	if err != nil {
+		simulation.Err = fmt.Errorf("failed execution: %w", err)
+		return simulation, nil
+	}
+
+	recorder := &changeRecorder{
+		Chain:    stateDiff,
+		consumed: imported,
+	}
+	if err := changes.Apply(recorder); err != nil {
+		return nil, fmt.Errorf("failed to apply changes: %w", err)
+	}
+
+	simulation.Consumed = recorder.consumed.List()
+	utils.Sort(simulation.Consumed)
+	simulation.Produced = recorder.produced
+	slices.SortFunc(simulation.Produced, func(a, b *avax.UTXO) int {
+		return a.InputID().Compare(b.InputID())
+	})
+	simulation.StakerChanges = recorder.stakerChanges
+	slices.SortStableFunc(simulation.StakerChanges, func(a, b StakerChange) int {
+		return a.Staker.TxID.Compare(b.Staker.TxID)
+	})
+	simulation.L1Validators = recorder.l1Validators
+	return simulation, nil
+}
+
+// simulate executes [tx] on top of [stateDiff] with the executor that a block
+// including [tx] would use. It returns the changes that accepting [tx] would
+// make and the IDs of the UTXOs imported by [tx].
+func (m *manager) simulate(
+	backend *executor.Backend,
+	feeCalculator fee.Calculator,
+	tx *txs.Tx,
+	stateDiff state.Diff,
+) (state.Diff, set.Set[ids.ID], error) {
+	var (
+		timestamp = stateDiff.GetTimestamp()
+		upgrades  = backend.Config.UpgradeConfig
+	)
+	switch tx.Unsigned.(type) {
+	case *txs.AdvanceTimeTx, *txs.RewardValidatorTx:
+		return simulateProposalTx(backend, feeCalculator, tx, stateDiff)
+	case *txs.AddValidatorTx, *txs.AddSubnetValidatorTx, *txs.AddDelegatorTx:
+		if !upgrades.IsBanffActivated(timestamp) {
+			return simulateProposalTx(backend, feeCalculator, tx, stateDiff)
+		}
+	case *txs.ImportTx, *txs.ExportTx:
+		if !upgrades.IsApricotPhase5Activated(timestamp) {
+			onAccept, inputs, _, err := executor.AtomicTx(
+				backend,
+				feeCalculator,
+				m.preferred,
+				m,
+				tx,
+			)
+			return onAccept, inputs, err
+		}
+	}
+
+	txDiff, err := state.NewDiffOn(stateDiff)
+	if err != nil {
+		return nil, nil, err
+	}
+	inputs, _, _, err := executor.StandardTx(
+		backend,
+		feeCalculator,
+		tx,
+		txDiff,
+	)
+	return txDiff, inputs, err
+}
+
+// simulateProposalTx returns the changes that committing the proposal [tx]
+// would make.
+func simulateProposalTx(
+	backend *executor.Backend,
+	feeCalculator fee.Calculator,
+	tx *txs.Tx,
+	stateDiff state.Diff,
+) (state.Diff, set.Set[ids.ID], error) {
+	onCommitState, err := state.NewDiffOn(stateDiff)
+	if err != nil {
+		return nil, nil, err
+	}
+	onAbortState, err := state.NewDiffOn(stateDiff)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = executor.ProposalTx(
+		backend,
+		feeCalculator,
+		tx,
+		onCommitState,
+		onAbortState,
+	)
+	return onCommitState, nil, err
+}
+
+// withoutCredentials returns a backend that doesn't verify credentials and a
+// copy of [tx] with placeholder credentials.
+func withoutCredentials(
+	backend *executor.Backend,
+	tx *txs.Tx,
+) (*executor.Backend, *txs.Tx, error) {
+	numCreds := tx.Unsigned.InputIDs().Len()
+	switch tx.Unsigned.(type) {
+	case *txs.AddSubnetValidatorTx,
+		*txs.RemoveSubnetValidatorTx,
+		*txs.CreateChainTx,
+		*txs.TransformSubnetTx,
+		*txs.TransferSubnetOwnershipTx,
+		*txs.ConvertSubnetToL1Tx,
+		*txs.DisableL1ValidatorTx:
+		// These transactions are authorized by an additional credential.
+		numCreds++
+	}
+
+	unsignedTx := &txs.Tx{
+		Unsigned: tx.Unsigned,
+		Creds:    make([]verify.Verifiable, numCreds),
+	}
+	for i := range unsignedTx.Creds {
+		unsignedTx.Creds[i] = &secp256k1fx.Credential{}
+	}
+	if err := unsignedTx.Initialize(txs.Codec); err != nil {
+		return nil, nil, err
+	}
+
+	unsignedBackend := *backend
+	unsignedBackend.Fx = &unsignedFx{Fx: backend.Fx}
+	unsignedBackend.FlowChecker = utxo.NewVerifier(
+		backend.Ctx,
+		backend.Clk,
+		unsignedBackend.Fx,
+	)
+	return &unsignedBackend, unsignedTx, nil
+}
+
+// unsignedFx verifies the amounts of transfers, without verifying the
+// credentials that authorize them.
+type unsignedFx struct {
+	fx.Fx
+}
+
+func (*unsignedFx) VerifyTransfer(_, inIntf, _, utxoIntf interface{}) error {
+	in, ok := inIntf.(*secp256k1fx.TransferInput)
+	if !ok {
+		return secp256k1fx.ErrWrongInputType
+	}
+	out, ok := utxoIntf.(*secp256k1fx.TransferOutput)
+	if !ok {
+		return secp256k1fx.ErrWrongUTXOType
+	}
+	if err := verify.All(out, in); err != nil {
+		return err
+	}
+	if out.Amt != in.Amt {
+		return fmt.Errorf("%w: %d != %d", secp256k1fx.ErrMismatchedAmounts, out.Amt, in.Amt)
+	}
+	return nil
+}
+
+func (*unsignedFx) VerifyPermission(_, inIntf, _, ownerIntf interface{}) error {
+	in, ok := inIntf.(*secp256k1fx.Input)
+	if !ok {
+		return secp256k1fx.ErrWrongInputType
+	}
+	owner, ok := ownerIntf.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return secp256k1fx.ErrWrongOwnerType
+	}
+	return verify.All(in, owner)
+}
+
+// changeRecorder records the UTXO and staker changes that are applied to it.
+type changeRecorder struct {
+	state.Chain
+
+	consumed      set.Set[ids.ID]
+	produced      []*avax.UTXO
+	stakerChanges []StakerChange
+	l1Validators  []state.L1Validator
+}
+
+func (r *changeRecorder) AddUTXO(utxo *avax.UTXO) {
+	r.produced = append(r.produced, utxo)
+	r.Chain.AddUTXO(utxo)
+}
+
+func (r *changeRecorder) DeleteUTXO(utxoID ids.ID) {
+	r.consumed.Add(utxoID)
+	r.Chain.DeleteUTXO(utxoID)
+}
+
+func (r *changeRecorder) PutCurrentValidator(staker *state.Staker) error {
+	r.recordStaker(false, false, staker)
+	return r.Chain.PutCurrentValidator(staker)
+}
+
+func (r *changeRecorder) DeleteCurrentValidator(staker *state.Staker) {
+	r.recordStaker(true, false, staker)
+	r.Chain.DeleteCurrentValidator(staker)
+}
+
+func (r *changeRecorder) PutCurrentDelegator(staker *state.Staker) {
+	r.recordStaker(false, false, staker)
+	r.Chain.PutCurrentDelegator(staker)
+}
+
+func (r *changeRecorder) DeleteCurrentDelegator(staker *state.Staker) {
+	r.recordStaker(true, false, staker)
+	r.Chain.DeleteCurrentDelegator(staker)
+}
+
+func (r *changeRecorder) PutPendingValidator(staker *state.Staker) error {
+	r.recordStaker(false, true, staker)
+	return r.Chain.PutPendingValidator(staker)
+}
+
+func (r *changeRecorder) DeletePendingValidator(staker *state.Staker) {
+	r.recordStaker(true, true, staker)
+	r.Chain.DeletePendingValidator(staker)
+}
+
+func (r *changeRecorder) PutPendingDelegator(staker *state.Staker) {
+	r.recordStaker(false, true, staker)
+	r.Chain.PutPendingDelegator(staker)
+}
+
+func (r *changeRecorder) DeletePendingDelegator(staker *state.Staker) {
+	r.recordStaker(true, true, staker)
+	r.Chain.DeletePendingDelegator(staker)
+}
+
+func (r *changeRecorder) PutL1Validator(l1Validator state.L1Validator) error {
+	r.l1Validators = append(r.l1Validators, l1Validator)
+	return r.Chain.PutL1Validator(l1Validator)
+}
+
+func (r *changeRecorder) recordStaker(removed, pending bool, staker *state.Staker) {
+	r.stakerChanges = append(r.stakerChanges, StakerChange{
+		Removed: removed,
+		Pending: pending,
+		Staker:  staker,
+	})
+}
@@ -79,6 +79,7 @@ func newTestVerifier(t testing.TB, c testVerifierConfig) *verifier {
 		gas.Dimensions{},
 		1_000_000,
 		ids.ID{},
+		&mockable.Clock{},
 		prometheus.NewRegistry(),
 	)
 	require.NoError(err)
@@ -464,6 +465,7 @@ func TestVerifierVisitCommitBlock(t *testing.T) {
 		gas.Dimensions{},
 		1_000_000,
 		ids.ID{},
+		&mockable.Clock{},
 		prometheus.NewRegistry(),
 	)
 	require.NoError(err)
@@ -544,6 +546,7 @@ func TestVerifierVisitAbortBlock(t *testing.T) {
 		gas.Dimensions{},
 		1_000_000,
 		ids.ID{},
+		&mockable.Clock{},
 		prometheus.NewRegistry(),
 	)
 	require.NoError(err)
@@ -625,6 +628,7 @@ func TestVerifyUnverifiedParent(t *testing.T) {
 		gas.Dimensions{},
 		1_000_000,
 		ids.ID{},
+		&mockable.Clock{},
 		prometheus.NewRegistry(),
 	)
 	require.NoError(err)
@@ -702,6 +706,7 @@ func TestBanffAbortBlockTimestampChecks(t *testing.T) {
 				gas.Dimensions{},
 				1_000_000,
 				ids.ID{},
+				&mockable.Clock{},
 				prometheus.NewRegistry(),
 			)
 			require.NoError(err)
@@ -809,6 +814,7 @@ func TestBanffCommitBlockTimestampChecks(t *testing.T) {
 				gas.Dimensions{},
 				1_000_000,
 				ids.ID{},
+				&mockable.Clock{},
 				prometheus.NewRegistry(),
 			)
 			require.NoError(err)
@@ -884,6 +890,7 @@ func TestVerifierVisitApricotStandardBlockWithProposalBlockParent(t *testing.T)
 		gas.Dimensions{},
 		1_000_000,
 		ids.ID{},
+		&mockable.Clock{},
 		prometheus.NewRegistry(),
 	)
 	require.NoError(err)
@@ -947,6 +954,7 @@ func TestVerifierVisitBanffStandardBlockWithProposalBlockParent(t *testing.T) {
 		gas.Dimensions{},
 		1_000_000,
 		ids.ID{},
+		&mockable.Clock{},
 		prometheus.NewRegistry(),
 	)
 	require.NoError(err)
@@ -311,6 +311,14 @@ func (c *Client) GetCurrentSupply(ctx context.Context, subnetID ids.ID, options
 	return uint64(res.Supply), uint64(res.Height), err
 }
 
+// CalculateReward returns the potential reward of a staker, and how it would be
+// split if the staker is a delegator, using the reward config of the chain.
+func (c *Client) CalculateReward(ctx context.Context, args *CalculateRewardArgs, options ...rpc.Option) (*CalculateRewardReply, error) {
+	res := &CalculateRewardReply{}
+	err := c.Requester.SendRequest(ctx, "platform.calculateReward", args, res, options...)
+	return res, err
+}
+
 // SampleValidators returns the nodeIDs of a sample of sampleSize validators
 // from the current validator set for subnetID.
 func (c *Client) SampleValidators(ctx context.Context, subnetID ids.ID, sampleSize uint16, options ...rpc.Option) ([]ids.NodeID, error) {
@@ -373,6 +381,22 @@ func (c *Client) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Opt
 	return res.TxID, err
 }
 
+// SimulateTx executes the signed or unsigned transaction against the preferred
+// state without issuing it.
+func (c *Client) SimulateTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (*SimulateTxReply, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &SimulateTxReply{}
+	err = c.Requester.SendRequest(ctx, "platform.simulateTx", &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, res, options...)
+	return res, err
+}
+
 // GetTx returns the byte representation of txID.
 func (c *Client) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error) {
 	res := &api.FormattedTx{}
@@ -484,6 +508,15 @@ func (c *Client) GetRewardUTXOs(ctx context.Context, args *api.GetTxArgs, option
 	return utxos, err
 }
 
+// GetStakingHistory returns a page of the completed staking periods selected
+// by [args]. The next page is fetched by setting [args.StartTime] and
+// [args.StartTxID] to the returned NextStartTime and NextStartTxID.
+func (c *Client) GetStakingHistory(ctx context.Context, args *GetStakingHistoryArgs, options ...rpc.Option) (*GetStakingHistoryReply, error) {
+	res := &GetStakingHistoryReply{}
+	err := c.Requester.SendRequest(ctx, "platform.getStakingHistory", args, res, options...)
+	return res, err
+}
+
 // GetTimestamp returns the current chain timestamp.
 func (c *Client) GetTimestamp(ctx context.Context, options ...rpc.Option) (time.Time, error) {
 	res := &GetTimestampReply{}
@@ -523,6 +556,19 @@ func (c *Client) GetValidatorsAt(
 	return res.Validators, err
 }
 
+// GetValidatorSetDiff returns a page of the changes made to the validator set
+// of a subnet between two heights. The next page is fetched by setting
+// [args.StartNodeID] to the returned NextNodeID.
+func (c *Client) GetValidatorSetDiff(
+	ctx context.Context,
+	args *GetValidatorSetDiffArgs,
+	options ...rpc.Option,
+) (*GetValidatorSetDiffReply, error) {
+	res := &GetValidatorSetDiffReply{}
+	err := c.Requester.SendRequest(ctx, "platform.getValidatorSetDiff", args, res, options...)
+	return res, err
+}
+
 // GetBlock returns blockID.
 func (c *Client) GetBlock(ctx context.Context, blockID ids.ID, options ...rpc.Option) ([]byte, error) {
 	res := &api.FormattedBlock{}
@@ -567,6 +613,54 @@ func (c *Client) GetFeeState(ctx context.Context, options ...rpc.Option) (
 	return res.State, res.Price, res.Time, err
 }
 
+// EstimateFee returns the fee of the signed or unsigned transaction now and
+// the range it can reach within [horizon].
+func (c *Client) EstimateFee(
+	ctx context.Context,
+	txBytes []byte,
+	horizon time.Duration,
+	options ...rpc.Option,
+) (*EstimateFeeReply, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &EstimateFeeReply{}
+	err = c.Requester.SendRequest(ctx, "platform.estimateFee", &EstimateFeeArgs{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+		Horizon:  json.Uint64(horizon / time.Second),
+	}, res, options...)
+	return res, err
+}
+
+// EstimateComplexityFee returns the fee of the complexity now and the range it
+// can reach within [horizon].
+func (c *Client) EstimateComplexityFee(
+	ctx context.Context,
+	complexity gas.Dimensions,
+	horizon time.Duration,
+	options ...rpc.Option,
+) (*EstimateFeeReply, error) {
+	res := &EstimateFeeReply{}
+	err := c.Requester.SendRequest(ctx, "platform.estimateFee", &EstimateFeeArgs{
+		Complexity: &complexity,
+		Horizon:    json.Uint64(horizon / time.Second),
+	}, res, options...)
+	return res, err
+}
+
+// GetFeeHistory returns the fees of up to [limit] of the most recently
+// accepted blocks, from oldest to newest.
+func (c *Client) GetFeeHistory(ctx context.Context, limit uint32, options ...rpc.Option) ([]FeeHistoryEntry, error) {
+	res := &GetFeeHistoryReply{}
+	err := c.Requester.SendRequest(ctx, "platform.getFeeHistory", &GetFeeHistoryArgs{
+		Limit: json.Uint32(limit),
+	}, res, options...)
+	return res.Blocks, err
+}
+
 // GetValidatorFeeConfig returns the validator fee config.
 func (c *Client) GetValidatorFeeConfig(ctx context.Context, options ...rpc.Option) (*fee.Config, error) {
 	res := &fee.Config{}
@@ -586,6 +680,38 @@ func (c *Client) GetValidatorFeeState(ctx context.Context, options ...rpc.Option
 	return res.Excess, res.Price, res.Time, err
 }
 
+// GetL1ValidatorDeactivations returns the projected deactivations of the
+// active L1 validators. If [subnetID] is non-empty, only validators of
+// [subnetID] are returned.
+func (c *Client) GetL1ValidatorDeactivations(
+	ctx context.Context,
+	subnetID ids.ID,
+	options ...rpc.Option,
+) ([]L1ValidatorDeactivation, time.Time, error) {
+	res := &GetL1ValidatorDeactivationsReply{}
+	err := c.Requester.SendRequest(ctx, "platform.getL1ValidatorDeactivations",
+		&GetL1ValidatorDeactivationsArgs{
+			SubnetID: subnetID,
+		},
+		res, options...,
+	)
+	return res.Deactivations, res.Time, err
+}
+
+// GetMempool returns the txs in the node's mempool and the drop reasons of
+// the provided txs that were recently dropped.
+func (c *Client) GetMempool(
+	ctx context.Context,
+	droppedTxIDs []ids.ID,
+	options ...rpc.Option,
+) (*GetMempoolReply, error) {
+	res := &GetMempoolReply{}
+	err := c.Requester.SendRequest(ctx, "platform.getMempool", &GetMempoolArgs{
+		DroppedTxIDs: droppedTxIDs,
+	}, res, options...)
+	return res, err
+}
+
 func AwaitTxAccepted(
 	c *Client,
 	ctx context.Context,
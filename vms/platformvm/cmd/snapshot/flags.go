@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"github.com/ava-labs/avalanchego/database/leveldb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+)
+
+const (
+	DBDirKey       = "db-dir"
+	DBTypeKey      = "db-type"
+	NetworkIDKey   = "network-id"
+	AVAXAssetIDKey = "avax-asset-id"
+	OutputKey      = "output"
+	FormatKey      = "format"
+	VerifyKey      = "verify"
+
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+var errCSVRequiresOutput = errors.New("csv format requires an output directory")
+
+func addFlags(flags *pflag.FlagSet) {
+	flags.String(DBDirKey, "", "Database directory of the stopped node, as passed to its --db-dir flag")
+	flags.String(DBTypeKey, leveldb.Name, "Database type of the stopped node, as passed to its --db-type flag")
+	flags.String(NetworkIDKey, constants.MainnetName, "Network of the stopped node, as passed to its --network-id flag")
+	flags.String(AVAXAssetIDKey, "", "ID of the AVAX asset. Only required for networks without a well-known genesis")
+	flags.String(OutputKey, "", fmt.Sprintf("File to write the snapshot to. Defaults to stdout for the %q format. Must be a directory for the %q format", FormatJSON, FormatCSV))
+	flags.String(FormatKey, FormatJSON, fmt.Sprintf("Format of the snapshot. One of {%s, %s}", FormatJSON, FormatCSV))
+	flags.Bool(VerifyKey, false, "Recompute the supply from the snapshot and compare it to the current supply of the state")
+}
+
+type Config struct {
+	DBDir       string
+	DBType      string
+	NetworkID   uint32
+	AVAXAssetID ids.ID
+	Output      string
+	Format      string
+	Verify      bool
+}
+
+func parseFlags(flags *pflag.FlagSet) (*Config, error) {
+	dbDir, err := flags.GetString(DBDirKey)
+	if err != nil {
+		return nil, err
+	}
+	if dbDir == "" {
+		return nil, fmt.Errorf("--%s must be provided", DBDirKey)
+	}
+
+	dbType, err := flags.GetString(DBTypeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	networkName, err := flags.GetString(NetworkIDKey)
+	if err != nil {
+		return nil, err
+	}
+	networkID, err := constants.NetworkID(networkName)
+	if err != nil {
+		return nil, err
+	}
+
+	var avaxAssetID ids.ID
+	if flags.Changed(AVAXAssetIDKey) {
+		avaxAssetIDStr, err := flags.GetString(AVAXAssetIDKey)
+		if err != nil {
+			return nil, err
+		}
+		avaxAssetID, err = ids.FromString(avaxAssetIDStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	output, err := flags.GetString(OutputKey)
+	if err != nil {
+		return nil, err
+	}
+
+	format, err := flags.GetString(FormatKey)
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case FormatJSON:
+	case FormatCSV:
+		if output == "" {
+			return nil, errCSVRequiresOutput
+		}
+	default:
+		return nil, fmt.Errorf("--%s must be one of {%s, %s}", FormatKey, FormatJSON, FormatCSV)
+	}
+
+	verify, err := flags.GetBool(VerifyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		DBDir:       dbDir,
+		DBType:      dbType,
+		NetworkID:   networkID,
+		AVAXAssetID: avaxAssetID,
+		Output:      output,
+		Format:      format,
+		Verify:      verify,
+	}, nil
+}
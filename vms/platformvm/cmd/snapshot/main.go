@@ -0,0 +1,218 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// snapshot exports the P-Chain state of a stopped node. See
+// vms/platformvm/snapshot/README.md for the format of the snapshot.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/chains"
+	"github.com/ava-labs/avalanchego/database/factory"
+	"github.com/ava-labs/avalanchego/database/leveldb"
+	"github.com/ava-labs/avalanchego/database/pebbledb"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/genesis"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/upgrade"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/perms"
+	"github.com/ava-labs/avalanchego/version"
+	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/snapshot"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+
+	platformconfig "github.com/ava-labs/avalanchego/vms/platformvm/config"
+)
+
+func main() {
+	c := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Exports the P-Chain state of a stopped node at its last accepted height",
+		RunE:  snapshotFunc,
+	}
+	addFlags(c.Flags())
+
+	if err := c.ExecuteContext(context.Background()); err != nil {
+		fmt.Fprintf(os.Stderr, "command failed %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func snapshotFunc(c *cobra.Command, _ []string) error {
+	config, err := parseFlags(c.Flags())
+	if err != nil {
+		return err
+	}
+
+	avaxAssetID, err := getAVAXAssetID(config)
+	if err != nil {
+		return err
+	}
+
+	s, closeFn, err := openState(config)
+	if err != nil {
+		return err
+	}
+	defer closeFn()
+
+	snap, err := snapshot.Export(c.Context(), s, config.NetworkID, avaxAssetID)
+	if err != nil {
+		return fmt.Errorf("failed to export snapshot: %w", err)
+	}
+	log.Printf("exported %d stakers, %d subnets, %d chains and %d L1 validators at height %d (%s)\n",
+		len(snap.Stakers),
+		len(snap.Subnets),
+		len(snap.Chains),
+		len(snap.L1Validators),
+		snap.Height,
+		snap.BlockID,
+	)
+
+	if err := writeSnapshot(config, snap); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+
+	if !config.Verify {
+		return nil
+	}
+
+	reports, err := snapshot.Verify(snap)
+	for _, report := range reports {
+		log.Printf("subnet %s asset %s: current supply %d, accounted %d (utxos %d, staked %d, potential rewards %d, delegatee rewards %d, L1 validator balances %d), burned or exported %d\n",
+			report.SubnetID,
+			report.AssetID,
+			report.CurrentSupply,
+			report.Accounted,
+			report.UTXOs,
+			report.Staked,
+			report.PotentialRewards,
+			report.DelegateeRewards,
+			report.L1ValidatorBalances,
+			report.BurnedOrExported,
+		)
+	}
+	return err
+}
+
+// getAVAXAssetID returns the AVAX asset ID provided by the user or, for
+// networks with a well-known genesis, the one defined in their genesis.
+func getAVAXAssetID(config *Config) (ids.ID, error) {
+	if config.AVAXAssetID != ids.Empty {
+		return config.AVAXAssetID, nil
+	}
+
+	switch config.NetworkID {
+	case constants.MainnetID, constants.FujiID, constants.LocalID:
+	default:
+		return ids.Empty, fmt.Errorf("--%s must be provided for network %s",
+			AVAXAssetIDKey,
+			constants.NetworkName(config.NetworkID),
+		)
+	}
+
+	_, avaxAssetID, err := genesis.FromConfig(genesis.GetConfig(config.NetworkID))
+	if err != nil {
+		return ids.Empty, fmt.Errorf("failed to build genesis: %w", err)
+	}
+	return avaxAssetID, nil
+}
+
+// openState opens the P-Chain state in the same location as the node would.
+// Any modification to the state is kept in memory and never written to disk.
+func openState(c *Config) (state.State, func(), error) {
+	var dbFolderName string
+	switch c.DBType {
+	case leveldb.Name:
+		dbFolderName = version.CurrentDatabase
+	case pebbledb.Name:
+		dbFolderName = "pebble"
+	default:
+		dbFolderName = "db"
+	}
+	dbPath := filepath.Join(
+		c.DBDir,
+		constants.NetworkName(c.NetworkID),
+		dbFolderName,
+	)
+	// leveldb would otherwise create an empty database.
+	if _, err := os.Stat(dbPath); err != nil {
+		return nil, nil, fmt.Errorf("couldn't find database: %w", err)
+	}
+
+	log := logging.NoLog{}
+	db, err := factory.New(
+		c.DBType,
+		dbPath,
+		true, // readOnly
+		nil,
+		prometheus.NewRegistry(),
+		log,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	closeFn := func() {
+		_ = db.Close()
+	}
+
+	vmDB := prefixdb.New(
+		chains.VMDBPrefix,
+		prefixdb.New(constants.PlatformChainID[:], db),
+	)
+	s, err := state.New(
+		vmDB,
+		nil, // The state must have already been initialized by the node.
+		prometheus.NewRegistry(),
+		validators.NewManager(),
+		upgrade.GetConfig(c.NetworkID),
+		&platformconfig.Default,
+		&snow.Context{
+			NetworkID: c.NetworkID,
+			SubnetID:  constants.PrimaryNetworkID,
+			ChainID:   constants.PlatformChainID,
+			Log:       log,
+		},
+		metrics.Noop,
+		reward.NewCalculator(genesis.GetStakingConfig(c.NetworkID).RewardConfig),
+	)
+	if err != nil {
+		closeFn()
+		return nil, nil, fmt.Errorf("failed to load P-Chain state: %w", err)
+	}
+	return s, func() {
+		_ = s.Close()
+		closeFn()
+	}, nil
+}
+
+func writeSnapshot(c *Config, snap *snapshot.Snapshot) error {
+	if c.Format == FormatCSV {
+		return snapshot.WriteCSV(c.Output, snap)
+	}
+	if c.Output == "" {
+		return snapshot.WriteJSON(os.Stdout, snap)
+	}
+
+	f, err := perms.Create(c.Output, perms.ReadWrite)
+	if err != nil {
+		return err
+	}
+	if err := snapshot.WriteJSON(f, snap); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -75,25 +75,30 @@ func TestConfigUnmarshal(t *testing.T) {
 				PullGossipFrequency:                         12,
 				PullGossipThrottlingPeriod:                  13,
 				PullGossipRequestsPerValidator:              14,
+				PullGossipMaxRetries:                        18,
+				PullGossipHedgingPercentile:                 .19,
+				PullGossipHedgingDelay:                      20,
 				ExpectedBloomFilterElements:                 15,
 				ExpectedBloomFilterFalsePositiveProbability: 16,
 				MaxBloomFilterFalsePositiveProbability:      17,
 			},
-			BlockCacheSize:                1,
-			TxCacheSize:                   2,
-			TransformedSubnetTxCacheSize:  3,
-			RewardUTXOsCacheSize:          5,
-			ChainCacheSize:                6,
-			ChainDBCacheSize:              7,
-			BlockIDCacheSize:              8,
-			FxOwnerCacheSize:              9,
-			SubnetToL1ConversionCacheSize: 10,
-			L1WeightsCacheSize:            11,
-			L1InactiveValidatorsCacheSize: 12,
-			L1SubnetIDNodeIDCacheSize:     13,
-			ChecksumsEnabled:              true,
-			MempoolPruneFrequency:         time.Minute,
-			MempoolGasCapacity:            14,
+			BlockCacheSize:                 1,
+			TxCacheSize:                    2,
+			TransformedSubnetTxCacheSize:   3,
+			RewardUTXOsCacheSize:           5,
+			ChainCacheSize:                 6,
+			ChainDBCacheSize:               7,
+			BlockIDCacheSize:               8,
+			FxOwnerCacheSize:               9,
+			SubnetToL1ConversionCacheSize:  10,
+			L1WeightsCacheSize:             11,
+			L1InactiveValidatorsCacheSize:  12,
+			L1SubnetIDNodeIDCacheSize:      13,
+			ChecksumsEnabled:               true,
+			MempoolPruneFrequency:          time.Minute,
+			MempoolGasCapacity:             14,
+			StakingIndexEnabled:            true,
+			L1ValidatorLowBalanceThreshold: time.Hour,
 		}
 		verifyInitializedStruct(t, *expected)
 		verifyInitializedStruct(t, expected.Network)
@@ -86,6 +86,10 @@ type Internal struct {
 	// on recently created subnets (without this, users need to wait for
 	// [recentlyAcceptedWindowTTL] to pass for activation to occur).
 	UseCurrentHeight bool
+
+	// True if the node's admin API is enabled. The P-Chain admin API is only
+	// served if it is set.
+	AdminAPIEnabled bool
 }
 
 // Create the blockchain described in [tx], but only if this node is a member of
@@ -26,6 +26,9 @@ var DefaultNetwork = Network{
 	// PullGossipRequestsPerValidator = PullGossipThrottlingPeriod / PullGossipFrequency =
 	// 3600 seconds/period / 1.5 requests/second = 2400 requests/validator
 	PullGossipRequestsPerValidator:              2400,
+	PullGossipMaxRetries:                        1,
+	PullGossipHedgingPercentile:                 .9,
+	PullGossipHedgingDelay:                      500 * time.Millisecond,
 	ExpectedBloomFilterElements:                 8 * 1024,
 	ExpectedBloomFilterFalsePositiveProbability: .01,
 	MaxBloomFilterFalsePositiveProbability:      .05,
@@ -77,6 +80,17 @@ type Network struct {
 	// PullGossipRequestsPerValidator is the number of pull gossip requests that
 	// a validator is expected to make in a throttling period.
 	PullGossipRequestsPerValidator float64 `json:"pull-gossip-requests-per-validator"`
+	// PullGossipMaxRetries is the number of times a failed pull gossip request
+	// is retried with a different validator in the same round.
+	PullGossipMaxRetries int `json:"pull-gossip-max-retries"`
+	// PullGossipHedgingPercentile is the percentile of recent pull gossip
+	// response latencies after which an unanswered request is also sent to a
+	// different validator.
+	PullGossipHedgingPercentile float64 `json:"pull-gossip-hedging-percentile"`
+	// PullGossipHedgingDelay is how long to wait for a pull gossip response
+	// before also sending the request to a different validator, until a
+	// response latency has been observed.
+	PullGossipHedgingDelay time.Duration `json:"pull-gossip-hedging-delay"`
 	// ExpectedBloomFilterElements is the number of elements to expect when
 	// creating a new bloom filter. The larger this number is, the larger the
 	// bloom filter will be.
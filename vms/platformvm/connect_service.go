@@ -0,0 +1,106 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/api/server"
+	"github.com/ava-labs/avalanchego/connectproto/pb/platformvm/platformvmconnect"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/events"
+
+	pb "github.com/ava-labs/avalanchego/connectproto/pb/platformvm"
+)
+
+var _ platformvmconnect.PlatformVMHandler = (*connectrpcService)(nil)
+
+type connectrpcService struct {
+	vm *VM
+}
+
+func (c *connectrpcService) SubscribeEvents(
+	ctx context.Context,
+	r *connect.Request[pb.SubscribeEventsRequest],
+	stream *connect.ServerStream[pb.Event],
+) error {
+	log := c.vm.ctx.Log.With(
+		zap.String("service", "platformvm"),
+		zap.String("method", "SubscribeEvents"),
+		zap.Strings("route", r.Header()[server.HTTPHeaderRoute]),
+	)
+	log.Debug("API called")
+
+	var subnetID []byte
+	if len(r.Msg.SubnetId) > 0 {
+		id, err := ids.ToID(r.Msg.SubnetId)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid subnetID: %w", err))
+		}
+		subnetID = id[:]
+	}
+
+	// Subscribing while holding the context lock ensures that the events of
+	// each accepted block are either fully published to the subscription or
+	// not at all.
+	c.vm.ctx.Lock.Lock()
+	subscription := c.vm.eventBroker.Subscribe()
+	c.vm.ctx.Lock.Unlock()
+	defer c.vm.eventBroker.Unsubscribe(subscription)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-subscription.Events():
+			if !ok {
+				err := subscription.Err()
+				if errors.Is(err, events.ErrFellBehind) {
+					return connect.NewError(connect.CodeResourceExhausted, err)
+				}
+				return connect.NewError(connect.CodeUnavailable, err)
+			}
+			if subnetID != nil && !relatesToSubnet(event, subnetID) {
+				continue
+			}
+			if err := stream.Send(event); err != nil {
+				log.Debug("failed to send event", zap.Error(err))
+				return err
+			}
+		}
+	}
+}
+
+// relatesToSubnet returns true if [event] should be sent to a subscriber that
+// is only interested in [subnetID].
+func relatesToSubnet(event *pb.Event, subnetID []byte) bool {
+	var eventSubnetID []byte
+	switch e := event.Event.(type) {
+	case *pb.Event_BlockAccepted:
+		return true
+	case *pb.Event_StakerAdded:
+		eventSubnetID = e.StakerAdded.GetStaker().GetSubnetId()
+	case *pb.Event_StakerRemoved:
+		eventSubnetID = e.StakerRemoved.GetStaker().GetSubnetId()
+	case *pb.Event_L1ValidatorRegistered:
+		eventSubnetID = e.L1ValidatorRegistered.SubnetId
+	case *pb.Event_L1ValidatorWeightChanged:
+		eventSubnetID = e.L1ValidatorWeightChanged.SubnetId
+	case *pb.Event_L1ValidatorDisabled:
+		eventSubnetID = e.L1ValidatorDisabled.SubnetId
+	case *pb.Event_SubnetCreated:
+		eventSubnetID = e.SubnetCreated.SubnetId
+	case *pb.Event_SubnetConverted:
+		eventSubnetID = e.SubnetConverted.SubnetId
+	case *pb.Event_ChainCreated:
+		eventSubnetID = e.ChainCreated.SubnetId
+	}
+	return bytes.Equal(eventSubnetID, subnetID)
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/connectproto/pb/platformvm/platformvmconnect"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis/genesistest"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	pb "github.com/ava-labs/avalanchego/connectproto/pb/platformvm"
+)
+
+func TestConnectRPCService_SubscribeEvents(t *testing.T) {
+	require := require.New(t)
+
+	vm, _, _ := defaultVM(t, upgradetest.Latest)
+
+	handler, err := vm.NewHTTPHandler(t.Context())
+	require.NoError(err)
+
+	server := httptest.NewUnstartedServer(handler)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	client := platformvmconnect.NewPlatformVMClient(server.Client(), server.URL)
+
+	invalidStream, err := client.SubscribeEvents(t.Context(), connect.NewRequest(&pb.SubscribeEventsRequest{
+		SubnetId: []byte{1},
+	}))
+	require.NoError(err)
+	require.False(invalidStream.Receive())
+	require.Equal(connect.CodeInvalidArgument, connect.CodeOf(invalidStream.Err()))
+	require.NoError(invalidStream.Close())
+
+	// The response headers are only received along with the first event, so
+	// the stream is opened asynchronously.
+	type result struct {
+		stream *connect.ServerStreamForClient[pb.Event]
+		err    error
+	}
+	results := make(chan result, 1)
+	go func() {
+		stream, err := client.SubscribeEvents(t.Context(), connect.NewRequest(&pb.SubscribeEventsRequest{}))
+		results <- result{stream: stream, err: err}
+	}()
+
+	// The subscription is registered once the server handles the request.
+	require.Eventually(vm.eventBroker.HasSubscribers, time.Minute, 10*time.Millisecond)
+
+	vm.ctx.Lock.Lock()
+	wallet := newWallet(t, vm, walletConfig{})
+	createSubnetTx, err := wallet.IssueCreateSubnetTx(
+		&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{genesistest.DefaultFundedKeys[0].Address()},
+		},
+	)
+	require.NoError(err)
+	vm.ctx.Lock.Unlock()
+
+	require.NoError(vm.issueTxFromRPC(createSubnetTx))
+
+	vm.ctx.Lock.Lock()
+	require.NoError(buildAndAcceptStandardBlock(vm))
+	lastAcceptedID := vm.manager.LastAccepted()
+	lastAccepted, err := vm.manager.GetStatelessBlock(lastAcceptedID)
+	require.NoError(err)
+	vm.ctx.Lock.Unlock()
+
+	r := <-results
+	require.NoError(r.err)
+	stream := r.stream
+	defer stream.Close()
+
+	require.True(stream.Receive(), stream.Err())
+	blockAccepted := stream.Msg()
+	require.Equal(lastAcceptedID[:], blockAccepted.BlockId)
+	require.Equal(lastAccepted.Height(), blockAccepted.Height)
+	parentID := lastAccepted.Parent()
+	subnetID := createSubnetTx.ID()
+	require.Equal(
+		&pb.BlockAccepted{
+			ParentId: parentID[:],
+			TxIds:    [][]byte{subnetID[:]},
+		},
+		blockAccepted.GetBlockAccepted(),
+	)
+
+	require.True(stream.Receive(), stream.Err())
+	subnetCreated := stream.Msg()
+	require.Equal(lastAcceptedID[:], subnetCreated.BlockId)
+	require.Equal(subnetID[:], subnetCreated.GetSubnetCreated().SubnetId)
+
+	// Closing the broker, as is done during shutdown, ends the subscription.
+	vm.eventBroker.Close()
+
+	require.False(stream.Receive())
+	require.Equal(connect.CodeUnavailable, connect.CodeOf(stream.Err()))
+}
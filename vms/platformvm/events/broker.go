@@ -0,0 +1,133 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/utils/set"
+
+	pb "github.com/ava-labs/avalanchego/connectproto/pb/platformvm"
+)
+
+var (
+	ErrBrokerClosed = errors.New("event broker closed")
+	ErrFellBehind   = errors.New("subscriber fell behind")
+)
+
+// Subscription receives the events published to a [Broker].
+type Subscription struct {
+	events chan *pb.Event
+	err    error
+}
+
+// Events returns the channel that events are delivered on. The channel is
+// closed when the subscription ends.
+func (s *Subscription) Events() <-chan *pb.Event {
+	return s.events
+}
+
+// Err returns the reason that the subscription ended. It must only be called
+// after the events channel is closed.
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// Broker fans out published events to its subscribers.
+//
+// Publishing never blocks. If a subscriber doesn't have room for the published
+// events, its subscription is ended with [ErrFellBehind]. Ended subscriptions
+// can't be resumed, and the published events are not retained, so a
+// subscriber that fell behind must resync its state before subscribing again.
+type Broker struct {
+	bufferSize int
+
+	lock          sync.Mutex
+	closed        bool
+	subscriptions set.Set[*Subscription]
+}
+
+// NewBroker returns a broker that buffers up to [bufferSize] events for each
+// subscriber.
+func NewBroker(bufferSize int) *Broker {
+	return &Broker{
+		bufferSize: bufferSize,
+	}
+}
+
+// Subscribe returns a subscription to all events published after this call.
+func (b *Broker) Subscribe() *Subscription {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	s := &Subscription{
+		events: make(chan *pb.Event, b.bufferSize),
+	}
+	if b.closed {
+		s.err = ErrBrokerClosed
+		close(s.events)
+		return s
+	}
+	b.subscriptions.Add(s)
+	return s
+}
+
+// Unsubscribe ends the subscription. It is safe to call after the subscription
+// has already ended.
+func (b *Broker) Unsubscribe(s *Subscription) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.subscriptions.Contains(s) {
+		b.end(s, nil)
+	}
+}
+
+// HasSubscribers returns true if there is at least one active subscription.
+func (b *Broker) HasSubscribers() bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.subscriptions.Len() > 0
+}
+
+// Publish delivers [events] to every subscriber.
+func (b *Broker) Publish(events []*pb.Event) {
+	if len(events) == 0 {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for s := range b.subscriptions {
+		if cap(s.events)-len(s.events) < len(events) {
+			b.end(s, ErrFellBehind)
+			continue
+		}
+		for _, event := range events {
+			s.events <- event
+		}
+	}
+}
+
+// Close ends all subscriptions with [ErrBrokerClosed]. Subsequent
+// subscriptions end immediately.
+func (b *Broker) Close() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.closed = true
+	for s := range b.subscriptions {
+		b.end(s, ErrBrokerClosed)
+	}
+}
+
+// end assumes the lock is held and that [s] is an active subscription.
+func (b *Broker) end(s *Subscription, err error) {
+	b.subscriptions.Remove(s)
+	s.err = err
+	close(s.events)
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/ava-labs/avalanchego/connectproto/pb/platformvm"
+)
+
+func TestBroker(t *testing.T) {
+	require := require.New(t)
+
+	b := NewBroker(2)
+	fast := b.Subscribe()
+	slow := b.Subscribe()
+	require.True(b.HasSubscribers())
+
+	event0 := &pb.Event{Height: 0}
+	event1 := &pb.Event{Height: 1}
+	b.Publish([]*pb.Event{event0, event1})
+	require.Equal(event0, <-fast.Events())
+	require.Equal(event1, <-fast.Events())
+
+	// [slow] doesn't have room for the event, so it's dropped.
+	event2 := &pb.Event{Height: 2}
+	b.Publish([]*pb.Event{event2})
+	require.Equal(event2, <-fast.Events())
+
+	require.Equal(event0, <-slow.Events())
+	require.Equal(event1, <-slow.Events())
+	_, ok := <-slow.Events()
+	require.False(ok)
+	require.ErrorIs(slow.Err(), ErrFellBehind)
+
+	// Unsubscribing ends the subscription without an error.
+	b.Unsubscribe(fast)
+	_, ok = <-fast.Events()
+	require.False(ok)
+	require.NoError(fast.Err())
+	require.False(b.HasSubscribers())
+
+	// Ending a subscription multiple times is a noop.
+	b.Unsubscribe(slow)
+	require.ErrorIs(slow.Err(), ErrFellBehind)
+
+	subscription := b.Subscribe()
+	b.Close()
+	_, ok = <-subscription.Events()
+	require.False(ok)
+	require.ErrorIs(subscription.Err(), ErrBrokerClosed)
+
+	subscription = b.Subscribe()
+	_, ok = <-subscription.Events()
+	require.False(ok)
+	require.ErrorIs(subscription.Err(), ErrBrokerClosed)
+}
@@ -0,0 +1,257 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+
+	pb "github.com/ava-labs/avalanchego/connectproto/pb/platformvm"
+)
+
+var _ state.Chain = (*Recorder)(nil)
+
+// Recorder wraps a chain and records an event for every change written to it
+// that subscribers may be interested in.
+//
+// Applying a [state.Diff] to a Recorder derives the events of the diff.
+type Recorder struct {
+	state.Chain
+
+	events []*pb.Event
+}
+
+func NewRecorder(chain state.Chain) *Recorder {
+	return &Recorder{
+		Chain: chain,
+	}
+}
+
+// Events returns the events recorded since the last call to Events.
+func (r *Recorder) Events() []*pb.Event {
+	events := r.events
+	r.events = nil
+	return events
+}
+
+func (r *Recorder) PutCurrentValidator(staker *state.Staker) error {
+	if err := r.Chain.PutCurrentValidator(staker); err != nil {
+		return err
+	}
+	r.stakerAdded(staker, false, false)
+	return nil
+}
+
+func (r *Recorder) DeleteCurrentValidator(staker *state.Staker) {
+	r.Chain.DeleteCurrentValidator(staker)
+	r.stakerRemoved(staker, false, false)
+}
+
+func (r *Recorder) PutCurrentDelegator(staker *state.Staker) {
+	r.Chain.PutCurrentDelegator(staker)
+	r.stakerAdded(staker, true, false)
+}
+
+func (r *Recorder) DeleteCurrentDelegator(staker *state.Staker) {
+	r.Chain.DeleteCurrentDelegator(staker)
+	r.stakerRemoved(staker, true, false)
+}
+
+func (r *Recorder) PutPendingValidator(staker *state.Staker) error {
+	if err := r.Chain.PutPendingValidator(staker); err != nil {
+		return err
+	}
+	r.stakerAdded(staker, false, true)
+	return nil
+}
+
+func (r *Recorder) DeletePendingValidator(staker *state.Staker) {
+	r.Chain.DeletePendingValidator(staker)
+	r.stakerRemoved(staker, false, true)
+}
+
+func (r *Recorder) PutPendingDelegator(staker *state.Staker) {
+	r.Chain.PutPendingDelegator(staker)
+	r.stakerAdded(staker, true, true)
+}
+
+func (r *Recorder) DeletePendingDelegator(staker *state.Staker) {
+	r.Chain.DeletePendingDelegator(staker)
+	r.stakerRemoved(staker, true, true)
+}
+
+func (r *Recorder) PutL1Validator(l1Validator state.L1Validator) error {
+	previous, err := r.Chain.GetL1Validator(l1Validator.ValidationID)
+	exists := err == nil
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return fmt.Errorf("failed to get L1 validator %s: %w", l1Validator.ValidationID, err)
+	}
+
+	if err := r.Chain.PutL1Validator(l1Validator); err != nil {
+		return err
+	}
+
+	switch {
+	case !exists:
+		if l1Validator.Weight == 0 {
+			// The validator was registered and removed in the same diff.
+			return nil
+		}
+		r.events = append(r.events, &pb.Event{
+			Event: &pb.Event_L1ValidatorRegistered{
+				L1ValidatorRegistered: &pb.L1ValidatorRegistered{
+					ValidationId: l1Validator.ValidationID[:],
+					SubnetId:     l1Validator.SubnetID[:],
+					NodeId:       l1Validator.NodeID.Bytes(),
+					Weight:       l1Validator.Weight,
+				},
+			},
+		})
+	default:
+		if previous.Weight != l1Validator.Weight {
+			r.events = append(r.events, &pb.Event{
+				Event: &pb.Event_L1ValidatorWeightChanged{
+					L1ValidatorWeightChanged: &pb.L1ValidatorWeightChanged{
+						ValidationId:   l1Validator.ValidationID[:],
+						SubnetId:       l1Validator.SubnetID[:],
+						NodeId:         l1Validator.NodeID.Bytes(),
+						PreviousWeight: previous.Weight,
+						Weight:         l1Validator.Weight,
+					},
+				},
+			})
+		}
+		if previous.IsActive() && !l1Validator.IsActive() && l1Validator.Weight != 0 {
+			r.events = append(r.events, &pb.Event{
+				Event: &pb.Event_L1ValidatorDisabled{
+					L1ValidatorDisabled: &pb.L1ValidatorDisabled{
+						ValidationId: l1Validator.ValidationID[:],
+						SubnetId:     l1Validator.SubnetID[:],
+						NodeId:       l1Validator.NodeID.Bytes(),
+					},
+				},
+			})
+		}
+	}
+	return nil
+}
+
+func (r *Recorder) AddSubnet(subnetID ids.ID) {
+	r.Chain.AddSubnet(subnetID)
+	r.events = append(r.events, &pb.Event{
+		Event: &pb.Event_SubnetCreated{
+			SubnetCreated: &pb.SubnetCreated{
+				SubnetId: subnetID[:],
+			},
+		},
+	})
+}
+
+func (r *Recorder) SetSubnetToL1Conversion(subnetID ids.ID, c state.SubnetToL1Conversion) {
+	r.Chain.SetSubnetToL1Conversion(subnetID, c)
+	r.events = append(r.events, &pb.Event{
+		Event: &pb.Event_SubnetConverted{
+			SubnetConverted: &pb.SubnetConverted{
+				SubnetId:     subnetID[:],
+				ConversionId: c.ConversionID[:],
+				ChainId:      c.ChainID[:],
+				Address:      c.Addr,
+			},
+		},
+	})
+}
+
+func (r *Recorder) AddChain(createChainTx *txs.Tx) {
+	r.Chain.AddChain(createChainTx)
+
+	utx, ok := createChainTx.Unsigned.(*txs.CreateChainTx)
+	if !ok {
+		return
+	}
+	chainID := createChainTx.ID()
+	r.events = append(r.events, &pb.Event{
+		Event: &pb.Event_ChainCreated{
+			ChainCreated: &pb.ChainCreated{
+				ChainId:  chainID[:],
+				SubnetId: utx.SubnetID[:],
+				VmId:     utx.VMID[:],
+				Name:     utx.ChainName,
+			},
+		},
+	})
+}
+
+func (r *Recorder) stakerAdded(staker *state.Staker, delegator bool, pending bool) {
+	r.events = append(r.events, &pb.Event{
+		Event: &pb.Event_StakerAdded{
+			StakerAdded: &pb.StakerAdded{
+				Staker: newStaker(staker, delegator, pending),
+			},
+		},
+	})
+}
+
+func (r *Recorder) stakerRemoved(staker *state.Staker, delegator bool, pending bool) {
+	r.events = append(r.events, &pb.Event{
+		Event: &pb.Event_StakerRemoved{
+			StakerRemoved: &pb.StakerRemoved{
+				Staker: newStaker(staker, delegator, pending),
+			},
+		},
+	})
+}
+
+func newStaker(staker *state.Staker, delegator bool, pending bool) *pb.Staker {
+	return &pb.Staker{
+		TxId:      staker.TxID[:],
+		NodeId:    staker.NodeID.Bytes(),
+		SubnetId:  staker.SubnetID[:],
+		Weight:    staker.Weight,
+		StartTime: staker.StartTime.Unix(),
+		EndTime:   staker.EndTime.Unix(),
+		Delegator: delegator,
+		Pending:   pending,
+	}
+}
+
+// Accepted returns the events of accepting a block, starting with the
+// [pb.BlockAccepted] event followed by the [changes] made by the block.
+func Accepted(
+	blkID ids.ID,
+	parentID ids.ID,
+	height uint64,
+	timestamp time.Time,
+	txIDs []ids.ID,
+	changes []*pb.Event,
+) []*pb.Event {
+	txIDBytes := make([][]byte, len(txIDs))
+	for i, txID := range txIDs {
+		txIDBytes[i] = txID[:]
+	}
+
+	events := make([]*pb.Event, 0, len(changes)+1)
+	events = append(events, &pb.Event{
+		Event: &pb.Event_BlockAccepted{
+			BlockAccepted: &pb.BlockAccepted{
+				ParentId: parentID[:],
+				TxIds:    txIDBytes,
+			},
+		},
+	})
+	events = append(events, changes...)
+
+	unix := timestamp.Unix()
+	for _, event := range events {
+		event.BlockId = blkID[:]
+		event.Height = height
+		event.Timestamp = unix
+	}
+	return events
+}
@@ -0,0 +1,204 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state/statetest"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	pb "github.com/ava-labs/avalanchego/connectproto/pb/platformvm"
+)
+
+func TestRecorder(t *testing.T) {
+	require := require.New(t)
+
+	baseState := statetest.New(t, statetest.Config{})
+
+	var (
+		subnetID        = ids.GenerateTestID()
+		weightChanged   = newTestL1Validator(subnetID, 10)
+		disabled        = newTestL1Validator(subnetID, 10)
+		removed         = newTestL1Validator(subnetID, 10)
+		registered      = newTestL1Validator(subnetID, 5)
+		currentStaker   = newTestStaker(subnetID)
+		pendingStaker   = newTestStaker(subnetID)
+		conversion      = state.SubnetToL1Conversion{ConversionID: ids.GenerateTestID(), ChainID: ids.GenerateTestID(), Addr: []byte{1}}
+		createChainTx   = &txs.Tx{Unsigned: &txs.CreateChainTx{SubnetID: subnetID, ChainName: "chain", VMID: ids.GenerateTestID(), SubnetAuth: &secp256k1fx.Input{}}}
+		createdSubnetID = ids.GenerateTestID()
+	)
+	require.NoError(createChainTx.Initialize(txs.Codec))
+	currentStaker.NextTime = currentStaker.EndTime
+	currentStaker.Priority = txs.SubnetPermissionedValidatorCurrentPriority
+	for _, l1Validator := range []state.L1Validator{weightChanged, disabled, removed} {
+		require.NoError(baseState.PutL1Validator(l1Validator))
+	}
+	require.NoError(baseState.PutPendingValidator(pendingStaker))
+
+	d, err := state.NewDiffOn(baseState)
+	require.NoError(err)
+
+	weightChanged.Weight = 20
+	require.NoError(d.PutL1Validator(weightChanged))
+	disabled.EndAccumulatedFee = 0
+	require.NoError(d.PutL1Validator(disabled))
+	removed.Weight = 0
+	require.NoError(d.PutL1Validator(removed))
+	require.NoError(d.PutL1Validator(registered))
+	require.NoError(d.PutCurrentValidator(currentStaker))
+	d.DeletePendingValidator(pendingStaker)
+	d.AddSubnet(createdSubnetID)
+	d.SetSubnetToL1Conversion(subnetID, conversion)
+	d.AddChain(createChainTx)
+
+	recorder := NewRecorder(baseState)
+	require.NoError(d.Apply(recorder))
+
+	chainID := createChainTx.ID()
+	expected := []*pb.Event{
+		{Event: &pb.Event_L1ValidatorWeightChanged{L1ValidatorWeightChanged: &pb.L1ValidatorWeightChanged{
+			ValidationId:   weightChanged.ValidationID[:],
+			SubnetId:       subnetID[:],
+			NodeId:         weightChanged.NodeID.Bytes(),
+			PreviousWeight: 10,
+			Weight:         20,
+		}}},
+		{Event: &pb.Event_L1ValidatorDisabled{L1ValidatorDisabled: &pb.L1ValidatorDisabled{
+			ValidationId: disabled.ValidationID[:],
+			SubnetId:     subnetID[:],
+			NodeId:       disabled.NodeID.Bytes(),
+		}}},
+		{Event: &pb.Event_L1ValidatorWeightChanged{L1ValidatorWeightChanged: &pb.L1ValidatorWeightChanged{
+			ValidationId:   removed.ValidationID[:],
+			SubnetId:       subnetID[:],
+			NodeId:         removed.NodeID.Bytes(),
+			PreviousWeight: 10,
+			Weight:         0,
+		}}},
+		{Event: &pb.Event_L1ValidatorRegistered{L1ValidatorRegistered: &pb.L1ValidatorRegistered{
+			ValidationId: registered.ValidationID[:],
+			SubnetId:     subnetID[:],
+			NodeId:       registered.NodeID.Bytes(),
+			Weight:       5,
+		}}},
+		{Event: &pb.Event_StakerAdded{StakerAdded: &pb.StakerAdded{
+			Staker: &pb.Staker{
+				TxId:      currentStaker.TxID[:],
+				NodeId:    currentStaker.NodeID.Bytes(),
+				SubnetId:  subnetID[:],
+				Weight:    currentStaker.Weight,
+				StartTime: currentStaker.StartTime.Unix(),
+				EndTime:   currentStaker.EndTime.Unix(),
+			},
+		}}},
+		{Event: &pb.Event_StakerRemoved{StakerRemoved: &pb.StakerRemoved{
+			Staker: &pb.Staker{
+				TxId:      pendingStaker.TxID[:],
+				NodeId:    pendingStaker.NodeID.Bytes(),
+				SubnetId:  subnetID[:],
+				Weight:    pendingStaker.Weight,
+				StartTime: pendingStaker.StartTime.Unix(),
+				EndTime:   pendingStaker.EndTime.Unix(),
+				Pending:   true,
+			},
+		}}},
+		{Event: &pb.Event_SubnetCreated{SubnetCreated: &pb.SubnetCreated{
+			SubnetId: createdSubnetID[:],
+		}}},
+		{Event: &pb.Event_SubnetConverted{SubnetConverted: &pb.SubnetConverted{
+			SubnetId:     subnetID[:],
+			ConversionId: conversion.ConversionID[:],
+			ChainId:      conversion.ChainID[:],
+			Address:      conversion.Addr,
+		}}},
+		{Event: &pb.Event_ChainCreated{ChainCreated: &pb.ChainCreated{
+			ChainId:  chainID[:],
+			SubnetId: subnetID[:],
+			VmId:     createChainTx.Unsigned.(*txs.CreateChainTx).VMID[:],
+			Name:     "chain",
+		}}},
+	}
+	require.ElementsMatch(expected, recorder.Events())
+	require.Empty(recorder.Events())
+
+	// The changes must have been written to the wrapped chain.
+	l1Validator, err := baseState.GetL1Validator(weightChanged.ValidationID)
+	require.NoError(err)
+	require.Equal(weightChanged, l1Validator)
+	_, err = baseState.GetCurrentValidator(subnetID, currentStaker.NodeID)
+	require.NoError(err)
+}
+
+func TestAccepted(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		blkID     = ids.GenerateTestID()
+		parentID  = ids.GenerateTestID()
+		txID      = ids.GenerateTestID()
+		subnetID  = ids.GenerateTestID()
+		timestamp = time.Unix(1_000, 0)
+	)
+	events := Accepted(
+		blkID,
+		parentID,
+		5,
+		timestamp,
+		[]ids.ID{txID},
+		[]*pb.Event{
+			{Event: &pb.Event_SubnetCreated{SubnetCreated: &pb.SubnetCreated{SubnetId: subnetID[:]}}},
+		},
+	)
+	require.Equal(
+		[]*pb.Event{
+			{
+				BlockId:   blkID[:],
+				Height:    5,
+				Timestamp: 1_000,
+				Event: &pb.Event_BlockAccepted{BlockAccepted: &pb.BlockAccepted{
+					ParentId: parentID[:],
+					TxIds:    [][]byte{txID[:]},
+				}},
+			},
+			{
+				BlockId:   blkID[:],
+				Height:    5,
+				Timestamp: 1_000,
+				Event:     &pb.Event_SubnetCreated{SubnetCreated: &pb.SubnetCreated{SubnetId: subnetID[:]}},
+			},
+		},
+		events,
+	)
+}
+
+func newTestL1Validator(subnetID ids.ID, weight uint64) state.L1Validator {
+	return state.L1Validator{
+		ValidationID:      ids.GenerateTestID(),
+		SubnetID:          subnetID,
+		NodeID:            ids.GenerateTestNodeID(),
+		Weight:            weight,
+		EndAccumulatedFee: 1,
+	}
+}
+
+func newTestStaker(subnetID ids.ID) *state.Staker {
+	startTime := time.Unix(1_000, 0)
+	return &state.Staker{
+		TxID:      ids.GenerateTestID(),
+		NodeID:    ids.GenerateTestNodeID(),
+		SubnetID:  subnetID,
+		Weight:    1,
+		StartTime: startTime,
+		EndTime:   startTime.Add(time.Hour),
+		NextTime:  startTime,
+		Priority:  txs.SubnetPermissionedValidatorPendingPriority,
+	}
+}
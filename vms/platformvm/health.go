@@ -5,13 +5,27 @@ package platformvm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 )
 
+var errLowL1ValidatorBalance = errors.New("local L1 validator balance is low")
+
+// lowBalanceL1Validator is reported by the health check for an L1 validator of
+// this node that is projected to be deactivated soon.
+type lowBalanceL1Validator struct {
+	ValidationID     ids.ID    `json:"validationID"`
+	SubnetID         ids.ID    `json:"subnetID"`
+	Balance          uint64    `json:"balance"`
+	DeactivationTime time.Time `json:"deactivationTime"`
+}
+
 func (vm *VM) HealthCheck(context.Context) (interface{}, error) {
 	localPrimaryValidator, err := vm.state.GetCurrentValidator(
 		constants.PrimaryNetworkID,
@@ -40,5 +54,53 @@ func (vm *VM) HealthCheck(context.Context) (interface{}, error) {
 			return nil, fmt.Errorf("couldn't get current subnet validator of %q: %w", subnetID, err)
 		}
 	}
+
+	// Report the L1 validators of tracked subnets that are projected to be
+	// deactivated within the low balance threshold.
+	maxTime := vm.state.GetTimestamp().Add(vm.l1ValidatorLowBalanceThreshold)
+	deactivations, err := state.GetL1ValidatorDeactivations(
+		vm.ValidatorFeeConfig,
+		vm.state,
+		maxTime,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't project L1 validator deactivations: %w", err)
+	}
+
+	var (
+		numLowBalance = make(map[ids.ID]int, vm.TrackedSubnets.Len())
+		localLow      []lowBalanceL1Validator
+	)
+	for _, deactivation := range deactivations {
+		// Deactivations are sorted, so all remaining validators are projected
+		// to be active beyond the threshold.
+		if !deactivation.DeactivationTime.Before(maxTime) {
+			break
+		}
+		if !vm.TrackedSubnets.Contains(deactivation.SubnetID) {
+			continue
+		}
+
+		numLowBalance[deactivation.SubnetID]++
+		if deactivation.NodeID == vm.ctx.NodeID {
+			localLow = append(localLow, lowBalanceL1Validator{
+				ValidationID:     deactivation.ValidationID,
+				SubnetID:         deactivation.SubnetID,
+				Balance:          deactivation.Balance,
+				DeactivationTime: deactivation.DeactivationTime,
+			})
+		}
+	}
+	for subnetID := range vm.TrackedSubnets {
+		vm.metrics.SetLowBalanceL1Validators(subnetID, numLowBalance[subnetID])
+	}
+
+	if len(localLow) > 0 {
+		return localLow, fmt.Errorf("%w: %d validators projected to be deactivated before %s",
+			errLowL1ValidatorBalance,
+			len(localLow),
+			maxTime,
+		)
+	}
 	return nil, nil
 }
@@ -0,0 +1,84 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+)
+
+func TestHealthCheckLowL1ValidatorBalance(t *testing.T) {
+	require := require.New(t)
+
+	vm, _, _ := defaultVM(t, upgradetest.Latest)
+	vm.ctx.Lock.Lock()
+	defer vm.ctx.Lock.Unlock()
+
+	var (
+		subnetID          = ids.GenerateTestID()
+		untrackedSubnetID = ids.GenerateTestID()
+	)
+	vm.TrackedSubnets.Add(subnetID)
+	vm.l1ValidatorLowBalanceThreshold = time.Minute
+
+	// Validators that are not validated by this node, or are of untracked
+	// subnets, do not fail the health check.
+	localValidator := state.L1Validator{
+		ValidationID:      ids.GenerateTestID(),
+		SubnetID:          subnetID,
+		NodeID:            vm.ctx.NodeID,
+		Weight:            1,
+		EndAccumulatedFee: units.Avax,
+	}
+	for _, l1Validator := range []state.L1Validator{
+		localValidator,
+		{
+			ValidationID:      ids.GenerateTestID(),
+			SubnetID:          subnetID,
+			NodeID:            ids.GenerateTestNodeID(),
+			Weight:            1,
+			EndAccumulatedFee: 2,
+		},
+		{
+			ValidationID:      ids.GenerateTestID(),
+			SubnetID:          untrackedSubnetID,
+			NodeID:            vm.ctx.NodeID,
+			Weight:            1,
+			EndAccumulatedFee: 2,
+		},
+	} {
+		require.NoError(vm.state.PutL1Validator(l1Validator))
+	}
+
+	details, err := vm.HealthCheck(context.Background())
+	require.NoError(err)
+	require.Nil(details)
+
+	// Reducing the balance of this node's validator causes the health check
+	// to fail.
+	localValidator.EndAccumulatedFee = 20
+	require.NoError(vm.state.PutL1Validator(localValidator))
+
+	details, err = vm.HealthCheck(context.Background())
+	require.ErrorIs(err, errLowL1ValidatorBalance)
+	require.Equal(
+		[]lowBalanceL1Validator{
+			{
+				ValidationID:     localValidator.ValidationID,
+				SubnetID:         subnetID,
+				Balance:          20,
+				DeactivationTime: vm.state.GetTimestamp().Add(10 * time.Second),
+			},
+		},
+		details,
+	)
+}
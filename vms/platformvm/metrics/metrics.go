@@ -69,6 +69,9 @@ type Metrics interface {
 	SetTimeUntilUnstake(time.Duration)
 	// Mark when this node will unstake from a subnet.
 	SetTimeUntilSubnetUnstake(subnetID ids.ID, timeUntilUnstake time.Duration)
+	// Mark how many L1 validators of a subnet are projected to be deactivated
+	// soon.
+	SetLowBalanceL1Validators(subnetID ids.ID, numValidators int)
 }
 
 func New(registerer prometheus.Registerer) (Metrics, error) {
@@ -86,6 +89,13 @@ func New(registerer prometheus.Registerer) (Metrics, error) {
 			},
 			[]string{"subnetID"},
 		),
+		lowBalanceL1Validators: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "low_balance_l1_validators",
+				Help: "Number of the subnet's L1 validators projected to be deactivated within the low balance threshold",
+			},
+			[]string{"subnetID"},
+		),
 		localStake: prometheus.NewGauge(prometheus.GaugeOpts{
 			Name: "local_staked",
 			Help: "Amount (in nAVAX) of AVAX staked on this node",
@@ -151,6 +161,7 @@ func New(registerer prometheus.Registerer) (Metrics, error) {
 	errs.Add(
 		registerer.Register(m.timeUntilUnstake),
 		registerer.Register(m.timeUntilSubnetUnstake),
+		registerer.Register(m.lowBalanceL1Validators),
 		registerer.Register(m.localStake),
 		registerer.Register(m.totalStake),
 
@@ -178,6 +189,7 @@ type metrics struct {
 	// Staking metrics
 	timeUntilUnstake       prometheus.Gauge
 	timeUntilSubnetUnstake *prometheus.GaugeVec
+	lowBalanceL1Validators *prometheus.GaugeVec
 	localStake             prometheus.Gauge
 	totalStake             prometheus.Gauge
 
@@ -240,3 +252,7 @@ func (m *metrics) SetTimeUntilUnstake(timeUntilUnstake time.Duration) {
 func (m *metrics) SetTimeUntilSubnetUnstake(subnetID ids.ID, timeUntilUnstake time.Duration) {
 	m.timeUntilSubnetUnstake.WithLabelValues(subnetID.String()).Set(float64(timeUntilUnstake))
 }
+
+func (m *metrics) SetLowBalanceL1Validators(subnetID ids.ID, numValidators int) {
+	m.lowBalanceL1Validators.WithLabelValues(subnetID.String()).Set(float64(numValidators))
+}
@@ -46,6 +46,8 @@ func (noopMetrics) SetTimeUntilUnstake(time.Duration) {}
 
 func (noopMetrics) SetTimeUntilSubnetUnstake(ids.ID, time.Duration) {}
 
+func (noopMetrics) SetLowBalanceL1Validators(ids.ID, int) {}
+
 func (noopMetrics) SetSubnetPercentConnected(ids.ID, float64) {}
 
 func (noopMetrics) SetPercentConnected(float64) {}
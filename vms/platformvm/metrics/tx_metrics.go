@@ -173,3 +173,10 @@ func (m *txMetrics) DisableL1ValidatorTx(*txs.DisableL1ValidatorTx) error {
 	}).Inc()
 	return nil
 }
+
+func (m *txMetrics) BatchL1ValidatorTx(*txs.BatchL1ValidatorTx) error {
+	m.numTxs.With(prometheus.Labels{
+		txLabel: "batch_l1_validator",
+	}).Inc()
+	return nil
+}
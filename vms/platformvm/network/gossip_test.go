@@ -13,6 +13,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/snowtest"
 	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -38,6 +39,7 @@ func TestGossipMempoolAddVerificationError(t *testing.T) {
 		gas.Dimensions{},
 		1_000_000,
 		ids.ID{},
+		&mockable.Clock{},
 		prometheus.NewRegistry(),
 	)
 	require.NoError(err)
@@ -68,6 +70,7 @@ func TestMempoolDuplicate(t *testing.T) {
 		gas.Dimensions{1, 1, 1, 1},
 		1_000_000,
 		snowtest.AVAXAssetID,
+		&mockable.Clock{},
 		prometheus.NewRegistry(),
 	)
 	require.NoError(err)
@@ -144,6 +147,7 @@ func TestGossipAddBloomFilter(t *testing.T) {
 		gas.Dimensions{1, 1, 1, 1},
 		1_000_000,
 		snowtest.AVAXAssetID,
+		&mockable.Clock{},
 		prometheus.NewRegistry(),
 	)
 	require.NoError(err)
@@ -19,6 +19,8 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/version"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -38,6 +40,7 @@ type Network struct {
 	txPullGossiper        gossip.Gossiper
 	txPullGossipFrequency time.Duration
 	peers                 *p2p.Peers
+	peerTracker           *p2p.PeerTracker
 }
 
 func New(
@@ -75,7 +78,28 @@ func New(
 	}
 
 	marshaller := txMarshaller{}
-	txGossipClient := p2pNetwork.NewClient(p2p.TxGossipHandlerID, validators)
+	// The peer tracker only tracks the response latencies used to hedge slow
+	// pull gossip requests.
+	peerTracker, err := p2p.NewPeerTracker(
+		log,
+		"tx_gossip_peer_tracker",
+		registerer,
+		set.Of(nodeID),
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize peer tracker: %w", err)
+	}
+	txGossipClient := p2pNetwork.NewClient(
+		p2p.TxGossipHandlerID,
+		validators,
+		p2p.WithRetries(config.PullGossipMaxRetries),
+		p2p.WithHedging(
+			peerTracker,
+			config.PullGossipHedgingPercentile,
+			config.PullGossipHedgingDelay,
+		),
+	)
 	txGossipMetrics, err := gossip.NewMetrics(registerer, "tx")
 	if err != nil {
 		return nil, err
@@ -192,9 +216,20 @@ func New(
 		txPullGossiper:            txPullGossiper,
 		txPullGossipFrequency:     config.PullGossipFrequency,
 		peers:                     peers,
+		peerTracker:               peerTracker,
 	}, nil
 }
 
+func (n *Network) Connected(ctx context.Context, nodeID ids.NodeID, nodeVersion *version.Application) error {
+	n.peerTracker.Connected(nodeID, nodeVersion)
+	return n.Network.Connected(ctx, nodeID, nodeVersion)
+}
+
+func (n *Network) Disconnected(ctx context.Context, nodeID ids.NodeID) error {
+	n.peerTracker.Disconnected(nodeID)
+	return n.Network.Disconnected(ctx, nodeID)
+}
+
 func (n *Network) PushGossip(ctx context.Context) {
 	gossip.Every(ctx, n.log, n.txPushGossiper, n.txPushGossipFrequency)
 }
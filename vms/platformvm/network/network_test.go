@@ -16,6 +16,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/engine/common/commonmock"
 	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
@@ -43,6 +44,9 @@ var (
 		PullGossipFrequency:                         time.Second,
 		PullGossipThrottlingPeriod:                  time.Second,
 		PullGossipRequestsPerValidator:              1,
+		PullGossipMaxRetries:                        1,
+		PullGossipHedgingPercentile:                 .9,
+		PullGossipHedgingDelay:                      time.Second,
 		ExpectedBloomFilterElements:                 10,
 		ExpectedBloomFilterFalsePositiveProbability: .1,
 		MaxBloomFilterFalsePositiveProbability:      .5,
@@ -78,6 +82,7 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 					gas.Dimensions{1, 1, 1, 1},
 					1_000_000,
 					snowtest.AVAXAssetID,
+					&mockable.Clock{},
 					prometheus.NewRegistry(),
 				)
 				require.NoError(t, err)
@@ -128,6 +133,7 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 					gas.Dimensions{1, 1, 1, 1},
 					1_000_000,
 					snowtest.AVAXAssetID,
+					&mockable.Clock{},
 					prometheus.NewRegistry(),
 				)
 				require.NoError(t, err)
@@ -164,6 +170,7 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 					gas.Dimensions{1, 1, 1, 1},
 					1_000_000,
 					snowtest.AVAXAssetID,
+					&mockable.Clock{},
 					prometheus.NewRegistry(),
 				)
 				require.NoError(t, err)
@@ -200,6 +207,7 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 					gas.Dimensions{1, 1, 1, 1},
 					1_000_000,
 					snowtest.AVAXAssetID,
+					&mockable.Clock{},
 					prometheus.NewRegistry(),
 				)
 				require.NoError(t, err)
@@ -258,6 +266,7 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 					gas.Dimensions{1, 1, 1, 1},
 					0,
 					snowtest.AVAXAssetID,
+					&mockable.Clock{},
 					prometheus.NewRegistry(),
 				)
 				require.NoError(t, err)
@@ -296,6 +305,7 @@ func TestNetworkIssueTxFromRPC(t *testing.T) {
 					gas.Dimensions{1, 1, 1, 1},
 					1_000_000,
 					snowtest.AVAXAssetID,
+					&mockable.Clock{},
 					prometheus.NewRegistry(),
 				)
 				require.NoError(t, err)
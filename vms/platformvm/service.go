@@ -4,6 +4,7 @@
 package platformvm
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -11,6 +12,7 @@ import (
 	"maps"
 	"math"
 	"net/http"
+	"reflect"
 	"slices"
 	"time"
 
@@ -33,6 +35,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakingindex"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -44,6 +47,9 @@ import (
 	avajson "github.com/ava-labs/avalanchego/utils/json"
 	safemath "github.com/ava-labs/avalanchego/utils/math"
 	platformapi "github.com/ava-labs/avalanchego/vms/platformvm/api"
+	blockexecutor "github.com/ava-labs/avalanchego/vms/platformvm/block/executor"
+	txexecutor "github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
+	txfee "github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 )
 
 const (
@@ -56,6 +62,10 @@ const (
 	// Max number of items allowed in a page
 	maxPageSize = 1024
 
+	// l1ValidatorDeactivationHorizon is the furthest in the future that L1
+	// validator deactivations are projected.
+	l1ValidatorDeactivationHorizon = 365 * 24 * time.Hour
+
 	// Note: Staker attributes cache should be large enough so that no evictions
 	// happen when the API loops through all stakers.
 	stakerAttributesCacheSize = 100_000
@@ -66,6 +76,13 @@ var (
 	errPrimaryNetworkIsNotASubnet = errors.New("the primary network isn't a subnet")
 	errNoAddresses                = errors.New("no addresses provided")
 	errMissingBlockchainID        = errors.New("argument 'blockchainID' not given")
+	errMissingComplexity          = errors.New("either 'tx' or 'complexity' must be given")
+	errDynamicFeesNotActivated    = errors.New("dynamic fees are not activated")
+	errStakingIndexDisabled       = errors.New("the staking index is disabled")
+	errInvalidDelegationShares    = errors.New("invalid delegation shares")
+	errInvalidStakeDuration       = errors.New("invalid stake duration")
+	errInvalidSupply              = errors.New("invalid supply")
+	errInvalidHeightRange         = errors.New("invalid height range")
 )
 
 // Service defines the API calls that can be made to the platform chain
@@ -1064,6 +1081,78 @@ func (s *Service) convertL1ValidatorToAPI(vdr state.L1Validator) (platformapi.AP
 	return apiVdr, nil
 }
 
+type GetL1ValidatorDeactivationsArgs struct {
+	// SubnetID, if non-empty, restricts the reply to L1 validators of the
+	// provided subnet.
+	SubnetID ids.ID `json:"subnetID"`
+}
+
+// L1ValidatorDeactivation is the projected deactivation of an active L1
+// validator.
+type L1ValidatorDeactivation struct {
+	ValidationID ids.ID         `json:"validationID"`
+	SubnetID     ids.ID         `json:"subnetID"`
+	NodeID       ids.NodeID     `json:"nodeID"`
+	Balance      avajson.Uint64 `json:"balance"`
+	// DeactivationTime is omitted if the validator is not projected to be
+	// deactivated within [l1ValidatorDeactivationHorizon].
+	DeactivationTime *time.Time `json:"deactivationTime,omitempty"`
+}
+
+type GetL1ValidatorDeactivationsReply struct {
+	// Deactivations are sorted by increasing deactivation time.
+	Deactivations []L1ValidatorDeactivation `json:"deactivations"`
+	// Time is the chain time the projections were made from.
+	Time time.Time `json:"timestamp"`
+}
+
+// GetL1ValidatorDeactivations returns the remaining balance and projected
+// deactivation time of every active L1 validator, assuming the current
+// validator fee state is maintained.
+func (s *Service) GetL1ValidatorDeactivations(_ *http.Request, args *GetL1ValidatorDeactivationsArgs, reply *GetL1ValidatorDeactivationsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getL1ValidatorDeactivations"),
+		zap.Stringer("subnetID", args.SubnetID),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	var (
+		currentTime = s.vm.state.GetTimestamp()
+		maxTime     = currentTime.Add(l1ValidatorDeactivationHorizon)
+	)
+	deactivations, err := state.GetL1ValidatorDeactivations(
+		s.vm.ValidatorFeeConfig,
+		s.vm.state,
+		maxTime,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to project L1 validator deactivations: %w", err)
+	}
+
+	reply.Deactivations = make([]L1ValidatorDeactivation, 0, len(deactivations))
+	for _, deactivation := range deactivations {
+		if args.SubnetID != ids.Empty && deactivation.SubnetID != args.SubnetID {
+			continue
+		}
+
+		apiDeactivation := L1ValidatorDeactivation{
+			ValidationID: deactivation.ValidationID,
+			SubnetID:     deactivation.SubnetID,
+			NodeID:       deactivation.NodeID,
+			Balance:      avajson.Uint64(deactivation.Balance),
+		}
+		if deactivation.DeactivationTime.Before(maxTime) {
+			apiDeactivation.DeactivationTime = &deactivation.DeactivationTime
+		}
+		reply.Deactivations = append(reply.Deactivations, apiDeactivation)
+	}
+	reply.Time = currentTime
+	return nil
+}
+
 // GetCurrentSupplyArgs are the arguments for calling GetCurrentSupply
 type GetCurrentSupplyArgs struct {
 	SubnetID ids.ID `json:"subnetID"`
@@ -1101,6 +1190,112 @@ func (s *Service) GetCurrentSupply(r *http.Request, args *GetCurrentSupplyArgs,
 	return nil
 }
 
+// CalculateRewardArgs are the arguments for calling CalculateReward
+type CalculateRewardArgs struct {
+	// SubnetID is the subnet whose reward config is used. If omitted, defaults
+	// to the primary network.
+	SubnetID ids.ID `json:"subnetID"`
+	// StakeAmount is the amount staked.
+	StakeAmount avajson.Uint64 `json:"stakeAmount"`
+	// StartTime is the unix timestamp, in seconds, that the staking period
+	// starts at. If omitted, defaults to the current chain time. It only
+	// determines the returned EndTime, as the reward doesn't depend on when
+	// the staking period starts.
+	StartTime avajson.Uint64 `json:"startTime"`
+	// Duration is the length of the staking period, in seconds.
+	Duration avajson.Uint64 `json:"duration"`
+	// CurrentSupply is the supply the reward is calculated from. If omitted,
+	// defaults to the subnet's current supply.
+	CurrentSupply *avajson.Uint64 `json:"currentSupply"`
+	// DelegationShares is the portion of a delegator's reward that is paid to
+	// the validator, in units of [reward.PercentDenominator]. It is the
+	// DelegationShares of the validator's tx.
+	DelegationShares avajson.Uint32 `json:"delegationShares"`
+}
+
+// CalculateRewardReply are the results from calling CalculateReward
+type CalculateRewardReply struct {
+	StartTime     avajson.Uint64 `json:"startTime"`
+	EndTime       avajson.Uint64 `json:"endTime"`
+	CurrentSupply avajson.Uint64 `json:"currentSupply"`
+	// PotentialReward is the reward of the staker if it is rewarded.
+	PotentialReward avajson.Uint64 `json:"potentialReward"`
+	// If the staker is a delegator, DelegationFeeReward is the portion of
+	// [PotentialReward] paid to the validator and DelegatorReward is the
+	// portion paid to the delegator.
+	DelegationFeeReward avajson.Uint64 `json:"delegationFeeReward"`
+	DelegatorReward     avajson.Uint64 `json:"delegatorReward"`
+	// RewardConfig is the config the reward was calculated with.
+	RewardConfig reward.Config `json:"rewardConfig"`
+}
+
+// CalculateReward returns the potential reward of a staker using the reward
+// config of the chain.
+func (s *Service) CalculateReward(_ *http.Request, args *CalculateRewardArgs, reply *CalculateRewardReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "calculateReward"),
+		zap.Stringer("subnetID", args.SubnetID),
+	)
+
+	if args.DelegationShares > reward.PercentDenominator {
+		return fmt.Errorf("%w: %d > %d", errInvalidDelegationShares, args.DelegationShares, reward.PercentDenominator)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	rewardConfig, err := txexecutor.GetRewardConfig(&s.vm.Internal, s.vm.state, args.SubnetID)
+	if err != nil {
+		return fmt.Errorf("fetching reward config failed: %w", err)
+	}
+
+	duration := time.Duration(args.Duration) * time.Second
+	if duration <= 0 || duration > rewardConfig.MintingPeriod {
+		return fmt.Errorf("%w: duration must be in (0, %d] seconds",
+			errInvalidStakeDuration,
+			uint64(rewardConfig.MintingPeriod/time.Second),
+		)
+	}
+
+	var currentSupply uint64
+	if args.CurrentSupply != nil {
+		currentSupply = uint64(*args.CurrentSupply)
+	} else {
+		currentSupply, err = s.vm.state.GetCurrentSupply(args.SubnetID)
+		if err != nil {
+			return fmt.Errorf("fetching current supply failed: %w", err)
+		}
+	}
+	if currentSupply == 0 || currentSupply > rewardConfig.SupplyCap {
+		return fmt.Errorf("%w: supply must be in (0, %d]",
+			errInvalidSupply,
+			rewardConfig.SupplyCap,
+		)
+	}
+
+	startTime := uint64(args.StartTime)
+	if startTime == 0 {
+		startTime = uint64(s.vm.state.GetTimestamp().Unix())
+	}
+
+	potentialReward := reward.NewCalculator(rewardConfig).Calculate(
+		duration,
+		uint64(args.StakeAmount),
+		currentSupply,
+	)
+	delegationFeeReward, delegatorReward := reward.Split(potentialReward, uint32(args.DelegationShares))
+
+	reply.StartTime = avajson.Uint64(startTime)
+	reply.EndTime = avajson.Uint64(startTime) + args.Duration
+	reply.CurrentSupply = avajson.Uint64(currentSupply)
+	reply.PotentialReward = avajson.Uint64(potentialReward)
+	reply.DelegationFeeReward = avajson.Uint64(delegationFeeReward)
+	reply.DelegatorReward = avajson.Uint64(delegatorReward)
+	reply.RewardConfig = rewardConfig
+	return nil
+}
+
 // SampleValidatorsArgs are the arguments for calling SampleValidators
 type SampleValidatorsArgs struct {
 	// Number of validators in the sample
@@ -1429,6 +1624,118 @@ func (s *Service) IssueTx(_ *http.Request, args *api.FormattedTx, response *api.
 	return nil
 }
 
+// SimulateTxReply is the response from calling SimulateTx
+type SimulateTxReply struct {
+	// TxID is the ID of the transaction. It is [ids.Empty] if the transaction
+	// is unsigned.
+	TxID ids.ID `json:"txID"`
+	// Fee is the fee the transaction must pay.
+	Fee avajson.Uint64 `json:"fee"`
+	// Consumed are the IDs of the UTXOs the transaction consumes.
+	Consumed []ids.ID `json:"consumed"`
+	// Produced are the UTXOs the transaction produces. If the transaction is
+	// unsigned, their IDs differ from the UTXOs of the signed transaction.
+	Produced []string `json:"produced"`
+	// StakerChanges are the stakers the transaction adds or removes.
+	StakerChanges []SimulatedStakerChange `json:"stakerChanges"`
+	// L1Validators are the L1 validators the transaction modifies.
+	L1Validators []platformapi.APIL1Validator `json:"l1Validators"`
+	// Error is the reason the transaction is invalid, if any.
+	Error    string              `json:"error,omitempty"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// SimulatedStakerChange is the addition or removal of a staker.
+type SimulatedStakerChange struct {
+	Removed   bool           `json:"removed"`
+	Pending   bool           `json:"pending"`
+	Delegator bool           `json:"delegator"`
+	TxID      ids.ID         `json:"txID"`
+	NodeID    ids.NodeID     `json:"nodeID"`
+	SubnetID  ids.ID         `json:"subnetID"`
+	Weight    avajson.Uint64 `json:"weight"`
+	StartTime avajson.Uint64 `json:"startTime"`
+	EndTime   avajson.Uint64 `json:"endTime"`
+}
+
+// SimulateTx executes a signed or unsigned transaction against the preferred
+// state without issuing it.
+func (s *Service) SimulateTx(_ *http.Request, args *api.FormattedTx, reply *SimulateTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "simulateTx"),
+	)
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+
+	// Unsigned transactions are simulated without verifying their
+	// credentials.
+	verifyCredentials := true
+	tx, err := txs.Parse(txs.Codec, txBytes)
+	if err != nil {
+		var unsignedTx txs.UnsignedTx
+		if _, err := txs.Codec.Unmarshal(txBytes, &unsignedTx); err != nil {
+			return fmt.Errorf("couldn't parse tx: %w", err)
+		}
+		tx = &txs.Tx{Unsigned: unsignedTx}
+		verifyCredentials = false
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	simulation, err := s.vm.manager.SimulateTx(tx, verifyCredentials)
+	if err != nil {
+		return fmt.Errorf("couldn't simulate tx: %w", err)
+	}
+
+	if verifyCredentials {
+		reply.TxID = tx.ID()
+	}
+	reply.Fee = avajson.Uint64(simulation.Fee)
+	reply.Consumed = simulation.Consumed
+	reply.Produced = make([]string, len(simulation.Produced))
+	for i, utxo := range simulation.Produced {
+		bytes, err := txs.Codec.Marshal(txs.CodecVersion, utxo)
+		if err != nil {
+			return fmt.Errorf("couldn't serialize UTXO %q: %w", utxo.InputID(), err)
+		}
+		reply.Produced[i], err = formatting.Encode(args.Encoding, bytes)
+		if err != nil {
+			return fmt.Errorf("couldn't encode UTXO %s as %s: %w", utxo.InputID(), args.Encoding, err)
+		}
+	}
+	reply.StakerChanges = make([]SimulatedStakerChange, len(simulation.StakerChanges))
+	for i, change := range simulation.StakerChanges {
+		reply.StakerChanges[i] = SimulatedStakerChange{
+			Removed:   change.Removed,
+			Pending:   change.Pending,
+			Delegator: change.Staker.Priority.IsDelegator(),
+			TxID:      change.Staker.TxID,
+			NodeID:    change.Staker.NodeID,
+			SubnetID:  change.Staker.SubnetID,
+			Weight:    avajson.Uint64(change.Staker.Weight),
+			StartTime: avajson.Uint64(change.Staker.StartTime.Unix()),
+			EndTime:   avajson.Uint64(change.Staker.EndTime.Unix()),
+		}
+	}
+	reply.L1Validators = make([]platformapi.APIL1Validator, len(simulation.L1Validators))
+	for i, l1Validator := range simulation.L1Validators {
+		reply.L1Validators[i], err = s.convertL1ValidatorToAPI(l1Validator)
+		if err != nil {
+			return err
+		}
+	}
+	if simulation.Err != nil {
+		reply.Error = simulation.Err.Error()
+	}
+	reply.Encoding = args.Encoding
+	return nil
+}
+
 func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, response *api.GetTxReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
@@ -1528,6 +1835,88 @@ func (s *Service) GetTxStatus(_ *http.Request, args *GetTxStatusArgs, response *
 	return nil
 }
 
+type GetMempoolArgs struct {
+	// DroppedTxIDs are reported in the reply if they were recently dropped
+	// from the mempool.
+	DroppedTxIDs []ids.ID `json:"droppedTxIDs"`
+}
+
+// MempoolTx describes a tx in the mempool.
+type MempoolTx struct {
+	TxID ids.ID         `json:"txID"`
+	Type string         `json:"type"`
+	Size avajson.Uint64 `json:"size"`
+	// Gas is the amount of gas the tx uses.
+	Gas gas.Gas `json:"gas"`
+	// GasPrice is the amount of AVAX the tx burns per unit of gas.
+	GasPrice avajson.Float64 `json:"gasPrice"`
+	// Fee is the amount of AVAX the tx burns.
+	Fee avajson.Uint64 `json:"fee"`
+	// Added is when the tx was added to the mempool.
+	Added time.Time `json:"added"`
+	// Age is the number of seconds since the tx was added to the mempool.
+	Age avajson.Uint64 `json:"age"`
+}
+
+// DroppedTx is a tx that was recently dropped from the mempool.
+type DroppedTx struct {
+	TxID   ids.ID `json:"txID"`
+	Reason string `json:"reason"`
+}
+
+type GetMempoolReply struct {
+	// Txs are sorted in the order they are included into blocks, by
+	// decreasing gas price.
+	Txs []MempoolTx `json:"txs"`
+	// Dropped are the requested txs that were recently dropped.
+	Dropped []DroppedTx `json:"dropped"`
+}
+
+// GetMempool returns the txs in the mempool and why the requested txs were
+// dropped.
+func (s *Service) GetMempool(_ *http.Request, args *GetMempoolArgs, reply *GetMempoolReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getMempool"),
+		zap.Int("numDroppedTxIDs", len(args.DroppedTxIDs)),
+	)
+
+	if len(args.DroppedTxIDs) > maxPageSize {
+		return fmt.Errorf("%d droppedTxIDs provided but the limit is %d", len(args.DroppedTxIDs), maxPageSize)
+	}
+
+	var (
+		now        = s.vm.clock.Time()
+		mempoolTxs = s.vm.Builder.Txs()
+	)
+	reply.Txs = make([]MempoolTx, len(mempoolTxs))
+	for i, tx := range mempoolTxs {
+		reply.Txs[i] = MempoolTx{
+			TxID:     tx.Tx.ID(),
+			Type:     reflect.TypeOf(tx.Tx.Unsigned).Elem().Name(),
+			Size:     avajson.Uint64(len(tx.Tx.Bytes())),
+			Gas:      tx.GasUsed,
+			GasPrice: avajson.Float64(tx.GasPrice),
+			Fee:      avajson.Uint64(tx.Burned),
+			Added:    tx.Added,
+			Age:      avajson.Uint64(now.Sub(tx.Added) / time.Second),
+		}
+	}
+
+	reply.Dropped = []DroppedTx{}
+	for _, txID := range args.DroppedTxIDs {
+		reason := s.vm.Builder.GetDropReason(txID)
+		if reason == nil {
+			continue
+		}
+		reply.Dropped = append(reply.Dropped, DroppedTx{
+			TxID:   txID,
+			Reason: reason.Error(),
+		})
+	}
+	return nil
+}
+
 type GetStakeArgs struct {
 	api.JSONAddresses
 	ValidatorsOnly bool                `json:"validatorsOnly"`
@@ -1762,6 +2151,120 @@ func (s *Service) GetRewardUTXOs(_ *http.Request, args *api.GetTxArgs, reply *Ge
 	return nil
 }
 
+// GetStakingHistoryArgs are the arguments for calling GetStakingHistory
+type GetStakingHistoryArgs struct {
+	// NodeID, if non-empty, selects the periods of the node.
+	NodeID ids.NodeID `json:"nodeID"`
+	// RewardAddress, if non-empty, selects the periods with the reward
+	// address.
+	RewardAddress string `json:"rewardAddress"`
+	// StartTime and EndTime select the periods that ended within [StartTime,
+	// EndTime]. If EndTime is 0, the range is unbounded.
+	StartTime avajson.Uint64 `json:"startTime"`
+	// StartTxID skips the periods that ended at StartTime and were added by a
+	// transaction with a lower ID. It is used to fetch the pages after the
+	// first one.
+	StartTxID ids.ID         `json:"startTxID"`
+	EndTime   avajson.Uint64 `json:"endTime"`
+	Limit     avajson.Uint32 `json:"limit"`
+}
+
+// StakingPeriod is a completed validation or delegation period.
+type StakingPeriod struct {
+	TxID            ids.ID         `json:"txID"`
+	NodeID          ids.NodeID     `json:"nodeID"`
+	SubnetID        ids.ID         `json:"subnetID"`
+	Delegator       bool           `json:"delegator"`
+	StartTime       avajson.Uint64 `json:"startTime"`
+	EndTime         avajson.Uint64 `json:"endTime"`
+	Weight          avajson.Uint64 `json:"weight"`
+	PotentialReward avajson.Uint64 `json:"potentialReward"`
+	Rewarded        bool           `json:"rewarded"`
+	Reward          avajson.Uint64 `json:"reward"`
+	RewardAddresses []string       `json:"rewardAddresses"`
+	Height          avajson.Uint64 `json:"height"`
+}
+
+// GetStakingHistoryReply is the response from calling GetStakingHistory
+type GetStakingHistoryReply struct {
+	// Periods are sorted by end time and then by transaction ID.
+	Periods []StakingPeriod `json:"periods"`
+	// NextStartTime and NextStartTxID are the StartTime and StartTxID of the
+	// next page. They are omitted on the last page.
+	NextStartTime *avajson.Uint64 `json:"nextStartTime,omitempty"`
+	NextStartTxID *ids.ID         `json:"nextStartTxID,omitempty"`
+}
+
+// GetStakingHistory returns the completed staking periods recorded by the
+// staking index.
+func (s *Service) GetStakingHistory(_ *http.Request, args *GetStakingHistoryArgs, reply *GetStakingHistoryReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getStakingHistory"),
+	)
+
+	if s.vm.stakingIndex == nil {
+		return errStakingIndexDisabled
+	}
+
+	query := stakingindex.Query{
+		NodeID:    args.NodeID,
+		StartTime: uint64(args.StartTime),
+		StartTxID: args.StartTxID,
+		EndTime:   uint64(args.EndTime),
+		Limit:     int(args.Limit),
+	}
+	if args.RewardAddress != "" {
+		var err error
+		query.Address, err = avax.ParseServiceAddress(s.addrManager, args.RewardAddress)
+		if err != nil {
+			return fmt.Errorf("couldn't parse reward address %q: %w", args.RewardAddress, err)
+		}
+	}
+	if query.Limit <= 0 || maxPageSize < query.Limit {
+		query.Limit = maxPageSize
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	periods, next, err := s.vm.stakingIndex.Get(query)
+	if err != nil {
+		return fmt.Errorf("couldn't get staking history: %w", err)
+	}
+	if next != nil {
+		nextStartTime := avajson.Uint64(next.EndTime)
+		reply.NextStartTime = &nextStartTime
+		reply.NextStartTxID = &next.TxID
+	}
+
+	reply.Periods = make([]StakingPeriod, len(periods))
+	for i, period := range periods {
+		rewardAddresses := make([]string, len(period.RewardAddresses))
+		for j, addr := range period.RewardAddresses {
+			rewardAddresses[j], err = s.addrManager.FormatLocalAddress(addr)
+			if err != nil {
+				return fmt.Errorf("couldn't format reward address %s: %w", addr, err)
+			}
+		}
+		reply.Periods[i] = StakingPeriod{
+			TxID:            period.TxID,
+			NodeID:          period.NodeID,
+			SubnetID:        period.SubnetID,
+			Delegator:       period.Delegator,
+			StartTime:       avajson.Uint64(period.StartTime),
+			EndTime:         avajson.Uint64(period.EndTime),
+			Weight:          avajson.Uint64(period.Weight),
+			PotentialReward: avajson.Uint64(period.PotentialReward),
+			Rewarded:        period.Rewarded,
+			Reward:          avajson.Uint64(period.Reward),
+			RewardAddresses: rewardAddresses,
+			Height:          avajson.Uint64(period.Height),
+		}
+	}
+	return nil
+}
+
 // GetTimestampReply is the response from GetTimestamp
 type GetTimestampReply struct {
 	// Current timestamp
@@ -1930,6 +2433,221 @@ func (s *Service) GetValidatorsAt(r *http.Request, args *GetValidatorsAtArgs, re
 	return nil
 }
 
+const (
+	validatorAdded    = "added"
+	validatorRemoved  = "removed"
+	validatorModified = "modified"
+)
+
+// GetValidatorSetDiffArgs are the arguments for GetValidatorSetDiff
+type GetValidatorSetDiffArgs struct {
+	SubnetID   ids.ID         `json:"subnetID"`
+	FromHeight avajson.Uint64 `json:"fromHeight"`
+	ToHeight   avajson.Uint64 `json:"toHeight"`
+	// StartNodeID is the smallest node ID to report the change of. It is used
+	// to fetch the pages after the first one.
+	StartNodeID ids.NodeID     `json:"startNodeID"`
+	Limit       avajson.Uint32 `json:"limit"`
+}
+
+// ValidatorSetChange describes how a validator changed between two heights.
+// The previous values of an added validator and the current values of a
+// removed validator are empty.
+type ValidatorSetChange struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	// Type is one of "added", "removed" or "modified".
+	Type              string         `json:"type"`
+	PreviousWeight    avajson.Uint64 `json:"previousWeight"`
+	Weight            avajson.Uint64 `json:"weight"`
+	PreviousPublicKey *string        `json:"previousPublicKey"`
+	PublicKey         *string        `json:"publicKey"`
+}
+
+// GetValidatorSetDiffReply is the response from GetValidatorSetDiff
+type GetValidatorSetDiffReply struct {
+	// Changes are sorted by node ID.
+	Changes []ValidatorSetChange `json:"changes"`
+	// NextNodeID is the StartNodeID of the next page. It is omitted on the
+	// last page.
+	NextNodeID *ids.NodeID `json:"nextNodeID,omitempty"`
+}
+
+// GetValidatorSetDiff returns the changes made to the validator set of a
+// subnet between two heights.
+func (s *Service) GetValidatorSetDiff(r *http.Request, args *GetValidatorSetDiffArgs, reply *GetValidatorSetDiffReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getValidatorSetDiff"),
+		zap.Stringer("subnetID", args.SubnetID),
+		zap.Uint64("fromHeight", uint64(args.FromHeight)),
+		zap.Uint64("toHeight", uint64(args.ToHeight)),
+	)
+
+	fromHeight, toHeight := uint64(args.FromHeight), uint64(args.ToHeight)
+	if fromHeight > toHeight {
+		return fmt.Errorf("%w: fromHeight (%d) > toHeight (%d)", errInvalidHeightRange, fromHeight, toHeight)
+	}
+
+	limit := int(args.Limit)
+	if limit <= 0 || maxPageSize < limit {
+		limit = maxPageSize
+	}
+
+	// The lock is only held to read the current validator set. The diffs of
+	// accepted heights are never modified, so they are applied without the
+	// lock.
+	ctx := r.Context()
+	s.vm.ctx.Lock.Lock()
+	currentHeight, err := s.vm.GetCurrentHeight(ctx)
+	currentValidators := s.vm.Validators.GetMap(args.SubnetID)
+	s.vm.ctx.Lock.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to get current height: %w", err)
+	}
+	if toHeight > currentHeight {
+		return fmt.Errorf("%w: toHeight (%d) > current height (%d)", errInvalidHeightRange, toHeight, currentHeight)
+	}
+
+	// Both validator sets are rebuilt by a single pass over the diffs from
+	// the current height down to [fromHeight].
+	toValidators, err := s.applyValidatorDiffs(ctx, currentValidators, currentHeight, toHeight, args.SubnetID)
+	if err != nil {
+		return err
+	}
+	fromValidators, err := s.applyValidatorDiffs(ctx, toValidators, toHeight, fromHeight, args.SubnetID)
+	if err != nil {
+		return err
+	}
+
+	changes, err := validatorSetChanges(fromValidators, toValidators)
+	if err != nil {
+		return err
+	}
+
+	start, _ := slices.BinarySearchFunc(changes, args.StartNodeID, func(change ValidatorSetChange, nodeID ids.NodeID) int {
+		return change.NodeID.Compare(nodeID)
+	})
+	changes = changes[start:]
+	if len(changes) > limit {
+		nextNodeID := changes[limit].NodeID
+		reply.NextNodeID = &nextNodeID
+		changes = changes[:limit]
+	}
+	reply.Changes = changes
+	return nil
+}
+
+// applyValidatorDiffs returns the validator set of [subnetID] at [toHeight]
+// given its validator set at [fromHeight], with fromHeight >= toHeight.
+// [vdrs] is not modified.
+func (s *Service) applyValidatorDiffs(
+	ctx context.Context,
+	vdrs map[ids.NodeID]*validators.GetValidatorOutput,
+	fromHeight uint64,
+	toHeight uint64,
+	subnetID ids.ID,
+) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+	// Applying the diffs modifies the validators in place, so they must be
+	// copied.
+	result := make(map[ids.NodeID]*validators.GetValidatorOutput, len(vdrs))
+	for nodeID, vdr := range vdrs {
+		vdrCopy := *vdr
+		result[nodeID] = &vdrCopy
+	}
+	if fromHeight == toHeight {
+		return result, nil
+	}
+
+	// The diffs of (toHeight, fromHeight] are applied in
+	// [toHeight+1, fromHeight] because the state interface is inclusive.
+	if err := s.vm.state.ApplyValidatorWeightDiffs(ctx, result, fromHeight, toHeight+1, subnetID); err != nil {
+		return nil, fmt.Errorf("failed to apply weight diffs: %w", err)
+	}
+	if err := s.vm.state.ApplyValidatorPublicKeyDiffs(ctx, result, fromHeight, toHeight+1, subnetID); err != nil {
+		return nil, fmt.Errorf("failed to apply public key diffs: %w", err)
+	}
+	return result, nil
+}
+
+// validatorSetChanges returns the changes between the [from] and [to]
+// validator sets, sorted by node ID.
+func validatorSetChanges(
+	from map[ids.NodeID]*validators.GetValidatorOutput,
+	to map[ids.NodeID]*validators.GetValidatorOutput,
+) ([]ValidatorSetChange, error) {
+	var changes []ValidatorSetChange
+	for nodeID, toVdr := range to {
+		change := ValidatorSetChange{
+			NodeID: nodeID,
+			Weight: avajson.Uint64(toVdr.Weight),
+		}
+		fromVdr, ok := from[nodeID]
+		if ok {
+			change.Type = validatorModified
+			change.PreviousWeight = avajson.Uint64(fromVdr.Weight)
+		} else {
+			change.Type = validatorAdded
+			fromVdr = &validators.GetValidatorOutput{}
+		}
+
+		pkChanged := !publicKeysEqual(fromVdr.PublicKey, toVdr.PublicKey)
+		if ok && fromVdr.Weight == toVdr.Weight && !pkChanged {
+			continue
+		}
+
+		var err error
+		change.PreviousPublicKey, err = formatPublicKey(fromVdr.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		change.PublicKey, err = formatPublicKey(toVdr.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	for nodeID, fromVdr := range from {
+		if _, ok := to[nodeID]; ok {
+			continue
+		}
+
+		previousPublicKey, err := formatPublicKey(fromVdr.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, ValidatorSetChange{
+			NodeID:            nodeID,
+			Type:              validatorRemoved,
+			PreviousWeight:    avajson.Uint64(fromVdr.Weight),
+			PreviousPublicKey: previousPublicKey,
+		})
+	}
+	slices.SortFunc(changes, func(a, b ValidatorSetChange) int {
+		return a.NodeID.Compare(b.NodeID)
+	})
+	return changes, nil
+}
+
+func publicKeysEqual(a, b *bls.PublicKey) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return bytes.Equal(bls.PublicKeyToCompressedBytes(a), bls.PublicKeyToCompressedBytes(b))
+}
+
+// formatPublicKey returns the hex encoding of the compressed [pk], or nil if
+// [pk] is nil.
+func formatPublicKey(pk *bls.PublicKey) (*string, error) {
+	if pk == nil {
+		return nil, nil
+	}
+	pkStr, err := formatting.Encode(formatting.HexNC, bls.PublicKeyToCompressedBytes(pk))
+	if err != nil {
+		return nil, err
+	}
+	return &pkStr, nil
+}
+
 func (s *Service) GetBlock(_ *http.Request, args *api.GetBlockArgs, response *api.GetBlockResponse) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
@@ -2041,6 +2759,176 @@ func (s *Service) GetFeeState(_ *http.Request, _ *struct{}, reply *GetFeeStateRe
 	return nil
 }
 
+// EstimateFeeArgs are the arguments for calling EstimateFee
+type EstimateFeeArgs struct {
+	// Tx is the signed or unsigned transaction whose fee is estimated. If Tx
+	// is empty, Complexity is used instead.
+	Tx         string              `json:"tx"`
+	Encoding   formatting.Encoding `json:"encoding"`
+	Complexity *gas.Dimensions     `json:"complexity"`
+	// Horizon is the number of seconds from now until the transaction is
+	// expected to be included.
+	Horizon avajson.Uint64 `json:"horizon"`
+}
+
+// EstimateFeeReply is the response from calling EstimateFee
+type EstimateFeeReply struct {
+	Complexity gas.Dimensions `json:"complexity"`
+	Gas        gas.Gas        `json:"gas"`
+	// Price and Fee are under the fee state as of now.
+	Price gas.Price      `json:"price"`
+	Fee   avajson.Uint64 `json:"fee"`
+	// MinPrice and MinFee are reached at the horizon if no gas is consumed
+	// until then.
+	MinPrice gas.Price      `json:"minPrice"`
+	MinFee   avajson.Uint64 `json:"minFee"`
+	// MaxPrice and MaxFee are reached at the horizon if all of the available
+	// capacity is consumed until then.
+	MaxPrice gas.Price      `json:"maxPrice"`
+	MaxFee   avajson.Uint64 `json:"maxFee"`
+	Time     time.Time      `json:"timestamp"`
+}
+
+// EstimateFee returns the fee of a transaction, or of the provided complexity,
+// now and the range it can reach by the requested horizon.
+func (s *Service) EstimateFee(_ *http.Request, args *EstimateFeeArgs, reply *EstimateFeeReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "estimateFee"),
+	)
+
+	switch {
+	case args.Tx != "":
+		txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+		if err != nil {
+			return fmt.Errorf("problem decoding transaction: %w", err)
+		}
+
+		var unsignedTx txs.UnsignedTx
+		if tx, err := txs.Parse(txs.Codec, txBytes); err == nil {
+			unsignedTx = tx.Unsigned
+		} else if _, err := txs.Codec.Unmarshal(txBytes, &unsignedTx); err != nil {
+			return fmt.Errorf("couldn't parse tx: %w", err)
+		}
+
+		reply.Complexity, err = txfee.TxComplexity(unsignedTx)
+		if err != nil {
+			return fmt.Errorf("couldn't calculate tx complexity: %w", err)
+		}
+	case args.Complexity != nil:
+		reply.Complexity = *args.Complexity
+	default:
+		return errMissingComplexity
+	}
+
+	config := s.vm.DynamicFeeConfig
+	var err error
+	reply.Gas, err = reply.Complexity.ToGas(config.Weights)
+	if err != nil {
+		return fmt.Errorf("couldn't calculate gas: %w", err)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	preferredState, ok := s.vm.manager.GetState(s.vm.manager.Preferred())
+	if !ok {
+		return fmt.Errorf("%w: %s", state.ErrMissingParentState, s.vm.manager.Preferred())
+	}
+
+	// Transactions can't be included in a block before the chain time.
+	var (
+		chainTime = preferredState.GetTimestamp()
+		now       = s.vm.clock.Time()
+	)
+	if now.Before(chainTime) {
+		now = chainTime
+	}
+	if !s.vm.UpgradeConfig.IsEtnaActivated(now) {
+		return errDynamicFeesNotActivated
+	}
+
+	feeState := preferredState.GetFeeState().AdvanceTime(
+		config.MaxCapacity,
+		config.MaxPerSecond,
+		config.TargetPerSecond,
+		uint64(now.Sub(chainTime)/time.Second),
+	)
+	minExcess, maxExcess := feeState.ExcessBounds(
+		config.MaxPerSecond,
+		config.TargetPerSecond,
+		uint64(args.Horizon),
+	)
+
+	prices := []*gas.Price{&reply.Price, &reply.MinPrice, &reply.MaxPrice}
+	fees := []*avajson.Uint64{&reply.Fee, &reply.MinFee, &reply.MaxFee}
+	for i, excess := range []gas.Gas{feeState.Excess, minExcess, maxExcess} {
+		*prices[i] = gas.CalculatePrice(config.MinPrice, excess, config.ExcessConversionConstant)
+		fee, err := reply.Gas.Cost(*prices[i])
+		if err != nil {
+			return fmt.Errorf("couldn't calculate fee: %w", err)
+		}
+		*fees[i] = avajson.Uint64(fee)
+	}
+	reply.Time = now
+	return nil
+}
+
+// GetFeeHistoryArgs are the arguments for calling GetFeeHistory
+type GetFeeHistoryArgs struct {
+	// Limit is the maximum number of blocks to return. If Limit is 0, or
+	// exceeds the number of retained blocks, all retained blocks are returned.
+	Limit avajson.Uint32 `json:"limit"`
+}
+
+// FeeHistoryEntry is the dynamic fee usage of an accepted block.
+type FeeHistoryEntry struct {
+	BlockID     ids.ID         `json:"blockID"`
+	Height      avajson.Uint64 `json:"height"`
+	Time        time.Time      `json:"timestamp"`
+	GasConsumed gas.Gas        `json:"gasConsumed"`
+	// State and Price are after the block was accepted.
+	State gas.State `json:"state"`
+	Price gas.Price `json:"price"`
+}
+
+// GetFeeHistoryReply is the response from calling GetFeeHistory
+type GetFeeHistoryReply struct {
+	// Blocks are ordered from oldest to newest.
+	Blocks []FeeHistoryEntry `json:"blocks"`
+}
+
+// GetFeeHistory returns the gas consumed by, and the gas price after, the most
+// recently accepted blocks.
+func (s *Service) GetFeeHistory(_ *http.Request, args *GetFeeHistoryArgs, reply *GetFeeHistoryReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getFeeHistory"),
+	)
+
+	limit := int(args.Limit)
+	if limit <= 0 || blockexecutor.MaxFeeHistory < limit {
+		limit = blockexecutor.MaxFeeHistory
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	records := s.vm.manager.FeeHistory(limit)
+	reply.Blocks = make([]FeeHistoryEntry, len(records))
+	for i, record := range records {
+		reply.Blocks[i] = FeeHistoryEntry{
+			BlockID:     record.BlockID,
+			Height:      avajson.Uint64(record.Height),
+			Time:        record.Timestamp,
+			GasConsumed: record.GasConsumed,
+			State:       record.GasState,
+			Price:       record.GasPrice,
+		}
+	}
+	return nil
+}
+
 // GetValidatorFeeConfig returns the validator fee config of the chain.
 func (s *Service) GetValidatorFeeConfig(_ *http.Request, _ *struct{}, reply *fee.Config) error {
 	s.vm.ctx.Log.Debug("API called",
@@ -10,6 +10,7 @@ import (
 	"math"
 	"math/rand"
 	"net/http"
+	"slices"
 	"testing"
 	"time"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
@@ -35,12 +37,14 @@ import (
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/version"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block/executor/executormock"
 	"github.com/ava-labs/avalanchego/vms/platformvm/genesis/genesistest"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
@@ -56,6 +60,7 @@ import (
 	blockbuilder "github.com/ava-labs/avalanchego/vms/platformvm/block/builder"
 	blockexecutor "github.com/ava-labs/avalanchego/vms/platformvm/block/executor"
 	txexecutor "github.com/ava-labs/avalanchego/vms/platformvm/txs/executor"
+	txfee "github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 )
 
 var encodings = []formatting.Encoding{
@@ -228,6 +233,70 @@ func TestGetTxStatus(t *testing.T) {
 	require.Empty(resp.Reason)
 }
 
+func TestGetMempool(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	var reply GetMempoolReply
+	require.NoError(service.GetMempool(nil, &GetMempoolArgs{}, &reply))
+	require.Empty(reply.Txs)
+	require.Empty(reply.Dropped)
+
+	service.vm.ctx.Lock.Lock()
+	wallet := newWallet(t, service.vm, walletConfig{})
+	tx, err := wallet.IssueCreateSubnetTx(
+		&secp256k1fx.OutputOwners{},
+	)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+
+	var (
+		droppedTxID = ids.GenerateTestID()
+		unknownTxID = ids.GenerateTestID()
+		dropReason  = errors.New("dropped for testing")
+	)
+	service.vm.Builder.MarkDropped(droppedTxID, dropReason)
+
+	reply = GetMempoolReply{}
+	require.NoError(service.GetMempool(
+		nil,
+		&GetMempoolArgs{
+			DroppedTxIDs: []ids.ID{droppedTxID, unknownTxID},
+		},
+		&reply,
+	))
+
+	require.Len(reply.Txs, 1)
+	mempoolTx := reply.Txs[0]
+	require.Equal(tx.ID(), mempoolTx.TxID)
+	require.Equal("CreateSubnetTx", mempoolTx.Type)
+	require.Equal(avajson.Uint64(len(tx.Bytes())), mempoolTx.Size)
+	require.Positive(mempoolTx.Gas)
+	require.Positive(mempoolTx.Fee)
+	require.Equal(float64(mempoolTx.Fee)/float64(mempoolTx.Gas), float64(mempoolTx.GasPrice))
+	require.Equal(service.vm.clock.Time(), mempoolTx.Added)
+	require.Zero(mempoolTx.Age)
+
+	require.Equal(
+		[]DroppedTx{
+			{
+				TxID:   droppedTxID,
+				Reason: dropReason.Error(),
+			},
+		},
+		reply.Dropped,
+	)
+
+	// The age is measured with the clock of the VM.
+	service.vm.clock.Set(mempoolTx.Added.Add(10 * time.Second))
+	reply = GetMempoolReply{}
+	require.NoError(service.GetMempool(nil, &GetMempoolArgs{}, &reply))
+	require.Len(reply.Txs, 1)
+	require.Equal(avajson.Uint64(10), reply.Txs[0].Age)
+}
+
 // Test issuing and then retrieving a transaction
 func TestGetTx(t *testing.T) {
 	type test struct {
@@ -865,6 +934,226 @@ func TestGetValidatorsAt(t *testing.T) {
 	require.Len(response.Validators, len(genesis.Validators)+1)
 }
 
+func TestGetValidatorSetDiff(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	service.vm.ctx.Lock.Lock()
+	wallet := newWallet(t, service.vm, walletConfig{})
+	service.vm.ctx.Lock.Unlock()
+
+	addValidator := func() (ids.NodeID, *bls.PublicKey, uint64) {
+		service.vm.ctx.Lock.Lock()
+		nodeID := ids.GenerateTestNodeID()
+		sk, err := localsigner.New()
+		require.NoError(err)
+		pop, err := signer.NewProofOfPossession(sk)
+		require.NoError(err)
+
+		rewardsOwner := &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		}
+		startTime := service.vm.clock.Time().Add(txexecutor.SyncBound)
+		tx, err := wallet.IssueAddPermissionlessValidatorTx(
+			&txs.SubnetValidator{
+				Validator: txs.Validator{
+					NodeID: nodeID,
+					Start:  uint64(startTime.Unix()),
+					End:    uint64(startTime.Add(defaultMinStakingDuration).Unix()),
+					Wght:   service.vm.MinValidatorStake,
+				},
+				Subnet: constants.PrimaryNetworkID,
+			},
+			pop,
+			service.vm.ctx.AVAXAssetID,
+			rewardsOwner,
+			rewardsOwner,
+			0,
+		)
+		require.NoError(err)
+		service.vm.ctx.Lock.Unlock()
+
+		require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+
+		service.vm.ctx.Lock.Lock()
+		defer service.vm.ctx.Lock.Unlock()
+		require.NoError(buildAndAcceptStandardBlock(service.vm))
+		lastAccepted, err := service.vm.manager.GetStatelessBlock(service.vm.manager.LastAccepted())
+		require.NoError(err)
+		return nodeID, sk.PublicKey(), lastAccepted.Height()
+	}
+
+	service.vm.ctx.Lock.Lock()
+	lastAccepted, err := service.vm.manager.GetStatelessBlock(service.vm.manager.LastAccepted())
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+	initialHeight := lastAccepted.Height()
+
+	nodeID0, pk0, height0 := addValidator()
+	nodeID1, pk1, height1 := addValidator()
+
+	change := func(nodeID ids.NodeID, pk *bls.PublicKey) ValidatorSetChange {
+		pkStr, err := formatPublicKey(pk)
+		require.NoError(err)
+		return ValidatorSetChange{
+			NodeID:    nodeID,
+			Type:      validatorAdded,
+			Weight:    avajson.Uint64(service.vm.MinValidatorStake),
+			PublicKey: pkStr,
+		}
+	}
+	change0 := change(nodeID0, pk0)
+	change1 := change(nodeID1, pk1)
+
+	// Only the validator added in (fromHeight, toHeight] is reported.
+	reply := GetValidatorSetDiffReply{}
+	require.NoError(service.GetValidatorSetDiff(&http.Request{}, &GetValidatorSetDiffArgs{
+		SubnetID:   constants.PrimaryNetworkID,
+		FromHeight: avajson.Uint64(initialHeight),
+		ToHeight:   avajson.Uint64(height0),
+	}, &reply))
+	require.Equal(GetValidatorSetDiffReply{Changes: []ValidatorSetChange{change0}}, reply)
+
+	reply = GetValidatorSetDiffReply{}
+	require.NoError(service.GetValidatorSetDiff(&http.Request{}, &GetValidatorSetDiffArgs{
+		SubnetID:   constants.PrimaryNetworkID,
+		FromHeight: avajson.Uint64(height0),
+		ToHeight:   avajson.Uint64(height1),
+	}, &reply))
+	require.Equal(GetValidatorSetDiffReply{Changes: []ValidatorSetChange{change1}}, reply)
+
+	reply = GetValidatorSetDiffReply{}
+	require.NoError(service.GetValidatorSetDiff(&http.Request{}, &GetValidatorSetDiffArgs{
+		SubnetID:   constants.PrimaryNetworkID,
+		FromHeight: avajson.Uint64(height1),
+		ToHeight:   avajson.Uint64(height1),
+	}, &reply))
+	require.Empty(reply.Changes)
+
+	// Both validators are reported across the full range, one per page.
+	expected := []ValidatorSetChange{change0, change1}
+	slices.SortFunc(expected, func(a, b ValidatorSetChange) int {
+		return a.NodeID.Compare(b.NodeID)
+	})
+
+	reply = GetValidatorSetDiffReply{}
+	require.NoError(service.GetValidatorSetDiff(&http.Request{}, &GetValidatorSetDiffArgs{
+		SubnetID:   constants.PrimaryNetworkID,
+		FromHeight: avajson.Uint64(initialHeight),
+		ToHeight:   avajson.Uint64(height1),
+		Limit:      1,
+	}, &reply))
+	require.Equal(expected[:1], reply.Changes)
+	require.NotNil(reply.NextNodeID)
+	require.Equal(expected[1].NodeID, *reply.NextNodeID)
+
+	nextNodeID := *reply.NextNodeID
+	reply = GetValidatorSetDiffReply{}
+	require.NoError(service.GetValidatorSetDiff(&http.Request{}, &GetValidatorSetDiffArgs{
+		SubnetID:    constants.PrimaryNetworkID,
+		FromHeight:  avajson.Uint64(initialHeight),
+		ToHeight:    avajson.Uint64(height1),
+		StartNodeID: nextNodeID,
+		Limit:       1,
+	}, &reply))
+	require.Equal(GetValidatorSetDiffReply{Changes: expected[1:]}, reply)
+
+	err = service.GetValidatorSetDiff(&http.Request{}, &GetValidatorSetDiffArgs{
+		SubnetID:   constants.PrimaryNetworkID,
+		FromHeight: avajson.Uint64(height1),
+		ToHeight:   avajson.Uint64(height0),
+	}, &reply)
+	require.ErrorIs(err, errInvalidHeightRange)
+
+	err = service.GetValidatorSetDiff(&http.Request{}, &GetValidatorSetDiffArgs{
+		SubnetID:   constants.PrimaryNetworkID,
+		FromHeight: avajson.Uint64(height1),
+		ToHeight:   avajson.Uint64(height1 + 1),
+	}, &reply)
+	require.ErrorIs(err, errInvalidHeightRange)
+}
+
+func TestValidatorSetChanges(t *testing.T) {
+	require := require.New(t)
+
+	newPublicKey := func() *bls.PublicKey {
+		sk, err := localsigner.New()
+		require.NoError(err)
+		return sk.PublicKey()
+	}
+	format := func(pk *bls.PublicKey) *string {
+		pkStr, err := formatPublicKey(pk)
+		require.NoError(err)
+		return pkStr
+	}
+
+	var (
+		unmodifiedNodeID    = ids.BuildTestNodeID([]byte{1})
+		addedNodeID         = ids.BuildTestNodeID([]byte{2})
+		removedNodeID       = ids.BuildTestNodeID([]byte{3})
+		weightNodeID        = ids.BuildTestNodeID([]byte{4})
+		publicKeyNodeID     = ids.BuildTestNodeID([]byte{5})
+		unmodifiedPK        = newPublicKey()
+		addedPK             = newPublicKey()
+		removedPK           = newPublicKey()
+		previousPK          = newPublicKey()
+		currentPK           = newPublicKey()
+		unmodifiedWeight    = uint64(1)
+		addedWeight         = uint64(2)
+		removedWeight       = uint64(3)
+		previousWeight      = uint64(4)
+		currentWeight       = uint64(5)
+		publicKeyNodeWeight = uint64(6)
+	)
+	from := map[ids.NodeID]*validators.GetValidatorOutput{
+		unmodifiedNodeID: {NodeID: unmodifiedNodeID, PublicKey: unmodifiedPK, Weight: unmodifiedWeight},
+		removedNodeID:    {NodeID: removedNodeID, PublicKey: removedPK, Weight: removedWeight},
+		weightNodeID:     {NodeID: weightNodeID, Weight: previousWeight},
+		publicKeyNodeID:  {NodeID: publicKeyNodeID, PublicKey: previousPK, Weight: publicKeyNodeWeight},
+	}
+	to := map[ids.NodeID]*validators.GetValidatorOutput{
+		unmodifiedNodeID: {NodeID: unmodifiedNodeID, PublicKey: unmodifiedPK, Weight: unmodifiedWeight},
+		addedNodeID:      {NodeID: addedNodeID, PublicKey: addedPK, Weight: addedWeight},
+		weightNodeID:     {NodeID: weightNodeID, Weight: currentWeight},
+		publicKeyNodeID:  {NodeID: publicKeyNodeID, PublicKey: currentPK, Weight: publicKeyNodeWeight},
+	}
+
+	changes, err := validatorSetChanges(from, to)
+	require.NoError(err)
+	require.Equal(
+		[]ValidatorSetChange{
+			{
+				NodeID:    addedNodeID,
+				Type:      validatorAdded,
+				Weight:    avajson.Uint64(addedWeight),
+				PublicKey: format(addedPK),
+			},
+			{
+				NodeID:            removedNodeID,
+				Type:              validatorRemoved,
+				PreviousWeight:    avajson.Uint64(removedWeight),
+				PreviousPublicKey: format(removedPK),
+			},
+			{
+				NodeID:         weightNodeID,
+				Type:           validatorModified,
+				PreviousWeight: avajson.Uint64(previousWeight),
+				Weight:         avajson.Uint64(currentWeight),
+			},
+			{
+				NodeID:            publicKeyNodeID,
+				Type:              validatorModified,
+				PreviousWeight:    avajson.Uint64(publicKeyNodeWeight),
+				Weight:            avajson.Uint64(publicKeyNodeWeight),
+				PreviousPublicKey: format(previousPK),
+				PublicKey:         format(currentPK),
+			},
+		},
+		changes,
+	)
+}
+
 func TestGetValidatorsAtArgsMarshalling(t *testing.T) {
 	subnetID, err := ids.FromString("u3Jjpzzj95827jdENvR1uc76f4zvvVQjGshbVWaSr2Ce5WV1H")
 	require.NoError(t, err)
@@ -1329,6 +1618,263 @@ func FuzzGetFeeState(f *testing.F) {
 	})
 }
 
+func TestEstimateFee(t *testing.T) {
+	require := require.New(t)
+
+	service, _ := defaultService(t)
+
+	var (
+		config     = defaultDynamicFeeConfig
+		complexity = gas.Dimensions{100, 10, 10, 1000}
+		feeState   = gas.State{
+			Capacity: config.MaxCapacity,
+			Excess:   10 * config.ExcessConversionConstant,
+		}
+	)
+	expectedGas, err := complexity.ToGas(config.Weights)
+	require.NoError(err)
+
+	service.vm.ctx.Lock.Lock()
+	now := service.vm.clock.Time()
+	service.vm.state.SetFeeState(feeState)
+	service.vm.state.SetTimestamp(now)
+	service.vm.ctx.Lock.Unlock()
+
+	price := func(excess gas.Gas) gas.Price {
+		return gas.CalculatePrice(config.MinPrice, excess, config.ExcessConversionConstant)
+	}
+	fee := func(price gas.Price) avajson.Uint64 {
+		fee, err := expectedGas.Cost(price)
+		require.NoError(err)
+		return avajson.Uint64(fee)
+	}
+
+	// Without a horizon, the fee can only change by consuming the current
+	// capacity.
+	var reply EstimateFeeReply
+	require.NoError(service.EstimateFee(nil, &EstimateFeeArgs{
+		Complexity: &complexity,
+	}, &reply))
+
+	expectedPrice := price(feeState.Excess)
+	expectedMaxPrice := price(feeState.Excess + feeState.Capacity)
+	require.Equal(EstimateFeeReply{
+		Complexity: complexity,
+		Gas:        expectedGas,
+		Price:      expectedPrice,
+		Fee:        fee(expectedPrice),
+		MinPrice:   expectedPrice,
+		MinFee:     fee(expectedPrice),
+		MaxPrice:   expectedMaxPrice,
+		MaxFee:     fee(expectedMaxPrice),
+		Time:       now,
+	}, reply)
+
+	// The range of the fee widens with the horizon.
+	const horizon = 60
+	require.NoError(service.EstimateFee(nil, &EstimateFeeArgs{
+		Complexity: &complexity,
+		Horizon:    horizon,
+	}, &reply))
+
+	minExcess, maxExcess := feeState.ExcessBounds(config.MaxPerSecond, config.TargetPerSecond, horizon)
+	require.Equal(expectedPrice, reply.Price)
+	require.Equal(price(minExcess), reply.MinPrice)
+	require.Equal(fee(price(minExcess)), reply.MinFee)
+	require.Equal(price(maxExcess), reply.MaxPrice)
+	require.Equal(fee(price(maxExcess)), reply.MaxFee)
+	require.Less(reply.MinPrice, reply.Price)
+	require.Greater(reply.MaxPrice, expectedMaxPrice)
+
+	// The complexity of a signed or unsigned transaction is calculated.
+	service.vm.ctx.Lock.Lock()
+	wallet := newWallet(t, service.vm, walletConfig{})
+	tx, err := wallet.IssueCreateSubnetTx(&secp256k1fx.OutputOwners{})
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	expectedComplexity, err := txfee.TxComplexity(tx.Unsigned)
+	require.NoError(err)
+
+	for _, txBytes := range [][]byte{tx.Bytes(), tx.Unsigned.Bytes()} {
+		txStr, err := formatting.Encode(formatting.Hex, txBytes)
+		require.NoError(err)
+
+		require.NoError(service.EstimateFee(nil, &EstimateFeeArgs{
+			Tx:       txStr,
+			Encoding: formatting.Hex,
+		}, &reply))
+		require.Equal(expectedComplexity, reply.Complexity)
+	}
+
+	err = service.EstimateFee(nil, &EstimateFeeArgs{}, &reply)
+	require.ErrorIs(err, errMissingComplexity)
+}
+
+func TestGetFeeHistory(t *testing.T) {
+	require := require.New(t)
+
+	service, _ := defaultService(t)
+
+	var reply GetFeeHistoryReply
+	require.NoError(service.GetFeeHistory(nil, &GetFeeHistoryArgs{}, &reply))
+	initialBlocks := reply.Blocks
+
+	service.vm.ctx.Lock.Lock()
+	wallet := newWallet(t, service.vm, walletConfig{})
+	tx, err := wallet.IssueCreateSubnetTx(&secp256k1fx.OutputOwners{})
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+
+	service.vm.ctx.Lock.Lock()
+	require.NoError(buildAndAcceptStandardBlock(service.vm))
+
+	blk, err := service.vm.manager.GetStatelessBlock(service.vm.manager.LastAccepted())
+	require.NoError(err)
+	complexity, err := txfee.TxComplexity(tx.Unsigned)
+	require.NoError(err)
+	expectedGas, err := complexity.ToGas(defaultDynamicFeeConfig.Weights)
+	require.NoError(err)
+	expectedState := service.vm.state.GetFeeState()
+	expectedTime := service.vm.state.GetTimestamp()
+	service.vm.ctx.Lock.Unlock()
+
+	require.NoError(service.GetFeeHistory(nil, &GetFeeHistoryArgs{}, &reply))
+	require.Equal(
+		append(initialBlocks, FeeHistoryEntry{
+			BlockID:     blk.ID(),
+			Height:      avajson.Uint64(blk.Height()),
+			Time:        expectedTime,
+			GasConsumed: expectedGas,
+			State:       expectedState,
+			Price: gas.CalculatePrice(
+				defaultDynamicFeeConfig.MinPrice,
+				expectedState.Excess,
+				defaultDynamicFeeConfig.ExcessConversionConstant,
+			),
+		}),
+		reply.Blocks,
+	)
+
+	// The limit returns the most recent blocks.
+	require.NoError(service.GetFeeHistory(nil, &GetFeeHistoryArgs{Limit: 1}, &reply))
+	require.Len(reply.Blocks, 1)
+	require.Equal(blk.ID(), reply.Blocks[0].BlockID)
+}
+
+func TestGetStakingHistory(t *testing.T) {
+	require := require.New(t)
+
+	service, _ := defaultService(t)
+	service.vm.ctx.Lock.Lock()
+
+	// Reward a genesis validator.
+	service.vm.clock.Set(genesistest.DefaultValidatorEndTime)
+	blk, err := service.vm.Builder.BuildBlock(t.Context())
+	require.NoError(err)
+	require.NoError(blk.Verify(t.Context()))
+
+	options, err := blk.(snowman.OracleBlock).Options(t.Context())
+	require.NoError(err)
+	commit := options[0]
+	require.NoError(commit.Verify(t.Context()))
+	require.NoError(blk.Accept(t.Context()))
+	require.NoError(commit.Accept(t.Context()))
+
+	rewardTx := blk.(*blockexecutor.Block).Txs()[0].Unsigned.(*txs.RewardValidatorTx)
+	stakerTx, _, err := service.vm.state.GetTx(rewardTx.TxID)
+	require.NoError(err)
+	validatorTx := stakerTx.Unsigned.(*txs.AddValidatorTx)
+	rewardUTXOs, err := service.vm.state.GetRewardUTXOs(rewardTx.TxID)
+	require.NoError(err)
+	require.Len(rewardUTXOs, 1)
+	reward := rewardUTXOs[0].Out.(*secp256k1fx.TransferOutput)
+
+	rewardAddr := validatorTx.RewardsOwner.(*secp256k1fx.OutputOwners).Addrs[0]
+	rewardAddrStr, err := service.addrManager.FormatLocalAddress(rewardAddr)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	expectedPeriods := []StakingPeriod{
+		{
+			TxID:            rewardTx.TxID,
+			NodeID:          validatorTx.NodeID(),
+			SubnetID:        constants.PrimaryNetworkID,
+			StartTime:       avajson.Uint64(validatorTx.StartTime().Unix()),
+			EndTime:         avajson.Uint64(genesistest.DefaultValidatorEndTimeUnix),
+			Weight:          avajson.Uint64(validatorTx.Weight()),
+			PotentialReward: avajson.Uint64(reward.Amt),
+			Rewarded:        true,
+			Reward:          avajson.Uint64(reward.Amt),
+			RewardAddresses: []string{rewardAddrStr},
+			Height:          avajson.Uint64(commit.Height()),
+		},
+	}
+
+	tests := []struct {
+		name     string
+		args     GetStakingHistoryArgs
+		expected []StakingPeriod
+	}{
+		{
+			name: "by node ID",
+			args: GetStakingHistoryArgs{
+				NodeID: validatorTx.NodeID(),
+			},
+			expected: expectedPeriods,
+		},
+		{
+			name: "by other node ID",
+			args: GetStakingHistoryArgs{
+				NodeID: ids.GenerateTestNodeID(),
+			},
+			expected: []StakingPeriod{},
+		},
+		{
+			name: "by reward address",
+			args: GetStakingHistoryArgs{
+				RewardAddress: rewardAddrStr,
+			},
+			expected: expectedPeriods,
+		},
+		{
+			name: "by time",
+			args: GetStakingHistoryArgs{
+				StartTime: avajson.Uint64(genesistest.DefaultValidatorEndTimeUnix),
+				EndTime:   avajson.Uint64(genesistest.DefaultValidatorEndTimeUnix),
+			},
+			expected: expectedPeriods,
+		},
+		{
+			name: "before",
+			args: GetStakingHistoryArgs{
+				EndTime: avajson.Uint64(genesistest.DefaultValidatorEndTimeUnix - 1),
+			},
+			expected: []StakingPeriod{},
+		},
+		{
+			name: "after",
+			args: GetStakingHistoryArgs{
+				StartTime: avajson.Uint64(genesistest.DefaultValidatorEndTimeUnix + 1),
+			},
+			expected: []StakingPeriod{},
+		},
+	}
+	for _, test := range tests {
+		var reply GetStakingHistoryReply
+		require.NoError(service.GetStakingHistory(nil, &test.args, &reply), test.name)
+		require.Equal(test.expected, reply.Periods, test.name)
+		require.Nil(reply.NextStartTime, test.name)
+		require.Nil(reply.NextStartTxID, test.name)
+	}
+
+	service.vm.stakingIndex = nil
+	err = service.GetStakingHistory(nil, &GetStakingHistoryArgs{}, &GetStakingHistoryReply{})
+	require.ErrorIs(err, errStakingIndexDisabled)
+}
+
 func TestGetCurrentValidatorsForL1(t *testing.T) {
 	subnetID := ids.GenerateTestID()
 
@@ -1588,6 +2134,234 @@ func TestGetCurrentValidatorsForL1(t *testing.T) {
 	}
 }
 
+func TestCalculateReward(t *testing.T) {
+	service, _ := defaultService(t)
+
+	service.vm.ctx.Lock.Lock()
+	currentSupply, err := service.vm.state.GetCurrentSupply(constants.PrimaryNetworkID)
+	require.NoError(t, err)
+	chainTime := uint64(service.vm.state.GetTimestamp().Unix())
+	service.vm.ctx.Lock.Unlock()
+
+	var (
+		rewardConfig       = service.vm.RewardConfig
+		calculator         = reward.NewCalculator(rewardConfig)
+		duration           = 14 * 24 * time.Hour
+		stakeAmount        = 2_000 * units.Avax
+		hypotheticalSupply = 400_000_000 * units.Avax
+
+		currentReward      = calculator.Calculate(duration, stakeAmount, currentSupply)
+		hypotheticalReward = calculator.Calculate(duration, stakeAmount, hypotheticalSupply)
+
+		apiHypotheticalSupply = avajson.Uint64(hypotheticalSupply)
+		apiExcessiveSupply    = avajson.Uint64(rewardConfig.SupplyCap + 1)
+	)
+	delegationFeeReward, delegatorReward := reward.Split(hypotheticalReward, 20_000)
+
+	tests := []struct {
+		name        string
+		args        CalculateRewardArgs
+		expected    CalculateRewardReply
+		expectedErr error
+	}{
+		{
+			name: "current supply",
+			args: CalculateRewardArgs{
+				StakeAmount: avajson.Uint64(stakeAmount),
+				Duration:    avajson.Uint64(duration / time.Second),
+			},
+			expected: CalculateRewardReply{
+				StartTime:       avajson.Uint64(chainTime),
+				EndTime:         avajson.Uint64(chainTime + uint64(duration/time.Second)),
+				CurrentSupply:   avajson.Uint64(currentSupply),
+				PotentialReward: avajson.Uint64(currentReward),
+				DelegatorReward: avajson.Uint64(currentReward),
+				RewardConfig:    rewardConfig,
+			},
+		},
+		{
+			name: "hypothetical supply with delegation fee",
+			args: CalculateRewardArgs{
+				StakeAmount:      avajson.Uint64(stakeAmount),
+				StartTime:        1_000,
+				Duration:         avajson.Uint64(duration / time.Second),
+				CurrentSupply:    &apiHypotheticalSupply,
+				DelegationShares: 20_000,
+			},
+			expected: CalculateRewardReply{
+				StartTime:           1_000,
+				EndTime:             avajson.Uint64(1_000 + uint64(duration/time.Second)),
+				CurrentSupply:       avajson.Uint64(hypotheticalSupply),
+				PotentialReward:     avajson.Uint64(hypotheticalReward),
+				DelegationFeeReward: avajson.Uint64(delegationFeeReward),
+				DelegatorReward:     avajson.Uint64(delegatorReward),
+				RewardConfig:        rewardConfig,
+			},
+		},
+		{
+			name: "invalid delegation shares",
+			args: CalculateRewardArgs{
+				StakeAmount:      avajson.Uint64(stakeAmount),
+				Duration:         avajson.Uint64(duration / time.Second),
+				DelegationShares: reward.PercentDenominator + 1,
+			},
+			expectedErr: errInvalidDelegationShares,
+		},
+		{
+			name: "zero duration",
+			args: CalculateRewardArgs{
+				StakeAmount: avajson.Uint64(stakeAmount),
+			},
+			expectedErr: errInvalidStakeDuration,
+		},
+		{
+			name: "duration longer than the minting period",
+			args: CalculateRewardArgs{
+				StakeAmount: avajson.Uint64(stakeAmount),
+				Duration:    avajson.Uint64(rewardConfig.MintingPeriod/time.Second + 1),
+			},
+			expectedErr: errInvalidStakeDuration,
+		},
+		{
+			name: "supply above the supply cap",
+			args: CalculateRewardArgs{
+				StakeAmount:   avajson.Uint64(stakeAmount),
+				Duration:      avajson.Uint64(duration / time.Second),
+				CurrentSupply: &apiExcessiveSupply,
+			},
+			expectedErr: errInvalidSupply,
+		},
+		{
+			name: "unknown subnet",
+			args: CalculateRewardArgs{
+				SubnetID:    ids.GenerateTestID(),
+				StakeAmount: avajson.Uint64(stakeAmount),
+				Duration:    avajson.Uint64(duration / time.Second),
+			},
+			expectedErr: database.ErrNotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			var reply CalculateRewardReply
+			err := service.CalculateReward(nil, &test.args, &reply)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Equal(test.expected, reply)
+		})
+	}
+}
+
+func TestGetL1ValidatorDeactivations(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	var (
+		subnetID      = ids.GenerateTestID()
+		otherSubnetID = ids.GenerateTestID()
+		l1Validators  = []state.L1Validator{
+			{
+				ValidationID:      ids.GenerateTestID(),
+				SubnetID:          subnetID,
+				NodeID:            ids.GenerateTestNodeID(),
+				Weight:            1,
+				EndAccumulatedFee: 20, // This validator should be evicted in 10 seconds.
+			},
+			{
+				ValidationID:      ids.GenerateTestID(),
+				SubnetID:          subnetID,
+				NodeID:            ids.GenerateTestNodeID(),
+				Weight:            1,
+				EndAccumulatedFee: units.Avax, // This validator won't be evicted within the horizon.
+			},
+			{
+				ValidationID:      ids.GenerateTestID(),
+				SubnetID:          otherSubnetID,
+				NodeID:            ids.GenerateTestNodeID(),
+				Weight:            1,
+				EndAccumulatedFee: 7, // This validator should be evicted in 3.5 seconds, which is rounded to 3.
+			},
+		}
+	)
+
+	service.vm.ctx.Lock.Lock()
+	for _, l1Validator := range l1Validators {
+		require.NoError(service.vm.state.PutL1Validator(l1Validator))
+	}
+	currentTime := service.vm.state.GetTimestamp()
+	service.vm.ctx.Lock.Unlock()
+
+	var (
+		tenSeconds   = currentTime.Add(10 * time.Second)
+		threeSeconds = currentTime.Add(3 * time.Second)
+	)
+	tests := []struct {
+		name     string
+		subnetID ids.ID
+		expected []L1ValidatorDeactivation
+	}{
+		{
+			name: "all subnets",
+			expected: []L1ValidatorDeactivation{
+				{
+					ValidationID:     l1Validators[2].ValidationID,
+					SubnetID:         otherSubnetID,
+					NodeID:           l1Validators[2].NodeID,
+					Balance:          7,
+					DeactivationTime: &threeSeconds,
+				},
+				{
+					ValidationID:     l1Validators[0].ValidationID,
+					SubnetID:         subnetID,
+					NodeID:           l1Validators[0].NodeID,
+					Balance:          20,
+					DeactivationTime: &tenSeconds,
+				},
+				{
+					ValidationID: l1Validators[1].ValidationID,
+					SubnetID:     subnetID,
+					NodeID:       l1Validators[1].NodeID,
+					Balance:      avajson.Uint64(units.Avax),
+				},
+			},
+		},
+		{
+			name:     "single subnet",
+			subnetID: otherSubnetID,
+			expected: []L1ValidatorDeactivation{
+				{
+					ValidationID:     l1Validators[2].ValidationID,
+					SubnetID:         otherSubnetID,
+					NodeID:           l1Validators[2].NodeID,
+					Balance:          7,
+					DeactivationTime: &threeSeconds,
+				},
+			},
+		},
+		{
+			name:     "unknown subnet",
+			subnetID: ids.GenerateTestID(),
+			expected: []L1ValidatorDeactivation{},
+		},
+	}
+	for _, test := range tests {
+		var reply GetL1ValidatorDeactivationsReply
+		require.NoError(service.GetL1ValidatorDeactivations(
+			nil,
+			&GetL1ValidatorDeactivationsArgs{
+				SubnetID: test.subnetID,
+			},
+			&reply,
+		), test.name)
+		require.Equal(test.expected, reply.Deactivations, test.name)
+		require.Equal(currentTime, reply.Time, test.name)
+	}
+}
+
 func TestGetValidatorFeeConfig(t *testing.T) {
 	require := require.New(t)
 
@@ -1628,3 +2402,111 @@ func FuzzGetValidatorFeeState(f *testing.F) {
 		require.Equal(expectedReply, reply)
 	})
 }
+
+func TestSimulateTx(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	service.vm.ctx.Lock.Lock()
+	wallet := newWallet(t, service.vm, walletConfig{})
+	rewardsOwner := &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+	}
+
+	sk, err := localsigner.New()
+	require.NoError(err)
+	pop, err := signer.NewProofOfPossession(sk)
+	require.NoError(err)
+
+	nodeID := ids.GenerateTestNodeID()
+	tx, err := wallet.IssueAddPermissionlessValidatorTx(
+		&txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				End:    uint64(service.vm.clock.Time().Add(defaultMinStakingDuration).Unix()),
+				Wght:   service.vm.MinValidatorStake,
+			},
+			Subnet: constants.PrimaryNetworkID,
+		},
+		pop,
+		service.vm.ctx.AVAXAssetID,
+		rewardsOwner,
+		rewardsOwner,
+		0,
+	)
+	require.NoError(err)
+
+	feeCalculator := state.PickFeeCalculator(&service.vm.Internal, service.vm.state)
+	expectedFee, err := feeCalculator.CalculateFee(tx.Unsigned)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	simulate := func(txBytes []byte) *SimulateTxReply {
+		txStr, err := formatting.Encode(formatting.Hex, txBytes)
+		require.NoError(err)
+
+		reply := &SimulateTxReply{}
+		require.NoError(service.SimulateTx(
+			&http.Request{},
+			&api.FormattedTx{
+				Tx:       txStr,
+				Encoding: formatting.Hex,
+			},
+			reply,
+		))
+		return reply
+	}
+
+	expectedConsumed := tx.Unsigned.InputIDs().List()
+	utils.Sort(expectedConsumed)
+
+	// The signed tx is valid.
+	reply := simulate(tx.Bytes())
+	require.Empty(reply.Error)
+	require.Equal(tx.ID(), reply.TxID)
+	require.Equal(avajson.Uint64(expectedFee), reply.Fee)
+	require.Equal(expectedConsumed, reply.Consumed)
+	require.Len(reply.Produced, len(tx.UTXOs()))
+	require.Equal(
+		[]SimulatedStakerChange{
+			{
+				TxID:      tx.ID(),
+				NodeID:    nodeID,
+				SubnetID:  constants.PrimaryNetworkID,
+				Weight:    avajson.Uint64(service.vm.MinValidatorStake),
+				StartTime: reply.StakerChanges[0].StartTime,
+				EndTime:   avajson.Uint64(tx.Unsigned.(*txs.AddPermissionlessValidatorTx).EndTime().Unix()),
+			},
+		},
+		reply.StakerChanges,
+	)
+
+	// The unsigned tx is simulated without verifying credentials.
+	reply = simulate(tx.Unsigned.Bytes())
+	require.Empty(reply.Error)
+	require.Equal(ids.Empty, reply.TxID)
+	require.Equal(avajson.Uint64(expectedFee), reply.Fee)
+	require.Equal(expectedConsumed, reply.Consumed)
+	require.Len(reply.StakerChanges, 1)
+
+	// A signed tx without credentials is invalid.
+	unsignedTx := &txs.Tx{Unsigned: tx.Unsigned}
+	require.NoError(unsignedTx.Initialize(txs.Codec))
+	reply = simulate(unsignedTx.Bytes())
+	require.NotEmpty(reply.Error)
+	require.Equal(avajson.Uint64(expectedFee), reply.Fee)
+	require.Empty(reply.Consumed)
+	require.Empty(reply.StakerChanges)
+
+	// Simulating the tx doesn't modify the state.
+	service.vm.ctx.Lock.Lock()
+	defer service.vm.ctx.Lock.Unlock()
+
+	_, err = service.vm.state.GetCurrentValidator(constants.PrimaryNetworkID, nodeID)
+	require.ErrorIs(err, database.ErrNotFound)
+	for _, utxoID := range expectedConsumed {
+		_, err := service.vm.state.GetUTXO(utxoID)
+		require.NoError(err)
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snapshot
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/constraints"
+
+	"github.com/ava-labs/avalanchego/utils/perms"
+	"github.com/ava-labs/avalanchego/vms/types"
+)
+
+const (
+	MetadataFile     = "metadata.csv"
+	StakersFile      = "stakers.csv"
+	SubnetsFile      = "subnets.csv"
+	ChainsFile       = "chains.csv"
+	L1ValidatorsFile = "l1_validators.csv"
+	UTXOsFile        = "utxos.csv"
+
+	// addressSeparator separates the addresses of an owner in a single CSV
+	// field.
+	addressSeparator = " "
+)
+
+// WriteCSV writes [snapshot] into [dir] as one CSV file per collection. The
+// directory is created if it doesn't exist.
+func WriteCSV(dir string, snapshot *Snapshot) error {
+	if err := os.MkdirAll(dir, perms.ReadWriteExecute); err != nil {
+		return err
+	}
+
+	metadata := [][]string{
+		{"version", "networkID", "height", "blockID", "timestamp", "avaxAssetID", "currentSupply", "accruedFees"},
+		{
+			formatUint(snapshot.Version),
+			formatUint(snapshot.NetworkID),
+			formatUint(snapshot.Height),
+			snapshot.BlockID.String(),
+			snapshot.Timestamp.Format(time.RFC3339),
+			snapshot.AVAXAssetID.String(),
+			formatUint(snapshot.CurrentSupply),
+			formatUint(snapshot.AccruedFees),
+		},
+	}
+	if err := writeCSV(filepath.Join(dir, MetadataFile), metadata); err != nil {
+		return err
+	}
+
+	stakers := [][]string{
+		{"txID", "nodeID", "subnetID", "publicKey", "kind", "pending", "weight", "startTime", "endTime", "potentialReward", "delegateeReward"},
+	}
+	for _, staker := range snapshot.Stakers {
+		stakers = append(stakers, []string{
+			staker.TxID.String(),
+			staker.NodeID.String(),
+			staker.SubnetID.String(),
+			formatBytes(staker.PublicKey),
+			staker.Kind,
+			strconv.FormatBool(staker.Pending),
+			formatUint(staker.Weight),
+			formatUint(staker.StartTime),
+			formatUint(staker.EndTime),
+			formatUint(staker.PotentialReward),
+			formatUint(staker.DelegateeReward),
+		})
+	}
+	if err := writeCSV(filepath.Join(dir, StakersFile), stakers); err != nil {
+		return err
+	}
+
+	subnets := [][]string{
+		{"id", "ownerLocktime", "ownerThreshold", "ownerAddresses", "transformationTxID", "assetID", "currentSupply", "conversionID", "managerChainID", "managerAddress"},
+	}
+	for _, subnet := range snapshot.Subnets {
+		subnets = append(subnets, []string{
+			subnet.ID.String(),
+			formatUint(subnet.Owner.Locktime),
+			formatUint(subnet.Owner.Threshold),
+			strings.Join(subnet.Owner.Addresses, addressSeparator),
+			subnet.TransformationTxID.String(),
+			subnet.AssetID.String(),
+			formatUint(subnet.CurrentSupply),
+			subnet.ConversionID.String(),
+			subnet.ManagerChainID.String(),
+			formatBytes(subnet.ManagerAddress),
+		})
+	}
+	if err := writeCSV(filepath.Join(dir, SubnetsFile), subnets); err != nil {
+		return err
+	}
+
+	chains := [][]string{
+		{"id", "subnetID", "name", "vmID"},
+	}
+	for _, chain := range snapshot.Chains {
+		chains = append(chains, []string{
+			chain.ID.String(),
+			chain.SubnetID.String(),
+			chain.Name,
+			chain.VMID.String(),
+		})
+	}
+	if err := writeCSV(filepath.Join(dir, ChainsFile), chains); err != nil {
+		return err
+	}
+
+	l1Validators := [][]string{
+		{"validationID", "subnetID", "nodeID", "publicKey", "weight", "startTime", "minNonce", "active", "balance"},
+	}
+	for _, l1Validator := range snapshot.L1Validators {
+		l1Validators = append(l1Validators, []string{
+			l1Validator.ValidationID.String(),
+			l1Validator.SubnetID.String(),
+			l1Validator.NodeID.String(),
+			formatBytes(l1Validator.PublicKey),
+			formatUint(l1Validator.Weight),
+			formatUint(l1Validator.StartTime),
+			formatUint(l1Validator.MinNonce),
+			strconv.FormatBool(l1Validator.Active),
+			formatUint(l1Validator.Balance),
+		})
+	}
+	if err := writeCSV(filepath.Join(dir, L1ValidatorsFile), l1Validators); err != nil {
+		return err
+	}
+
+	return writeUTXOsCSV(filepath.Join(dir, UTXOsFile), snapshot.UTXOs)
+}
+
+func writeCSV(path string, records [][]string) error {
+	f, err := perms.Create(path, perms.ReadWrite)
+	if err != nil {
+		return err
+	}
+
+	w := csv.NewWriter(f)
+	if err := w.WriteAll(records); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// writeUTXOsCSV writes [utxos] to [path] one row at a time, so that they are
+// never all held in memory.
+func writeUTXOsCSV(path string, utxos UTXOs) error {
+	f, err := perms.Create(path, perms.ReadWrite)
+	if err != nil {
+		return err
+	}
+
+	if err := writeUTXOs(csv.NewWriter(f), utxos); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func writeUTXOs(w *csv.Writer, utxos UTXOs) error {
+	err := w.Write([]string{"id", "txID", "outputIndex", "assetID", "amount", "stakeableLocktime", "ownerLocktime", "ownerThreshold", "ownerAddresses"})
+	if err != nil {
+		return err
+	}
+	err = utxos(func(utxo UTXO) error {
+		return w.Write([]string{
+			utxo.ID.String(),
+			utxo.TxID.String(),
+			formatUint(utxo.OutputIndex),
+			utxo.AssetID.String(),
+			formatUint(utxo.Amount),
+			formatUint(utxo.StakeableLocktime),
+			formatUint(utxo.Owner.Locktime),
+			formatUint(utxo.Owner.Threshold),
+			strings.Join(utxo.Owner.Addresses, addressSeparator),
+		})
+	})
+	if err != nil {
+		return err
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func formatUint[T constraints.Unsigned](v T) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
+// formatBytes matches the JSON encoding of [types.JSONByteSlice].
+func formatBytes(b types.JSONByteSlice) string {
+	if b == nil {
+		return ""
+	}
+	return "0x" + hex.EncodeToString(b)
+}
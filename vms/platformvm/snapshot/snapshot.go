@@ -0,0 +1,532 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/iterator"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/vms/types"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+)
+
+const (
+	// Version of the snapshot format produced by Export.
+	Version = 1
+
+	KindValidator             = "validator"
+	KindPermissionedValidator = "permissionedValidator"
+	KindDelegator             = "delegator"
+
+	utxoPageSize = 1024
+)
+
+var (
+	errUnexpectedOwnerType  = errors.New("unexpected owner type")
+	errUnexpectedOutputType = errors.New("unexpected output type")
+)
+
+// Snapshot is the content of the P-Chain state at a single accepted block.
+type Snapshot struct {
+	Version     uint32         `json:"version"`
+	NetworkID   uint32         `json:"networkID"`
+	Height      avajson.Uint64 `json:"height"`
+	BlockID     ids.ID         `json:"blockID"`
+	Timestamp   time.Time      `json:"timestamp"`
+	AVAXAssetID ids.ID         `json:"avaxAssetID"`
+	// CurrentSupply of AVAX, as reported by the state.
+	CurrentSupply avajson.Uint64 `json:"currentSupply"`
+	// AccruedFees is the total amount of fees charged to active L1
+	// validators since the Etna upgrade.
+	AccruedFees  avajson.Uint64 `json:"accruedFees"`
+	Stakers      []Staker       `json:"stakers"`
+	Subnets      []Subnet       `json:"subnets"`
+	Chains       []Chain        `json:"chains"`
+	L1Validators []L1Validator  `json:"l1Validators"`
+	// UTXOs are streamed rather than held in memory, as there may be too many
+	// of them. They are encoded last, as the utxos field.
+	UTXOs UTXOs `json:"-"`
+}
+
+// UTXOs iterates over the UTXOs of a snapshot in order of UTXO ID, calling [f]
+// on each of them. Iteration stops at the first error, which is returned.
+type UTXOs func(f func(UTXO) error) error
+
+// NewUTXOs returns the UTXOs iterator over [utxos].
+func NewUTXOs(utxos []UTXO) UTXOs {
+	return func(f func(UTXO) error) error {
+		for _, utxo := range utxos {
+			if err := f(utxo); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Staker is a current or pending staker of the primary network or of a
+// Subnet that has not been converted to an L1.
+type Staker struct {
+	TxID      ids.ID              `json:"txID"`
+	NodeID    ids.NodeID          `json:"nodeID"`
+	SubnetID  ids.ID              `json:"subnetID"`
+	PublicKey types.JSONByteSlice `json:"publicKey"`
+	// Kind is one of KindValidator, KindPermissionedValidator or
+	// KindDelegator.
+	Kind            string         `json:"kind"`
+	Pending         bool           `json:"pending"`
+	Weight          avajson.Uint64 `json:"weight"`
+	StartTime       avajson.Uint64 `json:"startTime"`
+	EndTime         avajson.Uint64 `json:"endTime"`
+	PotentialReward avajson.Uint64 `json:"potentialReward"`
+	// DelegateeReward is the delegation reward accrued so far by a current
+	// validator.
+	DelegateeReward avajson.Uint64 `json:"delegateeReward"`
+}
+
+// Subnet is a Subnet created on the P-Chain. The primary network is not
+// included.
+type Subnet struct {
+	ID    ids.ID `json:"id"`
+	Owner Owner  `json:"owner"`
+	// TransformationTxID is empty unless the Subnet was transformed into a
+	// permissionless Subnet.
+	TransformationTxID ids.ID `json:"transformationTxID"`
+	// AssetID and CurrentSupply are only populated for transformed Subnets.
+	AssetID       ids.ID         `json:"assetID"`
+	CurrentSupply avajson.Uint64 `json:"currentSupply"`
+	// ConversionID, ManagerChainID and ManagerAddress are only populated for
+	// Subnets that were converted to L1s.
+	ConversionID   ids.ID              `json:"conversionID"`
+	ManagerChainID ids.ID              `json:"managerChainID"`
+	ManagerAddress types.JSONByteSlice `json:"managerAddress"`
+}
+
+// Chain is a blockchain created on the P-Chain.
+type Chain struct {
+	ID       ids.ID `json:"id"`
+	SubnetID ids.ID `json:"subnetID"`
+	Name     string `json:"name"`
+	VMID     ids.ID `json:"vmID"`
+}
+
+// L1Validator is a validator of a Subnet that was converted to an L1.
+type L1Validator struct {
+	ValidationID ids.ID              `json:"validationID"`
+	SubnetID     ids.ID              `json:"subnetID"`
+	NodeID       ids.NodeID          `json:"nodeID"`
+	PublicKey    types.JSONByteSlice `json:"publicKey"`
+	Weight       avajson.Uint64      `json:"weight"`
+	StartTime    avajson.Uint64      `json:"startTime"`
+	MinNonce     avajson.Uint64      `json:"minNonce"`
+	Active       bool                `json:"active"`
+	// Balance is the remaining AVAX balance of the validator that has not
+	// been charged as fees yet. It is 0 for inactive validators.
+	Balance avajson.Uint64 `json:"balance"`
+}
+
+// UTXO is an unspent output of the P-Chain.
+type UTXO struct {
+	ID          ids.ID         `json:"id"`
+	TxID        ids.ID         `json:"txID"`
+	OutputIndex uint32         `json:"outputIndex"`
+	AssetID     ids.ID         `json:"assetID"`
+	Amount      avajson.Uint64 `json:"amount"`
+	// StakeableLocktime is the time until which the output can only be used
+	// for staking. It is 0 if the output is not stakeable locked.
+	StakeableLocktime avajson.Uint64 `json:"stakeableLocktime"`
+	Owner             Owner          `json:"owner"`
+}
+
+// Owner is a secp256k1fx owner with its addresses formatted for the P-Chain.
+type Owner struct {
+	Locktime  avajson.Uint64 `json:"locktime"`
+	Threshold avajson.Uint32 `json:"threshold"`
+	Addresses []string       `json:"addresses"`
+}
+
+// Export reads the stakers, Subnets, chains and L1 validators of [s] at its last
+// accepted block. The UTXOs are read from [s] page by page each time they are
+// iterated over.
+//
+// [s] must not be modified while the snapshot is being used.
+func Export(
+	ctx context.Context,
+	s state.State,
+	networkID uint32,
+	avaxAssetID ids.ID,
+) (*Snapshot, error) {
+	lastAcceptedID := s.GetLastAccepted()
+	lastAccepted, err := s.GetStatelessBlock(lastAcceptedID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last accepted block %s: %w", lastAcceptedID, err)
+	}
+
+	currentSupply, err := s.GetCurrentSupply(constants.PrimaryNetworkID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current supply: %w", err)
+	}
+
+	hrp := constants.GetHRP(networkID)
+	snapshot := &Snapshot{
+		Version:       Version,
+		NetworkID:     networkID,
+		Height:        avajson.Uint64(lastAccepted.Height()),
+		BlockID:       lastAcceptedID,
+		Timestamp:     s.GetTimestamp().UTC(),
+		AVAXAssetID:   avaxAssetID,
+		CurrentSupply: avajson.Uint64(currentSupply),
+		AccruedFees:   avajson.Uint64(s.GetAccruedFees()),
+		Stakers:       []Staker{},
+		Subnets:       []Subnet{},
+		Chains:        []Chain{},
+		L1Validators:  []L1Validator{},
+		UTXOs: func(f func(UTXO) error) error {
+			return exportUTXOs(ctx, s, hrp, f)
+		},
+	}
+
+	if err := snapshot.exportStakers(s); err != nil {
+		return nil, err
+	}
+	if err := snapshot.exportSubnets(ctx, s, hrp); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// WriteJSON writes [snapshot] to [w] as indented JSON. The UTXOs are written
+// one at a time.
+func WriteJSON(w io.Writer, snapshot *Snapshot) error {
+	metadata, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// The UTXOs are appended as the last field of the object.
+	bw := bufio.NewWriter(w)
+	if _, err := bw.Write(bytes.TrimSuffix(metadata, []byte("\n}"))); err != nil {
+		return err
+	}
+	if _, err := bw.WriteString(`,
+  "utxos": [`); err != nil {
+		return err
+	}
+
+	separator := "\n    "
+	err = snapshot.UTXOs(func(utxo UTXO) error {
+		b, err := json.MarshalIndent(utxo, "    ", "  ")
+		if err != nil {
+			return err
+		}
+		if _, err := bw.WriteString(separator); err != nil {
+			return err
+		}
+		separator = ",\n    "
+		_, err = bw.Write(b)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	end := "\n  ]\n}\n"
+	if separator == "\n    " {
+		end = "]\n}\n"
+	}
+	if _, err := bw.WriteString(end); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// ReadJSON reads a snapshot previously written with WriteJSON. The UTXOs are
+// read into memory.
+func ReadJSON(r io.Reader) (*Snapshot, error) {
+	var parsed struct {
+		*Snapshot
+		UTXOs []UTXO `json:"utxos"`
+	}
+	parsed.Snapshot = &Snapshot{}
+	if err := json.NewDecoder(r).Decode(&parsed); err != nil {
+		return nil, err
+	}
+	snapshot := parsed.Snapshot
+	if snapshot.Version != Version {
+		return nil, fmt.Errorf("unsupported snapshot version %d", snapshot.Version)
+	}
+	snapshot.UTXOs = NewUTXOs(parsed.UTXOs)
+	return snapshot, nil
+}
+
+func (s *Snapshot) exportStakers(chain state.State) error {
+	currentIt, err := chain.GetCurrentStakerIterator()
+	if err != nil {
+		return fmt.Errorf("failed to get current stakers: %w", err)
+	}
+	if err := s.appendStakers(chain, currentIt); err != nil {
+		return err
+	}
+
+	pendingIt, err := chain.GetPendingStakerIterator()
+	if err != nil {
+		return fmt.Errorf("failed to get pending stakers: %w", err)
+	}
+	return s.appendStakers(chain, pendingIt)
+}
+
+func (s *Snapshot) appendStakers(chain state.State, it iterator.Iterator[*state.Staker]) error {
+	defer it.Release()
+
+	for it.Next() {
+		staker := it.Value()
+
+		var publicKey types.JSONByteSlice
+		if staker.PublicKey != nil {
+			publicKey = bls.PublicKeyToCompressedBytes(staker.PublicKey)
+		}
+
+		var delegateeReward uint64
+		if staker.Priority.IsCurrentValidator() && !staker.Priority.IsPermissionedValidator() {
+			var err error
+			delegateeReward, err = chain.GetDelegateeReward(staker.SubnetID, staker.NodeID)
+			if err != nil && !errors.Is(err, database.ErrNotFound) {
+				return fmt.Errorf("failed to get delegatee reward of %s: %w", staker.NodeID, err)
+			}
+		}
+
+		s.Stakers = append(s.Stakers, Staker{
+			TxID:            staker.TxID,
+			NodeID:          staker.NodeID,
+			SubnetID:        staker.SubnetID,
+			PublicKey:       publicKey,
+			Kind:            stakerKind(staker.Priority),
+			Pending:         staker.Priority.IsPending(),
+			Weight:          avajson.Uint64(staker.Weight),
+			StartTime:       avajson.Uint64(staker.StartTime.Unix()),
+			EndTime:         avajson.Uint64(staker.EndTime.Unix()),
+			PotentialReward: avajson.Uint64(staker.PotentialReward),
+			DelegateeReward: avajson.Uint64(delegateeReward),
+		})
+	}
+	return nil
+}
+
+func (s *Snapshot) exportSubnets(ctx context.Context, chain state.State, hrp string) error {
+	if err := s.appendChains(chain, constants.PrimaryNetworkID); err != nil {
+		return err
+	}
+
+	subnetIDs, err := chain.GetSubnetIDs()
+	if err != nil {
+		return fmt.Errorf("failed to get subnet IDs: %w", err)
+	}
+
+	accruedFees := chain.GetAccruedFees()
+	for _, subnetID := range subnetIDs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		subnetOwner, err := chain.GetSubnetOwner(subnetID)
+		if err != nil {
+			return fmt.Errorf("failed to get owner of subnet %s: %w", subnetID, err)
+		}
+		owner, err := newOwner(subnetOwner, hrp)
+		if err != nil {
+			return fmt.Errorf("failed to format owner of subnet %s: %w", subnetID, err)
+		}
+
+		subnet := Subnet{
+			ID:    subnetID,
+			Owner: owner,
+		}
+
+		switch transformationTx, err := chain.GetSubnetTransformation(subnetID); err {
+		case nil:
+			transformation, ok := transformationTx.Unsigned.(*txs.TransformSubnetTx)
+			if !ok {
+				return fmt.Errorf("unexpected transformation tx type %T", transformationTx.Unsigned)
+			}
+			currentSupply, err := chain.GetCurrentSupply(subnetID)
+			if err != nil {
+				return fmt.Errorf("failed to get current supply of subnet %s: %w", subnetID, err)
+			}
+
+			subnet.TransformationTxID = transformationTx.ID()
+			subnet.AssetID = transformation.AssetID
+			subnet.CurrentSupply = avajson.Uint64(currentSupply)
+		case database.ErrNotFound:
+		default:
+			return fmt.Errorf("failed to get transformation of subnet %s: %w", subnetID, err)
+		}
+
+		switch conversion, err := chain.GetSubnetToL1Conversion(subnetID); err {
+		case nil:
+			subnet.ConversionID = conversion.ConversionID
+			subnet.ManagerChainID = conversion.ChainID
+			subnet.ManagerAddress = conversion.Addr
+
+			_, l1Validators, _, err := chain.GetCurrentValidators(ctx, subnetID)
+			if err != nil {
+				return fmt.Errorf("failed to get L1 validators of subnet %s: %w", subnetID, err)
+			}
+			for _, l1Validator := range l1Validators {
+				s.L1Validators = append(s.L1Validators, newL1Validator(l1Validator, accruedFees))
+			}
+		case database.ErrNotFound:
+		default:
+			return fmt.Errorf("failed to get conversion of subnet %s: %w", subnetID, err)
+		}
+
+		s.Subnets = append(s.Subnets, subnet)
+		if err := s.appendChains(chain, subnetID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Snapshot) appendChains(chain state.State, subnetID ids.ID) error {
+	chains, err := chain.GetChains(subnetID)
+	if err != nil {
+		return fmt.Errorf("failed to get chains of subnet %s: %w", subnetID, err)
+	}
+	for _, chainTx := range chains {
+		createChainTx, ok := chainTx.Unsigned.(*txs.CreateChainTx)
+		if !ok {
+			return fmt.Errorf("unexpected chain tx type %T", chainTx.Unsigned)
+		}
+		s.Chains = append(s.Chains, Chain{
+			ID:       chainTx.ID(),
+			SubnetID: createChainTx.SubnetID,
+			Name:     createChainTx.ChainName,
+			VMID:     createChainTx.VMID,
+		})
+	}
+	return nil
+}
+
+// exportUTXOs calls [f] on the UTXOs of [chain], reading them page by page.
+func exportUTXOs(ctx context.Context, chain state.State, hrp string, f func(UTXO) error) error {
+	var previous ids.ID
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		utxos, err := chain.UTXOs(previous, utxoPageSize)
+		if err != nil {
+			return fmt.Errorf("failed to get UTXOs after %s: %w", previous, err)
+		}
+		for _, utxo := range utxos {
+			formatted, err := newUTXO(utxo, hrp)
+			if err != nil {
+				return fmt.Errorf("failed to format UTXO %s: %w", utxo.InputID(), err)
+			}
+			if err := f(formatted); err != nil {
+				return err
+			}
+		}
+		if len(utxos) < utxoPageSize {
+			return nil
+		}
+		previous = utxos[len(utxos)-1].InputID()
+	}
+}
+
+func stakerKind(priority txs.Priority) string {
+	switch {
+	case priority.IsPermissionedValidator():
+		return KindPermissionedValidator
+	case priority.IsValidator():
+		return KindValidator
+	default:
+		return KindDelegator
+	}
+}
+
+func newL1Validator(l1Validator state.L1Validator, accruedFees uint64) L1Validator {
+	var balance uint64
+	if l1Validator.IsActive() {
+		balance = l1Validator.EndAccumulatedFee - accruedFees
+	}
+	return L1Validator{
+		ValidationID: l1Validator.ValidationID,
+		SubnetID:     l1Validator.SubnetID,
+		NodeID:       l1Validator.NodeID,
+		PublicKey:    l1Validator.PublicKey,
+		Weight:       avajson.Uint64(l1Validator.Weight),
+		StartTime:    avajson.Uint64(l1Validator.StartTime),
+		MinNonce:     avajson.Uint64(l1Validator.MinNonce),
+		Active:       l1Validator.IsActive(),
+		Balance:      avajson.Uint64(balance),
+	}
+}
+
+func newUTXO(utxo *avax.UTXO, hrp string) (UTXO, error) {
+	formatted := UTXO{
+		ID:          utxo.InputID(),
+		TxID:        utxo.TxID,
+		OutputIndex: utxo.OutputIndex,
+		AssetID:     utxo.AssetID(),
+	}
+
+	out := utxo.Out
+	if lockOut, ok := out.(*stakeable.LockOut); ok {
+		formatted.StakeableLocktime = avajson.Uint64(lockOut.Locktime)
+		out = lockOut.TransferableOut
+	}
+
+	transferOut, ok := out.(*secp256k1fx.TransferOutput)
+	if !ok {
+		return UTXO{}, fmt.Errorf("%w: %T", errUnexpectedOutputType, out)
+	}
+	owner, err := newOwner(&transferOut.OutputOwners, hrp)
+	if err != nil {
+		return UTXO{}, err
+	}
+	formatted.Amount = avajson.Uint64(transferOut.Amt)
+	formatted.Owner = owner
+	return formatted, nil
+}
+
+func newOwner(owner any, hrp string) (Owner, error) {
+	outputOwners, ok := owner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return Owner{}, fmt.Errorf("%w: %T", errUnexpectedOwnerType, owner)
+	}
+
+	addrs := make([]string, len(outputOwners.Addrs))
+	for i, addr := range outputOwners.Addrs {
+		formatted, err := address.Format("P", hrp, addr.Bytes())
+		if err != nil {
+			return Owner{}, err
+		}
+		addrs[i] = formatted
+	}
+	return Owner{
+		Locktime:  avajson.Uint64(outputOwners.Locktime),
+		Threshold: avajson.Uint32(outputOwners.Threshold),
+		Addresses: addrs,
+	}, nil
+}
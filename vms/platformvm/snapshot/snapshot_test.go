@@ -0,0 +1,231 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis/genesistest"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state/statetest"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+)
+
+func newTestSnapshot(t *testing.T) (*Snapshot, ids.ID, state.L1Validator) {
+	require := require.New(t)
+
+	s := statetest.New(t, statetest.Config{})
+
+	subnetID := ids.GenerateTestID()
+	s.AddSubnet(subnetID)
+	s.SetSubnetOwner(subnetID, &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs: []ids.ShortID{
+			genesistest.DefaultFundedKeys[0].Address(),
+		},
+	})
+	s.SetSubnetToL1Conversion(subnetID, state.SubnetToL1Conversion{
+		ConversionID: ids.GenerateTestID(),
+		ChainID:      ids.GenerateTestID(),
+		Addr:         []byte{'a', 'd', 'd', 'r'},
+	})
+
+	sk, err := localsigner.New()
+	require.NoError(err)
+	l1Validator := state.L1Validator{
+		ValidationID:      ids.GenerateTestID(),
+		SubnetID:          subnetID,
+		NodeID:            ids.GenerateTestNodeID(),
+		PublicKey:         bls.PublicKeyToUncompressedBytes(sk.PublicKey()),
+		Weight:            1,
+		EndAccumulatedFee: units.Avax,
+	}
+	require.NoError(s.PutL1Validator(l1Validator))
+	require.NoError(s.Commit())
+
+	snapshot, err := Export(context.Background(), s, constants.UnitTestID, snowtest.AVAXAssetID)
+	require.NoError(err)
+	return snapshot, subnetID, l1Validator
+}
+
+func collectUTXOs(t *testing.T, snapshot *Snapshot) []UTXO {
+	var utxos []UTXO
+	require.NoError(t, snapshot.UTXOs(func(utxo UTXO) error {
+		utxos = append(utxos, utxo)
+		return nil
+	}))
+	return utxos
+}
+
+func TestExport(t *testing.T) {
+	require := require.New(t)
+
+	snapshot, subnetID, l1Validator := newTestSnapshot(t)
+	require.Equal(uint32(Version), snapshot.Version)
+	require.Equal(constants.UnitTestID, snapshot.NetworkID)
+	require.Zero(snapshot.Height)
+	require.Equal(snowtest.AVAXAssetID, snapshot.AVAXAssetID)
+
+	require.Len(snapshot.Stakers, len(genesistest.DefaultNodeIDs))
+	for _, staker := range snapshot.Stakers {
+		require.Equal(constants.PrimaryNetworkID, staker.SubnetID)
+		require.Equal(KindValidator, staker.Kind)
+		require.False(staker.Pending)
+		require.Equal(avajson.Uint64(genesistest.DefaultValidatorWeight), staker.Weight)
+	}
+
+	require.Len(snapshot.Subnets, 1)
+	subnet := snapshot.Subnets[0]
+	require.Equal(subnetID, subnet.ID)
+	require.Equal(avajson.Uint32(1), subnet.Owner.Threshold)
+	require.Len(subnet.Owner.Addresses, 1)
+	require.Equal(ids.Empty, subnet.TransformationTxID)
+	require.NotEqual(ids.Empty, subnet.ConversionID)
+
+	require.Len(snapshot.Chains, 1)
+	require.Equal(constants.PrimaryNetworkID, snapshot.Chains[0].SubnetID)
+	require.Equal(genesistest.XChainName, snapshot.Chains[0].Name)
+
+	require.Equal(
+		[]L1Validator{
+			{
+				ValidationID: l1Validator.ValidationID,
+				SubnetID:     subnetID,
+				NodeID:       l1Validator.NodeID,
+				PublicKey:    l1Validator.PublicKey,
+				Weight:       1,
+				Active:       true,
+				Balance:      avajson.Uint64(units.Avax),
+			},
+		},
+		snapshot.L1Validators,
+	)
+
+	utxos := collectUTXOs(t, snapshot)
+	require.Len(utxos, len(genesistest.DefaultFundedKeys))
+	for i := 1; i < len(utxos); i++ {
+		require.Equal(-1, utxos[i-1].ID.Compare(utxos[i].ID))
+	}
+}
+
+func TestVerify(t *testing.T) {
+	require := require.New(t)
+
+	snapshot, _, _ := newTestSnapshot(t)
+	reports, err := Verify(snapshot)
+	require.NoError(err)
+	require.Len(reports, 1)
+
+	report := reports[0]
+	require.Equal(constants.PrimaryNetworkID, report.SubnetID)
+	require.Equal(snowtest.AVAXAssetID, report.AssetID)
+	require.Equal(snapshot.CurrentSupply, report.CurrentSupply)
+	require.Equal(avajson.Uint64(uint64(len(genesistest.DefaultFundedKeys))*genesistest.DefaultInitialBalance), report.UTXOs)
+	require.Equal(avajson.Uint64(uint64(len(genesistest.DefaultNodeIDs))*genesistest.DefaultValidatorWeight), report.Staked)
+	require.Equal(avajson.Uint64(units.Avax), report.L1ValidatorBalances)
+	require.Equal(report.CurrentSupply-report.Accounted, report.BurnedOrExported)
+
+	// Inflating the balances beyond the current supply must be reported.
+	utxos := collectUTXOs(t, snapshot)
+	utxos[0].Amount = snapshot.CurrentSupply
+	snapshot.UTXOs = NewUTXOs(utxos)
+	_, err = Verify(snapshot)
+	require.ErrorIs(err, ErrSupplyMismatch)
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	require := require.New(t)
+
+	snapshot, _, _ := newTestSnapshot(t)
+
+	var buf bytes.Buffer
+	require.NoError(WriteJSON(&buf, snapshot))
+
+	parsed, err := ReadJSON(&buf)
+	require.NoError(err)
+	require.Equal(collectUTXOs(t, snapshot), collectUTXOs(t, parsed))
+
+	snapshot.UTXOs = nil
+	parsed.UTXOs = nil
+	require.Equal(snapshot, parsed)
+}
+
+func TestJSONRoundTripWithoutUTXOs(t *testing.T) {
+	require := require.New(t)
+
+	snapshot, _, _ := newTestSnapshot(t)
+	snapshot.UTXOs = NewUTXOs(nil)
+
+	var buf bytes.Buffer
+	require.NoError(WriteJSON(&buf, snapshot))
+
+	parsed, err := ReadJSON(&buf)
+	require.NoError(err)
+	require.Empty(collectUTXOs(t, parsed))
+}
+
+func TestWriteCSV(t *testing.T) {
+	snapshot, _, _ := newTestSnapshot(t)
+
+	dir := t.TempDir()
+	require.NoError(t, WriteCSV(dir, snapshot))
+
+	tests := []struct {
+		file         string
+		expectedRows int
+	}{
+		{
+			file:         MetadataFile,
+			expectedRows: 1,
+		},
+		{
+			file:         StakersFile,
+			expectedRows: len(snapshot.Stakers),
+		},
+		{
+			file:         SubnetsFile,
+			expectedRows: len(snapshot.Subnets),
+		},
+		{
+			file:         ChainsFile,
+			expectedRows: len(snapshot.Chains),
+		},
+		{
+			file:         L1ValidatorsFile,
+			expectedRows: len(snapshot.L1Validators),
+		},
+		{
+			file:         UTXOsFile,
+			expectedRows: len(collectUTXOs(t, snapshot)),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			require := require.New(t)
+
+			f, err := os.Open(filepath.Join(dir, test.file))
+			require.NoError(err)
+			defer f.Close()
+
+			records, err := csv.NewReader(f).ReadAll()
+			require.NoError(err)
+			require.Len(records, test.expectedRows+1) // +1 for the header
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package snapshot
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+var ErrSupplyMismatch = errors.New("accounted supply exceeds current supply")
+
+// SupplyReport compares the supply of an asset recomputed from a snapshot
+// against the current supply recorded in the state.
+type SupplyReport struct {
+	SubnetID      ids.ID         `json:"subnetID"`
+	AssetID       ids.ID         `json:"assetID"`
+	CurrentSupply avajson.Uint64 `json:"currentSupply"`
+
+	UTXOs               avajson.Uint64 `json:"utxos"`
+	Staked              avajson.Uint64 `json:"staked"`
+	PotentialRewards    avajson.Uint64 `json:"potentialRewards"`
+	DelegateeRewards    avajson.Uint64 `json:"delegateeRewards"`
+	L1ValidatorBalances avajson.Uint64 `json:"l1ValidatorBalances"`
+
+	// Accounted is the sum of the above amounts.
+	Accounted avajson.Uint64 `json:"accounted"`
+	// BurnedOrExported is the expected difference between CurrentSupply and
+	// Accounted. The current supply isn't reduced when fees are burned or when
+	// funds are exported to other chains, so this is the amount that was
+	// burned as fees or exported, net of the amount that was imported.
+	BurnedOrExported avajson.Uint64 `json:"burnedOrExported"`
+}
+
+// Verify recomputes the supply of AVAX and of the asset of every transformed
+// Subnet from [snapshot].
+//
+// The current supply recorded in the state is not reduced when fees are burned
+// or when funds are exported from the P-Chain. So, the recomputed supply is a
+// lower bound of the current supply, the difference is reported as
+// BurnedOrExported and an error is returned if the recomputed supply exceeds
+// the current supply.
+func Verify(snapshot *Snapshot) ([]SupplyReport, error) {
+	reports := []*SupplyReport{
+		{
+			SubnetID:      constants.PrimaryNetworkID,
+			AssetID:       snapshot.AVAXAssetID,
+			CurrentSupply: snapshot.CurrentSupply,
+		},
+	}
+	for _, subnet := range snapshot.Subnets {
+		if subnet.TransformationTxID == ids.Empty {
+			continue
+		}
+		reports = append(reports, &SupplyReport{
+			SubnetID:      subnet.ID,
+			AssetID:       subnet.AssetID,
+			CurrentSupply: subnet.CurrentSupply,
+		})
+	}
+
+	var (
+		reportsBySubnetID = make(map[ids.ID]*SupplyReport, len(reports))
+		utxosByAssetID    = make(map[ids.ID]avajson.Uint64)
+		err               error
+	)
+	for _, report := range reports {
+		reportsBySubnetID[report.SubnetID] = report
+	}
+
+	err = snapshot.UTXOs(func(utxo UTXO) error {
+		sum := utxosByAssetID[utxo.AssetID]
+		if err := addTo(&sum, utxo.Amount); err != nil {
+			return fmt.Errorf("failed to sum UTXOs of asset %s: %w", utxo.AssetID, err)
+		}
+		utxosByAssetID[utxo.AssetID] = sum
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, report := range reports {
+		report.UTXOs = utxosByAssetID[report.AssetID]
+	}
+
+	for _, staker := range snapshot.Stakers {
+		report, ok := reportsBySubnetID[staker.SubnetID]
+		if !ok || staker.Kind == KindPermissionedValidator {
+			continue
+		}
+		if err := addTo(&report.Staked, staker.Weight); err != nil {
+			return nil, fmt.Errorf("failed to sum stake of subnet %s: %w", staker.SubnetID, err)
+		}
+		if err := addTo(&report.PotentialRewards, staker.PotentialReward); err != nil {
+			return nil, fmt.Errorf("failed to sum potential rewards of subnet %s: %w", staker.SubnetID, err)
+		}
+		if err := addTo(&report.DelegateeRewards, staker.DelegateeReward); err != nil {
+			return nil, fmt.Errorf("failed to sum delegatee rewards of subnet %s: %w", staker.SubnetID, err)
+		}
+	}
+
+	// L1 validator balances are always denominated in AVAX.
+	primaryReport := reports[0]
+	for _, l1Validator := range snapshot.L1Validators {
+		if err := addTo(&primaryReport.L1ValidatorBalances, l1Validator.Balance); err != nil {
+			return nil, fmt.Errorf("failed to sum L1 validator balances: %w", err)
+		}
+	}
+
+	result := make([]SupplyReport, len(reports))
+	for i, report := range reports {
+		for _, amount := range []avajson.Uint64{
+			report.UTXOs,
+			report.Staked,
+			report.PotentialRewards,
+			report.DelegateeRewards,
+			report.L1ValidatorBalances,
+		} {
+			if err := addTo(&report.Accounted, amount); err != nil {
+				return nil, fmt.Errorf("failed to sum supply of subnet %s: %w", report.SubnetID, err)
+			}
+		}
+		if report.Accounted > report.CurrentSupply {
+			err = errors.Join(err, fmt.Errorf("%w: subnet %s accounts for %d of asset %s but the current supply is %d",
+				ErrSupplyMismatch,
+				report.SubnetID,
+				report.Accounted,
+				report.AssetID,
+				report.CurrentSupply,
+			))
+		} else {
+			report.BurnedOrExported = report.CurrentSupply - report.Accounted
+		}
+		result[i] = *report
+	}
+	return result, err
+}
+
+func addTo(sum *avajson.Uint64, amount avajson.Uint64) error {
+	var err error
+	*sum, err = safemath.Add(*sum, amount)
+	return err
+}
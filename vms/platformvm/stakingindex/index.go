@@ -0,0 +1,344 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package stakingindex records the completed validation and delegation periods
+// of the P-Chain, so that they can be queried by node ID, reward address and
+// time.
+package stakingindex
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+var (
+	periodPrefix  = []byte("period")
+	nodeIDPrefix  = []byte("nodeID")
+	addressPrefix = []byte("address")
+	timePrefix    = []byte("time")
+
+	errWrongVersion   = errors.New("wrong version")
+	errNotStakerTx    = errors.New("not a staker tx")
+	errMissingStaker  = errors.New("missing staker")
+	errRewardOverflow = errors.New("reward overflow")
+)
+
+// Period is a completed validation or delegation period.
+type Period struct {
+	// TxID is the ID of the transaction that added the staker.
+	TxID     ids.ID     `serialize:"true"`
+	NodeID   ids.NodeID `serialize:"true"`
+	SubnetID ids.ID     `serialize:"true"`
+	// Delegator is true if the staker delegated to NodeID, rather than
+	// validated.
+	Delegator bool `serialize:"true"`
+	// StartTime and EndTime are the unix times the staker was added and
+	// removed.
+	StartTime uint64 `serialize:"true"`
+	EndTime   uint64 `serialize:"true"`
+	// Weight is the amount staked.
+	Weight          uint64 `serialize:"true"`
+	PotentialReward uint64 `serialize:"true"`
+	// Rewarded is true if the staker's uptime was sufficient for the
+	// potential reward to be paid.
+	Rewarded bool `serialize:"true"`
+	// Reward is the amount paid to the reward addresses when the staker was
+	// removed. This includes the delegation fees of a validator.
+	Reward uint64 `serialize:"true"`
+	// RewardAddresses are the addresses that own the rewards of the staker.
+	RewardAddresses []ids.ShortID `serialize:"true"`
+	// Height is the height of the block that removed the staker.
+	Height uint64 `serialize:"true"`
+
+	// owners are the owners of the rewards of the staker.
+	owners []*secp256k1fx.OutputOwners
+}
+
+// Query selects the periods that ended within [StartTime, EndTime]. If NodeID
+// or Address is non-empty, only the periods of that node or with that reward
+// address are selected.
+type Query struct {
+	NodeID    ids.NodeID
+	Address   ids.ShortID
+	StartTime uint64
+	// StartTxID skips the periods that ended at StartTime and were added by a
+	// transaction with a lower ID. Together with StartTime, it is set to a
+	// [Cursor] to fetch the next page.
+	StartTxID ids.ID
+	EndTime   uint64
+	// Limit is the maximum number of periods to return.
+	Limit int
+}
+
+// Cursor identifies the first period of the next page of a [Query].
+type Cursor struct {
+	EndTime uint64
+	TxID    ids.ID
+}
+
+// Index records completed staking periods.
+//
+// Periods are keyed by the ID of the transaction that added the staker, so
+// recording a period multiple times is idempotent.
+type Index struct {
+	periods   database.Database
+	nodeIDs   database.Database
+	addresses database.Database
+	times     database.Database
+}
+
+func New(db database.Database) *Index {
+	return &Index{
+		periods:   prefixdb.New(periodPrefix, db),
+		nodeIDs:   prefixdb.New(nodeIDPrefix, db),
+		addresses: prefixdb.New(addressPrefix, db),
+		times:     prefixdb.New(timePrefix, db),
+	}
+}
+
+// Removed returns the period of the staker that [proposal] removes, or nil if
+// [proposal] doesn't reward a staker. [chain] must be the state before the
+// staker is removed.
+//
+// The reward of the period is unknown until [Index.Accept] is called.
+func Removed(chain state.Chain, proposal block.Block) (*Period, error) {
+	var rewardTx *txs.RewardValidatorTx
+	for _, tx := range proposal.Txs() {
+		if utx, ok := tx.Unsigned.(*txs.RewardValidatorTx); ok {
+			rewardTx = utx
+		}
+	}
+	if rewardTx == nil {
+		return nil, nil
+	}
+
+	stakerTx, _, err := chain.GetTx(rewardTx.TxID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get staker tx %s: %w", rewardTx.TxID, err)
+	}
+
+	var (
+		period = &Period{
+			TxID:   rewardTx.TxID,
+			Height: proposal.Height() + 1,
+		}
+		owners []fx.Owner
+	)
+	switch utx := stakerTx.Unsigned.(type) {
+	case txs.ValidatorTx:
+		period.NodeID = utx.NodeID()
+		period.SubnetID = utx.SubnetID()
+		owners = []fx.Owner{utx.ValidationRewardsOwner(), utx.DelegationRewardsOwner()}
+
+		staker, err := chain.GetCurrentValidator(period.SubnetID, period.NodeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get validator %s: %w", period.NodeID, err)
+		}
+		period.setStaker(staker)
+	case txs.DelegatorTx:
+		period.NodeID = utx.NodeID()
+		period.SubnetID = utx.SubnetID()
+		period.Delegator = true
+		owners = []fx.Owner{utx.RewardsOwner()}
+
+		staker, err := getCurrentDelegator(chain, period.SubnetID, period.NodeID, period.TxID)
+		if err != nil {
+			return nil, err
+		}
+		period.setStaker(staker)
+	default:
+		return nil, fmt.Errorf("%w: %T", errNotStakerTx, utx)
+	}
+
+	var addrs set.Set[ids.ShortID]
+	for _, owner := range owners {
+		if owner, ok := owner.(*secp256k1fx.OutputOwners); ok {
+			period.owners = append(period.owners, owner)
+			addrs.Add(owner.Addrs...)
+		}
+	}
+	period.RewardAddresses = addrs.List()
+	utils.Sort(period.RewardAddresses)
+	return period, nil
+}
+
+func getCurrentDelegator(chain state.Chain, subnetID ids.ID, nodeID ids.NodeID, txID ids.ID) (*state.Staker, error) {
+	it, err := chain.GetCurrentDelegatorIterator(subnetID, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get delegators of %s: %w", nodeID, err)
+	}
+	defer it.Release()
+
+	for it.Next() {
+		if staker := it.Value(); staker.TxID == txID {
+			return staker, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", errMissingStaker, txID)
+}
+
+func (p *Period) setStaker(staker *state.Staker) {
+	p.StartTime = uint64(staker.StartTime.Unix())
+	p.EndTime = uint64(staker.EndTime.Unix())
+	p.Weight = staker.Weight
+	p.PotentialReward = staker.PotentialReward
+}
+
+// Accept records [period] once the removal of its staker is decided.
+// [rewardUTXOs] are the reward UTXOs of the staker after the decision.
+//
+// Only the reward UTXOs owned by the reward owners of the staker are counted.
+// Before Cortina, the reward UTXOs of a delegator also include the delegation
+// fee paid to its validator.
+func (i *Index) Accept(period *Period, rewarded bool, rewardUTXOs []*avax.UTXO) error {
+	period.Rewarded = rewarded
+	period.Reward = 0
+	for _, utxo := range rewardUTXOs {
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok || !period.ownsReward(&out.OutputOwners) {
+			continue
+		}
+		reward, err := safemath.Add(period.Reward, out.Amount())
+		if err != nil {
+			return fmt.Errorf("%w: %w", errRewardOverflow, err)
+		}
+		period.Reward = reward
+	}
+	return i.put(period)
+}
+
+func (p *Period) ownsReward(owner *secp256k1fx.OutputOwners) bool {
+	for _, o := range p.owners {
+		if o.Equals(owner) {
+			return true
+		}
+	}
+	return false
+}
+
+// Periods are stored by transaction ID, and indexed by node ID, reward
+// address and time. Each index key ends with the end time of the period and
+// the transaction ID, so that the periods of an index are sorted by end time.
+func (i *Index) put(period *Period) error {
+	bytes, err := Codec.Marshal(CodecVersion, period)
+	if err != nil {
+		return err
+	}
+	if err := i.periods.Put(period.TxID[:], bytes); err != nil {
+		return err
+	}
+
+	suffix := timeKey(period.EndTime, period.TxID)
+	if err := i.nodeIDs.Put(prefixKey(period.NodeID[:], suffix), nil); err != nil {
+		return err
+	}
+	for _, addr := range period.RewardAddresses {
+		if err := i.addresses.Put(prefixKey(addr[:], suffix), nil); err != nil {
+			return err
+		}
+	}
+	return i.times.Put(suffix, nil)
+}
+
+// Get returns the periods selected by [query], sorted by end time and then by
+// transaction ID. If more periods may be selected than [query.Limit], the
+// cursor of the next page is returned. Otherwise, the returned cursor is nil.
+func (i *Index) Get(query Query) ([]*Period, *Cursor, error) {
+	var (
+		db     = i.times
+		prefix []byte
+	)
+	switch {
+	case query.NodeID != ids.EmptyNodeID:
+		db = i.nodeIDs
+		prefix = query.NodeID[:]
+	case query.Address != ids.ShortEmpty:
+		db = i.addresses
+		prefix = query.Address[:]
+	}
+
+	endTime := query.EndTime
+	if endTime == 0 {
+		endTime = math.MaxUint64
+	}
+
+	start := prefixKey(prefix, timeKey(query.StartTime, query.StartTxID))
+	it := db.NewIteratorWithStartAndPrefix(start, prefix)
+	defer it.Release()
+
+	var periods []*Period
+	for it.Next() {
+		key := it.Key()[len(prefix):]
+		periodEndTime, err := database.ParseUInt64(key[:database.Uint64Size])
+		if err != nil {
+			return nil, nil, err
+		}
+		if periodEndTime > endTime {
+			break
+		}
+
+		txID, err := ids.ToID(key[database.Uint64Size:])
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(periods) >= query.Limit {
+			return periods, &Cursor{
+				EndTime: periodEndTime,
+				TxID:    txID,
+			}, it.Error()
+		}
+
+		period, err := i.getPeriod(txID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if query.Address != ids.ShortEmpty && !slices.Contains(period.RewardAddresses, query.Address) {
+			continue
+		}
+		periods = append(periods, period)
+	}
+	return periods, nil, it.Error()
+}
+
+func (i *Index) getPeriod(txID ids.ID) (*Period, error) {
+	bytes, err := i.periods.Get(txID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	period := &Period{}
+	parsedVersion, err := Codec.Unmarshal(bytes, period)
+	if err != nil {
+		return nil, err
+	}
+	if parsedVersion != CodecVersion {
+		return nil, errWrongVersion
+	}
+	return period, nil
+}
+
+func timeKey(endTime uint64, txID ids.ID) []byte {
+	return prefixKey(database.PackUInt64(endTime), txID[:])
+}
+
+func prefixKey(prefix, key []byte) []byte {
+	k := make([]byte, 0, len(prefix)+len(key))
+	k = append(k, prefix...)
+	return append(k, key...)
+}
@@ -0,0 +1,244 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package stakingindex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func newTestPeriod(nodeID ids.NodeID, endTime uint64, owner *secp256k1fx.OutputOwners) *Period {
+	return &Period{
+		TxID:            ids.GenerateTestID(),
+		NodeID:          nodeID,
+		EndTime:         endTime,
+		RewardAddresses: owner.Addrs,
+		owners:          []*secp256k1fx.OutputOwners{owner},
+	}
+}
+
+func newRewardUTXO(amount uint64, owner *secp256k1fx.OutputOwners) *avax.UTXO {
+	return &avax.UTXO{
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          amount,
+			OutputOwners: *owner,
+		},
+	}
+}
+
+func TestIndexAccept(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		index = New(memdb.New())
+		owner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		}
+		otherOwner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		}
+		period = newTestPeriod(ids.GenerateTestNodeID(), 1, owner)
+	)
+
+	// Rewards paid to other owners aren't included.
+	require.NoError(index.Accept(period, true, []*avax.UTXO{
+		newRewardUTXO(1, owner),
+		newRewardUTXO(2, owner),
+		newRewardUTXO(4, otherOwner),
+	}))
+
+	periods, _, err := index.Get(Query{
+		NodeID: period.NodeID,
+		Limit:  10,
+	})
+	require.NoError(err)
+	require.Len(periods, 1)
+	require.Equal(period.TxID, periods[0].TxID)
+	require.True(periods[0].Rewarded)
+	require.Equal(uint64(3), periods[0].Reward)
+
+	// Accepting a period again overwrites it.
+	require.NoError(index.Accept(period, false, nil))
+
+	periods, _, err = index.Get(Query{
+		NodeID: period.NodeID,
+		Limit:  10,
+	})
+	require.NoError(err)
+	require.Len(periods, 1)
+	require.False(periods[0].Rewarded)
+	require.Zero(periods[0].Reward)
+}
+
+func TestIndexGet(t *testing.T) {
+	var (
+		index   = New(memdb.New())
+		nodeID0 = ids.GenerateTestNodeID()
+		nodeID1 = ids.GenerateTestNodeID()
+		owner0  = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		}
+		owner1 = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		}
+		periods = []*Period{
+			newTestPeriod(nodeID0, 3, owner0),
+			newTestPeriod(nodeID0, 1, owner1),
+			newTestPeriod(nodeID1, 2, owner0),
+			newTestPeriod(nodeID1, 4, owner1),
+		}
+	)
+	for _, period := range periods {
+		require.NoError(t, index.Accept(period, true, nil))
+	}
+
+	tests := []struct {
+		name         string
+		query        Query
+		expected     []*Period
+		expectedNext *Cursor
+	}{
+		{
+			name: "all",
+			query: Query{
+				Limit: 10,
+			},
+			expected: []*Period{periods[1], periods[2], periods[0], periods[3]},
+		},
+		{
+			name: "limit",
+			query: Query{
+				Limit: 2,
+			},
+			expected: []*Period{periods[1], periods[2]},
+			expectedNext: &Cursor{
+				EndTime: 3,
+				TxID:    periods[0].TxID,
+			},
+		},
+		{
+			name: "cursor",
+			query: Query{
+				StartTime: 3,
+				StartTxID: periods[0].TxID,
+				Limit:     10,
+			},
+			expected: []*Period{periods[0], periods[3]},
+		},
+		{
+			name: "time range",
+			query: Query{
+				StartTime: 2,
+				EndTime:   3,
+				Limit:     10,
+			},
+			expected: []*Period{periods[2], periods[0]},
+		},
+		{
+			name: "node ID",
+			query: Query{
+				NodeID: nodeID1,
+				Limit:  10,
+			},
+			expected: []*Period{periods[2], periods[3]},
+		},
+		{
+			name: "address",
+			query: Query{
+				Address: owner0.Addrs[0],
+				Limit:   10,
+			},
+			expected: []*Period{periods[2], periods[0]},
+		},
+		{
+			name: "node ID and address",
+			query: Query{
+				NodeID:  nodeID0,
+				Address: owner1.Addrs[0],
+				Limit:   10,
+			},
+			expected: []*Period{periods[1]},
+		},
+		{
+			name: "node ID and time range",
+			query: Query{
+				NodeID:    nodeID0,
+				StartTime: 2,
+				Limit:     10,
+			},
+			expected: []*Period{periods[0]},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			actual, next, err := index.Get(test.query)
+			require.NoError(err)
+			require.Equal(test.expectedNext, next)
+
+			actualTxIDs := make([]ids.ID, len(actual))
+			for i, period := range actual {
+				actualTxIDs[i] = period.TxID
+			}
+			expectedTxIDs := make([]ids.ID, len(test.expected))
+			for i, period := range test.expected {
+				expectedTxIDs[i] = period.TxID
+			}
+			require.Equal(expectedTxIDs, actualTxIDs)
+		})
+	}
+}
+
+func TestIndexGetPages(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		index = New(memdb.New())
+		owner = &secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+		}
+		expectedTxIDs []ids.ID
+	)
+	// Periods that end at the same time are split across pages.
+	for endTime := uint64(1); endTime <= 3; endTime++ {
+		for range 3 {
+			period := newTestPeriod(ids.GenerateTestNodeID(), endTime, owner)
+			require.NoError(index.Accept(period, true, nil))
+			expectedTxIDs = append(expectedTxIDs, period.TxID)
+		}
+	}
+
+	var (
+		query = Query{
+			Address: owner.Addrs[0],
+			Limit:   2,
+		}
+		actualTxIDs []ids.ID
+	)
+	for {
+		periods, next, err := index.Get(query)
+		require.NoError(err)
+		for _, period := range periods {
+			actualTxIDs = append(actualTxIDs, period.TxID)
+		}
+		if next == nil {
+			break
+		}
+		query.StartTime = next.EndTime
+		query.StartTxID = next.TxID
+	}
+	require.ElementsMatch(expectedTxIDs, actualTxIDs)
+}
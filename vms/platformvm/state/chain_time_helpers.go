@@ -99,28 +99,107 @@ func getNextL1ValidatorEvictionTime(
 		return nextTime, nil
 	}
 
-	// Calculate the remaining funds that the next validator to evict has.
-	var (
-		// GetActiveL1ValidatorsIterator iterates in order of increasing
-		// EndAccumulatedFee, so the first L1 validator is the next L1 validator
-		// to evict.
-		l1Validator = l1ValidatorIterator.Value()
-		accruedFees = state.GetAccruedFees()
+	// GetActiveL1ValidatorsIterator iterates in order of increasing
+	// EndAccumulatedFee, so the first L1 validator is the next L1 validator to
+	// evict.
+	feeState := validatorfee.State{
+		Current: gas.Gas(state.NumActiveL1Validators()),
+		Excess:  state.GetL1ValidatorExcess(),
+	}
+	deactivation, err := projectL1ValidatorDeactivation(
+		config,
+		feeState,
+		state.GetTimestamp(),
+		state.GetAccruedFees(),
+		l1ValidatorIterator.Value(),
+		nextTime,
 	)
-	remainingFunds, err := math.Sub(l1Validator.EndAccumulatedFee, accruedFees)
 	if err != nil {
-		return time.Time{}, fmt.Errorf("could not calculate remaining funds: %w", err)
+		return time.Time{}, err
 	}
+	return deactivation.DeactivationTime, nil
+}
+
+// L1ValidatorDeactivation is the projected deactivation of an active L1
+// validator.
+type L1ValidatorDeactivation struct {
+	L1Validator
+
+	// Balance is the amount of funds the validator has remaining to pay for
+	// its continuous fee.
+	Balance uint64
+	// DeactivationTime is the time the validator is projected to be
+	// deactivated, capped at the provided maximum time.
+	DeactivationTime time.Time
+}
+
+// GetL1ValidatorDeactivations returns the projected deactivation of every
+// active L1 validator, in the order they are expected to be deactivated.
+//
+// Projections assume that the current L1 validator fee state is maintained
+// until [maxTime]. Deactivation times after [maxTime] are reported as
+// [maxTime].
+//
+// Validators are iterated in order of increasing remaining funds, so the fee
+// state is advanced at most once per second until [maxTime] for all of them.
+func GetL1ValidatorDeactivations(
+	config validatorfee.Config,
+	state Chain,
+	maxTime time.Time,
+) ([]L1ValidatorDeactivation, error) {
+	l1ValidatorIterator, err := state.GetActiveL1ValidatorsIterator()
+	if err != nil {
+		return nil, fmt.Errorf("could not iterate over active L1 validators: %w", err)
+	}
+	defer l1ValidatorIterator.Release()
 
-	// Calculate how many seconds the remaining funds can last for.
 	var (
 		currentTime = state.GetTimestamp()
-		maxSeconds  = uint64(nextTime.Sub(currentTime) / time.Second)
+		accruedFees = state.GetAccruedFees()
+		feeState    = validatorfee.State{
+			Current: gas.Gas(state.NumActiveL1Validators()),
+			Excess:  state.GetL1ValidatorExcess(),
+		}
+		maxSeconds    = uint64(maxTime.Sub(currentTime) / time.Second)
+		calculator    = feeState.NewSecondsRemainingCalculator(config, maxSeconds)
+		deactivations []L1ValidatorDeactivation
 	)
-	feeState := validatorfee.State{
-		Current: gas.Gas(state.NumActiveL1Validators()),
-		Excess:  state.GetL1ValidatorExcess(),
+	for l1ValidatorIterator.Next() {
+		l1Validator := l1ValidatorIterator.Value()
+		remainingFunds, err := math.Sub(l1Validator.EndAccumulatedFee, accruedFees)
+		if err != nil {
+			return nil, fmt.Errorf("could not calculate remaining funds: %w", err)
+		}
+
+		remainingSeconds := calculator.SecondsRemaining(remainingFunds)
+		deactivationTime := currentTime.Add(time.Duration(remainingSeconds) * time.Second)
+		if maxTime.Before(deactivationTime) {
+			deactivationTime = maxTime
+		}
+		deactivations = append(deactivations, L1ValidatorDeactivation{
+			L1Validator:      l1Validator,
+			Balance:          remainingFunds,
+			DeactivationTime: deactivationTime,
+		})
 	}
+	return deactivations, nil
+}
+
+func projectL1ValidatorDeactivation(
+	config validatorfee.Config,
+	feeState validatorfee.State,
+	currentTime time.Time,
+	accruedFees uint64,
+	l1Validator L1Validator,
+	maxTime time.Time,
+) (L1ValidatorDeactivation, error) {
+	remainingFunds, err := math.Sub(l1Validator.EndAccumulatedFee, accruedFees)
+	if err != nil {
+		return L1ValidatorDeactivation{}, fmt.Errorf("could not calculate remaining funds: %w", err)
+	}
+
+	// Calculate how many seconds the remaining funds can last for.
+	maxSeconds := uint64(maxTime.Sub(currentTime) / time.Second)
 	remainingSeconds := feeState.SecondsRemaining(
 		config,
 		maxSeconds,
@@ -128,10 +207,14 @@ func getNextL1ValidatorEvictionTime(
 	)
 
 	deactivationTime := currentTime.Add(time.Duration(remainingSeconds) * time.Second)
-	if deactivationTime.Before(nextTime) {
-		nextTime = deactivationTime
+	if maxTime.Before(deactivationTime) {
+		deactivationTime = maxTime
 	}
-	return nextTime, nil
+	return L1ValidatorDeactivation{
+		L1Validator:      l1Validator,
+		Balance:          remainingFunds,
+		DeactivationTime: deactivationTime,
+	}, nil
 }
 
 // PickFeeCalculator creates either a simple or a dynamic fee calculator,
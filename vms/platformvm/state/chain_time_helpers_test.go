@@ -209,6 +209,132 @@ func TestGetNextStakerChangeTime(t *testing.T) {
 	}
 }
 
+func TestGetL1ValidatorDeactivations(t *testing.T) {
+	require := require.New(t)
+
+	config := validatorfee.Config{
+		Capacity:                 genesis.LocalParams.ValidatorFeeConfig.Capacity,
+		Target:                   genesis.LocalParams.ValidatorFeeConfig.Target,
+		MinPrice:                 gas.Price(2 * units.NanoAvax),
+		ExcessConversionConstant: genesis.LocalParams.ValidatorFeeConfig.ExcessConversionConstant,
+	}
+
+	s := newTestState(t, memdb.New())
+
+	deactivations, err := GetL1ValidatorDeactivations(config, s, mockable.MaxTime)
+	require.NoError(err)
+	require.Empty(deactivations)
+
+	l1Validators := []L1Validator{
+		{
+			ValidationID:      ids.GenerateTestID(),
+			SubnetID:          ids.GenerateTestID(),
+			NodeID:            ids.GenerateTestNodeID(),
+			Weight:            1,
+			EndAccumulatedFee: 20, // This validator should be evicted in 10 seconds.
+		},
+		{
+			ValidationID:      ids.GenerateTestID(),
+			SubnetID:          ids.GenerateTestID(),
+			NodeID:            ids.GenerateTestNodeID(),
+			Weight:            1,
+			EndAccumulatedFee: units.Avax, // This validator won't be evicted before maxTime.
+		},
+		{
+			ValidationID:      ids.GenerateTestID(),
+			SubnetID:          ids.GenerateTestID(),
+			NodeID:            ids.GenerateTestNodeID(),
+			Weight:            1,
+			EndAccumulatedFee: 3, // This validator should be evicted in 1.5 seconds, which is rounded to 1.
+		},
+	}
+	for _, l1Validator := range l1Validators {
+		require.NoError(s.PutL1Validator(l1Validator))
+	}
+
+	var (
+		currentTime = genesistest.DefaultValidatorStartTime
+		maxTime     = currentTime.Add(time.Minute)
+	)
+	deactivations, err = GetL1ValidatorDeactivations(config, s, maxTime)
+	require.NoError(err)
+	require.Len(deactivations, 3)
+
+	expected := []struct {
+		validationID     ids.ID
+		balance          uint64
+		deactivationTime time.Time
+	}{
+		{
+			validationID:     l1Validators[2].ValidationID,
+			balance:          3,
+			deactivationTime: currentTime.Add(time.Second),
+		},
+		{
+			validationID:     l1Validators[0].ValidationID,
+			balance:          20,
+			deactivationTime: currentTime.Add(10 * time.Second),
+		},
+		{
+			validationID:     l1Validators[1].ValidationID,
+			balance:          units.Avax,
+			deactivationTime: maxTime,
+		},
+	}
+	for i, deactivation := range deactivations {
+		require.Equal(expected[i].validationID, deactivation.ValidationID)
+		require.Equal(expected[i].balance, deactivation.Balance)
+		require.Equal(expected[i].deactivationTime.Local(), deactivation.DeactivationTime.Local())
+	}
+}
+
+func TestGetL1ValidatorDeactivationsMatchesProjection(t *testing.T) {
+	require := require.New(t)
+
+	// The price increases every second, so the fee state must be advanced to
+	// project the deactivations.
+	config := validatorfee.Config{
+		Capacity:                 genesis.LocalParams.ValidatorFeeConfig.Capacity,
+		Target:                   0,
+		MinPrice:                 gas.Price(2 * units.NanoAvax),
+		ExcessConversionConstant: genesis.LocalParams.ValidatorFeeConfig.ExcessConversionConstant,
+	}
+
+	s := newTestState(t, memdb.New())
+	s.SetL1ValidatorExcess(gas.Gas(genesis.LocalParams.ValidatorFeeConfig.ExcessConversionConstant))
+	for _, fee := range []uint64{1, 10, 10, units.MicroAvax, units.MilliAvax, units.Avax} {
+		require.NoError(s.PutL1Validator(L1Validator{
+			ValidationID:      ids.GenerateTestID(),
+			SubnetID:          ids.GenerateTestID(),
+			NodeID:            ids.GenerateTestNodeID(),
+			Weight:            1,
+			EndAccumulatedFee: fee,
+		}))
+	}
+
+	maxTime := s.GetTimestamp().Add(time.Hour)
+	deactivations, err := GetL1ValidatorDeactivations(config, s, maxTime)
+	require.NoError(err)
+	require.Len(deactivations, 6)
+
+	feeState := validatorfee.State{
+		Current: gas.Gas(s.NumActiveL1Validators()),
+		Excess:  s.GetL1ValidatorExcess(),
+	}
+	for _, deactivation := range deactivations {
+		expected, err := projectL1ValidatorDeactivation(
+			config,
+			feeState,
+			s.GetTimestamp(),
+			s.GetAccruedFees(),
+			deactivation.L1Validator,
+			maxTime,
+		)
+		require.NoError(err)
+		require.Equal(expected, deactivation)
+	}
+}
+
 func TestPickFeeCalculator(t *testing.T) {
 	dynamicFeeConfig := genesis.LocalParams.DynamicFeeConfig
 
@@ -1055,6 +1055,21 @@ func (mr *MockStateMockRecorder) UTXOIDs(addr, previous, limit any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UTXOIDs", reflect.TypeOf((*MockState)(nil).UTXOIDs), addr, previous, limit)
 }
 
+// UTXOs mocks base method.
+func (m *MockState) UTXOs(previous ids.ID, limit int) ([]*avax.UTXO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UTXOs", previous, limit)
+	ret0, _ := ret[0].([]*avax.UTXO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UTXOs indicates an expected call of UTXOs.
+func (mr *MockStateMockRecorder) UTXOs(previous, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UTXOs", reflect.TypeOf((*MockState)(nil).UTXOs), previous, limit)
+}
+
 // WeightOfL1Validators mocks base method.
 func (m *MockState) WeightOfL1Validators(subnetID ids.ID) (uint64, error) {
 	m.ctrl.T.Helper()
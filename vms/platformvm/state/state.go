@@ -173,6 +173,11 @@ type State interface {
 	GetSubnetIDs() ([]ids.ID, error)
 	GetChains(subnetID ids.ID) ([]*txs.Tx, error)
 
+	// UTXOs returns the committed UTXOs in increasing order of their IDs,
+	// starting after [previous]. Uncommitted UTXO modifications are ignored.
+	// Returns at most [limit] UTXOs.
+	UTXOs(previous ids.ID, limit int) ([]*avax.UTXO, error)
+
 	// ApplyValidatorWeightDiffs iterates from [startHeight] towards the genesis
 	// block until it has applied all of the diffs up to and including
 	// [endHeight]. Applying the diffs modifies [validators].
@@ -1337,6 +1342,10 @@ func (s *state) UTXOIDs(addr []byte, start ids.ID, limit int) ([]ids.ID, error)
 	return s.utxoState.UTXOIDs(addr, start, limit)
 }
 
+func (s *state) UTXOs(start ids.ID, limit int) ([]*avax.UTXO, error) {
+	return s.utxoState.UTXOs(start, limit)
+}
+
 func (s *state) AddUTXO(utxo *avax.UTXO) {
 	s.modifiedUTXOs[utxo.InputID()] = utxo
 }
@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"errors"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/vms/types"
+)
+
+var (
+	_ UnsignedTx    = (*BatchL1ValidatorTx)(nil)
+	_ L1ValidatorOp = (*RegisterL1ValidatorOp)(nil)
+	_ L1ValidatorOp = (*SetL1ValidatorWeightOp)(nil)
+
+	ErrBatchMustIncludeOperations = errors.New("batch must include at least one operation")
+	ErrNilL1ValidatorOp           = errors.New("nil L1 validator operation")
+)
+
+// BatchL1ValidatorTx atomically applies multiple L1 validator operations for
+// a single Subnet. Either every operation is applied or the tx is rejected.
+type BatchL1ValidatorTx struct {
+	// Metadata, inputs and outputs
+	BaseTx `serialize:"true"`
+	// ID of the Subnet that every operation must modify
+	Subnet ids.ID `serialize:"true" json:"subnetID"`
+	// Operations are applied in order
+	Operations []L1ValidatorOp `serialize:"true" json:"operations"`
+}
+
+// L1ValidatorOp is a single operation included in a BatchL1ValidatorTx.
+type L1ValidatorOp interface {
+	// WarpMessage returns the signed Warp message authorizing the operation.
+	WarpMessage() []byte
+}
+
+// RegisterL1ValidatorOp is the batched equivalent of a RegisterL1ValidatorTx.
+type RegisterL1ValidatorOp struct {
+	// Balance <= sum($AVAX inputs) - sum($AVAX outputs) - TxFee - the balances
+	// of the other operations in the batch.
+	Balance uint64 `serialize:"true" json:"balance"`
+	// ProofOfPossession of the BLS key that is included in the Message.
+	ProofOfPossession [bls.SignatureLen]byte `serialize:"true" json:"proofOfPossession"`
+	// Message is expected to be a signed Warp message containing an
+	// AddressedCall payload with the RegisterL1Validator message.
+	Message types.JSONByteSlice `serialize:"true" json:"message"`
+}
+
+func (op *RegisterL1ValidatorOp) WarpMessage() []byte {
+	return op.Message
+}
+
+// SetL1ValidatorWeightOp is the batched equivalent of a
+// SetL1ValidatorWeightTx.
+type SetL1ValidatorWeightOp struct {
+	// Message is expected to be a signed Warp message containing an
+	// AddressedCall payload with the SetL1ValidatorWeight message.
+	Message types.JSONByteSlice `serialize:"true" json:"message"`
+}
+
+func (op *SetL1ValidatorWeightOp) WarpMessage() []byte {
+	return op.Message
+}
+
+func (tx *BatchL1ValidatorTx) SyntacticVerify(ctx *snow.Context) error {
+	switch {
+	case tx == nil:
+		return ErrNilTx
+	case tx.SyntacticallyVerified:
+		// already passed syntactic verification
+		return nil
+	case len(tx.Operations) == 0:
+		return ErrBatchMustIncludeOperations
+	}
+
+	if err := tx.BaseTx.SyntacticVerify(ctx); err != nil {
+		return err
+	}
+	for _, op := range tx.Operations {
+		switch op := op.(type) {
+		case *RegisterL1ValidatorOp:
+			if op == nil {
+				return ErrNilL1ValidatorOp
+			}
+		case *SetL1ValidatorWeightOp:
+			if op == nil {
+				return ErrNilL1ValidatorOp
+			}
+		default:
+			return ErrNilL1ValidatorOp
+		}
+	}
+
+	tx.SyntacticallyVerified = true
+	return nil
+}
+
+func (tx *BatchL1ValidatorTx) Visit(visitor Visitor) error {
+	return visitor.BatchL1ValidatorTx(tx)
+}
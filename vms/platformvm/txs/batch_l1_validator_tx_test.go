@@ -0,0 +1,159 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package txs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestBatchL1ValidatorTxSerialization(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		avaxAssetID = ids.GenerateTestID()
+		unsignedTx  = &BatchL1ValidatorTx{
+			BaseTx: BaseTx{
+				BaseTx: avax.BaseTx{
+					NetworkID:    constants.UnitTestID,
+					BlockchainID: constants.PlatformChainID,
+					Outs:         []*avax.TransferableOutput{},
+					Ins: []*avax.TransferableInput{
+						{
+							UTXOID: avax.UTXOID{
+								TxID:        ids.GenerateTestID(),
+								OutputIndex: 1,
+							},
+							Asset: avax.Asset{
+								ID: avaxAssetID,
+							},
+							In: &secp256k1fx.TransferInput{
+								Amt: 2 * units.Avax,
+								Input: secp256k1fx.Input{
+									SigIndices: []uint32{0},
+								},
+							},
+						},
+					},
+					Memo: []byte{},
+				},
+			},
+			Subnet: ids.GenerateTestID(),
+			Operations: []L1ValidatorOp{
+				&RegisterL1ValidatorOp{
+					Balance:           units.Avax,
+					ProofOfPossession: [96]byte{1, 2, 3},
+					Message:           []byte("register"),
+				},
+				&SetL1ValidatorWeightOp{
+					Message: []byte("set weight"),
+				},
+			},
+		}
+		tx = &Tx{
+			Unsigned: unsignedTx,
+			Creds:    []verify.Verifiable{},
+		}
+	)
+	require.NoError(tx.Initialize(Codec))
+
+	parsedTx, err := Parse(Codec, tx.Bytes())
+	require.NoError(err)
+	require.Equal(tx, parsedTx)
+}
+
+func TestBatchL1ValidatorTxSyntacticVerify(t *testing.T) {
+	var (
+		ctx         = snowtest.Context(t, ids.GenerateTestID())
+		validBaseTx = BaseTx{
+			BaseTx: avax.BaseTx{
+				NetworkID:    ctx.NetworkID,
+				BlockchainID: ctx.ChainID,
+			},
+		}
+		validOperations = []L1ValidatorOp{
+			&SetL1ValidatorWeightOp{},
+		}
+	)
+	tests := []struct {
+		name        string
+		tx          *BatchL1ValidatorTx
+		expectedErr error
+	}{
+		{
+			name:        "nil tx",
+			tx:          nil,
+			expectedErr: ErrNilTx,
+		},
+		{
+			name: "already verified",
+			// The tx includes invalid data to verify that a cached result is
+			// returned.
+			tx: &BatchL1ValidatorTx{
+				BaseTx: BaseTx{
+					SyntacticallyVerified: true,
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "no operations",
+			tx: &BatchL1ValidatorTx{
+				BaseTx: validBaseTx,
+			},
+			expectedErr: ErrBatchMustIncludeOperations,
+		},
+		{
+			name: "invalid BaseTx",
+			tx: &BatchL1ValidatorTx{
+				BaseTx:     BaseTx{},
+				Operations: validOperations,
+			},
+			expectedErr: avax.ErrWrongNetworkID,
+		},
+		{
+			name: "nil operation",
+			tx: &BatchL1ValidatorTx{
+				BaseTx: validBaseTx,
+				Operations: []L1ValidatorOp{
+					(*RegisterL1ValidatorOp)(nil),
+				},
+			},
+			expectedErr: ErrNilL1ValidatorOp,
+		},
+		{
+			name: "passes verification",
+			tx: &BatchL1ValidatorTx{
+				BaseTx: validBaseTx,
+				Operations: []L1ValidatorOp{
+					&RegisterL1ValidatorOp{},
+					&SetL1ValidatorWeightOp{},
+				},
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			err := test.tx.SyntacticVerify(ctx)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.True(test.tx.SyntacticallyVerified)
+		})
+	}
+}
@@ -48,6 +48,7 @@ func init() {
 		errs.Add(
 			RegisterDurangoTypes(c),
 			RegisterEtnaTypes(c),
+			RegisterHeliconTypes(c),
 		)
 	}
 
@@ -129,3 +130,13 @@ func RegisterEtnaTypes(targetCodec linearcodec.Codec) error {
 		targetCodec.RegisterType(&DisableL1ValidatorTx{}),
 	)
 }
+
+// RegisterHeliconTypes registers the type information for transactions that
+// were valid during the Helicon series of upgrades.
+func RegisterHeliconTypes(targetCodec linearcodec.Codec) error {
+	return errors.Join(
+		targetCodec.RegisterType(&BatchL1ValidatorTx{}),
+		targetCodec.RegisterType(&RegisterL1ValidatorOp{}),
+		targetCodec.RegisterType(&SetL1ValidatorWeightOp{}),
+	)
+}
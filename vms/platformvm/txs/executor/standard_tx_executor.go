@@ -50,6 +50,7 @@ var (
 	errMissingStartTimePreDurango       = errors.New("staker transactions must have a StartTime pre-Durango")
 	errEtnaUpgradeNotActive             = errors.New("attempting to use an Etna-upgrade feature prior to activation")
 	errTransformSubnetTxPostEtna        = errors.New("TransformSubnetTx is not permitted post-Etna")
+	errHeliconUpgradeNotActive          = errors.New("attempting to use a Helicon-upgrade feature prior to activation")
 	errMaxNumActiveValidators           = errors.New("already at the max number of active validators")
 	errCouldNotLoadSubnetToL1Conversion = errors.New("could not load subnet conversion")
 	errWrongWarpMessageSourceChainID    = errors.New("wrong warp message source chain ID")
@@ -61,6 +62,8 @@ var (
 	errWarpMessageContainsStaleNonce    = errors.New("warp message contains stale nonce")
 	errRemovingLastValidator            = errors.New("attempting to remove the last L1 validator from a converted subnet")
 	errStateCorruption                  = errors.New("state corruption")
+	errBatchOperationWrongSubnet        = errors.New("batch operation modifies a different subnet")
+	errUnknownL1ValidatorOp             = errors.New("unknown L1 validator operation")
 )
 
 // StandardTx executes the standard transaction [tx].
@@ -915,115 +918,15 @@ func (e *standardTxExecutor) RegisterL1ValidatorTx(tx *txs.RegisterL1ValidatorTx
 		return err
 	}
 
-	// Parse the warp message.
-	warpMessage, err := warp.ParseMessage(tx.Message)
-	if err != nil {
-		return err
-	}
-	addressedCall, err := payload.ParseAddressedCall(warpMessage.Payload)
-	if err != nil {
-		return err
-	}
-	msg, err := message.ParseRegisterL1Validator(addressedCall.Payload)
-	if err != nil {
-		return err
-	}
-	if err := msg.Verify(); err != nil {
-		return err
-	}
-
-	// Verify that the warp message was sent from the expected chain and
-	// address.
-	if err := verifyL1Conversion(e.state, msg.SubnetID, warpMessage.SourceChainID, addressedCall.SourceAddress); err != nil {
-		return err
-	}
-
-	// Verify that the message contains a valid expiry time.
-	currentTimestampUnix := uint64(currentTimestamp.Unix())
-	if msg.Expiry <= currentTimestampUnix {
-		return fmt.Errorf("%w at %d and it is currently %d", errWarpMessageExpired, msg.Expiry, currentTimestampUnix)
-	}
-	if secondsUntilExpiry := msg.Expiry - currentTimestampUnix; secondsUntilExpiry > RegisterL1ValidatorTxExpiryWindow {
-		return fmt.Errorf("%w because time is %d seconds in the future but the limit is %d", errWarpMessageNotYetAllowed, secondsUntilExpiry, RegisterL1ValidatorTxExpiryWindow)
-	}
-
-	// Verify that this warp message isn't being replayed.
-	validationID := msg.ValidationID()
-	expiry := state.ExpiryEntry{
-		Timestamp:    msg.Expiry,
-		ValidationID: validationID,
-	}
-	isDuplicate, err := e.state.HasExpiry(expiry)
-	if err != nil {
-		return err
-	}
-	if isDuplicate {
-		return fmt.Errorf("%w for validationID %s", errWarpMessageAlreadyIssued, validationID)
-	}
-
-	// Verify proof of possession provided by the transaction against the public
-	// key provided by the warp message.
-	pop := signer.ProofOfPossession{
-		PublicKey:         msg.BLSPublicKey,
-		ProofOfPossession: tx.ProofOfPossession,
-	}
-	if err := pop.Verify(); err != nil {
-		return err
-	}
-
-	// Create the L1 validator.
-	nodeID, err := ids.ToNodeID(msg.NodeID)
-	if err != nil {
-		return err
-	}
-	remainingBalanceOwner, err := txs.Codec.Marshal(txs.CodecVersion, &msg.RemainingBalanceOwner)
-	if err != nil {
-		return err
-	}
-	deactivationOwner, err := txs.Codec.Marshal(txs.CodecVersion, &msg.DisableOwner)
-	if err != nil {
-		return err
-	}
-	l1Validator := state.L1Validator{
-		ValidationID:          validationID,
-		SubnetID:              msg.SubnetID,
-		NodeID:                nodeID,
-		PublicKey:             bls.PublicKeyToUncompressedBytes(pop.Key()),
-		RemainingBalanceOwner: remainingBalanceOwner,
-		DeactivationOwner:     deactivationOwner,
-		StartTime:             currentTimestampUnix,
-		Weight:                msg.Weight,
-		MinNonce:              0,
-		EndAccumulatedFee:     0, // If Balance is 0, this is will remain 0
-	}
-
-	// If the balance is non-zero, this validator should be initially active.
-	if tx.Balance != 0 {
-		// Verify that there is space for an active validator.
-		if gas.Gas(e.state.NumActiveL1Validators()) >= e.backend.Config.ValidatorFeeConfig.Capacity {
-			return errMaxNumActiveValidators
-		}
-
-		// Mark the validator as active.
-		currentFees := e.state.GetAccruedFees()
-		l1Validator.EndAccumulatedFee, err = math.Add(tx.Balance, currentFees)
-		if err != nil {
-			return err
-		}
-	}
-
-	if err := e.state.PutL1Validator(l1Validator); err != nil {
+	if _, err := e.registerL1Validator(tx.Balance, tx.ProofOfPossession, tx.Message); err != nil {
 		return err
 	}
 
 	txID := e.tx.ID()
-
 	// Consume the UTXOS
 	avax.Consume(e.state, tx.Ins)
 	// Produce the UTXOS
 	avax.Produce(e.state, txID, tx.Outs)
-	// Prevent this warp message from being replayed
-	e.state.PutExpiry(expiry)
 	return nil
 }
 
@@ -1073,99 +976,11 @@ func (e *standardTxExecutor) SetL1ValidatorWeightTx(tx *txs.SetL1ValidatorWeight
 		return err
 	}
 
-	// Parse the warp message.
-	warpMessage, err := warp.ParseMessage(tx.Message)
-	if err != nil {
-		return err
-	}
-	addressedCall, err := payload.ParseAddressedCall(warpMessage.Payload)
-	if err != nil {
-		return err
-	}
-	msg, err := message.ParseL1ValidatorWeight(addressedCall.Payload)
-	if err != nil {
-		return err
-	}
-	if err := msg.Verify(); err != nil {
-		return err
-	}
-
-	// Verify that the message contains a valid nonce for a current validator.
-	l1Validator, err := e.state.GetL1Validator(msg.ValidationID)
-	if err != nil {
-		return fmt.Errorf("%w: %w", errCouldNotLoadL1Validator, err)
-	}
-	if msg.Nonce < l1Validator.MinNonce {
-		return fmt.Errorf("%w %d must be at least %d", errWarpMessageContainsStaleNonce, msg.Nonce, l1Validator.MinNonce)
-	}
-
-	// Verify that the warp message was sent from the expected chain and
-	// address.
-	if err := verifyL1Conversion(e.state, l1Validator.SubnetID, warpMessage.SourceChainID, addressedCall.SourceAddress); err != nil {
+	if _, _, err := e.setL1ValidatorWeight(tx.Message, uint32(len(tx.Outs))); err != nil {
 		return err
 	}
 
 	txID := e.tx.ID()
-
-	// Check if we are removing the validator.
-	if msg.Weight == 0 {
-		// Verify that we are not removing the last validator.
-		weight, err := e.state.WeightOfL1Validators(l1Validator.SubnetID)
-		if err != nil {
-			return fmt.Errorf("could not load L1 validator weights: %w", err)
-		}
-		if weight == l1Validator.Weight {
-			return errRemovingLastValidator
-		}
-
-		// If the validator is currently active, we need to refund the remaining
-		// balance.
-		if l1Validator.EndAccumulatedFee != 0 {
-			var remainingBalanceOwner message.PChainOwner
-			if _, err := txs.Codec.Unmarshal(l1Validator.RemainingBalanceOwner, &remainingBalanceOwner); err != nil {
-				return fmt.Errorf("%w: remaining balance owner is malformed", errStateCorruption)
-			}
-
-			accruedFees := e.state.GetAccruedFees()
-			if l1Validator.EndAccumulatedFee <= accruedFees {
-				// This check should be unreachable. However, it prevents AVAX
-				// from being minted due to state corruption. This also prevents
-				// invalid UTXOs from being created (with 0 value).
-				return fmt.Errorf("%w: validator should have already been disabled", errStateCorruption)
-			}
-			remainingBalance := l1Validator.EndAccumulatedFee - accruedFees
-
-			utxo := &avax.UTXO{
-				UTXOID: avax.UTXOID{
-					TxID:        txID,
-					OutputIndex: uint32(len(tx.Outs)),
-				},
-				Asset: avax.Asset{
-					ID: e.backend.Ctx.AVAXAssetID,
-				},
-				Out: &secp256k1fx.TransferOutput{
-					Amt: remainingBalance,
-					OutputOwners: secp256k1fx.OutputOwners{
-						Threshold: remainingBalanceOwner.Threshold,
-						Addrs:     remainingBalanceOwner.Addresses,
-					},
-				},
-			}
-			e.state.AddUTXO(utxo)
-		}
-	}
-
-	// If the weight is being set to 0, it is possible for the nonce increment
-	// to overflow. However, the validator is being removed and the nonce
-	// doesn't matter. If weight is not 0, [msg.Nonce] is enforced by
-	// [msg.Verify()] to be less than MaxUInt64 and can therefore be incremented
-	// without overflow.
-	l1Validator.MinNonce = msg.Nonce + 1
-	l1Validator.Weight = msg.Weight
-	if err := e.state.PutL1Validator(l1Validator); err != nil {
-		return err
-	}
-
 	// Consume the UTXOS
 	avax.Consume(e.state, tx.Ins)
 	// Produce the UTXOS
@@ -1368,7 +1183,311 @@ func (e *standardTxExecutor) DisableL1ValidatorTx(tx *txs.DisableL1ValidatorTx)
 	return e.state.PutL1Validator(l1Validator)
 }
 
+// BatchL1ValidatorTx applies each operation in order. If any operation fails,
+// the entire tx is invalid.
+func (e *standardTxExecutor) BatchL1ValidatorTx(tx *txs.BatchL1ValidatorTx) error {
+	var (
+		currentTimestamp = e.state.GetTimestamp()
+		upgrades         = e.backend.Config.UpgradeConfig
+	)
+	if !upgrades.IsHeliconActivated(currentTimestamp) {
+		return errHeliconUpgradeNotActive
+	}
+
+	if err := e.tx.SyntacticVerify(e.backend.Ctx); err != nil {
+		return err
+	}
+
+	if err := avax.VerifyMemoFieldLength(tx.Memo, true /*=isDurangoActive*/); err != nil {
+		return err
+	}
+
+	ins, outs, producedAVAX, err := utxo.GetInputOutputs(tx)
+	if err != nil {
+		return fmt.Errorf("getting utxos %w", err)
+	}
+
+	// Verify the flowcheck
+	fee, err := e.feeCalculator.CalculateFee(tx)
+	if err != nil {
+		return err
+	}
+
+	producedAVAX, err = math.Add(producedAVAX, fee)
+	if err != nil {
+		return fmt.Errorf("adding fee: %w", err)
+	}
+
+	if err := e.backend.FlowChecker.VerifySpend(
+		tx,
+		e.state,
+		ins,
+		outs,
+		e.tx.Creds,
+		map[ids.ID]uint64{
+			e.backend.Ctx.AVAXAssetID: producedAVAX,
+		},
+	); err != nil {
+		return err
+	}
+
+	// Refund UTXOs are produced after the outputs of the tx.
+	nextOutputIndex := uint32(len(tx.Outs))
+	for i, op := range tx.Operations {
+		var subnetID ids.ID
+		switch op := op.(type) {
+		case *txs.RegisterL1ValidatorOp:
+			subnetID, err = e.registerL1Validator(op.Balance, op.ProofOfPossession, op.Message)
+		case *txs.SetL1ValidatorWeightOp:
+			var refunded bool
+			subnetID, refunded, err = e.setL1ValidatorWeight(op.Message, nextOutputIndex)
+			if refunded {
+				nextOutputIndex++
+			}
+		default:
+			err = errUnknownL1ValidatorOp
+		}
+		if err != nil {
+			return fmt.Errorf("operation %d: %w", i, err)
+		}
+		if subnetID != tx.Subnet {
+			return fmt.Errorf("%w: operation %d modifies %s but expected %s",
+				errBatchOperationWrongSubnet,
+				i,
+				subnetID,
+				tx.Subnet,
+			)
+		}
+	}
+
+	txID := e.tx.ID()
+	// Consume the UTXOS
+	avax.Consume(e.state, tx.Ins)
+	// Produce the UTXOS
+	avax.Produce(e.state, txID, tx.Outs)
+	return nil
+}
+
 // Creates the staker as defined in [stakerTx] and adds it to [e.State].
+// registerL1Validator verifies the RegisterL1Validator warp message and, if
+// it is valid, adds the L1 validator to [e.state]. The ID of the Subnet the
+// validator was added to is returned.
+func (e *standardTxExecutor) registerL1Validator(
+	balance uint64,
+	proofOfPossession [bls.SignatureLen]byte,
+	rawMessage []byte,
+) (ids.ID, error) {
+	// Parse the warp message.
+	warpMessage, err := warp.ParseMessage(rawMessage)
+	if err != nil {
+		return ids.Empty, err
+	}
+	addressedCall, err := payload.ParseAddressedCall(warpMessage.Payload)
+	if err != nil {
+		return ids.Empty, err
+	}
+	msg, err := message.ParseRegisterL1Validator(addressedCall.Payload)
+	if err != nil {
+		return ids.Empty, err
+	}
+	if err := msg.Verify(); err != nil {
+		return ids.Empty, err
+	}
+
+	// Verify that the warp message was sent from the expected chain and
+	// address.
+	if err := verifyL1Conversion(e.state, msg.SubnetID, warpMessage.SourceChainID, addressedCall.SourceAddress); err != nil {
+		return ids.Empty, err
+	}
+
+	// Verify that the message contains a valid expiry time.
+	currentTimestampUnix := uint64(e.state.GetTimestamp().Unix())
+	if msg.Expiry <= currentTimestampUnix {
+		return ids.Empty, fmt.Errorf("%w at %d and it is currently %d", errWarpMessageExpired, msg.Expiry, currentTimestampUnix)
+	}
+	if secondsUntilExpiry := msg.Expiry - currentTimestampUnix; secondsUntilExpiry > RegisterL1ValidatorTxExpiryWindow {
+		return ids.Empty, fmt.Errorf("%w because time is %d seconds in the future but the limit is %d", errWarpMessageNotYetAllowed, secondsUntilExpiry, RegisterL1ValidatorTxExpiryWindow)
+	}
+
+	// Verify that this warp message isn't being replayed.
+	validationID := msg.ValidationID()
+	expiry := state.ExpiryEntry{
+		Timestamp:    msg.Expiry,
+		ValidationID: validationID,
+	}
+	isDuplicate, err := e.state.HasExpiry(expiry)
+	if err != nil {
+		return ids.Empty, err
+	}
+	if isDuplicate {
+		return ids.Empty, fmt.Errorf("%w for validationID %s", errWarpMessageAlreadyIssued, validationID)
+	}
+
+	// Verify proof of possession provided by the transaction against the public
+	// key provided by the warp message.
+	pop := signer.ProofOfPossession{
+		PublicKey:         msg.BLSPublicKey,
+		ProofOfPossession: proofOfPossession,
+	}
+	if err := pop.Verify(); err != nil {
+		return ids.Empty, err
+	}
+
+	// Create the L1 validator.
+	nodeID, err := ids.ToNodeID(msg.NodeID)
+	if err != nil {
+		return ids.Empty, err
+	}
+	remainingBalanceOwner, err := txs.Codec.Marshal(txs.CodecVersion, &msg.RemainingBalanceOwner)
+	if err != nil {
+		return ids.Empty, err
+	}
+	deactivationOwner, err := txs.Codec.Marshal(txs.CodecVersion, &msg.DisableOwner)
+	if err != nil {
+		return ids.Empty, err
+	}
+	l1Validator := state.L1Validator{
+		ValidationID:          validationID,
+		SubnetID:              msg.SubnetID,
+		NodeID:                nodeID,
+		PublicKey:             bls.PublicKeyToUncompressedBytes(pop.Key()),
+		RemainingBalanceOwner: remainingBalanceOwner,
+		DeactivationOwner:     deactivationOwner,
+		StartTime:             currentTimestampUnix,
+		Weight:                msg.Weight,
+		MinNonce:              0,
+		EndAccumulatedFee:     0, // If Balance is 0, this is will remain 0
+	}
+
+	// If the balance is non-zero, this validator should be initially active.
+	if balance != 0 {
+		// Verify that there is space for an active validator.
+		if gas.Gas(e.state.NumActiveL1Validators()) >= e.backend.Config.ValidatorFeeConfig.Capacity {
+			return ids.Empty, errMaxNumActiveValidators
+		}
+
+		// Mark the validator as active.
+		currentFees := e.state.GetAccruedFees()
+		l1Validator.EndAccumulatedFee, err = math.Add(balance, currentFees)
+		if err != nil {
+			return ids.Empty, err
+		}
+	}
+
+	if err := e.state.PutL1Validator(l1Validator); err != nil {
+		return ids.Empty, err
+	}
+
+	// Prevent this warp message from being replayed
+	e.state.PutExpiry(expiry)
+	return msg.SubnetID, nil
+}
+
+// setL1ValidatorWeight verifies the L1ValidatorWeight warp message and, if it
+// is valid, applies the weight change to [e.state]. If the validator is removed
+// while it still has a balance, the remaining balance is refunded in a UTXO
+// with [outputIndex]. The ID of the Subnet the validator belongs to is returned
+// along with whether a refund UTXO was produced.
+func (e *standardTxExecutor) setL1ValidatorWeight(
+	rawMessage []byte,
+	outputIndex uint32,
+) (ids.ID, bool, error) {
+	// Parse the warp message.
+	warpMessage, err := warp.ParseMessage(rawMessage)
+	if err != nil {
+		return ids.Empty, false, err
+	}
+	addressedCall, err := payload.ParseAddressedCall(warpMessage.Payload)
+	if err != nil {
+		return ids.Empty, false, err
+	}
+	msg, err := message.ParseL1ValidatorWeight(addressedCall.Payload)
+	if err != nil {
+		return ids.Empty, false, err
+	}
+	if err := msg.Verify(); err != nil {
+		return ids.Empty, false, err
+	}
+
+	// Verify that the message contains a valid nonce for a current validator.
+	l1Validator, err := e.state.GetL1Validator(msg.ValidationID)
+	if err != nil {
+		return ids.Empty, false, fmt.Errorf("%w: %w", errCouldNotLoadL1Validator, err)
+	}
+	if msg.Nonce < l1Validator.MinNonce {
+		return ids.Empty, false, fmt.Errorf("%w %d must be at least %d", errWarpMessageContainsStaleNonce, msg.Nonce, l1Validator.MinNonce)
+	}
+
+	// Verify that the warp message was sent from the expected chain and
+	// address.
+	if err := verifyL1Conversion(e.state, l1Validator.SubnetID, warpMessage.SourceChainID, addressedCall.SourceAddress); err != nil {
+		return ids.Empty, false, err
+	}
+
+	// Check if we are removing the validator.
+	var refunded bool
+	if msg.Weight == 0 {
+		// Verify that we are not removing the last validator.
+		weight, err := e.state.WeightOfL1Validators(l1Validator.SubnetID)
+		if err != nil {
+			return ids.Empty, false, fmt.Errorf("could not load L1 validator weights: %w", err)
+		}
+		if weight == l1Validator.Weight {
+			return ids.Empty, false, errRemovingLastValidator
+		}
+
+		// If the validator is currently active, we need to refund the remaining
+		// balance.
+		if l1Validator.EndAccumulatedFee != 0 {
+			var remainingBalanceOwner message.PChainOwner
+			if _, err := txs.Codec.Unmarshal(l1Validator.RemainingBalanceOwner, &remainingBalanceOwner); err != nil {
+				return ids.Empty, false, fmt.Errorf("%w: remaining balance owner is malformed", errStateCorruption)
+			}
+
+			accruedFees := e.state.GetAccruedFees()
+			if l1Validator.EndAccumulatedFee <= accruedFees {
+				// This check should be unreachable. However, it prevents AVAX
+				// from being minted due to state corruption. This also prevents
+				// invalid UTXOs from being created (with 0 value).
+				return ids.Empty, false, fmt.Errorf("%w: validator should have already been disabled", errStateCorruption)
+			}
+			remainingBalance := l1Validator.EndAccumulatedFee - accruedFees
+
+			utxo := &avax.UTXO{
+				UTXOID: avax.UTXOID{
+					TxID:        e.tx.ID(),
+					OutputIndex: outputIndex,
+				},
+				Asset: avax.Asset{
+					ID: e.backend.Ctx.AVAXAssetID,
+				},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: remainingBalance,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: remainingBalanceOwner.Threshold,
+						Addrs:     remainingBalanceOwner.Addresses,
+					},
+				},
+			}
+			e.state.AddUTXO(utxo)
+			refunded = true
+		}
+	}
+
+	// If the weight is being set to 0, it is possible for the nonce increment
+	// to overflow. However, the validator is being removed and the nonce
+	// doesn't matter. If weight is not 0, [msg.Nonce] is enforced by
+	// [msg.Verify()] to be less than MaxUInt64 and can therefore be incremented
+	// without overflow.
+	l1Validator.MinNonce = msg.Nonce + 1
+	l1Validator.Weight = msg.Weight
+	if err := e.state.PutL1Validator(l1Validator); err != nil {
+		return ids.Empty, false, err
+	}
+
+	return l1Validator.SubnetID, refunded, nil
+}
+
 func (e *standardTxExecutor) putStaker(stakerTx txs.Staker) error {
 	var (
 		chainTime = e.state.GetTimestamp()
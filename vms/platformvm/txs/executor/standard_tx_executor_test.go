@@ -4355,6 +4355,342 @@ func TestStandardExecutorDisableL1ValidatorTx(t *testing.T) {
 	}
 }
 
+func TestStandardExecutorBatchL1ValidatorTx(t *testing.T) {
+	var (
+		fx = &secp256k1fx.Fx{}
+		vm = &secp256k1fx.TestVM{
+			Log: logging.NoLog{},
+		}
+	)
+	require.NoError(t, fx.InitializeVM(vm))
+
+	var (
+		ctx           = snowtest.Context(t, constants.PlatformChainID)
+		defaultConfig = &config.Internal{
+			DynamicFeeConfig:   genesis.LocalParams.DynamicFeeConfig,
+			ValidatorFeeConfig: genesis.LocalParams.ValidatorFeeConfig,
+			UpgradeConfig:      upgradetest.GetConfig(upgradetest.Latest),
+		}
+		baseState = statetest.New(t, statetest.Config{
+			Upgrades: defaultConfig.UpgradeConfig,
+			Context:  ctx,
+		})
+		wallet = txstest.NewWallet(
+			t,
+			ctx,
+			defaultConfig,
+			baseState,
+			secp256k1fx.NewKeychain(genesistest.DefaultFundedKeys...),
+			nil, // subnetIDs
+			nil, // validationIDs
+			nil, // chainIDs
+		)
+		flowChecker = utxo.NewVerifier(
+			ctx,
+			&vm.Clk,
+			fx,
+		)
+
+		backend = &Backend{
+			Config:       defaultConfig,
+			Bootstrapped: utils.NewAtomic(true),
+			Fx:           fx,
+			FlowChecker:  flowChecker,
+			Ctx:          ctx,
+		}
+		feeCalculator = state.PickFeeCalculator(defaultConfig, baseState)
+	)
+
+	// Create the initial state
+	diff, err := state.NewDiffOn(baseState)
+	require.NoError(t, err)
+
+	// Create the subnet
+	createSubnetTx, err := wallet.IssueCreateSubnetTx(
+		&secp256k1fx.OutputOwners{},
+	)
+	require.NoError(t, err)
+
+	// Execute the subnet creation
+	_, _, _, err = StandardTx(
+		backend,
+		feeCalculator,
+		createSubnetTx,
+		diff,
+	)
+	require.NoError(t, err)
+
+	// Create the subnet conversion
+	sk, err := localsigner.New()
+	require.NoError(t, err)
+	pop, err := signer.NewProofOfPossession(sk)
+	require.NoError(t, err)
+
+	const (
+		initialWeight = 1
+		balance       = units.Avax
+	)
+	var (
+		subnetID  = createSubnetTx.ID()
+		chainID   = ids.GenerateTestID()
+		address   = utils.RandomBytes(32)
+		validator = &txs.ConvertSubnetToL1Validator{
+			NodeID:  ids.GenerateTestNodeID().Bytes(),
+			Weight:  initialWeight,
+			Balance: balance,
+			Signer:  *pop,
+		}
+		initialValidationID = subnetID.Append(0)
+	)
+
+	convertSubnetToL1Tx, err := wallet.IssueConvertSubnetToL1Tx(
+		subnetID,
+		chainID,
+		address,
+		[]*txs.ConvertSubnetToL1Validator{
+			validator,
+		},
+	)
+	require.NoError(t, err)
+
+	// Execute the subnet conversion
+	_, _, _, err = StandardTx(
+		backend,
+		feeCalculator,
+		convertSubnetToL1Tx,
+		diff,
+	)
+	require.NoError(t, err)
+	require.NoError(t, diff.Apply(baseState))
+	require.NoError(t, baseState.Commit())
+
+	// Create the Warp messages
+	newWarpMessage := func(addressedCallPayload []byte) []byte {
+		unsignedWarp := must[*warp.UnsignedMessage](t)(warp.NewUnsignedMessage(
+			ctx.NetworkID,
+			chainID,
+			must[*payload.AddressedCall](t)(payload.NewAddressedCall(
+				address,
+				addressedCallPayload,
+			)).Bytes(),
+		))
+		sig, err := sk.Sign(unsignedWarp.Bytes())
+		require.NoError(t, err)
+		return must[*warp.Message](t)(warp.NewMessage(
+			unsignedWarp,
+			&warp.BitSetSignature{
+				Signers:   set.NewBits(0).Bytes(),
+				Signature: ([bls.SignatureLen]byte)(bls.SignatureToBytes(sig)),
+			},
+		)).Bytes()
+	}
+
+	expiry := uint64(baseState.GetTimestamp().Add(5 * time.Minute).Unix())
+	newRegisterOp := func() (*txs.RegisterL1ValidatorOp, ids.ID) {
+		sk, err := localsigner.New()
+		require.NoError(t, err)
+		pop, err := signer.NewProofOfPossession(sk)
+		require.NoError(t, err)
+
+		msg := must[*message.RegisterL1Validator](t)(message.NewRegisterL1Validator(
+			subnetID,
+			ids.GenerateTestNodeID(),
+			pop.PublicKey,
+			expiry,
+			message.PChainOwner{},
+			message.PChainOwner{},
+			1, // weight
+		))
+		return &txs.RegisterL1ValidatorOp{
+			Balance:           balance,
+			ProofOfPossession: pop.ProofOfPossession,
+			Message:           newWarpMessage(msg.Bytes()),
+		}, msg.ValidationID()
+	}
+	newSetWeightOp := func(validationID ids.ID, weight uint64) *txs.SetL1ValidatorWeightOp {
+		return &txs.SetL1ValidatorWeightOp{
+			Message: newWarpMessage(must[*message.L1ValidatorWeight](t)(message.NewL1ValidatorWeight(
+				validationID,
+				1, // nonce
+				weight,
+			)).Bytes()),
+		}
+	}
+
+	var (
+		registerA, validationIDA = newRegisterOp()
+		registerB, validationIDB = newRegisterOp()
+	)
+	tests := []struct {
+		name           string
+		subnetID       ids.ID
+		operations     []txs.L1ValidatorOp
+		updateExecutor func(*standardTxExecutor) error
+		// expectedWeights maps the validationIDs to their expected weight. A
+		// weight of 0 means the validator is expected to have been removed.
+		expectedWeights map[ids.ID]uint64
+		expectedRefunds int
+		expectedErr     error
+	}{
+		{
+			name: "invalid prior to Helicon",
+			operations: []txs.L1ValidatorOp{
+				registerA,
+			},
+			updateExecutor: func(e *standardTxExecutor) error {
+				e.backend.Config = &config.Internal{
+					UpgradeConfig: upgradetest.GetConfig(upgradetest.Granite),
+				}
+				return nil
+			},
+			expectedErr: errHeliconUpgradeNotActive,
+		},
+		{
+			name:        "no operations",
+			expectedErr: txs.ErrBatchMustIncludeOperations,
+		},
+		{
+			name:     "operation modifies a different subnet",
+			subnetID: ids.GenerateTestID(),
+			operations: []txs.L1ValidatorOp{
+				registerA,
+			},
+			expectedErr: errBatchOperationWrongSubnet,
+		},
+		{
+			name: "replayed warp message",
+			operations: []txs.L1ValidatorOp{
+				registerA,
+				registerA,
+			},
+			expectedErr: errWarpMessageAlreadyIssued,
+		},
+		{
+			name: "removing last validator",
+			operations: []txs.L1ValidatorOp{
+				newSetWeightOp(initialValidationID, 0),
+			},
+			expectedErr: errRemovingLastValidator,
+		},
+		{
+			name: "register and update validators",
+			operations: []txs.L1ValidatorOp{
+				registerA,
+				newSetWeightOp(initialValidationID, 2),
+			},
+			expectedWeights: map[ids.ID]uint64{
+				initialValidationID: 2,
+				validationIDA:       1,
+			},
+		},
+		{
+			name: "register and remove validators",
+			operations: []txs.L1ValidatorOp{
+				registerA,
+				registerB,
+				newSetWeightOp(validationIDA, 0),
+				newSetWeightOp(initialValidationID, 0),
+			},
+			expectedWeights: map[ids.ID]uint64{
+				initialValidationID: 0,
+				validationIDA:       0,
+				validationIDB:       1,
+			},
+			expectedRefunds: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			// Create the BatchL1ValidatorTx
+			wallet := txstest.NewWallet(
+				t,
+				ctx,
+				defaultConfig,
+				baseState,
+				secp256k1fx.NewKeychain(genesistest.DefaultFundedKeys...),
+				nil, // subnetIDs
+				nil, // validationIDs
+				nil, // chainIDs
+			)
+
+			txSubnetID := subnetID
+			if test.subnetID != ids.Empty {
+				txSubnetID = test.subnetID
+			}
+			batchL1ValidatorTx, err := wallet.IssueBatchL1ValidatorTx(
+				txSubnetID,
+				test.operations,
+			)
+			require.NoError(err)
+
+			diff, err := state.NewDiffOn(baseState)
+			require.NoError(err)
+
+			executor := &standardTxExecutor{
+				backend: &Backend{
+					Config:       defaultConfig,
+					Bootstrapped: utils.NewAtomic(true),
+					Fx:           fx,
+					FlowChecker:  flowChecker,
+					Ctx:          ctx,
+				},
+				feeCalculator: state.PickFeeCalculator(defaultConfig, baseState),
+				tx:            batchL1ValidatorTx,
+				state:         diff,
+			}
+			if test.updateExecutor != nil {
+				require.NoError(test.updateExecutor(executor))
+			}
+
+			err = batchL1ValidatorTx.Unsigned.Visit(executor)
+			require.ErrorIs(err, test.expectedErr)
+			if err != nil {
+				return
+			}
+
+			for utxoID := range batchL1ValidatorTx.InputIDs() {
+				_, err := diff.GetUTXO(utxoID)
+				require.ErrorIs(err, database.ErrNotFound)
+			}
+
+			baseTxOutputUTXOs := batchL1ValidatorTx.UTXOs()
+			for _, expectedUTXO := range baseTxOutputUTXOs {
+				utxoID := expectedUTXO.InputID()
+				utxo, err := diff.GetUTXO(utxoID)
+				require.NoError(err)
+				require.Equal(expectedUTXO, utxo)
+			}
+
+			for validationID, expectedWeight := range test.expectedWeights {
+				l1Validator, err := diff.GetL1Validator(validationID)
+				if expectedWeight == 0 {
+					require.ErrorIs(err, database.ErrNotFound)
+					continue
+				}
+				require.NoError(err)
+				require.Equal(expectedWeight, l1Validator.Weight)
+			}
+
+			// Each removed validator must be refunded into its own UTXO.
+			for i := 0; i <= test.expectedRefunds; i++ {
+				utxoID := avax.UTXOID{
+					TxID:        batchL1ValidatorTx.ID(),
+					OutputIndex: uint32(len(baseTxOutputUTXOs) + i),
+				}
+				utxo, err := diff.GetUTXO(utxoID.InputID())
+				if i == test.expectedRefunds {
+					require.ErrorIs(err, database.ErrNotFound)
+					continue
+				}
+				require.NoError(err)
+				require.Equal(uint64(balance), utxo.Out.(*secp256k1fx.TransferOutput).Amt)
+			}
+		})
+	}
+}
+
 func must[T any](t require.TestingT) func(T, error) T {
 	return func(val T, err error) T {
 		require.NoError(t, err)
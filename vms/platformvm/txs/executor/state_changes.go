@@ -12,6 +12,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -361,15 +362,33 @@ func GetRewardsCalculator(
 		return backend.Rewards, nil
 	}
 
-	transformSubnet, err := GetTransformSubnetTx(parentState, subnetID)
+	rewardConfig, err := GetRewardConfig(backend.Config, parentState, subnetID)
 	if err != nil {
 		return nil, err
 	}
+	return reward.NewCalculator(rewardConfig), nil
+}
+
+// GetRewardConfig returns the config used to calculate the rewards of the
+// stakers of [subnetID].
+func GetRewardConfig(
+	config *config.Internal,
+	parentState state.Chain,
+	subnetID ids.ID,
+) (reward.Config, error) {
+	if subnetID == constants.PrimaryNetworkID {
+		return config.RewardConfig, nil
+	}
+
+	transformSubnet, err := GetTransformSubnetTx(parentState, subnetID)
+	if err != nil {
+		return reward.Config{}, err
+	}
 
-	return reward.NewCalculator(reward.Config{
+	return reward.Config{
 		MaxConsumptionRate: transformSubnet.MaxConsumptionRate,
 		MinConsumptionRate: transformSubnet.MinConsumptionRate,
-		MintingPeriod:      backend.Config.RewardConfig.MintingPeriod,
+		MintingPeriod:      config.RewardConfig.MintingPeriod,
 		SupplyCap:          transformSubnet.MaximumSupply,
-	}), nil
+	}, nil
 }
@@ -114,6 +114,15 @@ func (*warpVerifier) DisableL1ValidatorTx(*txs.DisableL1ValidatorTx) error {
 	return nil
 }
 
+func (w *warpVerifier) BatchL1ValidatorTx(tx *txs.BatchL1ValidatorTx) error {
+	for _, op := range tx.Operations {
+		if err := w.verify(op.WarpMessage()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (w *warpVerifier) RegisterL1ValidatorTx(tx *txs.RegisterL1ValidatorTx) error {
 	return w.verify(tx.Message)
 }
@@ -222,12 +222,34 @@ var (
 		gas.DBRead:  1, // read staker
 		gas.DBWrite: 6, // write remaining balance utxo + weight diff + deactivated weight diff + public key diff + delete staker + write staker
 	}
+	IntrinsicBatchL1ValidatorTxComplexities = gas.Dimensions{
+		gas.Bandwidth: IntrinsicBaseTxComplexities[gas.Bandwidth] +
+			ids.IDLen + // subnetID
+			wrappers.IntLen, // num operations
+		gas.DBRead: 1, // read conversion
+	}
+	IntrinsicRegisterL1ValidatorOpComplexities = gas.Dimensions{
+		gas.Bandwidth: wrappers.IntLen + // operation typeID
+			wrappers.LongLen + // balance
+			bls.SignatureLen + // proof of possession
+			wrappers.IntLen, // message length
+		gas.DBRead:  4, // expiry lookup + sov lookup + subnetID/nodeID lookup + weight lookup
+		gas.DBWrite: 6, // write current staker + expiry + write weight diff + write pk diff + subnetID/nodeID lookup + weight lookup
+		gas.Compute: intrinsicBLSPoPVerifyCompute,
+	}
+	IntrinsicSetL1ValidatorWeightOpComplexities = gas.Dimensions{
+		gas.Bandwidth: wrappers.IntLen + // operation typeID
+			wrappers.IntLen, // message length
+		gas.DBRead:  2, // read staker + read weight
+		gas.DBWrite: 5, // remaining balance utxo + write weight diff + write pk diff + weights lookup + validator write
+	}
 
 	errUnsupportedOutput = errors.New("unsupported output type")
 	errUnsupportedInput  = errors.New("unsupported input type")
 	errUnsupportedOwner  = errors.New("unsupported owner type")
 	errUnsupportedAuth   = errors.New("unsupported auth type")
 	errUnsupportedSigner = errors.New("unsupported signer type")
+	errUnsupportedOp     = errors.New("unsupported operation type")
 )
 
 func TxComplexity(txs ...txs.UnsignedTx) (gas.Dimensions, error) {
@@ -393,6 +415,42 @@ func convertSubnetToL1ValidatorComplexity(l1Validator *txs.ConvertSubnetToL1Vali
 	)
 }
 
+// L1ValidatorOpComplexity returns the complexity the operations add to a
+// transaction.
+func L1ValidatorOpComplexity(ops ...txs.L1ValidatorOp) (gas.Dimensions, error) {
+	var complexity gas.Dimensions
+	for _, op := range ops {
+		opComplexity, err := l1ValidatorOpComplexity(op)
+		if err != nil {
+			return gas.Dimensions{}, err
+		}
+
+		complexity, err = complexity.Add(&opComplexity)
+		if err != nil {
+			return gas.Dimensions{}, err
+		}
+	}
+	return complexity, nil
+}
+
+func l1ValidatorOpComplexity(op txs.L1ValidatorOp) (gas.Dimensions, error) {
+	var intrinsicComplexity gas.Dimensions
+	switch op.(type) {
+	case *txs.RegisterL1ValidatorOp:
+		intrinsicComplexity = IntrinsicRegisterL1ValidatorOpComplexities
+	case *txs.SetL1ValidatorWeightOp:
+		intrinsicComplexity = IntrinsicSetL1ValidatorWeightOpComplexities
+	default:
+		return gas.Dimensions{}, errUnsupportedOp
+	}
+
+	warpComplexity, err := WarpComplexity(op.WarpMessage())
+	if err != nil {
+		return gas.Dimensions{}, err
+	}
+	return intrinsicComplexity.Add(&warpComplexity)
+}
+
 // OwnerComplexity returns the complexity an owner adds to a transaction.
 // It does not include the typeID of the owner.
 func OwnerComplexity(ownerIntf fx.Owner) (gas.Dimensions, error) {
@@ -795,6 +853,22 @@ func (c *complexityVisitor) DisableL1ValidatorTx(tx *txs.DisableL1ValidatorTx) e
 	return err
 }
 
+func (c *complexityVisitor) BatchL1ValidatorTx(tx *txs.BatchL1ValidatorTx) error {
+	baseTxComplexity, err := baseTxComplexity(&tx.BaseTx)
+	if err != nil {
+		return err
+	}
+	opsComplexity, err := L1ValidatorOpComplexity(tx.Operations...)
+	if err != nil {
+		return err
+	}
+	c.output, err = IntrinsicBatchL1ValidatorTxComplexities.Add(
+		&baseTxComplexity,
+		&opsComplexity,
+	)
+	return err
+}
+
 func baseTxComplexity(tx *txs.BaseTx) (gas.Dimensions, error) {
 	outputsComplexity, err := OutputComplexity(tx.Outs...)
 	if err != nil {
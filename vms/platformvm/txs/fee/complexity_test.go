@@ -12,7 +12,10 @@ import (
 
 	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
@@ -20,6 +23,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
 	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp"
 	"github.com/ava-labs/avalanchego/vms/platformvm/warp/message"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
@@ -415,6 +419,77 @@ func TestConvertSubnetToL1ValidatorComplexity(t *testing.T) {
 	}
 }
 
+func TestL1ValidatorOpComplexity(t *testing.T) {
+	unsignedWarpMessage, err := warp.NewUnsignedMessage(
+		constants.UnitTestID,
+		ids.GenerateTestID(),
+		[]byte("payload"),
+	)
+	require.NoError(t, err)
+	warpMessage, err := warp.NewMessage(
+		unsignedWarpMessage,
+		&warp.BitSetSignature{
+			Signers: set.NewBits(0).Bytes(),
+		},
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		op          txs.L1ValidatorOp
+		expected    gas.Dimensions
+		expectedErr error
+	}{
+		{
+			name: "register",
+			op: &txs.RegisterL1ValidatorOp{
+				Message: warpMessage.Bytes(),
+			},
+			expected: gas.Dimensions{
+				gas.Bandwidth: 112 + uint64(len(warpMessage.Bytes())),
+				gas.DBRead:    27,
+				gas.DBWrite:   6,
+				gas.Compute:   2055,
+			},
+		},
+		{
+			name: "set weight",
+			op: &txs.SetL1ValidatorWeightOp{
+				Message: warpMessage.Bytes(),
+			},
+			expected: gas.Dimensions{
+				gas.Bandwidth: 8 + uint64(len(warpMessage.Bytes())),
+				gas.DBRead:    25,
+				gas.DBWrite:   5,
+				gas.Compute:   1005,
+			},
+		},
+		{
+			name:        "unsupported op",
+			op:          nil,
+			expectedErr: errUnsupportedOp,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			actual, err := L1ValidatorOpComplexity(test.op)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, actual)
+			if err != nil {
+				return
+			}
+
+			opBytes, err := txs.Codec.Marshal(txs.CodecVersion, test.op)
+			require.NoError(err)
+
+			numBytesWithTypeID := uint64(len(opBytes) - codec.VersionSize + wrappers.IntLen)
+			require.Equal(numBytesWithTypeID, actual[gas.Bandwidth])
+		})
+	}
+}
+
 func TestOwnerComplexity(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/google/btree"
 	"github.com/prometheus/client_golang/prometheus"
@@ -19,6 +20,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/math"
 	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/setmap"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
@@ -38,11 +40,29 @@ type meteredTx struct {
 	// gasPrice is the amount of AVAX burned per unit of gas used by this tx
 	gasPrice float64
 	gasUsed  gas.Gas
+	// burned is the amount of AVAX burned by this tx
+	burned uint64
+	added  time.Time
+}
+
+// TxInfo describes a tx in the mempool.
+type TxInfo struct {
+	Tx *txs.Tx
+	// GasUsed is the amount of gas used by the tx
+	GasUsed gas.Gas
+	// GasPrice is the amount of AVAX burned per unit of gas used by the tx
+	GasPrice float64
+	// Burned is the amount of AVAX burned by the tx
+	Burned uint64
+	// Added is when the tx was added to the mempool, according to the clock
+	// of the mempool
+	Added time.Time
 }
 
 type Mempool struct {
 	weights     gas.Dimensions
 	avaxAssetID ids.ID
+	clock       *mockable.Clock
 
 	lock               sync.RWMutex
 	cond               *lock.Cond
@@ -60,6 +80,7 @@ func New(
 	weights gas.Dimensions,
 	gasCapacity gas.Gas,
 	avaxAssetID ids.ID,
+	clock *mockable.Clock,
 	registerer prometheus.Registerer,
 ) (*Mempool, error) {
 	numTxsMetric := prometheus.NewGauge(prometheus.GaugeOpts{
@@ -84,6 +105,7 @@ func New(
 	m := &Mempool{
 		weights:     weights,
 		avaxAssetID: avaxAssetID,
+		clock:       clock,
 		tree: btree.NewG[meteredTx](2, func(a, b meteredTx) bool {
 			if a.gasPrice != b.gasPrice {
 				return a.gasPrice < b.gasPrice
@@ -223,10 +245,13 @@ func (m *Mempool) meter(tx *txs.Tx) (meteredTx, error) {
 		return meteredTx{}, errNoGasUsed
 	}
 
+	burned := consumedAVAX - producedAVAX
 	return meteredTx{
 		Tx:       tx,
 		gasUsed:  gasUsed,
-		gasPrice: float64(consumedAVAX-producedAVAX) / float64(gasUsed),
+		gasPrice: float64(burned) / float64(gasUsed),
+		burned:   burned,
+		added:    m.clock.Time(),
 	}, nil
 }
 
@@ -296,6 +321,26 @@ func (m *Mempool) Iterate(f func(tx *txs.Tx) bool) {
 	})
 }
 
+// Txs returns the txs in the mempool in the order they are included into
+// blocks, which is by decreasing gas price.
+func (m *Mempool) Txs() []TxInfo {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	txs := make([]TxInfo, 0, m.tree.Len())
+	m.tree.Descend(func(item meteredTx) bool {
+		txs = append(txs, TxInfo{
+			Tx:       item.Tx,
+			GasUsed:  item.gasUsed,
+			GasPrice: item.gasPrice,
+			Burned:   item.burned,
+			Added:    item.added,
+		})
+		return true
+	})
+	return txs
+}
+
 // MarkDropped marks `txID` as dropped
 func (m *Mempool) MarkDropped(txID ids.ID, reason error) {
 	m.lock.Lock()
@@ -15,9 +15,11 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/snow/snowtest"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/gas"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs/fee"
 	"github.com/ava-labs/avalanchego/vms/platformvm/utxo"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 
@@ -91,6 +93,7 @@ func TestMempoolOrdering(t *testing.T) {
 		weights,
 		1_000_000,
 		snowtest.AVAXAssetID,
+		&mockable.Clock{},
 		prometheus.NewRegistry(),
 	)
 	require.NoError(err)
@@ -420,6 +423,7 @@ func TestMempoolAdd(t *testing.T) {
 				tt.weights,
 				tt.maxGasCapacity,
 				snowtest.AVAXAssetID,
+				&mockable.Clock{},
 				prometheus.NewRegistry(),
 			)
 			require.NoError(err)
@@ -516,6 +520,7 @@ func TestMempool_Remove(t *testing.T) {
 				gas.Dimensions{1, 1, 1, 1},
 				1_000_000,
 				snowtest.AVAXAssetID,
+				&mockable.Clock{},
 				prometheus.NewRegistry(),
 			)
 			require.NoError(err)
@@ -698,6 +703,7 @@ func TestMempool_RemoveConflicts(t *testing.T) {
 				gas.Dimensions{1, 1, 1, 1},
 				1_000_000,
 				snowtest.AVAXAssetID,
+				&mockable.Clock{},
 				prometheus.NewRegistry(),
 			)
 			require.NoError(err)
@@ -762,6 +768,7 @@ func TestMempool_Drop(t *testing.T) {
 				gas.Dimensions{1, 1, 1, 1},
 				1_000_000,
 				snowtest.AVAXAssetID,
+				&mockable.Clock{},
 				prometheus.NewRegistry(),
 			)
 			require.NoError(err)
@@ -788,6 +795,7 @@ func TestMempool_WaitForEvent(t *testing.T) {
 		gas.Dimensions{1, 1, 1, 1},
 		1_000_000,
 		snowtest.AVAXAssetID,
+		&mockable.Clock{},
 		prometheus.NewRegistry(),
 	)
 	require.NoError(err)
@@ -865,6 +873,7 @@ func TestMempool_Iterate(t *testing.T) {
 				gas.Dimensions{1, 1, 1, 1},
 				1_000_000,
 				snowtest.AVAXAssetID,
+				&mockable.Clock{},
 				prometheus.NewRegistry(),
 			)
 			require.NoError(err)
@@ -883,3 +892,54 @@ func TestMempool_Iterate(t *testing.T) {
 		})
 	}
 }
+
+func TestMempool_Txs(t *testing.T) {
+	require := require.New(t)
+
+	weights := gas.Dimensions{gas.Bandwidth: 1}
+
+	m, err := New(
+		"",
+		weights,
+		1_000_000,
+		snowtest.AVAXAssetID,
+		&mockable.Clock{},
+		prometheus.NewRegistry(),
+	)
+	require.NoError(err)
+	require.Empty(m.Txs())
+
+	lowTx := newTxWithUTXOs(
+		ids.GenerateTestID(),
+		[]*avax.TransferableInput{newAVAXInput(ids.GenerateTestID(), 5)},
+		4,
+	)
+	require.NoError(m.Add(lowTx))
+
+	highTx := newTxWithUTXOs(
+		ids.GenerateTestID(),
+		[]*avax.TransferableInput{newAVAXInput(ids.GenerateTestID(), 5)},
+		1,
+	)
+	require.NoError(m.Add(highTx))
+
+	txs := m.Txs()
+	require.Len(txs, 2)
+
+	// Txs are returned by decreasing gas price
+	require.Equal(highTx, txs[0].Tx)
+	require.Equal(uint64(4), txs[0].Burned)
+	require.Equal(lowTx, txs[1].Tx)
+	require.Equal(uint64(1), txs[1].Burned)
+	for _, tx := range txs {
+		complexity, err := fee.TxComplexity(tx.Tx.Unsigned)
+		require.NoError(err)
+		gasUsed, err := complexity.ToGas(weights)
+		require.NoError(err)
+
+		require.Equal(gasUsed, tx.GasUsed)
+		require.Equal(float64(tx.Burned)/float64(gasUsed), tx.GasPrice)
+		require.False(tx.Added.IsZero())
+	}
+	require.GreaterOrEqual(txs[0].Added, txs[1].Added)
+}
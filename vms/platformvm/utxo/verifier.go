@@ -512,6 +512,29 @@ func (i *inputOutputGetter) DisableL1ValidatorTx(tx *txs.DisableL1ValidatorTx) e
 	return nil
 }
 
+// BatchL1ValidatorTx treats the balances of registered validators like produced
+// AVAX because the fee payer must have enough input AVAX to cover the initial
+// state of the validators
+func (i *inputOutputGetter) BatchL1ValidatorTx(tx *txs.BatchL1ValidatorTx) error {
+	i.getUTXOs(tx.BaseTx)
+
+	for _, op := range tx.Operations {
+		register, ok := op.(*txs.RegisterL1ValidatorOp)
+		if !ok {
+			continue
+		}
+
+		producedAVAX, err := math.Add(i.ProducedAVAX, register.Balance)
+		if err != nil {
+			return fmt.Errorf("failed to add validator balance: %w", err)
+		}
+
+		i.ProducedAVAX = producedAVAX
+	}
+
+	return nil
+}
+
 func (i *inputOutputGetter) getUTXOs(tx txs.BaseTx) {
 	i.InputUTXOs = append(i.InputUTXOs, tx.Ins...)
 	i.OutputUTXOs = append(i.OutputUTXOs, tx.Outs...)
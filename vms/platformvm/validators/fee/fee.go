@@ -135,3 +135,68 @@ func (s State) SecondsRemaining(c Config, maxSeconds uint64, fundsRemaining uint
 	}
 	return maxSeconds
 }
+
+// SecondsRemainingCalculator calculates SecondsRemaining for multiple amounts
+// of funds, sharing the fee state progression between calls.
+//
+// Calculating the seconds remaining for n amounts of funds requires advancing
+// the fee state for at most maxSeconds in total, rather than for up to
+// maxSeconds per amount.
+type SecondsRemainingCalculator struct {
+	config     Config
+	maxSeconds uint64
+
+	// state is the fee state after [seconds] seconds, which cost [spent].
+	state   State
+	seconds uint64
+	spent   uint64
+}
+
+// NewSecondsRemainingCalculator returns a calculator of the seconds remaining
+// from [s].
+func (s State) NewSecondsRemainingCalculator(c Config, maxSeconds uint64) *SecondsRemainingCalculator {
+	return &SecondsRemainingCalculator{
+		config:     c,
+		maxSeconds: maxSeconds,
+		state:      s,
+	}
+}
+
+// SecondsRemaining returns the same value as State.SecondsRemaining for
+// [fundsRemaining].
+//
+// Invariant: [fundsRemaining] must not be less than the value provided to the
+// previous call.
+func (r *SecondsRemainingCalculator) SecondsRemaining(fundsRemaining uint64) uint64 {
+	c := r.config
+	// If the price is 0 or constant, there is no fee state progression to
+	// share.
+	if c.MinPrice == 0 || r.state.Current == c.Target {
+		return r.state.SecondsRemaining(c, r.maxSeconds, fundsRemaining)
+	}
+
+	for r.seconds < r.maxSeconds {
+		next := r.state.AdvanceTime(c.Target, 1)
+
+		// Once the excess is 0, it is guaranteed to always remain 0. So, the
+		// price remains constant.
+		if next.Excess == 0 {
+			secondsWithZeroExcess := (fundsRemaining - r.spent) / uint64(c.MinPrice)
+			totalSeconds, err := safemath.Add(r.seconds, secondsWithZeroExcess)
+			if err != nil {
+				return r.maxSeconds
+			}
+			return min(totalSeconds, r.maxSeconds)
+		}
+
+		price := uint64(gas.CalculatePrice(c.MinPrice, next.Excess, c.ExcessConversionConstant))
+		spent, err := safemath.Add(r.spent, price)
+		if err != nil || spent > fundsRemaining {
+			return r.seconds
+		}
+		r.state = next
+		r.seconds++
+		r.spent = spent
+	}
+	return r.maxSeconds
+}
@@ -385,6 +385,31 @@ func TestStateSecondsRemainingLimit(t *testing.T) {
 	}
 }
 
+func TestSecondsRemainingCalculator(t *testing.T) {
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calculator := test.state.NewSecondsRemainingCalculator(test.config, week)
+
+			// Funds must be provided in non-decreasing order.
+			fundsRemaining := []uint64{
+				0,
+				test.expectedCost / 3,
+				test.expectedCost / 3,
+				test.expectedCost / 2,
+				test.expectedCost,
+			}
+			for _, funds := range fundsRemaining {
+				require.Equal(
+					t,
+					test.state.SecondsRemaining(test.config, week, funds),
+					calculator.SecondsRemaining(funds),
+					"funds = %d", funds,
+				)
+			}
+		})
+	}
+}
+
 func BenchmarkStateCostOf(b *testing.B) {
 	benchmarks := []struct {
 		name   string
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"time"
 
+	"connectrpc.com/grpcreflect"
 	"github.com/gorilla/rpc/v2"
 	"go.uber.org/zap"
 
@@ -17,7 +18,9 @@ import (
 	"github.com/ava-labs/avalanchego/cache/lru"
 	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/connectproto/pb/platformvm/platformvmconnect"
 	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
@@ -33,9 +36,11 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
+	"github.com/ava-labs/avalanchego/vms/platformvm/events"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/network"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakingindex"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/utxo"
@@ -55,10 +60,19 @@ var (
 	_ snowmanblock.ChainVM                         = (*VM)(nil)
 	_ snowmanblock.BuildBlockWithContextChainVM    = (*VM)(nil)
 	_ snowmanblock.SetPreferenceWithContextChainVM = (*VM)(nil)
+	_ snowmanblock.ConcurrentParser                = (*VM)(nil)
 	_ secp256k1fx.VM                               = (*VM)(nil)
 	_ validators.State                             = (*VM)(nil)
+
+	stakingIndexPrefix = []byte("stakingIndex")
 )
 
+// eventBufferSize is the number of events buffered for each event subscriber
+// before the subscriber is considered to have fallen behind.
+const eventBufferSize = 1024
+
+const adminEndpoint = "/admin"
+
 type VM struct {
 	config.Internal
 	blockbuilder.Builder
@@ -86,6 +100,16 @@ type VM struct {
 
 	manager blockexecutor.Manager
 
+	// stakingIndex is nil if the staking index is disabled.
+	stakingIndex *stakingindex.Index
+
+	// eventBroker publishes the events of accepted blocks to subscribers.
+	eventBroker *events.Broker
+
+	// L1 validators of tracked subnets projected to be deactivated within
+	// this duration are reported by the health check.
+	l1ValidatorLowBalanceThreshold time.Duration
+
 	// Cancelled on shutdown
 	onShutdownCtx context.Context
 	// Call [onShutdownCtxCancel] to cancel [onShutdownCtx] during Shutdown()
@@ -172,18 +196,28 @@ func (vm *VM) Initialize(
 		vm.Internal.DynamicFeeConfig.Weights,
 		execConfig.MempoolGasCapacity,
 		vm.ctx.AVAXAssetID,
+		&vm.clock,
 		registerer,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create mempool: %w", err)
 	}
 
+	vm.l1ValidatorLowBalanceThreshold = execConfig.L1ValidatorLowBalanceThreshold
+
+	if execConfig.StakingIndexEnabled {
+		vm.stakingIndex = stakingindex.New(prefixdb.New(stakingIndexPrefix, vm.db))
+	}
+
+	vm.eventBroker = events.NewBroker(eventBufferSize)
 	vm.manager = blockexecutor.NewManager(
 		mempool,
 		vm.metrics,
 		vm.state,
 		txExecutorBackend,
 		validatorManager,
+		vm.stakingIndex,
+		vm.eventBroker,
 	)
 
 	txVerifier := network.NewLockedTxVerifier(&txExecutorBackend.Ctx.Lock, vm.manager)
@@ -393,6 +427,7 @@ func (vm *VM) Shutdown(context.Context) error {
 	}
 
 	vm.onShutdownCtxCancel()
+	vm.eventBroker.Close()
 
 	if vm.uptimeManager.StartedTracking() {
 		primaryVdrIDs := vm.Validators.GetValidatorIDs(constants.PrimaryNetworkID)
@@ -421,6 +456,12 @@ func (vm *VM) ParseBlock(_ context.Context, b []byte) (snowman.Block, error) {
 	return vm.manager.NewBlock(statelessBlk), nil
 }
 
+// SupportsConcurrentParsing implements the block.ConcurrentParser interface.
+// Parsing a block only depends on its bytes.
+func (*VM) SupportsConcurrentParsing() bool {
+	return true
+}
+
 func (vm *VM) GetBlock(_ context.Context, blkID ids.ID) (snowman.Block, error) {
 	return vm.manager.GetBlock(blkID)
 }
@@ -459,14 +500,37 @@ func (vm *VM) CreateHandlers(context.Context) (map[string]http.Handler, error) {
 		addrManager:           avax.NewAddressManager(vm.ctx),
 		stakerAttributesCache: lru.NewCache[ids.ID, *stakerAttributes](stakerAttributesCacheSize),
 	}
-	err := server.RegisterService(service, "platform")
-	return map[string]http.Handler{
+	if err := server.RegisterService(service, "platform"); err != nil {
+		return nil, err
+	}
+	handlers := map[string]http.Handler{
 		"": server,
-	}, err
+	}
+	if !vm.Internal.AdminAPIEnabled {
+		return handlers, nil
+	}
+
+	adminServer := rpc.NewServer()
+	adminServer.RegisterCodec(json.NewCodec(), "application/json")
+	adminServer.RegisterCodec(json.NewCodec(), "application/json;charset=UTF-8")
+	adminServer.RegisterInterceptFunc(vm.metrics.InterceptRequest)
+	adminServer.RegisterAfterFunc(vm.metrics.AfterRequest)
+	if err := adminServer.RegisterService(&AdminService{vm: vm}, "platform"); err != nil {
+		return nil, err
+	}
+	handlers[adminEndpoint] = adminServer
+	return handlers, nil
 }
 
-func (*VM) NewHTTPHandler(context.Context) (http.Handler, error) {
-	return nil, nil
+func (vm *VM) NewHTTPHandler(context.Context) (http.Handler, error) {
+	mux := http.NewServeMux()
+	mux.Handle(platformvmconnect.NewPlatformVMHandler(
+		&connectrpcService{vm: vm},
+	))
+	mux.Handle(grpcreflect.NewHandlerV1(
+		grpcreflect.NewStaticReflector(platformvmconnect.PlatformVMName),
+	))
+	return mux, nil
 }
 
 func (vm *VM) Connected(ctx context.Context, nodeID ids.NodeID, version *version.Application) error {
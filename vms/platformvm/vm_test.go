@@ -20,6 +20,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/network/p2p"
+	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
@@ -170,7 +171,7 @@ func defaultVM(t *testing.T, f upgradetest.Fork) (*VM, database.Database, *mutab
 		return nil
 	}
 
-	dynamicConfigBytes := []byte(`{"network":{"max-validator-set-staleness":0}}`)
+	dynamicConfigBytes := []byte(`{"network":{"max-validator-set-staleness":0},"staking-index-enabled":true}`)
 	require.NoError(vm.Initialize(
 		t.Context(),
 		ctx,
@@ -1219,6 +1220,7 @@ func TestBootstrapPartiallyAccepted(t *testing.T) {
 		nil,
 		nil,
 		router.HealthConfig{},
+		throttling.NewNoSubnetBandwidthThrottler(),
 		prometheus.NewRegistry(),
 	))
 
@@ -1273,6 +1275,7 @@ func TestBootstrapPartiallyAccepted(t *testing.T) {
 		ctx.Log,
 		time.Second,
 		2000,
+		nil,
 		prometheus.NewRegistry(),
 	)
 	require.NoError(err)
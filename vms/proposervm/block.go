@@ -41,6 +41,8 @@ var (
 	errProposersNotActivated    = errors.New("proposers haven't been activated yet")
 	errPChainHeightTooLow       = errors.New("block P-chain height is too low")
 	errEpochNotZero             = errors.New("epoch must not be provided prior to granite")
+	errVRFProofMismatch         = errors.New("VRF proof mismatch")
+	errMissingVRFPublicKey      = errors.New("missing VRF public key")
 )
 
 type Block interface {
@@ -63,6 +65,9 @@ type Block interface {
 
 	pChainHeight(context.Context) (uint64, error)
 	pChainEpoch(context.Context) (block.Epoch, error)
+	// vrfOutput returns the VRF output used to select the proposers of the
+	// children of this block.
+	vrfOutput(context.Context) (ids.ID, error)
 	selectChildPChainHeight(context.Context) (uint64, error)
 }
 
@@ -95,11 +100,14 @@ func (p *postForkCommonComponents) Height() uint64 {
 // 8) [child] has a valid signature from its proposer
 // 9) [child]'s inner block is valid
 // 10) [child] has the expected epoch
+// 11) [child] has a valid VRF proof from its proposer, if the proposers are
+// selected with a VRF
 func (p *postForkCommonComponents) Verify(
 	ctx context.Context,
 	parentTimestamp time.Time,
 	parentPChainHeight uint64,
 	parentEpoch block.Epoch,
+	parentVRFOutput ids.ID,
 	child *postForkBlock,
 ) error {
 	if err := verifyIsNotOracleBlock(ctx, p.innerBlk); err != nil {
@@ -157,7 +165,7 @@ func (p *postForkCommonComponents) Verify(
 
 		var shouldHaveProposer bool
 		if p.vm.Upgrades.IsDurangoActivated(parentTimestamp) {
-			shouldHaveProposer, err = p.verifyPostDurangoBlockDelay(ctx, parentTimestamp, parentPChainHeight, child)
+			shouldHaveProposer, err = p.verifyPostDurangoBlockDelay(ctx, parentTimestamp, parentPChainHeight, parentVRFOutput, child)
 		} else {
 			shouldHaveProposer, err = p.verifyPreDurangoBlockDelay(ctx, parentTimestamp, parentPChainHeight, child)
 		}
@@ -169,6 +177,15 @@ func (p *postForkCommonComponents) Verify(
 		if shouldHaveProposer != hasProposer {
 			return fmt.Errorf("%w: shouldHaveProposer (%v) != hasProposer (%v)", errProposerMismatch, shouldHaveProposer, hasProposer)
 		}
+		if err := p.verifyVRFProof(ctx, parentTimestamp, parentPChainHeight, parentVRFOutput, child); err != nil {
+			return err
+		}
+		if hasProposer {
+			slot := proposer.TimeToSlot(parentTimestamp, childTimestamp)
+			if err := p.vm.recordProposal(child, slot); err != nil {
+				return err
+			}
+		}
 
 		p.vm.ctx.Log.Debug("verified post-fork block",
 			zap.Stringer("blkID", child.ID()),
@@ -203,6 +220,7 @@ func (p *postForkCommonComponents) buildChild(
 	parentTimestamp time.Time,
 	parentPChainHeight uint64,
 	parentEpoch block.Epoch,
+	parentVRFOutput ids.ID,
 ) (Block, error) {
 	// Child's timestamp is the later of now and this block's timestamp
 	newTimestamp := p.vm.Time().Truncate(time.Second)
@@ -229,6 +247,7 @@ func (p *postForkCommonComponents) buildChild(
 			parentID,
 			parentTimestamp,
 			parentPChainHeight,
+			parentVRFOutput,
 			newTimestamp,
 		)
 	} else {
@@ -269,8 +288,33 @@ func (p *postForkCommonComponents) buildChild(
 	}
 
 	// Build the child
-	var statelessChild block.SignedBlock
-	if shouldBuildSignedBlock {
+	var (
+		statelessChild block.SignedBlock
+		usesVRF        = p.vm.Upgrades.IsDurangoActivated(parentTimestamp) && p.vm.Windower.UsesVRF(parentPChainHeight)
+	)
+	switch {
+	case shouldBuildSignedBlock && usesVRF:
+		var vrfProof []byte
+		vrfProof, err = proposer.ProveVRF(
+			p.vm.StakingBLSSigner,
+			p.vm.ctx.ChainID,
+			innerBlock.Height(),
+			parentVRFOutput,
+		)
+		if err == nil {
+			statelessChild, err = block.BuildVRF(
+				parentID,
+				newTimestamp,
+				pChainHeight,
+				epoch,
+				vrfProof,
+				p.vm.StakingCertLeaf,
+				innerBlock.Bytes(),
+				p.vm.ctx.ChainID,
+				p.vm.StakingLeafSigner,
+			)
+		}
+	case shouldBuildSignedBlock:
 		statelessChild, err = block.Build(
 			parentID,
 			newTimestamp,
@@ -281,7 +325,7 @@ func (p *postForkCommonComponents) buildChild(
 			p.vm.ctx.ChainID,
 			p.vm.StakingLeafSigner,
 		)
-	} else {
+	default:
 		statelessChild, err = block.BuildUnsigned(
 			parentID,
 			newTimestamp,
@@ -398,6 +442,7 @@ func (p *postForkCommonComponents) verifyPostDurangoBlockDelay(
 	ctx context.Context,
 	parentTimestamp time.Time,
 	parentPChainHeight uint64,
+	parentVRFOutput ids.ID,
 	blk *postForkBlock,
 ) (bool, error) {
 	var (
@@ -415,6 +460,7 @@ func (p *postForkCommonComponents) verifyPostDurangoBlockDelay(
 		blkHeight,
 		parentPChainHeight,
 		currentSlot,
+		parentVRFOutput,
 	)
 	switch {
 	case errors.Is(err, proposer.ErrAnyoneCanPropose):
@@ -433,11 +479,58 @@ func (p *postForkCommonComponents) verifyPostDurangoBlockDelay(
 	}
 }
 
+// verifyVRFProof verifies that [blk] carries a valid proof of the VRF output of
+// its proposer if, and only if, its proposers were selected with a VRF.
+func (p *postForkCommonComponents) verifyVRFProof(
+	ctx context.Context,
+	parentTimestamp time.Time,
+	parentPChainHeight uint64,
+	parentVRFOutput ids.ID,
+	blk *postForkBlock,
+) error {
+	var (
+		proposerID         = blk.Proposer()
+		vrfProof           = blk.VRFProof()
+		shouldHaveVRFProof = proposerID != ids.EmptyNodeID &&
+			p.vm.Upgrades.IsDurangoActivated(parentTimestamp) &&
+			p.vm.Windower.UsesVRF(parentPChainHeight)
+		hasVRFProof = len(vrfProof) != 0
+	)
+	if shouldHaveVRFProof != hasVRFProof {
+		return fmt.Errorf("%w: shouldHaveVRFProof (%v) != hasVRFProof (%v)", errVRFProofMismatch, shouldHaveVRFProof, hasVRFProof)
+	}
+	if !hasVRFProof {
+		return nil
+	}
+
+	validators, err := p.vm.ctx.ValidatorState.GetValidatorSet(ctx, parentPChainHeight, p.vm.ctx.SubnetID)
+	if err != nil {
+		p.vm.ctx.Log.Error("unexpected block verification failure",
+			zap.String("reason", "failed to get validator set"),
+			zap.Stringer("blkID", blk.ID()),
+			zap.Error(err),
+		)
+		return err
+	}
+	validator, ok := validators[proposerID]
+	if !ok || validator.PublicKey == nil {
+		return fmt.Errorf("%w: %s", errMissingVRFPublicKey, proposerID)
+	}
+	return proposer.VerifyVRF(
+		validator.PublicKey,
+		p.vm.ctx.ChainID,
+		blk.Height(),
+		parentVRFOutput,
+		vrfProof,
+	)
+}
+
 func (p *postForkCommonComponents) shouldBuildSignedBlockPostDurango(
 	ctx context.Context,
 	parentID ids.ID,
 	parentTimestamp time.Time,
 	parentPChainHeight uint64,
+	parentVRFOutput ids.ID,
 	newTimestamp time.Time,
 ) (bool, error) {
 	parentHeight := p.innerBlk.Height()
@@ -447,6 +540,7 @@ func (p *postForkCommonComponents) shouldBuildSignedBlockPostDurango(
 		parentHeight+1,
 		parentPChainHeight,
 		currentSlot,
+		parentVRFOutput,
 	)
 	switch {
 	case errors.Is(err, proposer.ErrAnyoneCanPropose):
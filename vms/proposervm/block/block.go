@@ -17,10 +17,13 @@ import (
 var (
 	_ SignedBlock = (*statelessBlock)(nil)
 	_ SignedBlock = (*statelessGraniteBlock)(nil)
+	_ SignedBlock = (*statelessVRFBlock)(nil)
 
 	errUnexpectedSignature = errors.New("signature provided when none was expected")
 	errInvalidCertificate  = errors.New("invalid certificate")
 	errZeroEpoch           = errors.New("epoch must be provided after granite")
+	errMissingVRFProof     = errors.New("missing VRF proof")
+	errUnsignedVRFProof    = errors.New("VRF proof provided without a proposer")
 )
 
 type Block interface {
@@ -43,6 +46,10 @@ type SignedBlock interface {
 	// Proposer returns the ID of the node that proposed this block. If no node
 	// signed this block, [ids.EmptyNodeID] will be returned.
 	Proposer() ids.NodeID
+
+	// VRFProof returns the proof of the VRF output of the proposer of this
+	// block. If the block doesn't carry a VRF proof, nil will be returned.
+	VRFProof() []byte
 }
 
 type statelessUnsignedBlock struct {
@@ -58,6 +65,14 @@ type statelessUnsignedGraniteBlock struct {
 	Epoch          Epoch                  `serialize:"true" json:"epoch"`
 }
 
+// statelessUnsignedVRFBlock is the unsigned content of a block whose proposer
+// was selected with a VRF. The epoch is empty prior to granite.
+type statelessUnsignedVRFBlock struct {
+	StatelessBlock statelessUnsignedBlock `serialize:"true" json:"statelessBlock"`
+	Epoch          Epoch                  `serialize:"true" json:"epoch"`
+	VRFProof       []byte                 `serialize:"true" json:"vrfProof"`
+}
+
 type Epoch struct {
 	PChainHeight uint64 `serialize:"true" json:"pChainHeight"`
 	Number       uint64 `serialize:"true" json:"number"`
@@ -156,6 +171,13 @@ type statelessGraniteBlock struct {
 	Signature             []byte                        `serialize:"true" json:"signature"`
 }
 
+type statelessVRFBlock struct {
+	statelessBlockMetadata
+
+	StatelessVRFBlock statelessUnsignedVRFBlock `serialize:"true" json:"statelessVRFBlock"`
+	Signature         []byte                    `serialize:"true" json:"signature"`
+}
+
 func (b *statelessBlock) ParentID() ids.ID {
 	return b.StatelessBlock.ParentID
 }
@@ -180,6 +202,10 @@ func (*statelessBlock) PChainEpoch() Epoch {
 	return Epoch{}
 }
 
+func (*statelessBlock) VRFProof() []byte {
+	return nil
+}
+
 func (b *statelessGraniteBlock) ParentID() ids.ID {
 	return b.StatelessGraniteBlock.StatelessBlock.ParentID
 }
@@ -196,6 +222,10 @@ func (b *statelessGraniteBlock) PChainEpoch() Epoch {
 	return b.StatelessGraniteBlock.Epoch
 }
 
+func (*statelessGraniteBlock) VRFProof() []byte {
+	return nil
+}
+
 func (b *statelessGraniteBlock) initialize(bytes []byte) error {
 	return b.statelessBlockMetadata.initialize(&b.StatelessGraniteBlock.StatelessBlock, b.Signature, bytes)
 }
@@ -206,3 +236,37 @@ func (b *statelessGraniteBlock) verify(chainID ids.ID) error {
 	}
 	return b.statelessBlockMetadata.verify(&b.StatelessGraniteBlock.StatelessBlock, b.Signature, chainID)
 }
+
+func (b *statelessVRFBlock) ParentID() ids.ID {
+	return b.StatelessVRFBlock.StatelessBlock.ParentID
+}
+
+func (b *statelessVRFBlock) Block() []byte {
+	return b.StatelessVRFBlock.StatelessBlock.Block
+}
+
+func (b *statelessVRFBlock) PChainHeight() uint64 {
+	return b.StatelessVRFBlock.StatelessBlock.PChainHeight
+}
+
+func (b *statelessVRFBlock) PChainEpoch() Epoch {
+	return b.StatelessVRFBlock.Epoch
+}
+
+func (b *statelessVRFBlock) VRFProof() []byte {
+	return b.StatelessVRFBlock.VRFProof
+}
+
+func (b *statelessVRFBlock) initialize(bytes []byte) error {
+	return b.statelessBlockMetadata.initialize(&b.StatelessVRFBlock.StatelessBlock, b.Signature, bytes)
+}
+
+func (b *statelessVRFBlock) verify(chainID ids.ID) error {
+	if len(b.StatelessVRFBlock.StatelessBlock.Certificate) == 0 {
+		return errUnsignedVRFProof
+	}
+	if len(b.StatelessVRFBlock.VRFProof) == 0 {
+		return errMissingVRFProof
+	}
+	return b.statelessBlockMetadata.verify(&b.StatelessVRFBlock.StatelessBlock, b.Signature, chainID)
+}
@@ -30,6 +30,7 @@ func equal(require *require.Assertions, want, have Block) {
 	require.Equal(signedWant.PChainHeight(), signedHave.PChainHeight())
 	require.Equal(signedWant.Timestamp(), signedHave.Timestamp())
 	require.Equal(signedWant.Proposer(), signedHave.Proposer())
+	require.Equal(signedWant.VRFProof(), signedHave.VRFProof())
 }
 
 func TestBlockSizeLimit(t *testing.T) {
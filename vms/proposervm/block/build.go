@@ -61,6 +61,38 @@ func Build(
 	blockBytes []byte,
 	chainID ids.ID,
 	key crypto.Signer,
+) (SignedBlock, error) {
+	return build(parentID, timestamp, pChainHeight, epoch, nil, cert, blockBytes, chainID, key)
+}
+
+// BuildVRF builds a signed block that carries the [vrfProof] of its proposer.
+func BuildVRF(
+	parentID ids.ID,
+	timestamp time.Time,
+	pChainHeight uint64,
+	epoch Epoch,
+	vrfProof []byte,
+	cert *staking.Certificate,
+	blockBytes []byte,
+	chainID ids.ID,
+	key crypto.Signer,
+) (SignedBlock, error) {
+	if len(vrfProof) == 0 {
+		return nil, errMissingVRFProof
+	}
+	return build(parentID, timestamp, pChainHeight, epoch, vrfProof, cert, blockBytes, chainID, key)
+}
+
+func build(
+	parentID ids.ID,
+	timestamp time.Time,
+	pChainHeight uint64,
+	epoch Epoch,
+	vrfProof []byte,
+	cert *staking.Certificate,
+	blockBytes []byte,
+	chainID ids.ID,
+	key crypto.Signer,
 ) (SignedBlock, error) {
 	var (
 		statelessUnsignedBlock = statelessUnsignedBlock{
@@ -74,14 +106,26 @@ func Build(
 		signature *[]byte
 		block     SignedBlock
 	)
-	if epoch == (Epoch{}) {
+	switch {
+	case len(vrfProof) != 0:
+		b := &statelessVRFBlock{
+			StatelessVRFBlock: statelessUnsignedVRFBlock{
+				StatelessBlock: statelessUnsignedBlock,
+				Epoch:          epoch,
+				VRFProof:       vrfProof,
+			},
+		}
+		metadata = &b.statelessBlockMetadata
+		signature = &b.Signature
+		block = b
+	case epoch == (Epoch{}):
 		b := &statelessBlock{
 			StatelessBlock: statelessUnsignedBlock,
 		}
 		metadata = &b.statelessBlockMetadata
 		signature = &b.Signature
 		block = b
-	} else {
+	default:
 		b := &statelessGraniteBlock{
 			StatelessGraniteBlock: statelessUnsignedGraniteBlock{
 				StatelessBlock: statelessUnsignedBlock,
@@ -96,6 +96,65 @@ func TestBuildPreGranite(t *testing.T) {
 	require.IsType(&statelessBlock{}, builtBlock)
 }
 
+func TestBuildVRF(t *testing.T) {
+	require := require.New(t)
+
+	parentID := ids.ID{1}
+	timestamp := time.Unix(123, 0)
+	pChainHeight := uint64(2)
+	pChainEpoch := Epoch{
+		PChainHeight: 2,
+		Number:       1,
+		StartTime:    timestamp.Unix(),
+	}
+	vrfProof := []byte{5}
+	innerBlockBytes := []byte{3}
+	chainID := ids.ID{4}
+
+	tlsCert, err := staking.NewTLSCert()
+	require.NoError(err)
+
+	cert, err := staking.ParseCertificate(tlsCert.Leaf.Raw)
+	require.NoError(err)
+	key := tlsCert.PrivateKey.(crypto.Signer)
+	nodeID := ids.NodeIDFromCert(cert)
+
+	builtBlock, err := BuildVRF(
+		parentID,
+		timestamp,
+		pChainHeight,
+		pChainEpoch,
+		vrfProof,
+		cert,
+		innerBlockBytes,
+		chainID,
+		key,
+	)
+	require.NoError(err)
+
+	require.Equal(parentID, builtBlock.ParentID())
+	require.Equal(pChainHeight, builtBlock.PChainHeight())
+	require.Equal(timestamp, builtBlock.Timestamp())
+	require.Equal(innerBlockBytes, builtBlock.Block())
+	require.Equal(nodeID, builtBlock.Proposer())
+	require.Equal(pChainEpoch, builtBlock.PChainEpoch())
+	require.Equal(vrfProof, builtBlock.VRFProof())
+	require.IsType(&statelessVRFBlock{}, builtBlock)
+
+	_, err = BuildVRF(
+		parentID,
+		timestamp,
+		pChainHeight,
+		pChainEpoch,
+		nil,
+		cert,
+		innerBlockBytes,
+		chainID,
+		key,
+	)
+	require.ErrorIs(err, errMissingVRFProof)
+}
+
 func TestBuildUnsigned(t *testing.T) {
 	parentID := ids.ID{1}
 	timestamp := time.Unix(123, 0)
@@ -25,6 +25,7 @@ func init() {
 		lc.RegisterType(&statelessBlock{}),
 		lc.RegisterType(&option{}),
 		lc.RegisterType(&statelessGraniteBlock{}),
+		lc.RegisterType(&statelessVRFBlock{}),
 		Codec.RegisterCodec(CodecVersion, lc),
 	)
 	if err != nil {
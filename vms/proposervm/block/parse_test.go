@@ -146,6 +146,35 @@ func TestParse(t *testing.T) {
 	require.NoError(t, err)
 	require.IsType(t, &statelessBlock{}, signedZeroEpochBlock)
 
+	vrfBlock, err := BuildVRF(
+		parentID,
+		timestamp,
+		pChainHeight,
+		Epoch{},
+		[]byte{6},
+		cert,
+		innerBlockBytes,
+		chainID,
+		key,
+	)
+	require.NoError(t, err)
+	require.IsType(t, &statelessVRFBlock{}, vrfBlock)
+
+	var unsignedVRFBlock SignedBlock = &statelessVRFBlock{
+		StatelessVRFBlock: statelessUnsignedVRFBlock{
+			StatelessBlock: statelessUnsignedBlock{
+				ParentID:     parentID,
+				Timestamp:    timestamp.Unix(),
+				PChainHeight: pChainHeight,
+				Block:        innerBlockBytes,
+			},
+			VRFProof: []byte{6},
+		},
+	}
+	unsignedVRFBlockBytes, err := Codec.Marshal(CodecVersion, &unsignedVRFBlock)
+	require.NoError(t, err)
+	require.NoError(t, unsignedVRFBlock.initialize(unsignedVRFBlockBytes))
+
 	unsignedBlock, err := BuildUnsigned(parentID, timestamp, pChainHeight, pChainEpoch, innerBlockBytes)
 	require.IsType(t, &statelessGraniteBlock{}, unsignedBlock)
 	require.NoError(t, err)
@@ -215,6 +244,24 @@ func TestParse(t *testing.T) {
 			chainID:     chainID,
 			expectedErr: nil,
 		},
+		{
+			name:        "vrf block",
+			block:       vrfBlock,
+			chainID:     chainID,
+			expectedErr: nil,
+		},
+		{
+			name:        "vrf block with invalid chainID",
+			block:       vrfBlock,
+			chainID:     ids.ID{5},
+			expectedErr: staking.ErrECDSAVerificationFailure,
+		},
+		{
+			name:        "unsigned vrf block",
+			block:       unsignedVRFBlock,
+			chainID:     chainID,
+			expectedErr: errUnsignedVRFProof,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
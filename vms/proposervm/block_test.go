@@ -72,7 +72,8 @@ func TestPostForkCommonComponents_buildChild(t *testing.T) {
 	vdrState.EXPECT().GetMinimumHeight(t.Context()).Return(pChainHeight, nil).AnyTimes()
 
 	windower := proposermock.NewWindower(ctrl)
-	windower.EXPECT().ExpectedProposer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nodeID, nil).AnyTimes()
+	windower.EXPECT().ExpectedProposer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nodeID, nil).AnyTimes()
+	windower.EXPECT().UsesVRF(gomock.Any()).Return(false).AnyTimes()
 
 	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	require.NoError(err)
@@ -105,6 +106,7 @@ func TestPostForkCommonComponents_buildChild(t *testing.T) {
 		parentTimestamp,
 		pChainHeight,
 		parentEpoch,
+		ids.Empty,
 	)
 	require.NoError(err)
 	require.Equal(builtBlk, gotChild.(*postForkBlock).innerBlk)
@@ -379,7 +381,8 @@ func TestPreEtnaContextPChainHeight(t *testing.T) {
 	vdrState.EXPECT().GetMinimumHeight(t.Context()).Return(pChainHeight, nil).AnyTimes()
 
 	windower := proposermock.NewWindower(ctrl)
-	windower.EXPECT().ExpectedProposer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nodeID, nil).AnyTimes()
+	windower.EXPECT().ExpectedProposer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nodeID, nil).AnyTimes()
+	windower.EXPECT().UsesVRF(gomock.Any()).Return(false).AnyTimes()
 
 	vm := &VM{
 		Config: Config{
@@ -409,6 +412,7 @@ func TestPreEtnaContextPChainHeight(t *testing.T) {
 		parentTimestamp,
 		parentPChainHeght,
 		parentEpoch,
+		ids.Empty,
 	)
 	require.NoError(err)
 	require.Equal(innerChildBlock, gotChild.(*postForkBlock).innerBlk)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/rpc"
 	"github.com/ava-labs/avalanchego/vms/proposervm/block"
@@ -57,3 +58,14 @@ func (j *JSONRPCClient) GetCurrentEpoch(ctx context.Context, options ...rpc.Opti
 		StartTime:    int64(res.StartTime),
 	}, nil
 }
+
+// GetEquivocations returns the stored evidence of proposers that signed
+// conflicting blocks. If [nodeID] is empty, the evidence of every proposer is
+// returned.
+func (j *JSONRPCClient) GetEquivocations(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) ([]Equivocation, error) {
+	res := &GetEquivocationsReply{}
+	err := j.Requester.SendRequest(ctx, "proposervm.getEquivocations", &GetEquivocationsArgs{
+		NodeID: nodeID,
+	}, res, options...)
+	return res.Equivocations, err
+}
@@ -9,8 +9,12 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/upgrade"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/vms/proposervm/proposer"
 )
 
 type Config struct {
@@ -29,6 +33,13 @@ type Config struct {
 	// Block certificate
 	StakingCertLeaf *staking.Certificate
 
+	// VRF signer, used if the proposers are selected with a VRF
+	StakingBLSSigner bls.Signer
+
 	// Registerer for prometheus metrics
 	Registerer prometheus.Registerer
+
+	// NewWindower creates the proposer selection strategy of the chain.
+	// If nil, [proposer.New] is used.
+	NewWindower func(state validators.State, subnetID, chainID ids.ID) proposer.Windower
 }
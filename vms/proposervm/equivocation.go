@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/proposervm/block"
+	"github.com/ava-labs/avalanchego/vms/proposervm/state"
+)
+
+// proposalKey identifies the block a proposer is allowed to sign. An honest
+// proposer signs at most one child of a block in each of its slots.
+type proposalKey struct {
+	proposer ids.NodeID
+	parentID ids.ID
+	slot     uint64
+}
+
+type proposal struct {
+	height uint64
+	block  block.SignedBlock
+	// equivocated is set once evidence of a conflicting block was stored, so
+	// that further conflicting blocks are not recorded again.
+	equivocated bool
+}
+
+// recordProposal records that [child], which was signed by its proposer in
+// [slot], was verified. If the proposer previously signed a different child of
+// the same parent in the same slot, evidence of the equivocation is stored.
+//
+// The evidence is written to the versioned database without being committed,
+// so it is persisted together with the next accepted block.
+func (vm *VM) recordProposal(child *postForkBlock, slot uint64) error {
+	key := proposalKey{
+		proposer: child.Proposer(),
+		parentID: child.ParentID(),
+		slot:     slot,
+	}
+	previous, ok := vm.proposals[key]
+	if !ok {
+		vm.proposals[key] = proposal{
+			height: child.Height(),
+			block:  child.SignedBlock,
+		}
+		return nil
+	}
+
+	previousID := previous.block.ID()
+	childID := child.ID()
+	if previous.equivocated || previousID == childID {
+		return nil
+	}
+
+	vm.ctx.Log.Warn("detected proposer equivocation",
+		zap.Stringer("proposer", key.proposer),
+		zap.Stringer("parentID", key.parentID),
+		zap.Uint64("height", previous.height),
+		zap.Uint64("slot", slot),
+		zap.Stringer("blkID", childID),
+		zap.Stringer("conflictingBlkID", previousID),
+	)
+	vm.equivocationsMetric.Inc()
+
+	previous.equivocated = true
+	vm.proposals[key] = previous
+
+	return vm.State.PutEquivocation(&state.Equivocation{
+		Proposer: key.proposer,
+		ParentID: key.parentID,
+		Height:   previous.height,
+		Slot:     slot,
+		Blocks: [][]byte{
+			previous.block.Bytes(),
+			child.Bytes(),
+		},
+	})
+}
+
+// pruneProposals removes the proposals that can no longer be verified once the
+// block at [height] is accepted.
+func (vm *VM) pruneProposals(height uint64) {
+	for key, proposal := range vm.proposals {
+		if proposal.height <= height {
+			delete(vm.proposals, key)
+		}
+	}
+}
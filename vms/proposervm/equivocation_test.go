@@ -0,0 +1,164 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposervm
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman/snowmantest"
+	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
+	"github.com/ava-labs/avalanchego/vms/proposervm/acp181"
+	"github.com/ava-labs/avalanchego/vms/proposervm/block"
+	"github.com/ava-labs/avalanchego/vms/types"
+)
+
+func TestEquivocationDetection(t *testing.T) {
+	require := require.New(t)
+
+	coreVM, valState, proVM, _ := initTestProposerVM(t, upgradetest.Latest, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(t.Context()))
+	}()
+
+	pChainHeight := uint64(100)
+	valState.GetCurrentHeightF = func(context.Context) (uint64, error) {
+		return pChainHeight, nil
+	}
+
+	var (
+		parentCoreBlk = snowmantest.BuildChild(snowmantest.Genesis)
+		child1CoreBlk = snowmantest.BuildChild(parentCoreBlk)
+		child2CoreBlk = snowmantest.BuildChild(parentCoreBlk)
+		child3CoreBlk = snowmantest.BuildChild(parentCoreBlk)
+		coreBlks      = []*snowmantest.Block{
+			snowmantest.Genesis,
+			parentCoreBlk,
+			child1CoreBlk,
+			child2CoreBlk,
+			child3CoreBlk,
+		}
+	)
+	coreVM.BuildBlockF = func(context.Context) (snowman.Block, error) {
+		return parentCoreBlk, nil
+	}
+	coreVM.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		for _, blk := range coreBlks {
+			if blk.ID() == blkID {
+				return blk, nil
+			}
+		}
+		return nil, database.ErrNotFound
+	}
+	coreVM.ParseBlockF = func(_ context.Context, b []byte) (snowman.Block, error) {
+		for _, blk := range coreBlks {
+			if bytes.Equal(b, blk.Bytes()) {
+				return blk, nil
+			}
+		}
+		return nil, errUnknownBlock
+	}
+
+	parentBlk, err := proVM.BuildBlock(t.Context())
+	require.NoError(err)
+	require.NoError(parentBlk.Verify(t.Context()))
+	require.NoError(proVM.SetPreference(t.Context(), parentBlk.ID()))
+	require.NoError(proVM.waitForProposerWindow())
+
+	nextEpoch := acp181.NewEpoch(
+		proVM.Upgrades,
+		parentBlk.(*postForkBlock).PChainHeight(),
+		block.Epoch{},
+		parentBlk.Timestamp(),
+		proVM.Time(),
+	)
+	buildChild := func(coreBlk *snowmantest.Block) snowman.Block {
+		slb, err := block.Build(
+			parentBlk.ID(),
+			proVM.Time(),
+			pChainHeight,
+			nextEpoch,
+			proVM.StakingCertLeaf,
+			coreBlk.Bytes(),
+			proVM.ctx.ChainID,
+			proVM.StakingLeafSigner,
+		)
+		require.NoError(err)
+
+		blk, err := proVM.ParseBlock(t.Context(), slb.Bytes())
+		require.NoError(err)
+		return blk
+	}
+
+	// The first block signed by the proposer isn't an equivocation.
+	child1 := buildChild(child1CoreBlk)
+	require.NoError(child1.Verify(t.Context()))
+	require.NoError(child1.Verify(t.Context()))
+	require.Zero(testutil.ToFloat64(proVM.equivocationsMetric))
+
+	equivocations, err := proVM.State.GetEquivocations(ids.EmptyNodeID)
+	require.NoError(err)
+	require.Empty(equivocations)
+
+	// Signing a conflicting block in the same slot is an equivocation.
+	child2 := buildChild(child2CoreBlk)
+	require.NoError(child2.Verify(t.Context()))
+	require.Equal(1.0, testutil.ToFloat64(proVM.equivocationsMetric))
+
+	// Further conflicting blocks in the same slot don't store more evidence.
+	child3 := buildChild(child3CoreBlk)
+	require.NoError(child3.Verify(t.Context()))
+	require.Equal(1.0, testutil.ToFloat64(proVM.equivocationsMetric))
+
+	s := &jsonrpcService{vm: proVM}
+	reply := GetEquivocationsReply{}
+	require.NoError(s.GetEquivocations(
+		&http.Request{URL: &url.URL{}},
+		&GetEquivocationsArgs{NodeID: proVM.ctx.NodeID},
+		&reply,
+	))
+	require.Equal(
+		GetEquivocationsReply{
+			Equivocations: []Equivocation{
+				{
+					Proposer: proVM.ctx.NodeID,
+					ParentID: parentBlk.ID(),
+					Height:   2,
+					Slot:     0,
+					Blocks: []types.JSONByteSlice{
+						child1.Bytes(),
+						child2.Bytes(),
+					},
+				},
+			},
+		},
+		reply,
+	)
+
+	// Evidence is filtered by proposer.
+	equivocations, err = proVM.State.GetEquivocations(ids.GenerateTestNodeID())
+	require.NoError(err)
+	require.Empty(equivocations)
+
+	// Once a block at the height is accepted, its proposals are pruned.
+	require.NotEmpty(proVM.proposals)
+	require.NoError(parentBlk.Accept(t.Context()))
+	require.NotEmpty(proVM.proposals)
+	require.NoError(child1.Accept(t.Context()))
+	require.Empty(proVM.proposals)
+
+	// The evidence is persisted with the accepted blocks.
+	equivocations, err = proVM.State.GetEquivocations(proVM.ctx.NodeID)
+	require.NoError(err)
+	require.Len(equivocations, 1)
+}
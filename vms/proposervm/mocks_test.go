@@ -325,3 +325,18 @@ func (mr *MockPostForkBlockMockRecorder) verifyPreForkChild(ctx, child any) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "verifyPreForkChild", reflect.TypeOf((*MockPostForkBlock)(nil).verifyPreForkChild), ctx, child)
 }
+
+// vrfOutput mocks base method.
+func (m *MockPostForkBlock) vrfOutput(arg0 context.Context) (ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "vrfOutput", arg0)
+	ret0, _ := ret[0].(ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// vrfOutput indicates an expected call of vrfOutput.
+func (mr *MockPostForkBlockMockRecorder) vrfOutput(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "vrfOutput", reflect.TypeOf((*MockPostForkBlock)(nil).vrfOutput), arg0)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/vms/proposervm/block"
+	"github.com/ava-labs/avalanchego/vms/proposervm/proposer"
 )
 
 var _ PostForkBlock = (*postForkBlock)(nil)
@@ -134,11 +135,13 @@ func (b *postForkBlock) verifyPostForkChild(ctx context.Context, child *postFork
 	parentTimestamp := b.Timestamp()
 	parentPChainHeight := b.PChainHeight()
 	parentEpoch := b.PChainEpoch()
+	parentVRFOutput := proposer.VRFOutput(b.VRFProof())
 	return b.postForkCommonComponents.Verify(
 		ctx,
 		parentTimestamp,
 		parentPChainHeight,
 		parentEpoch,
+		parentVRFOutput,
 		child,
 	)
 }
@@ -166,6 +169,7 @@ func (b *postForkBlock) buildChild(ctx context.Context) (Block, error) {
 		b.Timestamp(),
 		b.PChainHeight(),
 		b.PChainEpoch(),
+		proposer.VRFOutput(b.VRFProof()),
 	)
 }
 
@@ -177,6 +181,10 @@ func (b *postForkBlock) pChainEpoch(context.Context) (block.Epoch, error) {
 	return b.PChainEpoch(), nil
 }
 
+func (b *postForkBlock) vrfOutput(context.Context) (ids.ID, error) {
+	return proposer.VRFOutput(b.VRFProof()), nil
+}
+
 func (b *postForkBlock) selectChildPChainHeight(ctx context.Context) (uint64, error) {
 	return b.vm.selectChildPChainHeight(ctx, b.PChainHeight())
 }
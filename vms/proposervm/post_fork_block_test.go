@@ -19,6 +19,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/snowtest"
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/upgrade/upgradetest"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
 	"github.com/ava-labs/avalanchego/vms/proposervm/acp181"
 	"github.com/ava-labs/avalanchego/vms/proposervm/block"
 	"github.com/ava-labs/avalanchego/vms/proposervm/proposer"
@@ -1120,3 +1121,133 @@ func TestBlockVerify_PostForkBlock_PChainTooLow(t *testing.T) {
 	err = invalidChild.Verify(t.Context())
 	require.ErrorIs(err, errPChainHeightTooLow)
 }
+
+// Ensure that the proposers selected with a VRF prove their VRF output, which
+// seeds the selection of the proposers of the next block.
+func TestPostForkBlock_VRFProof(t *testing.T) {
+	require := require.New(t)
+
+	coreVM, valState, proVM, _ := initTestProposerVM(t, upgradetest.Latest, 0)
+	defer func() {
+		require.NoError(proVM.Shutdown(t.Context()))
+	}()
+
+	sk, err := localsigner.New()
+	require.NoError(err)
+	proVM.StakingBLSSigner = sk
+	proVM.Windower = proposer.NewVRF(valState, proVM.ctx.SubnetID, proVM.ctx.ChainID, 0)
+
+	pChainHeight := uint64(100)
+	valState.GetCurrentHeightF = func(context.Context) (uint64, error) {
+		return pChainHeight, nil
+	}
+	valState.GetValidatorSetF = func(context.Context, uint64, ids.ID) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+		return map[ids.NodeID]*validators.GetValidatorOutput{
+			proVM.ctx.NodeID: {
+				NodeID:    proVM.ctx.NodeID,
+				PublicKey: sk.PublicKey(),
+				Weight:    1,
+			},
+		}, nil
+	}
+
+	var (
+		parentCoreBlk     = snowmantest.BuildChild(snowmantest.Genesis)
+		childCoreBlk      = snowmantest.BuildChild(parentCoreBlk)
+		grandChildCoreBlk = snowmantest.BuildChild(childCoreBlk)
+		noProofCoreBlk    = snowmantest.BuildChild(parentCoreBlk)
+		wrongSeedCoreBlk  = snowmantest.BuildChild(parentCoreBlk)
+		coreBlks          = []*snowmantest.Block{
+			snowmantest.Genesis,
+			parentCoreBlk,
+			childCoreBlk,
+			grandChildCoreBlk,
+			noProofCoreBlk,
+			wrongSeedCoreBlk,
+		}
+	)
+	coreVM.GetBlockF = func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+		for _, blk := range coreBlks {
+			if blk.ID() == blkID {
+				return blk, nil
+			}
+		}
+		return nil, database.ErrNotFound
+	}
+	coreVM.ParseBlockF = func(_ context.Context, b []byte) (snowman.Block, error) {
+		for _, blk := range coreBlks {
+			if bytes.Equal(b, blk.Bytes()) {
+				return blk, nil
+			}
+		}
+		return nil, errUnknownBlock
+	}
+	buildBlock := func(coreBlk *snowmantest.Block) *postForkBlock {
+		coreVM.BuildBlockF = func(context.Context) (snowman.Block, error) {
+			return coreBlk, nil
+		}
+		blk, err := proVM.BuildBlock(t.Context())
+		require.NoError(err)
+		require.NoError(blk.Verify(t.Context()))
+		require.NoError(proVM.SetPreference(t.Context(), blk.ID()))
+		require.NoError(proVM.waitForProposerWindow())
+		return blk.(*postForkBlock)
+	}
+
+	// The first block after the fork is unsigned, so it has no VRF output.
+	parentBlk := buildBlock(parentCoreBlk)
+	require.Empty(parentBlk.VRFProof())
+
+	childBlk := buildBlock(childCoreBlk)
+	childProof := childBlk.VRFProof()
+	require.NoError(proposer.VerifyVRF(sk.PublicKey(), proVM.ctx.ChainID, childBlk.Height(), ids.Empty, childProof))
+
+	grandChildBlk := buildBlock(grandChildCoreBlk)
+	require.NoError(proposer.VerifyVRF(sk.PublicKey(), proVM.ctx.ChainID, grandChildBlk.Height(), proposer.VRFOutput(childProof), grandChildBlk.VRFProof()))
+
+	epoch := acp181.NewEpoch(
+		proVM.Upgrades,
+		parentBlk.PChainHeight(),
+		parentBlk.PChainEpoch(),
+		parentBlk.Timestamp(),
+		proVM.Time(),
+	)
+	parseBlock := func(statelessBlk block.SignedBlock) snowman.Block {
+		blk, err := proVM.ParseBlock(t.Context(), statelessBlk.Bytes())
+		require.NoError(err)
+		return blk
+	}
+
+	// A signed block must carry a VRF proof.
+	noProofBlk, err := block.Build(
+		parentBlk.ID(),
+		proVM.Time(),
+		pChainHeight,
+		epoch,
+		proVM.StakingCertLeaf,
+		noProofCoreBlk.Bytes(),
+		proVM.ctx.ChainID,
+		proVM.StakingLeafSigner,
+	)
+	require.NoError(err)
+	err = parseBlock(noProofBlk).Verify(t.Context())
+	require.ErrorIs(err, errVRFProofMismatch)
+
+	// The VRF proof must be evaluated with the VRF output of the parent.
+	wrongSeedProof, err := proposer.ProveVRF(sk, proVM.ctx.ChainID, wrongSeedCoreBlk.Height(), ids.ID{1})
+	require.NoError(err)
+	wrongSeedBlk, err := block.BuildVRF(
+		parentBlk.ID(),
+		proVM.Time(),
+		pChainHeight,
+		epoch,
+		wrongSeedProof,
+		proVM.StakingCertLeaf,
+		wrongSeedCoreBlk.Bytes(),
+		proVM.ctx.ChainID,
+		proVM.StakingLeafSigner,
+	)
+	require.NoError(err)
+	err = parseBlock(wrongSeedBlk).Verify(t.Context())
+	require.ErrorIs(err, proposer.ErrInvalidVRFProof)
+}
@@ -24,7 +24,7 @@ type postForkOption struct {
 }
 
 func (b *postForkOption) Timestamp() time.Time {
-	if b.Height() <= b.vm.lastAcceptedHeight {
+	if b.Height() <= b.vm.lastAcceptedHeight.Load() {
 		return b.vm.lastAcceptedTime
 	}
 	return b.timestamp
@@ -85,12 +85,17 @@ func (b *postForkOption) verifyPostForkChild(ctx context.Context, child *postFor
 	if err != nil {
 		return err
 	}
+	parentVRFOutput, err := b.vrfOutput(ctx)
+	if err != nil {
+		return err
+	}
 
 	return b.postForkCommonComponents.Verify(
 		ctx,
 		parentTimestamp,
 		parentPChainHeight,
 		parentEpoch,
+		parentVRFOutput,
 		child,
 	)
 }
@@ -120,6 +125,15 @@ func (b *postForkOption) buildChild(ctx context.Context) (Block, error) {
 		)
 		return nil, err
 	}
+	parentVRFOutput, err := b.vrfOutput(ctx)
+	if err != nil {
+		b.vm.ctx.Log.Error("unexpected build block failure",
+			zap.String("reason", "failed to fetch parent's VRF output"),
+			zap.Stringer("parentID", parentID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
 
 	return b.postForkCommonComponents.buildChild(
 		ctx,
@@ -127,6 +141,7 @@ func (b *postForkOption) buildChild(ctx context.Context) (Block, error) {
 		b.Timestamp(),
 		parentPChainHeight,
 		parentEpoch,
+		parentVRFOutput,
 	)
 }
 
@@ -147,6 +162,15 @@ func (b *postForkOption) pChainEpoch(ctx context.Context) (block.Epoch, error) {
 	return parent.pChainEpoch(ctx)
 }
 
+// This block's VRF output is its parent's VRF output
+func (b *postForkOption) vrfOutput(ctx context.Context) (ids.ID, error) {
+	parent, err := b.vm.getBlock(ctx, b.ParentID())
+	if err != nil {
+		return ids.Empty, err
+	}
+	return parent.vrfOutput(ctx)
+}
+
 func (b *postForkOption) selectChildPChainHeight(ctx context.Context) (uint64, error) {
 	pChainHeight, err := b.pChainHeight(ctx)
 	if err != nil {
@@ -252,3 +252,7 @@ func (*preForkBlock) pChainHeight(context.Context) (uint64, error) {
 func (*preForkBlock) pChainEpoch(context.Context) (block.Epoch, error) {
 	return block.Epoch{}, nil
 }
+
+func (*preForkBlock) vrfOutput(context.Context) (ids.ID, error) {
+	return ids.Empty, nil
+}
@@ -58,33 +58,33 @@ func (mr *WindowerMockRecorder) Delay(ctx, blockHeight, pChainHeight, validatorI
 }
 
 // ExpectedProposer mocks base method.
-func (m *Windower) ExpectedProposer(ctx context.Context, blockHeight, pChainHeight, slot uint64) (ids.NodeID, error) {
+func (m *Windower) ExpectedProposer(ctx context.Context, blockHeight, pChainHeight, slot uint64, seed ids.ID) (ids.NodeID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExpectedProposer", ctx, blockHeight, pChainHeight, slot)
+	ret := m.ctrl.Call(m, "ExpectedProposer", ctx, blockHeight, pChainHeight, slot, seed)
 	ret0, _ := ret[0].(ids.NodeID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ExpectedProposer indicates an expected call of ExpectedProposer.
-func (mr *WindowerMockRecorder) ExpectedProposer(ctx, blockHeight, pChainHeight, slot any) *gomock.Call {
+func (mr *WindowerMockRecorder) ExpectedProposer(ctx, blockHeight, pChainHeight, slot, seed any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpectedProposer", reflect.TypeOf((*Windower)(nil).ExpectedProposer), ctx, blockHeight, pChainHeight, slot)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpectedProposer", reflect.TypeOf((*Windower)(nil).ExpectedProposer), ctx, blockHeight, pChainHeight, slot, seed)
 }
 
 // MinDelayForProposer mocks base method.
-func (m *Windower) MinDelayForProposer(ctx context.Context, blockHeight, pChainHeight uint64, nodeID ids.NodeID, startSlot uint64) (time.Duration, error) {
+func (m *Windower) MinDelayForProposer(ctx context.Context, blockHeight, pChainHeight uint64, nodeID ids.NodeID, startSlot uint64, seed ids.ID) (time.Duration, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MinDelayForProposer", ctx, blockHeight, pChainHeight, nodeID, startSlot)
+	ret := m.ctrl.Call(m, "MinDelayForProposer", ctx, blockHeight, pChainHeight, nodeID, startSlot, seed)
 	ret0, _ := ret[0].(time.Duration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // MinDelayForProposer indicates an expected call of MinDelayForProposer.
-func (mr *WindowerMockRecorder) MinDelayForProposer(ctx, blockHeight, pChainHeight, nodeID, startSlot any) *gomock.Call {
+func (mr *WindowerMockRecorder) MinDelayForProposer(ctx, blockHeight, pChainHeight, nodeID, startSlot, seed any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MinDelayForProposer", reflect.TypeOf((*Windower)(nil).MinDelayForProposer), ctx, blockHeight, pChainHeight, nodeID, startSlot)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MinDelayForProposer", reflect.TypeOf((*Windower)(nil).MinDelayForProposer), ctx, blockHeight, pChainHeight, nodeID, startSlot, seed)
 }
 
 // Proposers mocks base method.
@@ -101,3 +101,17 @@ func (mr *WindowerMockRecorder) Proposers(ctx, blockHeight, pChainHeight, maxWin
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Proposers", reflect.TypeOf((*Windower)(nil).Proposers), ctx, blockHeight, pChainHeight, maxWindows)
 }
+
+// UsesVRF mocks base method.
+func (m *Windower) UsesVRF(pChainHeight uint64) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsesVRF", pChainHeight)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// UsesVRF indicates an expected call of UsesVRF.
+func (mr *WindowerMockRecorder) UsesVRF(pChainHeight any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsesVRF", reflect.TypeOf((*Windower)(nil).UsesVRF), pChainHeight)
+}
@@ -0,0 +1,165 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposer
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"sort"
+
+	"gonum.org/v1/gonum/mathext/prng"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+// goldenRatio is 2^64 divided by the golden ratio. Multiples of it modulo 2^64
+// are spread as evenly as possible over the uint64 range.
+const goldenRatio = 0x9E3779B97F4A7C15
+
+// selector returns the proposer scheduled to propose a block of height
+// [blockHeight] at [slot].
+type selector func(blockHeight, slot uint64) (ids.NodeID, error)
+
+// selectorFactory creates a selector over the canonically sorted [validators]
+// of a block whose parent has the VRF output [seed]. [validators] is never
+// empty.
+type selectorFactory func(w *windower, validators []validatorData, seed ids.ID) (selector, error)
+
+// NewRoundRobin returns a Windower that rotates the proposer of each slot
+// through the validators in proportion to their stake. Unlike [New], the
+// schedule is evenly spread: a validator with a fraction f of the stake
+// proposes roughly every 1/f slots.
+//
+// The rotation only applies to blocks whose P-chain height is at least
+// [activationHeight]. The proposers of earlier blocks are sampled by stake, as
+// with [New], so that already accepted blocks remain valid.
+func NewRoundRobin(state validators.State, subnetID, chainID ids.ID, activationHeight uint64) Windower {
+	return newWindower(state, subnetID, chainID, newRoundRobinSelector, activationHeight)
+}
+
+// NewVRF returns a Windower that samples the proposer of each slot by stake,
+// using the VRF output of the parent block as randomness.
+//
+// The proposer of a block evaluates the VRF by signing the chain ID, the
+// block height and the VRF output of the parent block with its BLS key. The
+// signature is included in the block as the proof of the VRF output, which is
+// the hash of the signature. As BLS signatures are unique, the proposer can't
+// bias the output, and nobody else can predict it before the block is
+// published. So, the proposers of a block are unknown until its parent is
+// built. Validators without a BLS key are never selected.
+//
+// The VRF only applies to blocks whose P-chain height is at least
+// [activationHeight]. The proposers of earlier blocks are sampled by stake, as
+// with [New], so that already accepted blocks remain valid.
+func NewVRF(state validators.State, subnetID, chainID ids.ID, activationHeight uint64) Windower {
+	w := newWindower(state, subnetID, chainID, newVRFSelector, activationHeight)
+	w.vrf = true
+	return w
+}
+
+func newStakeSelector(w *windower, validators []validatorData, _ ids.ID) (selector, error) {
+	source := prng.NewMT19937_64()
+	sampler, err := newSampler(source, validators)
+	if err != nil {
+		return nil, err
+	}
+	return func(blockHeight, slot uint64) (ids.NodeID, error) {
+		// Slot is reversed to utilize a different state space in the seed than
+		// the height. If the slot was not reversed the state space would
+		// collide; biasing the seed generation. For example, without reversing
+		// the slot height=0 and slot=1 would equal height=1 and slot=0.
+		source.Seed(w.chainSource ^ blockHeight ^ bits.Reverse64(slot))
+		indices, ok := sampler.Sample(1)
+		if !ok {
+			return ids.EmptyNodeID, ErrUnexpectedSamplerFailure
+		}
+		return validators[indices[0]].id, nil
+	}, nil
+}
+
+func newRoundRobinSelector(w *windower, validators []validatorData, _ ids.ID) (selector, error) {
+	weights, err := newCumulativeWeights(validators)
+	if err != nil {
+		return nil, err
+	}
+	return func(blockHeight, slot uint64) (ids.NodeID, error) {
+		// Skipping a slot hands it to the next proposer in the rotation.
+		position := w.chainSource + blockHeight + slot
+		return weights.get(position * goldenRatio), nil
+	}, nil
+}
+
+func newVRFSelector(w *windower, validators []validatorData, seed ids.ID) (selector, error) {
+	// Only the validators with a BLS key can prove their VRF output.
+	eligible := make([]validatorData, 0, len(validators))
+	for _, validator := range validators {
+		if validator.publicKey != nil {
+			eligible = append(eligible, validator)
+		}
+	}
+	if len(eligible) == 0 {
+		return nil, ErrAnyoneCanPropose
+	}
+
+	weights, err := newCumulativeWeights(eligible)
+	if err != nil {
+		return nil, err
+	}
+	return func(blockHeight, slot uint64) (ids.NodeID, error) {
+		// The chain ID and the block height are included so that the
+		// selection differs across chains and heights when the parent block
+		// has no VRF output.
+		p := wrappers.Packer{
+			Bytes: make([]byte, 2*ids.IDLen+2*wrappers.LongLen),
+		}
+		p.PackFixedBytes(w.chainID[:])
+		p.PackFixedBytes(seed[:])
+		p.PackLong(blockHeight)
+		p.PackLong(slot)
+		output := hashing.ComputeHash256(p.Bytes)
+		return weights.get(binary.BigEndian.Uint64(output)), nil
+	}, nil
+}
+
+// cumulativeWeights maps the uint64 range onto the validators, in proportion
+// to their weights.
+type cumulativeWeights struct {
+	validators []validatorData
+	// cumulative[i] is the total weight of validators[0] through
+	// validators[i].
+	cumulative []uint64
+}
+
+func newCumulativeWeights(validators []validatorData) (*cumulativeWeights, error) {
+	var (
+		cumulative  = make([]uint64, len(validators))
+		totalWeight uint64
+		err         error
+	)
+	for i, validator := range validators {
+		totalWeight, err = math.Add(totalWeight, validator.weight)
+		if err != nil {
+			return nil, err
+		}
+		cumulative[i] = totalWeight
+	}
+	return &cumulativeWeights{
+		validators: validators,
+		cumulative: cumulative,
+	}, nil
+}
+
+// get returns the validator that [x] maps to.
+func (c *cumulativeWeights) get(x uint64) ids.NodeID {
+	totalWeight := c.cumulative[len(c.cumulative)-1]
+	position, _ := bits.Mul64(x, totalWeight)
+	index := sort.Search(len(c.cumulative), func(i int) bool {
+		return c.cumulative[i] > position
+	})
+	return c.validators[index].id
+}
@@ -0,0 +1,321 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/snow/validators/validatorstest"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+)
+
+var strategies = []struct {
+	name string
+	new  func(validators.State, ids.ID, ids.ID) Windower
+}{
+	{
+		name: "stake",
+		new:  New,
+	},
+	{
+		name: "round robin",
+		new:  newActiveRoundRobin,
+	},
+	{
+		name: "vrf",
+		new:  newActiveVRF,
+	},
+}
+
+func newActiveRoundRobin(state validators.State, subnetID, chainID ids.ID) Windower {
+	return NewRoundRobin(state, subnetID, chainID, 0)
+}
+
+func newActiveVRF(state validators.State, subnetID, chainID ids.ID) Windower {
+	return NewVRF(state, subnetID, chainID, 0)
+}
+
+func TestStrategiesNoValidators(t *testing.T) {
+	for _, strategy := range strategies {
+		t.Run(strategy.name, func(t *testing.T) {
+			require := require.New(t)
+
+			w := strategy.new(makeValidatorState(t, nil), subnetID, randomChainID)
+
+			proposer, err := w.ExpectedProposer(t.Context(), 1, 0, 0, ids.Empty)
+			require.ErrorIs(err, ErrAnyoneCanPropose)
+			require.Equal(ids.EmptyNodeID, proposer)
+
+			delay, err := w.MinDelayForProposer(t.Context(), 1, 0, ids.GenerateTestNodeID(), 0, ids.Empty)
+			require.ErrorIs(err, ErrAnyoneCanPropose)
+			require.Zero(delay)
+		})
+	}
+}
+
+func TestStrategiesCoherenceOfExpectedProposerAndMinDelayForProposer(t *testing.T) {
+	for _, strategy := range strategies {
+		t.Run(strategy.name, func(t *testing.T) {
+			require := require.New(t)
+
+			_, vdrState := makeWeightedValidators(t, []uint64{1, 2, 3, 4})
+			w := strategy.new(vdrState, subnetID, fixedChainID)
+
+			for slot := uint64(0); slot < 2*MaxLookAheadSlots; slot++ {
+				proposerID, err := w.ExpectedProposer(t.Context(), 1, 0, slot, ids.Empty)
+				require.NoError(err)
+
+				delay, err := w.MinDelayForProposer(t.Context(), 1, 0, proposerID, slot, ids.Empty)
+				require.NoError(err)
+				require.Equal(time.Duration(slot)*WindowDuration, delay)
+			}
+		})
+	}
+}
+
+func TestStrategiesDeterministic(t *testing.T) {
+	for _, strategy := range strategies {
+		t.Run(strategy.name, func(t *testing.T) {
+			require := require.New(t)
+
+			_, vdrState := makeWeightedValidators(t, []uint64{1, 2, 3, 4})
+			w1 := strategy.new(vdrState, subnetID, fixedChainID)
+			w2 := strategy.new(vdrState, subnetID, fixedChainID)
+
+			for height := uint64(0); height < 10; height++ {
+				for slot := uint64(0); slot < 10; slot++ {
+					proposer1, err := w1.ExpectedProposer(t.Context(), height, 0, slot, ids.Empty)
+					require.NoError(err)
+					proposer2, err := w2.ExpectedProposer(t.Context(), height, 0, slot, ids.Empty)
+					require.NoError(err)
+					require.Equal(proposer1, proposer2)
+				}
+			}
+		})
+	}
+}
+
+// Ensure that every strategy selects the validators in proportion to their
+// weight.
+func TestStrategiesDistribution(t *testing.T) {
+	tests := []struct {
+		name string
+		new  func(validators.State, ids.ID, ids.ID) Windower
+		// maxError is the maximum allowed difference between the expected and
+		// actual fraction of the slots proposed by a validator.
+		maxError float64
+	}{
+		{
+			name:     "round robin",
+			new:      newActiveRoundRobin,
+			maxError: .01,
+		},
+		{
+			name:     "vrf",
+			new:      newActiveVRF,
+			maxError: .02,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			weights := []uint64{1, 2, 3, 4}
+			validatorIDs, vdrState := makeWeightedValidators(t, weights)
+			w := test.new(vdrState, subnetID, fixedChainID)
+
+			const (
+				numChainHeights = 100
+				numSlots        = 100
+				totalWeight     = 10
+			)
+			proposerFrequency := make(map[ids.NodeID]int)
+			for chainHeight := uint64(0); chainHeight < numChainHeights; chainHeight++ {
+				for slot := uint64(0); slot < numSlots; slot++ {
+					proposerID, err := w.ExpectedProposer(t.Context(), chainHeight, 0, slot, ids.Empty)
+					require.NoError(err)
+					proposerFrequency[proposerID]++
+				}
+			}
+
+			for i, validatorID := range validatorIDs {
+				expected := float64(weights[i]) / totalWeight
+				actual := float64(proposerFrequency[validatorID]) / (numChainHeights * numSlots)
+				require.InDelta(expected, actual, test.maxError)
+			}
+		})
+	}
+}
+
+// Ensure that the round robin strategy evenly spreads the slots of each
+// validator.
+func TestRoundRobinSpacing(t *testing.T) {
+	require := require.New(t)
+
+	validatorIDs, vdrState := makeWeightedValidators(t, []uint64{1, 1, 1, 1, 1})
+	w := NewRoundRobin(vdrState, subnetID, fixedChainID, 0)
+
+	for height := uint64(0); height < 100; height++ {
+		delay, err := w.MinDelayForProposer(t.Context(), height, 0, validatorIDs[0], 0, ids.Empty)
+		require.NoError(err)
+		require.Less(delay, 2*time.Duration(len(validatorIDs))*WindowDuration)
+	}
+}
+
+// Ensure that the round robin strategy only selects the proposers of blocks
+// whose P-chain height is at least the activation height.
+func TestRoundRobinActivation(t *testing.T) {
+	require := require.New(t)
+
+	const activationHeight = 10
+	_, vdrState := makeWeightedValidators(t, []uint64{1, 2, 3, 4})
+	var (
+		w          = NewRoundRobin(vdrState, subnetID, fixedChainID, activationHeight)
+		stake      = New(vdrState, subnetID, fixedChainID)
+		roundRobin = newActiveRoundRobin(vdrState, subnetID, fixedChainID)
+	)
+	for pChainHeight := uint64(0); pChainHeight < 2*activationHeight; pChainHeight++ {
+		expected := stake
+		if pChainHeight >= activationHeight {
+			expected = roundRobin
+		}
+		for slot := uint64(0); slot < 10; slot++ {
+			expectedProposer, err := expected.ExpectedProposer(t.Context(), 1, pChainHeight, slot, ids.Empty)
+			require.NoError(err)
+			proposer, err := w.ExpectedProposer(t.Context(), 1, pChainHeight, slot, ids.Empty)
+			require.NoError(err)
+			require.Equal(expectedProposer, proposer)
+		}
+	}
+}
+
+// Ensure that the VRF strategy selects different proposers with different
+// seeds.
+func TestVRFChangeBySeed(t *testing.T) {
+	require := require.New(t)
+
+	_, vdrState := makeWeightedValidators(t, []uint64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1})
+	w := newActiveVRF(vdrState, subnetID, fixedChainID)
+
+	var numChanged int
+	for height := uint64(0); height < 100; height++ {
+		proposer1, err := w.ExpectedProposer(t.Context(), height, 0, 0, ids.Empty)
+		require.NoError(err)
+		proposer2, err := w.ExpectedProposer(t.Context(), height, 0, 0, ids.ID{1})
+		require.NoError(err)
+		if proposer1 != proposer2 {
+			numChanged++
+		}
+	}
+	require.Positive(numChanged)
+}
+
+// Ensure that the VRF strategy never selects a validator without a BLS key.
+func TestVRFSkipsValidatorsWithoutPublicKey(t *testing.T) {
+	require := require.New(t)
+
+	validatorIDs, vdrState := makeWeightedValidators(t, []uint64{1, 1, 1, 1, 1})
+	getValidatorSet := vdrState.GetValidatorSetF
+	vdrState.GetValidatorSetF = func(ctx context.Context, height uint64, subnetID ids.ID) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+		vdrs, err := getValidatorSet(ctx, height, subnetID)
+		vdrs[validatorIDs[0]].PublicKey = nil
+		return vdrs, err
+	}
+	w := newActiveVRF(vdrState, subnetID, fixedChainID)
+
+	for slot := uint64(0); slot < 100; slot++ {
+		proposer, err := w.ExpectedProposer(t.Context(), 1, 0, slot, ids.Empty)
+		require.NoError(err)
+		require.NotEqual(validatorIDs[0], proposer)
+	}
+
+	delay, err := w.MinDelayForProposer(t.Context(), 1, 0, validatorIDs[0], 0, ids.Empty)
+	require.NoError(err)
+	require.Equal(MaxLookAheadSlots*WindowDuration, delay)
+}
+
+func TestVRFWithoutPublicKeys(t *testing.T) {
+	require := require.New(t)
+
+	_, vdrState := makeWeightedValidators(t, []uint64{1, 1})
+	getValidatorSet := vdrState.GetValidatorSetF
+	vdrState.GetValidatorSetF = func(ctx context.Context, height uint64, subnetID ids.ID) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+		vdrs, err := getValidatorSet(ctx, height, subnetID)
+		for _, vdr := range vdrs {
+			vdr.PublicKey = nil
+		}
+		return vdrs, err
+	}
+	w := newActiveVRF(vdrState, subnetID, fixedChainID)
+
+	_, err := w.ExpectedProposer(t.Context(), 1, 0, 0, ids.Empty)
+	require.ErrorIs(err, ErrAnyoneCanPropose)
+}
+
+// Ensure that the VRF strategy only selects the proposers of blocks whose
+// P-chain height is at least the activation height.
+func TestVRFActivation(t *testing.T) {
+	require := require.New(t)
+
+	const activationHeight = 10
+	_, vdrState := makeWeightedValidators(t, []uint64{1, 2, 3, 4})
+	var (
+		w     = NewVRF(vdrState, subnetID, fixedChainID, activationHeight)
+		stake = New(vdrState, subnetID, fixedChainID)
+		vrf   = newActiveVRF(vdrState, subnetID, fixedChainID)
+		seed  = ids.ID{1}
+	)
+	for pChainHeight := uint64(0); pChainHeight < 2*activationHeight; pChainHeight++ {
+		expected := stake
+		if pChainHeight >= activationHeight {
+			expected = vrf
+		}
+		require.Equal(pChainHeight >= activationHeight, w.UsesVRF(pChainHeight))
+		for slot := uint64(0); slot < 10; slot++ {
+			expectedProposer, err := expected.ExpectedProposer(t.Context(), 1, pChainHeight, slot, seed)
+			require.NoError(err)
+			proposer, err := w.ExpectedProposer(t.Context(), 1, pChainHeight, slot, seed)
+			require.NoError(err)
+			require.Equal(expectedProposer, proposer)
+		}
+	}
+
+	require.False(stake.UsesVRF(activationHeight))
+	require.False(newActiveRoundRobin(vdrState, subnetID, fixedChainID).UsesVRF(activationHeight))
+}
+
+func makeWeightedValidators(t testing.TB, weights []uint64) ([]ids.NodeID, *validatorstest.State) {
+	var (
+		validatorIDs = make([]ids.NodeID, len(weights))
+		vdrs         = make(map[ids.NodeID]*validators.GetValidatorOutput, len(weights))
+	)
+	for i, weight := range weights {
+		sk, err := localsigner.New()
+		require.NoError(t, err)
+
+		validatorIDs[i] = ids.BuildTestNodeID([]byte{byte(i) + 1})
+		vdrs[validatorIDs[i]] = &validators.GetValidatorOutput{
+			NodeID:    validatorIDs[i],
+			PublicKey: sk.PublicKey(),
+			Weight:    weight,
+		}
+	}
+	return validatorIDs, &validatorstest.State{
+		T: t,
+		GetValidatorSetF: func(context.Context, uint64, ids.ID) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+			vdrsCopy := make(map[ids.NodeID]*validators.GetValidatorOutput, len(vdrs))
+			for nodeID, vdr := range vdrs {
+				vdrCopy := *vdr
+				vdrsCopy[nodeID] = &vdrCopy
+			}
+			return vdrsCopy, nil
+		},
+	}
+}
@@ -6,13 +6,15 @@ package proposer
 import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 )
 
 var _ utils.Sortable[validatorData] = validatorData{}
 
 type validatorData struct {
-	id     ids.NodeID
-	weight uint64
+	id        ids.NodeID
+	weight    uint64
+	publicKey *bls.PublicKey
 }
 
 func (d validatorData) Compare(other validatorData) int {
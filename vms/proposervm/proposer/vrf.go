@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+// vrfPrefix separates the messages signed to evaluate the VRF from the other
+// messages signed with the BLS keys of the validators, such as warp messages.
+var vrfPrefix = []byte("avalanche proposervm vrf")
+
+var ErrInvalidVRFProof = errors.New("invalid VRF proof")
+
+// VRFMessage returns the message that the proposer of the block at
+// [blockHeight] of [chainID] signs with its BLS key to evaluate the VRF.
+// [seed] is the VRF output of the parent block.
+func VRFMessage(chainID ids.ID, blockHeight uint64, seed ids.ID) []byte {
+	p := wrappers.Packer{
+		Bytes: make([]byte, len(vrfPrefix)+ids.IDLen+wrappers.LongLen+ids.IDLen),
+	}
+	p.PackFixedBytes(vrfPrefix)
+	p.PackFixedBytes(chainID[:])
+	p.PackLong(blockHeight)
+	p.PackFixedBytes(seed[:])
+	return p.Bytes
+}
+
+// ProveVRF evaluates the VRF of the block at [blockHeight] of [chainID] with
+// [signer] and returns the proof of its output.
+func ProveVRF(signer bls.Signer, chainID ids.ID, blockHeight uint64, seed ids.ID) ([]byte, error) {
+	sig, err := signer.Sign(VRFMessage(chainID, blockHeight, seed))
+	if err != nil {
+		return nil, err
+	}
+	return bls.SignatureToBytes(sig), nil
+}
+
+// VerifyVRF verifies that [proof] was produced by the owner of [pk] for the
+// block at [blockHeight] of [chainID].
+//
+// The proof is a BLS signature, which is unique for a key and a message. As
+// the proof must also be canonically encoded, the proposer can't choose
+// between different VRF outputs.
+func VerifyVRF(pk *bls.PublicKey, chainID ids.ID, blockHeight uint64, seed ids.ID, proof []byte) error {
+	sig, err := bls.SignatureFromBytes(proof)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidVRFProof, err)
+	}
+	if !bytes.Equal(bls.SignatureToBytes(sig), proof) {
+		return fmt.Errorf("%w: non-canonical encoding", ErrInvalidVRFProof)
+	}
+	if !bls.Verify(pk, sig, VRFMessage(chainID, blockHeight, seed)) {
+		return ErrInvalidVRFProof
+	}
+	return nil
+}
+
+// VRFOutput returns the VRF output proven by [proof]. If [proof] is empty,
+// [ids.Empty] is returned.
+func VRFOutput(proof []byte) ids.ID {
+	if len(proof) == 0 {
+		return ids.Empty
+	}
+	return hashing.ComputeHash256Array(proof)
+}
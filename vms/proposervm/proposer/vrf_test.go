@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proposer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls/signer/localsigner"
+)
+
+func TestVRF(t *testing.T) {
+	sk, err := localsigner.New()
+	require.NoError(t, err)
+	pk := sk.PublicKey()
+
+	var (
+		chainID     = ids.GenerateTestID()
+		blockHeight = uint64(10)
+		seed        = ids.GenerateTestID()
+	)
+	proof, err := ProveVRF(sk, chainID, blockHeight, seed)
+	require.NoError(t, err)
+	require.NoError(t, VerifyVRF(pk, chainID, blockHeight, seed, proof))
+
+	// BLS signatures are deterministic, so the output can't be changed by
+	// proving it again.
+	otherProof, err := ProveVRF(sk, chainID, blockHeight, seed)
+	require.NoError(t, err)
+	require.Equal(t, proof, otherProof)
+	require.Equal(t, VRFOutput(proof), VRFOutput(otherProof))
+	require.NotEqual(t, ids.Empty, VRFOutput(proof))
+
+	otherSK, err := localsigner.New()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		verify      func() error
+		expectedErr error
+	}{
+		{
+			name: "wrong key",
+			verify: func() error {
+				return VerifyVRF(otherSK.PublicKey(), chainID, blockHeight, seed, proof)
+			},
+			expectedErr: ErrInvalidVRFProof,
+		},
+		{
+			name: "wrong chain",
+			verify: func() error {
+				return VerifyVRF(pk, ids.GenerateTestID(), blockHeight, seed, proof)
+			},
+			expectedErr: ErrInvalidVRFProof,
+		},
+		{
+			name: "wrong height",
+			verify: func() error {
+				return VerifyVRF(pk, chainID, blockHeight+1, seed, proof)
+			},
+			expectedErr: ErrInvalidVRFProof,
+		},
+		{
+			name: "wrong seed",
+			verify: func() error {
+				return VerifyVRF(pk, chainID, blockHeight, ids.Empty, proof)
+			},
+			expectedErr: ErrInvalidVRFProof,
+		},
+		{
+			name: "malformed proof",
+			verify: func() error {
+				return VerifyVRF(pk, chainID, blockHeight, seed, proof[1:])
+			},
+			expectedErr: ErrInvalidVRFProof,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.ErrorIs(t, test.verify(), test.expectedErr)
+		})
+	}
+}
+
+func TestVRFOutputWithoutProof(t *testing.T) {
+	require.Equal(t, ids.Empty, VRFOutput(nil))
+}
@@ -6,7 +6,6 @@ package proposer
 import (
 	"context"
 	"errors"
-	"math/bits"
 	"time"
 
 	"gonum.org/v1/gonum/mathext/prng"
@@ -67,7 +66,8 @@ type Windower interface {
 	// [pChainHeight] gets specific slots it can propose in (instead of being
 	// able to propose from a given time on as it happens Pre-Durango).
 	// [ExpectedProposer] calculates which nodeID is scheduled to propose a
-	// block of height [blockHeight] at [slot].
+	// block of height [blockHeight] at [slot]. [seed] is the VRF output of the
+	// parent block, which is only used if [UsesVRF] returns true.
 	// If no validators are currently available, [ErrAnyoneCanPropose] is
 	// returned.
 	ExpectedProposer(
@@ -75,6 +75,7 @@ type Windower interface {
 		blockHeight,
 		pChainHeight,
 		slot uint64,
+		seed ids.ID,
 	) (ids.NodeID, error)
 
 	// In the Post-Durango windowing scheme, every validator active at
@@ -83,7 +84,8 @@ type Windower interface {
 	// [MinDelayForProposer] specifies how long [nodeID] needs to wait for its
 	// slot to start. Delay is specified as starting from slot zero start.
 	// (which is parent timestamp). For efficiency reasons, we cap the slot
-	// search to [MaxLookAheadSlots].
+	// search to [MaxLookAheadSlots]. [seed] is the VRF output of the parent
+	// block, which is only used if [UsesVRF] returns true.
 	// If no validators are currently available, [ErrAnyoneCanPropose] is
 	// returned.
 	MinDelayForProposer(
@@ -92,7 +94,14 @@ type Windower interface {
 		pChainHeight uint64,
 		nodeID ids.NodeID,
 		startSlot uint64,
+		seed ids.ID,
 	) (time.Duration, error)
+
+	// UsesVRF returns true if the Post-Durango slot proposers are selected
+	// with the VRF output of the parent block when the validator set is
+	// defined at [pChainHeight]. If so, the signed blocks must carry the proof
+	// of the VRF output of their proposer.
+	UsesVRF(pChainHeight uint64) bool
 }
 
 // windower interfaces with P-Chain and it is responsible for calculating the
@@ -100,21 +109,45 @@ type Windower interface {
 type windower struct {
 	state       validators.State
 	subnetID    ids.ID
+	chainID     ids.ID
 	chainSource uint64
+
+	// newSelector creates the selector of the Post-Durango slot proposers
+	// once the validator set is taken from a P-chain height of at least
+	// [activationHeight]. Before that, the proposers are sampled by stake.
+	newSelector      selectorFactory
+	activationHeight uint64
+	// vrf is true if [newSelector] uses the VRF output of the parent block.
+	vrf bool
 }
 
+// New returns a Windower that samples the proposer of each slot by stake.
+// This is the default proposer selection strategy.
 func New(state validators.State, subnetID, chainID ids.ID) Windower {
+	return newWindower(state, subnetID, chainID, newStakeSelector, 0)
+}
+
+func newWindower(
+	state validators.State,
+	subnetID,
+	chainID ids.ID,
+	newSelector selectorFactory,
+	activationHeight uint64,
+) *windower {
 	w := wrappers.Packer{Bytes: chainID[:]}
 	return &windower{
-		state:       state,
-		subnetID:    subnetID,
-		chainSource: w.UnpackLong(),
+		state:            state,
+		subnetID:         subnetID,
+		chainID:          chainID,
+		chainSource:      w.UnpackLong(),
+		newSelector:      newSelector,
+		activationHeight: activationHeight,
 	}
 }
 
 func (w *windower) Proposers(ctx context.Context, blockHeight, pChainHeight uint64, maxWindows int) ([]ids.NodeID, error) {
 	// Note: The 32-bit prng is used here for legacy reasons. All other usages
-	// of a prng in this file should use the 64-bit version.
+	// of a prng should use the 64-bit version.
 	source := prng.NewMT19937()
 	sampler, validators, err := w.makeSampler(ctx, pChainHeight, source)
 	if err != nil {
@@ -169,23 +202,13 @@ func (w *windower) ExpectedProposer(
 	blockHeight,
 	pChainHeight,
 	slot uint64,
+	seed ids.ID,
 ) (ids.NodeID, error) {
-	source := prng.NewMT19937_64()
-	sampler, validators, err := w.makeSampler(ctx, pChainHeight, source)
+	selector, err := w.makeSelector(ctx, pChainHeight, seed)
 	if err != nil {
 		return ids.EmptyNodeID, err
 	}
-	if len(validators) == 0 {
-		return ids.EmptyNodeID, ErrAnyoneCanPropose
-	}
-
-	return w.expectedProposer(
-		validators,
-		source,
-		sampler,
-		blockHeight,
-		slot,
-	)
+	return selector(blockHeight, slot)
 }
 
 func (w *windower) MinDelayForProposer(
@@ -194,25 +217,16 @@ func (w *windower) MinDelayForProposer(
 	pChainHeight uint64,
 	nodeID ids.NodeID,
 	startSlot uint64,
+	seed ids.ID,
 ) (time.Duration, error) {
-	source := prng.NewMT19937_64()
-	sampler, validators, err := w.makeSampler(ctx, pChainHeight, source)
+	selector, err := w.makeSelector(ctx, pChainHeight, seed)
 	if err != nil {
 		return 0, err
 	}
-	if len(validators) == 0 {
-		return 0, ErrAnyoneCanPropose
-	}
 
 	maxSlot := startSlot + MaxLookAheadSlots
 	for slot := startSlot; slot < maxSlot; slot++ {
-		expectedNodeID, err := w.expectedProposer(
-			validators,
-			source,
-			sampler,
-			blockHeight,
-			slot,
-		)
+		expectedNodeID, err := selector(blockHeight, slot)
 		if err != nil {
 			return 0, err
 		}
@@ -226,58 +240,74 @@ func (w *windower) MinDelayForProposer(
 	return time.Duration(maxSlot) * WindowDuration, nil
 }
 
+func (w *windower) UsesVRF(pChainHeight uint64) bool {
+	return w.vrf && pChainHeight >= w.activationHeight
+}
+
 func (w *windower) makeSampler(
 	ctx context.Context,
 	pChainHeight uint64,
 	source sampler.Source,
 ) (sampler.WeightedWithoutReplacement, []validatorData, error) {
-	// Get the canonical representation of the validator set at the provided
-	// p-chain height.
-	validatorsMap, err := w.state.GetValidatorSet(ctx, pChainHeight, w.subnetID)
+	validators, err := w.getValidators(ctx, pChainHeight)
 	if err != nil {
 		return nil, nil, err
 	}
+	sampler, err := newSampler(source, validators)
+	return sampler, validators, err
+}
+
+// makeSelector returns the selector of the slot proposers when the validator
+// set is defined at [pChainHeight] and the parent block has the VRF output
+// [seed]. If no validators are currently available, [ErrAnyoneCanPropose] is
+// returned.
+func (w *windower) makeSelector(ctx context.Context, pChainHeight uint64, seed ids.ID) (selector, error) {
+	validators, err := w.getValidators(ctx, pChainHeight)
+	if err != nil {
+		return nil, err
+	}
+	if len(validators) == 0 {
+		return nil, ErrAnyoneCanPropose
+	}
+	if pChainHeight < w.activationHeight {
+		return newStakeSelector(w, validators, seed)
+	}
+	return w.newSelector(w, validators, seed)
+}
+
+// getValidators returns the canonical representation of the validator set at
+// the provided p-chain height.
+func (w *windower) getValidators(ctx context.Context, pChainHeight uint64) ([]validatorData, error) {
+	validatorsMap, err := w.state.GetValidatorSet(ctx, pChainHeight, w.subnetID)
+	if err != nil {
+		return nil, err
+	}
 
 	delete(validatorsMap, ids.EmptyNodeID) // Ignore inactive ACP-77 validators.
 
 	validators := make([]validatorData, 0, len(validatorsMap))
 	for k, v := range validatorsMap {
 		validators = append(validators, validatorData{
-			id:     k,
-			weight: v.Weight,
+			id:        k,
+			weight:    v.Weight,
+			publicKey: v.PublicKey,
 		})
 	}
 
 	// Note: validators are sorted by ID. Sorting by weight would not create a
 	// canonically sorted list.
 	utils.Sort(validators)
+	return validators, nil
+}
 
+func newSampler(source sampler.Source, validators []validatorData) (sampler.WeightedWithoutReplacement, error) {
 	weights := make([]uint64, len(validators))
 	for i, validator := range validators {
 		weights[i] = validator.weight
 	}
 
 	sampler := sampler.NewDeterministicWeightedWithoutReplacement(source)
-	return sampler, validators, sampler.Initialize(weights)
-}
-
-func (w *windower) expectedProposer(
-	validators []validatorData,
-	source *prng.MT19937_64,
-	sampler sampler.WeightedWithoutReplacement,
-	blockHeight,
-	slot uint64,
-) (ids.NodeID, error) {
-	// Slot is reversed to utilize a different state space in the seed than the
-	// height. If the slot was not reversed the state space would collide;
-	// biasing the seed generation. For example, without reversing the slot
-	// height=0 and slot=1 would equal height=1 and slot=0.
-	source.Seed(w.chainSource ^ blockHeight ^ bits.Reverse64(slot))
-	indices, ok := sampler.Sample(1)
-	if !ok {
-		return ids.EmptyNodeID, ErrUnexpectedSamplerFailure
-	}
-	return validators[indices[0]].id, nil
+	return sampler, sampler.Initialize(weights)
 }
 
 func TimeToSlot(start, now time.Time) uint64 {
@@ -60,11 +60,11 @@ func TestWindowerNoValidators(t *testing.T) {
 			require.NoError(err)
 			require.Zero(delay)
 
-			proposer, err := w.ExpectedProposer(t.Context(), chainHeight, pChainHeight, slot)
+			proposer, err := w.ExpectedProposer(t.Context(), chainHeight, pChainHeight, slot, ids.Empty)
 			require.ErrorIs(err, ErrAnyoneCanPropose)
 			require.Equal(ids.EmptyNodeID, proposer)
 
-			delay, err = w.MinDelayForProposer(t.Context(), chainHeight, pChainHeight, nodeID, slot)
+			delay, err = w.MinDelayForProposer(t.Context(), chainHeight, pChainHeight, nodeID, slot, ids.Empty)
 			require.ErrorIs(err, ErrAnyoneCanPropose)
 			require.Zero(delay)
 		})
@@ -206,7 +206,7 @@ func TestExpectedProposerChangeByHeight(t *testing.T) {
 	}
 
 	for chainHeight, expectedProposerID := range expectedProposers {
-		proposerID, err := w.ExpectedProposer(dummyCtx, chainHeight, pChainHeight, slot)
+		proposerID, err := w.ExpectedProposer(dummyCtx, chainHeight, pChainHeight, slot, ids.Empty)
 		require.NoError(err)
 		require.Equal(expectedProposerID, proposerID)
 	}
@@ -242,7 +242,7 @@ func TestExpectedProposerChangeByChain(t *testing.T) {
 
 	for chainID, expectedProposerID := range expectedProposers {
 		w := New(vdrState, subnetID, chainID)
-		proposerID, err := w.ExpectedProposer(dummyCtx, chainHeight, pChainHeight, slot)
+		proposerID, err := w.ExpectedProposer(dummyCtx, chainHeight, pChainHeight, slot, ids.Empty)
 		require.NoError(err)
 		require.Equal(expectedProposerID, proposerID)
 	}
@@ -295,7 +295,7 @@ func TestExpectedProposerChangeBySlot(t *testing.T) {
 	}
 
 	for slot, expectedProposerID := range expectedProposers {
-		actualProposerID, err := w.ExpectedProposer(dummyCtx, chainHeight, pChainHeight, slot)
+		actualProposerID, err := w.ExpectedProposer(dummyCtx, chainHeight, pChainHeight, slot, ids.Empty)
 		require.NoError(err)
 		require.Equal(expectedProposerID, actualProposerID)
 	}
@@ -314,12 +314,12 @@ func TestCoherenceOfExpectedProposerAndMinDelayForProposer(t *testing.T) {
 	)
 
 	for slot := uint64(0); slot < 3*MaxLookAheadSlots; slot++ {
-		proposerID, err := w.ExpectedProposer(dummyCtx, chainHeight, pChainHeight, slot)
+		proposerID, err := w.ExpectedProposer(dummyCtx, chainHeight, pChainHeight, slot, ids.Empty)
 		require.NoError(err)
 
 		// proposerID is the scheduled proposer. It should start with the
 		// expected delay
-		delay, err := w.MinDelayForProposer(dummyCtx, chainHeight, pChainHeight, proposerID, slot)
+		delay, err := w.MinDelayForProposer(dummyCtx, chainHeight, pChainHeight, proposerID, slot, ids.Empty)
 		require.NoError(err)
 		require.Equal(time.Duration(slot)*WindowDuration, delay)
 	}
@@ -353,7 +353,7 @@ func TestMinDelayForProposer(t *testing.T) {
 	}
 
 	for nodeID, expectedDelay := range expectedDelays {
-		delay, err := w.MinDelayForProposer(dummyCtx, chainHeight, pChainHeight, nodeID, slot)
+		delay, err := w.MinDelayForProposer(dummyCtx, chainHeight, pChainHeight, nodeID, slot, ids.Empty)
 		require.NoError(err)
 		require.Equal(expectedDelay, delay)
 	}
@@ -375,7 +375,7 @@ func BenchmarkMinDelayForProposer(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		_, err := w.MinDelayForProposer(dummyCtx, chainHeight, pChainHeight, nodeID, slot)
+		_, err := w.MinDelayForProposer(dummyCtx, chainHeight, pChainHeight, nodeID, slot, ids.Empty)
 		require.NoError(err)
 	}
 }
@@ -438,7 +438,7 @@ func TestProposerDistribution(t *testing.T) {
 	}
 	for chainHeight := uint64(0); chainHeight < numChainHeights; chainHeight++ {
 		for slot := uint64(0); slot < numSlots; slot++ {
-			proposerID, err := w.ExpectedProposer(dummyCtx, chainHeight, pChainHeight, slot)
+			proposerID, err := w.ExpectedProposer(dummyCtx, chainHeight, pChainHeight, slot, ids.Empty)
 			require.NoError(err)
 			proposerFrequency[proposerID]++
 		}
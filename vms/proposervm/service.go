@@ -15,8 +15,10 @@ import (
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/api/server"
 	"github.com/ava-labs/avalanchego/connectproto/pb/proposervm/proposervmconnect"
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/vms/proposervm/acp181"
 	"github.com/ava-labs/avalanchego/vms/proposervm/block"
+	"github.com/ava-labs/avalanchego/vms/types"
 
 	pb "github.com/ava-labs/avalanchego/connectproto/pb/proposervm"
 	avajson "github.com/ava-labs/avalanchego/utils/json"
@@ -130,6 +132,60 @@ func (j *jsonrpcService) GetCurrentEpoch(r *http.Request, _ *struct{}, reply *Ge
 	return nil
 }
 
+type GetEquivocationsArgs struct {
+	// NodeID filters the evidence by proposer. If empty, the evidence of every
+	// proposer is returned.
+	NodeID ids.NodeID `json:"nodeID"`
+}
+
+type Equivocation struct {
+	Proposer ids.NodeID     `json:"proposer"`
+	ParentID ids.ID         `json:"parentID"`
+	Height   avajson.Uint64 `json:"height"`
+	Slot     avajson.Uint64 `json:"slot"`
+	// Blocks are the conflicting signed blocks.
+	Blocks []types.JSONByteSlice `json:"blocks"`
+}
+
+type GetEquivocationsReply struct {
+	Equivocations []Equivocation `json:"equivocations"`
+}
+
+// GetEquivocations returns the stored evidence of proposers that signed
+// conflicting blocks.
+func (j *jsonrpcService) GetEquivocations(r *http.Request, args *GetEquivocationsArgs, reply *GetEquivocationsReply) error {
+	j.vm.ctx.Log.Debug("API called",
+		zap.String("service", "proposervm"),
+		zap.String("method", "getEquivocations"),
+		zap.Stringer("nodeID", args.NodeID),
+		zap.String("path", r.URL.Path),
+	)
+
+	j.vm.ctx.Lock.Lock()
+	defer j.vm.ctx.Lock.Unlock()
+
+	equivocations, err := j.vm.State.GetEquivocations(args.NodeID)
+	if err != nil {
+		return fmt.Errorf("couldn't get equivocations: %w", err)
+	}
+
+	reply.Equivocations = make([]Equivocation, len(equivocations))
+	for i, equivocation := range equivocations {
+		blocks := make([]types.JSONByteSlice, len(equivocation.Blocks))
+		for k, blk := range equivocation.Blocks {
+			blocks[k] = blk
+		}
+		reply.Equivocations[i] = Equivocation{
+			Proposer: equivocation.Proposer,
+			ParentID: equivocation.ParentID,
+			Height:   avajson.Uint64(equivocation.Height),
+			Slot:     avajson.Uint64(equivocation.Slot),
+			Blocks:   blocks,
+		}
+	}
+	return nil
+}
+
 func (vm *VM) getCurrentEpoch(ctx context.Context) (block.Epoch, error) {
 	vm.ctx.Lock.Lock()
 	defer vm.ctx.Lock.Unlock()
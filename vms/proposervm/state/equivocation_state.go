@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"errors"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+var (
+	errEquivocationWrongVersion = errors.New("wrong version")
+
+	_ EquivocationState = (*equivocationState)(nil)
+)
+
+// Equivocation is evidence that a proposer signed two different blocks that
+// build on the same parent in the same proposer slot.
+type Equivocation struct {
+	Proposer ids.NodeID `serialize:"true"`
+	ParentID ids.ID     `serialize:"true"`
+	Height   uint64     `serialize:"true"`
+	Slot     uint64     `serialize:"true"`
+	// Blocks are the signed conflicting blocks. The proposer's signatures can
+	// be verified by parsing the blocks.
+	Blocks [][]byte `serialize:"true"`
+}
+
+type EquivocationState interface {
+	// PutEquivocation stores evidence that [equivocation.Proposer] signed
+	// conflicting children of [equivocation.ParentID] in [equivocation.Slot].
+	// At most one piece of evidence is stored per proposer, parent and slot.
+	PutEquivocation(equivocation *Equivocation) error
+	// GetEquivocations returns the stored evidence of [proposer]. If
+	// [proposer] is empty, the evidence of every proposer is returned.
+	GetEquivocations(proposer ids.NodeID) ([]*Equivocation, error)
+}
+
+type equivocationState struct {
+	db database.Database
+}
+
+func NewEquivocationState(db database.Database) EquivocationState {
+	return &equivocationState{
+		db: db,
+	}
+}
+
+// Evidence is keyed by proposer, then height, then parent, then slot.
+func equivocationKey(equivocation *Equivocation) []byte {
+	key := make([]byte, 0, ids.NodeIDLen+2*database.Uint64Size+ids.IDLen)
+	key = append(key, equivocation.Proposer.Bytes()...)
+	key = append(key, database.PackUInt64(equivocation.Height)...)
+	key = append(key, equivocation.ParentID[:]...)
+	return append(key, database.PackUInt64(equivocation.Slot)...)
+}
+
+func (s *equivocationState) PutEquivocation(equivocation *Equivocation) error {
+	bytes, err := Codec.Marshal(CodecVersion, equivocation)
+	if err != nil {
+		return err
+	}
+	return s.db.Put(equivocationKey(equivocation), bytes)
+}
+
+func (s *equivocationState) GetEquivocations(proposer ids.NodeID) ([]*Equivocation, error) {
+	var prefix []byte
+	if proposer != ids.EmptyNodeID {
+		prefix = proposer.Bytes()
+	}
+
+	it := s.db.NewIteratorWithPrefix(prefix)
+	defer it.Release()
+
+	var equivocations []*Equivocation
+	for it.Next() {
+		equivocation := &Equivocation{}
+		parsedVersion, err := Codec.Unmarshal(it.Value(), equivocation)
+		if err != nil {
+			return nil, err
+		}
+		if parsedVersion != CodecVersion {
+			return nil, errEquivocationWrongVersion
+		}
+		equivocations = append(equivocations, equivocation)
+	}
+	return equivocations, it.Error()
+}
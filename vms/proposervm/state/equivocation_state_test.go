@@ -0,0 +1,68 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestEquivocationState(t *testing.T) {
+	require := require.New(t)
+
+	es := NewEquivocationState(memdb.New())
+
+	equivocations, err := es.GetEquivocations(ids.EmptyNodeID)
+	require.NoError(err)
+	require.Empty(equivocations)
+
+	var (
+		proposer1     = ids.GenerateTestNodeID()
+		proposer2     = ids.GenerateTestNodeID()
+		equivocation1 = &Equivocation{
+			Proposer: proposer1,
+			ParentID: ids.GenerateTestID(),
+			Height:   1,
+			Slot:     2,
+			Blocks:   [][]byte{{1}, {2}},
+		}
+		equivocation2 = &Equivocation{
+			Proposer: proposer2,
+			ParentID: ids.GenerateTestID(),
+			Height:   3,
+			Slot:     4,
+			Blocks:   [][]byte{{3}, {4}},
+		}
+	)
+	require.NoError(es.PutEquivocation(equivocation1))
+	require.NoError(es.PutEquivocation(equivocation2))
+
+	// Evidence of the same proposer, parent and slot replaces the previous
+	// evidence.
+	replacement := &Equivocation{
+		Proposer: proposer1,
+		ParentID: equivocation1.ParentID,
+		Height:   equivocation1.Height,
+		Slot:     equivocation1.Slot,
+		Blocks:   [][]byte{{1}, {5}},
+	}
+	require.NoError(es.PutEquivocation(replacement))
+	equivocation1 = replacement
+
+	equivocations, err = es.GetEquivocations(proposer1)
+	require.NoError(err)
+	require.Equal([]*Equivocation{equivocation1}, equivocations)
+
+	equivocations, err = es.GetEquivocations(proposer2)
+	require.NoError(err)
+	require.Equal([]*Equivocation{equivocation2}, equivocations)
+
+	equivocations, err = es.GetEquivocations(ids.EmptyNodeID)
+	require.NoError(err)
+	require.ElementsMatch([]*Equivocation{equivocation1, equivocation2}, equivocations)
+}
@@ -11,32 +11,37 @@ import (
 )
 
 var (
-	chainStatePrefix  = []byte("chain")
-	blockStatePrefix  = []byte("block")
-	heightIndexPrefix = []byte("height")
+	chainStatePrefix   = []byte("chain")
+	blockStatePrefix   = []byte("block")
+	heightIndexPrefix  = []byte("height")
+	equivocationPrefix = []byte("equivocation")
 )
 
 type State interface {
 	ChainState
 	BlockState
 	HeightIndex
+	EquivocationState
 }
 
 type state struct {
 	ChainState
 	BlockState
 	HeightIndex
+	EquivocationState
 }
 
 func New(db *versiondb.Database) State {
 	chainDB := prefixdb.New(chainStatePrefix, db)
 	blockDB := prefixdb.New(blockStatePrefix, db)
 	heightDB := prefixdb.New(heightIndexPrefix, db)
+	equivocationDB := prefixdb.New(equivocationPrefix, db)
 
 	return &state{
-		ChainState:  NewChainState(chainDB),
-		BlockState:  NewBlockState(blockDB),
-		HeightIndex: NewHeightIndex(heightDB, db),
+		ChainState:        NewChainState(chainDB),
+		BlockState:        NewBlockState(blockDB),
+		HeightIndex:       NewHeightIndex(heightDB, db),
+		EquivocationState: NewEquivocationState(equivocationDB),
 	}
 }
 
@@ -44,6 +49,7 @@ func NewMetered(db *versiondb.Database, namespace string, metrics prometheus.Reg
 	chainDB := prefixdb.New(chainStatePrefix, db)
 	blockDB := prefixdb.New(blockStatePrefix, db)
 	heightDB := prefixdb.New(heightIndexPrefix, db)
+	equivocationDB := prefixdb.New(equivocationPrefix, db)
 
 	blockState, err := NewMeteredBlockState(blockDB, namespace, metrics)
 	if err != nil {
@@ -51,8 +57,9 @@ func NewMetered(db *versiondb.Database, namespace string, metrics prometheus.Reg
 	}
 
 	return &state{
-		ChainState:  NewChainState(chainDB),
-		BlockState:  blockState,
-		HeightIndex: NewHeightIndex(heightDB, db),
+		ChainState:        NewChainState(chainDB),
+		BlockState:        blockState,
+		HeightIndex:       NewHeightIndex(heightDB, db),
+		EquivocationState: NewEquivocationState(equivocationDB),
 	}, nil
 }
@@ -535,7 +535,7 @@ func TestStateSummaryAcceptOlderBlock(t *testing.T) {
 
 	// Set the last accepted block height to be higher than the state summary
 	// we are going to attempt to accept
-	vm.lastAcceptedHeight = innerSummary.Height() + 1
+	vm.lastAcceptedHeight.Store(innerSummary.Height() + 1)
 
 	// store post fork block associated with summary
 	innerBlk := &snowmantest.Block{
@@ -594,7 +594,7 @@ func TestStateSummaryAcceptOlderBlock(t *testing.T) {
 	require.True(calledInnerAccept)
 
 	require.NoError(vm.SetState(t.Context(), snow.Bootstrapping))
-	require.Equal(summary.Height(), vm.lastAcceptedHeight)
+	require.Equal(summary.Height(), vm.lastAcceptedHeight.Load())
 	lastAcceptedID, err := vm.LastAccepted(t.Context())
 	require.NoError(err)
 	require.Equal(proBlk.ID(), lastAcceptedID)
@@ -634,7 +634,7 @@ func TestStateSummaryAcceptOlderBlockSkipStateSync(t *testing.T) {
 
 	// Set the last accepted block height to be higher than the state summary
 	// we are going to attempt to accept
-	vm.lastAcceptedHeight = innerBlk2.Height()
+	vm.lastAcceptedHeight.Store(innerBlk2.Height())
 
 	innerVM.LastAcceptedF = func(context.Context) (ids.ID, error) {
 		return innerBlk2.IDV, nil
@@ -729,7 +729,7 @@ func TestStateSummaryAcceptOlderBlockSkipStateSync(t *testing.T) {
 	require.True(calledInnerAccept)
 	require.NoError(vm.SetState(t.Context(), snow.Bootstrapping))
 
-	require.Equal(innerBlk2.Height(), vm.lastAcceptedHeight)
+	require.Equal(innerBlk2.Height(), vm.lastAcceptedHeight.Load())
 	lastAcceptedID, err := vm.LastAccepted(t.Context())
 	require.NoError(err)
 	require.Equal(proBlk2.ID(), lastAcceptedID)
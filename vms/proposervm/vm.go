@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"connectrpc.com/grpcreflect"
@@ -89,6 +90,9 @@ type VM struct {
 	// Each element is a block that passed verification but
 	// hasn't yet been accepted/rejected
 	verifiedBlocks map[ids.ID]PostForkBlock
+	// Signed blocks that passed verification and whose height hasn't been
+	// accepted yet. Used to detect proposers signing conflicting blocks.
+	proposals map[proposalKey]proposal
 	// Stateless block ID --> inner block.
 	// Only contains post-fork blocks near the tip so that the cache doesn't get
 	// filled with random blocks every time this node parses blocks while
@@ -103,7 +107,9 @@ type VM struct {
 	lastAcceptedTime time.Time
 
 	// lastAcceptedHeight is set to the last accepted PostForkBlock's height.
-	lastAcceptedHeight uint64
+	// It is read when parsing blocks, which may happen concurrently with the
+	// acceptance of blocks during bootstrapping.
+	lastAcceptedHeight atomic.Uint64
 
 	// proposerBuildSlotGauge reports the slot index when this node may attempt
 	// to build a block.
@@ -116,6 +122,10 @@ type VM struct {
 	// lastAcceptedTimestampGaugeVec reports timestamps for the last-accepted
 	// [postForkBlock] and its inner block.
 	lastAcceptedTimestampGaugeVec *prometheus.GaugeVec
+
+	// equivocationsMetric reports the number of times a proposer was detected
+	// signing conflicting blocks.
+	equivocationsMetric prometheus.Counter
 }
 
 // New performs best when [minBlkDelay] is whole seconds. This is because block
@@ -155,7 +165,11 @@ func (vm *VM) Initialize(
 		return err
 	}
 	vm.State = baseState
-	vm.Windower = proposer.New(chainCtx.ValidatorState, chainCtx.SubnetID, chainCtx.ChainID)
+	newWindower := vm.NewWindower
+	if newWindower == nil {
+		newWindower = proposer.New
+	}
+	vm.Windower = newWindower(chainCtx.ValidatorState, chainCtx.SubnetID, chainCtx.ChainID)
 	vm.Tree = tree.New()
 	innerBlkCache, err := metercacher.New(
 		"inner_block_cache",
@@ -168,6 +182,7 @@ func (vm *VM) Initialize(
 	vm.innerBlkCache = innerBlkCache
 
 	vm.verifiedBlocks = make(map[ids.ID]PostForkBlock)
+	vm.proposals = make(map[proposalKey]proposal)
 
 	err = vm.ChainVM.Initialize(
 		ctx,
@@ -201,7 +216,7 @@ func (vm *VM) Initialize(
 		chainCtx.Log.Info("initialized proposervm",
 			zap.String("state", "after fork"),
 			zap.Uint64("forkHeight", forkHeight),
-			zap.Uint64("lastAcceptedHeight", vm.lastAcceptedHeight),
+			zap.Uint64("lastAcceptedHeight", vm.lastAcceptedHeight.Load()),
 		)
 	case database.ErrNotFound:
 		chainCtx.Log.Info("initialized proposervm",
@@ -234,11 +249,16 @@ func (vm *VM) Initialize(
 		},
 		[]string{"block_type"},
 	)
+	vm.equivocationsMetric = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "equivocations",
+		Help: "number of times a proposer was detected signing conflicting blocks",
+	})
 
 	return errors.Join(
 		vm.Config.Registerer.Register(vm.proposerBuildSlotGauge),
 		vm.Config.Registerer.Register(vm.acceptedBlocksSlotHistogram),
 		vm.Config.Registerer.Register(vm.lastAcceptedTimestampGaugeVec),
+		vm.Config.Registerer.Register(vm.equivocationsMetric),
 	)
 }
 
@@ -496,6 +516,10 @@ func (vm *VM) timeToBuild(ctx context.Context) (time.Time, bool, error) {
 	if err != nil {
 		return time.Time{}, false, err
 	}
+	vrfOutput, err := blk.vrfOutput(ctx)
+	if err != nil {
+		return time.Time{}, false, err
+	}
 
 	var (
 		childBlockHeight = blk.Height() + 1
@@ -509,6 +533,7 @@ func (vm *VM) timeToBuild(ctx context.Context) (time.Time, bool, error) {
 			childBlockHeight,
 			pChainHeight,
 			proposer.TimeToSlot(parentTimestamp, currentTime),
+			vrfOutput,
 			parentTimestamp,
 		); err == nil {
 			vm.proposerBuildSlotGauge.Set(float64(proposer.TimeToSlot(parentTimestamp, nextStartTime)))
@@ -562,6 +587,7 @@ func (vm *VM) getPostDurangoSlotTime(
 	blkHeight,
 	pChainHeight,
 	slot uint64,
+	vrfOutput ids.ID,
 	parentTimestamp time.Time,
 ) (time.Time, error) {
 	delay, err := vm.Windower.MinDelayForProposer(
@@ -570,6 +596,7 @@ func (vm *VM) getPostDurangoSlotTime(
 		pChainHeight,
 		vm.ctx.NodeID,
 		slot,
+		vrfOutput,
 	)
 	// Note: The P-chain does not currently try to target any block time. It
 	// notifies the consensus engine as soon as a new block may be built. To
@@ -674,7 +701,7 @@ func (vm *VM) setLastAcceptedMetadata(ctx context.Context) error {
 	if err == database.ErrNotFound {
 		// If the last accepted block wasn't a PostFork block, then we don't
 		// initialize the metadata.
-		vm.lastAcceptedHeight = 0
+		vm.lastAcceptedHeight.Store(0)
 		vm.lastAcceptedTime = time.Time{}
 		return nil
 	}
@@ -688,7 +715,7 @@ func (vm *VM) setLastAcceptedMetadata(ctx context.Context) error {
 	}
 
 	// Set the last accepted height
-	vm.lastAcceptedHeight = lastAccepted.Height()
+	vm.lastAcceptedHeight.Store(lastAccepted.Height())
 
 	if _, ok := lastAccepted.getStatelessBlk().(statelessblock.SignedBlock); ok {
 		// If the last accepted block wasn't a PostForkOption, then we don't
@@ -807,8 +834,9 @@ func (vm *VM) acceptPostForkBlock(blk PostForkBlock) error {
 	height := blk.Height()
 	blkID := blk.ID()
 
-	vm.lastAcceptedHeight = height
+	vm.lastAcceptedHeight.Store(height)
 	delete(vm.verifiedBlocks, blkID)
+	vm.pruneProposals(height)
 
 	// Persist this block, its height index, and its status
 	if err := vm.State.SetLastAccepted(blkID); err != nil {
@@ -922,7 +950,7 @@ func (vm *VM) parseInnerBlock(ctx context.Context, outerBlkID ids.ID, innerBlkBy
 // Caches proposervm block ID --> inner block if the inner block's height
 // is within [innerBlkCacheSize] of the last accepted block's height.
 func (vm *VM) cacheInnerBlock(outerBlkID ids.ID, innerBlk snowman.Block) {
-	diff := math.AbsDiff(innerBlk.Height(), vm.lastAcceptedHeight)
+	diff := math.AbsDiff(innerBlk.Height(), vm.lastAcceptedHeight.Load())
 	if diff < innerBlkCacheSize {
 		vm.innerBlkCache.Put(outerBlkID, innerBlk)
 	}
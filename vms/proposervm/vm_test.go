@@ -233,6 +233,10 @@ func (vm *VM) waitForProposerWindow() error {
 	if err != nil {
 		return fmt.Errorf("couldn't get P-Chain height from tip: %w", err)
 	}
+	vrfOutput, err := preferred.vrfOutput(ctx)
+	if err != nil {
+		return fmt.Errorf("couldn't get VRF output from tip: %w", err)
+	}
 
 	var (
 		childBlockHeight = preferred.Height() + 1
@@ -247,6 +251,7 @@ func (vm *VM) waitForProposerWindow() error {
 			pChainHeight,
 			vm.ctx.NodeID,
 			slot,
+			vrfOutput,
 		)
 		if err != nil {
 			return err
@@ -2057,13 +2062,13 @@ func TestVMInnerBlkCache(t *testing.T) {
 	gotBlk, ok := vm.innerBlkCache.Get(blkNearTip.ID())
 	require.True(ok)
 	require.Equal(mockInnerBlkNearTip, gotBlk)
-	require.Zero(vm.lastAcceptedHeight)
+	require.Zero(vm.lastAcceptedHeight.Load())
 
 	// Clear the cache
 	vm.innerBlkCache.Flush()
 
 	// Advance the tip height
-	vm.lastAcceptedHeight = innerBlkCacheSize + 1
+	vm.lastAcceptedHeight.Store(innerBlkCacheSize + 1)
 
 	// Parse the block again. This time it shouldn't be cached
 	// because it's not close to the tip.
@@ -2522,6 +2527,7 @@ func TestGetPostDurangoSlotTimeWithNoValidators(t *testing.T) {
 		statefulBlock.Height()+1,
 		statelessBlock.PChainHeight(),
 		proposer.TimeToSlot(parentTimestamp, currentTime),
+		ids.Empty,
 		parentTimestamp,
 	)
 	require.NoError(err)
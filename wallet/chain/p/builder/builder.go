@@ -210,6 +210,17 @@ type Builder interface {
 		options ...common.Option,
 	) (*txs.DisableL1ValidatorTx, error)
 
+	// NewBatchL1ValidatorTx atomically applies multiple validator operations
+	// to an L1.
+	//
+	// - [subnetID] of the L1 that every operation modifies
+	// - [operations] to apply, in order
+	NewBatchL1ValidatorTx(
+		subnetID ids.ID,
+		operations []txs.L1ValidatorOp,
+		options ...common.Option,
+	) (*txs.BatchL1ValidatorTx, error)
+
 	// NewImportTx creates an import transaction that attempts to consume all
 	// the available UTXOs and import the funds to [to].
 	//
@@ -1106,6 +1117,74 @@ func (b *builder) NewDisableL1ValidatorTx(
 	return tx, b.initCtx(tx)
 }
 
+func (b *builder) NewBatchL1ValidatorTx(
+	subnetID ids.ID,
+	operations []txs.L1ValidatorOp,
+	options ...common.Option,
+) (*txs.BatchL1ValidatorTx, error) {
+	var balance uint64
+	for _, op := range operations {
+		register, ok := op.(*txs.RegisterL1ValidatorOp)
+		if !ok {
+			continue
+		}
+
+		var err error
+		balance, err = math.Add(balance, register.Balance)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		toBurn = map[ids.ID]uint64{
+			b.context.AVAXAssetID: balance,
+		}
+		toStake        = map[ids.ID]uint64{}
+		ops            = common.NewOptions(options)
+		memo           = ops.Memo()
+		memoComplexity = gas.Dimensions{
+			gas.Bandwidth: uint64(len(memo)),
+		}
+	)
+	operationsComplexity, err := fee.L1ValidatorOpComplexity(operations...)
+	if err != nil {
+		return nil, err
+	}
+	complexity, err := fee.IntrinsicBatchL1ValidatorTxComplexities.Add(
+		&memoComplexity,
+		&operationsComplexity,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, outputs, _, err := b.spend(
+		toBurn,
+		toStake,
+		0,
+		complexity,
+		nil,
+		ops,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &txs.BatchL1ValidatorTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    b.context.NetworkID,
+			BlockchainID: constants.PlatformChainID,
+			Ins:          inputs,
+			Outs:         outputs,
+			Memo:         memo,
+		}},
+		Subnet:     subnetID,
+		Operations: operations,
+	}
+	return tx, b.initCtx(tx)
+}
+
 func (b *builder) NewImportTx(
 	sourceChainID ids.ID,
 	to *secp256k1fx.OutputOwners,
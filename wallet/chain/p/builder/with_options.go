@@ -217,6 +217,18 @@ func (w *withOptions) NewDisableL1ValidatorTx(
 	)
 }
 
+func (w *withOptions) NewBatchL1ValidatorTx(
+	subnetID ids.ID,
+	operations []txs.L1ValidatorOp,
+	options ...common.Option,
+) (*txs.BatchL1ValidatorTx, error) {
+	return w.builder.NewBatchL1ValidatorTx(
+		subnetID,
+		operations,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *withOptions) NewImportTx(
 	sourceChainID ids.ID,
 	to *secp256k1fx.OutputOwners,
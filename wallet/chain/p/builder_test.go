@@ -35,6 +35,8 @@ import (
 	"github.com/ava-labs/avalanchego/wallet/chain/p/wallet"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
 	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common/utxotest"
+
+	walletsigner "github.com/ava-labs/avalanchego/wallet/chain/p/signer"
 )
 
 var (
@@ -905,6 +907,152 @@ func TestDisableL1ValidatorTx(t *testing.T) {
 	}
 }
 
+func TestBatchL1ValidatorTx(t *testing.T) {
+	const (
+		balance = units.Avax
+		weight  = 7905001371
+	)
+
+	sk, err := localsigner.New()
+	require.NoError(t, err)
+	pop, err := signer.NewProofOfPossession(sk)
+	require.NoError(t, err)
+
+	// newWarpMessage returns a Warp message carrying [addressedCallPayload]
+	// signed by [sk].
+	newWarpMessage := func(addressedCallPayload []byte) []byte {
+		addressedCall, err := payload.NewAddressedCall(
+			utils.RandomBytes(20),
+			addressedCallPayload,
+		)
+		require.NoError(t, err)
+
+		unsignedWarp, err := warp.NewUnsignedMessage(
+			constants.UnitTestID,
+			ids.GenerateTestID(),
+			addressedCall.Bytes(),
+		)
+		require.NoError(t, err)
+
+		sig, err := sk.Sign(unsignedWarp.Bytes())
+		require.NoError(t, err)
+
+		msg, err := warp.NewMessage(
+			unsignedWarp,
+			&warp.BitSetSignature{
+				Signers: set.NewBits(0).Bytes(),
+				Signature: ([bls.SignatureLen]byte)(
+					bls.SignatureToBytes(sig),
+				),
+			},
+		)
+		require.NoError(t, err)
+		return msg.Bytes()
+	}
+
+	registerPayload, err := message.NewRegisterL1Validator(
+		subnetID,
+		nodeID,
+		pop.PublicKey,
+		uint64(time.Now().Unix()),
+		message.PChainOwner{
+			Threshold: 1,
+			Addresses: []ids.ShortID{
+				ids.GenerateTestShortID(),
+			},
+		},
+		message.PChainOwner{
+			Threshold: 1,
+			Addresses: []ids.ShortID{
+				ids.GenerateTestShortID(),
+			},
+		},
+		weight,
+	)
+	require.NoError(t, err)
+	weightPayload, err := message.NewL1ValidatorWeight(
+		ids.GenerateTestID(),
+		1,
+		weight,
+	)
+	require.NoError(t, err)
+	// Setting the weight of an L1 validator to 0 removes it.
+	removalPayload, err := message.NewL1ValidatorWeight(
+		validationID,
+		1,
+		0,
+	)
+	require.NoError(t, err)
+
+	operations := []txs.L1ValidatorOp{
+		&txs.RegisterL1ValidatorOp{
+			Balance:           balance,
+			ProofOfPossession: pop.ProofOfPossession,
+			Message:           newWarpMessage(registerPayload.Bytes()),
+		},
+		&txs.RegisterL1ValidatorOp{
+			Balance:           2 * balance,
+			ProofOfPossession: pop.ProofOfPossession,
+			Message:           newWarpMessage(registerPayload.Bytes()),
+		},
+		&txs.SetL1ValidatorWeightOp{
+			Message: newWarpMessage(weightPayload.Bytes()),
+		},
+		&txs.SetL1ValidatorWeightOp{
+			Message: newWarpMessage(removalPayload.Bytes()),
+		},
+	}
+	for _, e := range testEnvironment {
+		t.Run(e.name, func(t *testing.T) {
+			var (
+				require    = require.New(t)
+				chainUTXOs = utxotest.NewDeterministicChainUTXOs(t, map[ids.ID][]*avax.UTXO{
+					constants.PlatformChainID: utxos,
+				})
+				backend  = wallet.NewBackend(chainUTXOs, nil)
+				builder  = builder.New(set.Of(utxoAddr), e.context, backend)
+				txSigner = walletsigner.New(secp256k1fx.NewKeychain(utxoKey), backend)
+			)
+
+			utx, err := builder.NewBatchL1ValidatorTx(
+				subnetID,
+				operations,
+				common.WithMemo(e.memo),
+			)
+			require.NoError(err)
+			require.Equal(subnetID, utx.Subnet)
+			require.Equal(operations, utx.Operations)
+			require.Equal(types.JSONByteSlice(e.memo), utx.Memo)
+			requireFeeIsCorrect(
+				require,
+				e.feeCalculator,
+				utx,
+				&utx.BaseTx.BaseTx,
+				nil,
+				nil,
+				map[ids.ID]uint64{
+					e.context.AVAXAssetID: 3 * balance, // Balances of the registered validators
+				},
+			)
+
+			tx, err := walletsigner.SignUnsigned(t.Context(), txSigner, utx)
+			require.NoError(err)
+			require.Len(tx.Creds, len(utx.Ins))
+
+			unsignedBytes := tx.Unsigned.Bytes()
+			for _, credIntf := range tx.Creds {
+				require.IsType(&secp256k1fx.Credential{}, credIntf)
+				cred := credIntf.(*secp256k1fx.Credential)
+				require.Len(cred.Sigs, 1)
+
+				pk, err := secp256k1.RecoverPublicKey(unsignedBytes, cred.Sigs[0][:])
+				require.NoError(err)
+				require.Equal(utxoAddr, pk.Address())
+			}
+		})
+	}
+}
+
 func makeTestUTXOs(utxosKey *secp256k1.PrivateKey) []*avax.UTXO {
 	// Note: we avoid ids.GenerateTestNodeID here to make sure that UTXO IDs
 	// won't change run by run. This simplifies checking what utxos are included
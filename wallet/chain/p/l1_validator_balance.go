@@ -0,0 +1,42 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/wallet"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+)
+
+// TopUpL1ValidatorBalance increases the balance of the L1 validator with
+// [validationID] to [target] if its current balance is below [threshold].
+//
+// If the balance is not below [threshold], no transaction is issued and nil is
+// returned.
+func TopUpL1ValidatorBalance(
+	c *platformvm.Client,
+	w wallet.Wallet,
+	validationID ids.ID,
+	threshold uint64,
+	target uint64,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	ctx := common.NewOptions(options).Context()
+	l1Validator, _, err := c.GetL1Validator(ctx, validationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L1 validator %s: %w", validationID, err)
+	}
+	if l1Validator.Balance >= threshold || l1Validator.Balance >= target {
+		return nil, nil
+	}
+	return w.IssueIncreaseL1ValidatorBalanceTx(
+		validationID,
+		target-l1Validator.Balance,
+		options...,
+	)
+}
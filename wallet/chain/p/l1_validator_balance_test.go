@@ -0,0 +1,147 @@
+// Copyright (C) 2019-2025, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package p
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/rpc"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/api"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/builder"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/wallet"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common/utxotest"
+
+	walletsigner "github.com/ava-labs/avalanchego/wallet/chain/p/signer"
+)
+
+var errTestGetL1Validator = errors.New("test get L1 validator error")
+
+// l1ValidatorRequester replies to platform.getL1Validator requests with
+// [balance], or fails them with [err].
+type l1ValidatorRequester struct {
+	balance uint64
+	err     error
+}
+
+func (r *l1ValidatorRequester) SendRequest(_ context.Context, _ string, _ interface{}, reply interface{}, _ ...rpc.Option) error {
+	if r.err != nil {
+		return r.err
+	}
+	balance := json.Uint64(r.balance)
+	*reply.(*platformvm.GetL1ValidatorReply) = platformvm.GetL1ValidatorReply{
+		APIL1Validator: api.APIL1Validator{
+			BaseL1Validator: api.BaseL1Validator{
+				RemainingBalanceOwner: &api.Owner{},
+				DeactivationOwner:     &api.Owner{},
+				Balance:               &balance,
+			},
+		},
+	}
+	return nil
+}
+
+// issuedTxs records the txs issued by a wallet.
+type issuedTxs []*txs.Tx
+
+func (i *issuedTxs) IssueTx(tx *txs.Tx, _ ...common.Option) error {
+	*i = append(*i, tx)
+	return nil
+}
+
+func TestTopUpL1ValidatorBalance(t *testing.T) {
+	const (
+		threshold = units.Avax
+		target    = 2 * units.Avax
+	)
+	validationID := ids.GenerateTestID()
+	tests := []struct {
+		name            string
+		requester       *l1ValidatorRequester
+		expectedErr     error
+		expectedBalance uint64 // 0 if no tx is expected to be issued
+	}{
+		{
+			name: "fetch failure",
+			requester: &l1ValidatorRequester{
+				err: errTestGetL1Validator,
+			},
+			expectedErr: errTestGetL1Validator,
+		},
+		{
+			name: "balance above threshold",
+			requester: &l1ValidatorRequester{
+				balance: threshold + 1,
+			},
+		},
+		{
+			name: "balance at threshold",
+			requester: &l1ValidatorRequester{
+				balance: threshold,
+			},
+		},
+		{
+			name: "empty balance",
+			requester: &l1ValidatorRequester{
+				balance: 0,
+			},
+			expectedBalance: target,
+		},
+		{
+			name: "balance below threshold",
+			requester: &l1ValidatorRequester{
+				balance: threshold - 1,
+			},
+			expectedBalance: target - threshold + 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				require    = require.New(t)
+				chainUTXOs = utxotest.NewDeterministicChainUTXOs(t, map[ids.ID][]*avax.UTXO{
+					constants.PlatformChainID: utxos,
+				})
+				backend = wallet.NewBackend(chainUTXOs, nil)
+				issued  issuedTxs
+				w       = wallet.New(
+					&issued,
+					builder.New(set.Of(utxoAddr), testContextPostEtna, backend),
+					walletsigner.New(secp256k1fx.NewKeychain(utxoKey), backend),
+				)
+				c = &platformvm.Client{
+					Requester: test.requester,
+				}
+			)
+
+			tx, err := TopUpL1ValidatorBalance(c, w, validationID, threshold, target)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedBalance == 0 {
+				require.Nil(tx)
+				require.Empty(issued)
+				return
+			}
+
+			require.Equal(issuedTxs{tx}, issued)
+			require.IsType(&txs.IncreaseL1ValidatorBalanceTx{}, tx.Unsigned)
+			utx := tx.Unsigned.(*txs.IncreaseL1ValidatorBalanceTx)
+			require.Equal(validationID, utx.ValidationID)
+			require.Equal(test.expectedBalance, utx.Balance)
+			require.Len(tx.Creds, len(utx.Ins))
+		})
+	}
+}
@@ -198,6 +198,17 @@ type Wallet interface {
 		options ...common.Option,
 	) (*txs.Tx, error)
 
+	// IssueBatchL1ValidatorTx creates, signs, and issues a transaction that
+	// atomically applies multiple validator operations to an L1.
+	//
+	// - [subnetID] of the L1 that every operation modifies
+	// - [operations] to apply, in order
+	IssueBatchL1ValidatorTx(
+		subnetID ids.ID,
+		operations []txs.L1ValidatorOp,
+		options ...common.Option,
+	) (*txs.Tx, error)
+
 	// IssueImportTx creates, signs, and issues an import transaction that
 	// attempts to consume all the available UTXOs and import the funds to [to].
 	//
@@ -499,6 +510,18 @@ func (w *wallet) IssueDisableL1ValidatorTx(
 	return w.IssueUnsignedTx(utx, options...)
 }
 
+func (w *wallet) IssueBatchL1ValidatorTx(
+	subnetID ids.ID,
+	operations []txs.L1ValidatorOp,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	utx, err := w.builder.NewBatchL1ValidatorTx(subnetID, operations, options...)
+	if err != nil {
+		return nil, err
+	}
+	return w.IssueUnsignedTx(utx, options...)
+}
+
 func (w *wallet) IssueImportTx(
 	sourceChainID ids.ID,
 	to *secp256k1fx.OutputOwners,
@@ -206,6 +206,18 @@ func (w *withOptions) IssueDisableL1ValidatorTx(
 	)
 }
 
+func (w *withOptions) IssueBatchL1ValidatorTx(
+	subnetID ids.ID,
+	operations []txs.L1ValidatorOp,
+	options ...common.Option,
+) (*txs.Tx, error) {
+	return w.wallet.IssueBatchL1ValidatorTx(
+		subnetID,
+		operations,
+		common.UnionOptions(w.options, options)...,
+	)
+}
+
 func (w *withOptions) IssueImportTx(
 	sourceChainID ids.ID,
 	to *secp256k1fx.OutputOwners,
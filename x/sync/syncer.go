@@ -33,6 +33,15 @@ const (
 	initialRetryWait            = 10 * time.Millisecond
 	maxRetryWait                = time.Second
 	retryWaitFactor             = 1.5 // Larger --> timeout grows more quickly
+
+	// maxRequestRetries is the number of times a failed proof request is sent
+	// to a different peer before its work item is retried.
+	maxRequestRetries = 2
+	// Proof requests that haven't been responded to within the
+	// [hedgingPercentile] of recent response latencies are also sent to a
+	// different peer.
+	hedgingPercentile   = .9
+	defaultHedgingDelay = time.Second
 )
 
 var (
@@ -152,6 +161,9 @@ type Config[R any, C any] struct {
 	TargetRoot            ids.ID
 	EmptyRoot             ids.ID
 	StateSyncNodes        []ids.NodeID
+	// PeerTracker tracks the response latencies used to hedge slow proof
+	// requests. If nil, the syncer creates its own.
+	PeerTracker *p2p.PeerTracker
 }
 
 func NewSyncer[R any, C any](
@@ -181,6 +193,29 @@ func NewSyncer[R any, C any](
 		return nil, err
 	}
 
+	if config.PeerTracker == nil {
+		config.PeerTracker, err = p2p.NewPeerTracker(
+			config.Log,
+			"sync_peer_tracker",
+			registerer,
+			nil,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	clientOptions := []p2p.ClientOption{
+		p2p.WithRetries(maxRequestRetries),
+		p2p.WithHedging(
+			config.PeerTracker,
+			hedgingPercentile,
+			defaultHedgingDelay,
+		),
+	}
+	config.RangeProofClient = config.RangeProofClient.WithOptions(clientOptions...)
+	config.ChangeProofClient = config.ChangeProofClient.WithOptions(clientOptions...)
+
 	m := &Syncer[R, C]{
 		db:              db,
 		config:          config,